@@ -8,8 +8,11 @@ import (
 	"os/signal"
 	"syscall"
 
+	"github.com/kyma-project/control-plane/components/kubeconfig-service/pkg/audit"
 	"github.com/kyma-project/control-plane/components/kubeconfig-service/pkg/authn"
+	"github.com/kyma-project/control-plane/components/kubeconfig-service/pkg/grant"
 	"github.com/kyma-project/control-plane/components/kubeconfig-service/pkg/reload"
+	"github.com/kyma-project/control-plane/components/kubeconfig-service/pkg/roles"
 	"github.com/kyma-project/control-plane/components/kubeconfig-service/pkg/runtime"
 	"k8s.io/apiserver/pkg/authentication/authenticator"
 
@@ -34,11 +37,28 @@ func main() {
 		log.Fatalf("Cannot create OIDC Authenticator, %v", err)
 	}
 
-	ec := endpoints.NewEndpointClient(env.Config.GraphqlURL)
+	catalogue, err := roles.Load(env.Config.Roles.CataloguePath)
+	if err != nil {
+		log.Fatalf("Cannot load role catalogue, %v", err)
+	}
+
+	kcpK8s, err := runtime.GetK8sClient()
+	if err != nil {
+		log.Fatalf("Cannot create KCP client, %v", err)
+	}
+	grants := grant.NewConfigMapRegistry(kcpK8s, runtime.KcpNamespace)
+	reconciler := grant.NewReconciler(grants, runtime.NewGrantRevoker(env.Config.GraphqlURL), env.Config.Grant.ReconcileInterval)
+
+	auditor := audit.NewRecorder(env.Config.Audit.URL, env.Config.Audit.Timeout)
+	ec := endpoints.NewEndpointClient(env.Config.GraphqlURL, grants, catalogue, auditor, env.Config.OIDC.IssuerURL, env.Config.Grant.TTL, env.Config.Grant.MaxTTL)
 	router := mux.NewRouter()
 	router.Use(authn.AuthMiddleware(oidcAuthenticator))
 	router.Methods("GET").Path("/kubeconfig/{tenantID}/{runtimeID}").HandlerFunc(ec.GetKubeConfig)
 
+	adminRouter := mux.NewRouter()
+	adminRouter.Use(authn.AuthMiddleware(oidcAuthenticator), authn.RequireRole(runtime.RUNTIME_ADMIN))
+	grant.NewHandler(grants, reconciler).AttachRoutes(adminRouter)
+
 	healthRouter := mux.NewRouter()
 	healthRouter.Methods("GET").Path("/health/ready").HandlerFunc(ec.GetHealthStatus)
 
@@ -46,16 +66,16 @@ func main() {
 	signal.Notify(term, os.Interrupt, syscall.SIGTERM)
 
 	go func() {
-		err := runtime.SetupConfigMap()
+		err := grant.MigrateLegacyConfigMaps(kcpK8s, runtime.KcpNamespace, grants)
 		if err != nil {
-			log.Errorf("Error initializing ConfigMaps: %v", err)
+			log.Errorf("Error migrating ConfigMaps: %v", err)
 			term <- os.Interrupt
+			return
 		}
-		log.Infof("ConfigMaps initialization completed.")
+		log.Infof("Grants reconciliation started.")
+		reconciler.Run(fileWatcherCtx)
 	}()
 
-	log.Infof("ConfigMaps initialization started.")
-
 	go func() {
 		err := http.ListenAndServe(fmt.Sprintf(":%d", env.Config.Port.Service), router)
 		log.Errorf("Error serving HTTP: %v", err)
@@ -72,6 +92,14 @@ func main() {
 
 	log.Infof("Health endpoint started on port: %d", env.Config.Port.Health)
 
+	go func() {
+		err := http.ListenAndServe(fmt.Sprintf(":%d", env.Config.Port.Admin), adminRouter)
+		log.Errorf("Error serving HTTP: %v", err)
+		term <- os.Interrupt
+	}()
+
+	log.Infof("Admin endpoint started on port: %d", env.Config.Port.Admin)
+
 	log.Infof("Using GraphQL Service: %s", env.Config.GraphqlURL)
 	select {
 	case <-term:
@@ -0,0 +1,98 @@
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const source = "kubeconfig-service"
+
+// Record is the audit record of a kubeconfig issued to a user, stored by Kyma Environment Broker
+type Record struct {
+	Source          string    `json:"source"`
+	UserID          string    `json:"userID"`
+	Issuer          string    `json:"issuer,omitempty"`
+	Groups          []string  `json:"groups,omitempty"`
+	GlobalAccountID string    `json:"globalAccountID,omitempty"`
+	RuntimeID       string    `json:"runtimeID"`
+	Role            string    `json:"role,omitempty"`
+	SourceIP        string    `json:"sourceIP,omitempty"`
+	UserAgent       string    `json:"userAgent,omitempty"`
+	ExpiresAt       time.Time `json:"expiresAt"`
+}
+
+// Recorder stores the audit records of the issued kubeconfigs
+type Recorder interface {
+	Record(record Record) error
+}
+
+// NewRecorder returns the recorder sending the records to the /audit/kubeconfigs API of Kyma Environment Broker,
+// the records are not stored if the url is empty
+func NewRecorder(url string, timeout time.Duration) Recorder {
+	if url == "" {
+		return noopRecorder{}
+	}
+	return &client{
+		url:        strings.TrimSuffix(url, "/"),
+		httpClient: &http.Client{Timeout: timeout},
+	}
+}
+
+type client struct {
+	url        string
+	httpClient *http.Client
+}
+
+func (c *client) Record(record Record) error {
+	record.Source = source
+	body, err := json.Marshal(record)
+	if err != nil {
+		return errors.Wrap(err, "while encoding audit record")
+	}
+
+	resp, err := c.httpClient.Post(fmt.Sprintf("%s/audit/kubeconfigs", c.url), "application/json", bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "while sending audit record")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		return fmt.Errorf("storing audit record returned %s status", resp.Status)
+	}
+	return nil
+}
+
+type noopRecorder struct{}
+
+func (noopRecorder) Record(Record) error {
+	return nil
+}
+
+// SourceIP returns the address of the client. Only the last X-Forwarded-For hop, appended by the Istio ingress gateway,
+// can be trusted, the previous ones are sent by the client. Values which are not IP addresses are returned as an empty string.
+func SourceIP(req *http.Request) string {
+	if forwarded := req.Header.Get("X-Forwarded-For"); forwarded != "" {
+		hops := strings.Split(forwarded, ",")
+		return validIP(hops[len(hops)-1])
+	}
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		return validIP(req.RemoteAddr)
+	}
+	return validIP(host)
+}
+
+func validIP(value string) string {
+	ip := net.ParseIP(strings.TrimSpace(value))
+	if ip == nil {
+		return ""
+	}
+	return ip.String()
+}
@@ -0,0 +1,67 @@
+package audit
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecorder(t *testing.T) {
+	t.Run("should send record to the broker", func(t *testing.T) {
+		// given
+		var received Record
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "/audit/kubeconfigs", r.URL.Path)
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+			w.WriteHeader(http.StatusCreated)
+		}))
+		defer server.Close()
+		recorder := NewRecorder(server.URL+"/", time.Second)
+
+		// when
+		err := recorder.Record(Record{UserID: "john.smith@email.com", RuntimeID: "runtime-id", Role: "runtimeAdmin"})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, source, received.Source)
+		assert.Equal(t, "john.smith@email.com", received.UserID)
+		assert.Equal(t, "runtimeAdmin", received.Role)
+	})
+
+	t.Run("should fail when the broker does not store the record", func(t *testing.T) {
+		// given
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+
+		// when
+		err := NewRecorder(server.URL, time.Second).Record(Record{RuntimeID: "runtime-id"})
+
+		// then
+		assert.Error(t, err)
+	})
+
+	t.Run("should not record without url", func(t *testing.T) {
+		assert.NoError(t, NewRecorder("", time.Second).Record(Record{}))
+	})
+}
+
+func TestSourceIP(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/kubeconfig", nil)
+	req.RemoteAddr = "10.0.0.3:4321"
+	assert.Equal(t, "10.0.0.3", SourceIP(req))
+
+	req.Header.Set("X-Forwarded-For", "10.0.0.1, 10.1.1.1")
+	assert.Equal(t, "10.1.1.1", SourceIP(req))
+
+	req.Header.Set("X-Forwarded-For", "10.0.0.1, "+strings.Repeat("a", 100))
+	assert.Empty(t, SourceIP(req))
+}
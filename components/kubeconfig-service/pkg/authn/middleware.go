@@ -35,6 +35,8 @@ type UserInfo struct {
 	ID   string
 	Role string
 	Exp  time.Time
+	// Groups from the oidc token, they are mapped to the roles which can be requested by the role catalogue
+	Groups []string
 }
 
 func AuthMiddleware(a authenticator.Request) func(http.Handler) http.Handler {
@@ -62,6 +64,21 @@ func AuthMiddleware(a authenticator.Request) func(http.Handler) http.Handler {
 	}
 }
 
+// RequireRole rejects requests of users without the role, it must be used after AuthMiddleware
+func RequireRole(role string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			userInfo, ok := r.Context().Value("userInfo").(UserInfo)
+			if !ok || userInfo.Role != role {
+				http.Error(w, fmt.Sprintf("Forbidden, %s role required", role), http.StatusForbidden)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
 func ValidateToken(r *http.Request) (UserInfo, string, int) {
 	var userInfo = UserInfo{ID: "", Role: "", Exp: time.Time{}}
 	authHeader := strings.Split(r.Header.Get("Authorization"), "Bearer ")
@@ -82,6 +99,7 @@ func ValidateToken(r *http.Request) (UserInfo, string, int) {
 		}
 
 		userInfo.Role = role
+		userInfo.Groups = extractGroups(dat)
 		userInfo.ID = ExtractUserID(dat)
 		userInfo.Exp = extractExpiredData(dat)
 	}
@@ -157,17 +175,30 @@ func extractRole(dat map[string]interface{}) (string, string, int) {
 		return role, NO_GROUPS_IN_TOKEN, http.StatusForbidden
 	}
 
+	// users without the L2/L3 groups can still request the roles of the catalogue mapped to their groups
 	data := dataAsString(dat[groups])
 	if strings.Contains(data, L2L3OperatiorRoles[0]) {
 		role = L2L3OperatiorRoles[0]
 	} else if strings.Contains(data, L2L3OperatiorRoles[1]) {
 		role = L2L3OperatiorRoles[1]
-	} else {
-		return role, fmt.Sprintf("Not found %s in oidc token", L2L3OperatiorRoles), http.StatusForbidden
 	}
 	return role, "", http.StatusOK
 }
 
+func extractGroups(dat map[string]interface{}) []string {
+	var result []string
+	switch values := dat[groups].(type) {
+	case []interface{}:
+		for _, value := range values {
+			result = append(result, fmt.Sprintf("%v", value))
+		}
+	case string:
+		result = append(result, values)
+	default:
+	}
+	return result
+}
+
 func ParseToken(jwtToken string) (map[string]interface{}, string, int) {
 	errMsg, code := "", http.StatusOK
 	var dat map[string]interface{}
@@ -1,6 +1,7 @@
 package authn
 
 import (
+	"context"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -148,6 +149,44 @@ func TestAuthMiddleware(t *testing.T) {
 	})
 }
 
+func TestRequireRole(t *testing.T) {
+	t.Run("When user has the role", func(t *testing.T) {
+		authenticated := &mockAuthenticator{Authorised: true}
+		next := &mockHandler{}
+		response := httptest.NewRecorder()
+		AuthMiddleware(authenticated)(RequireRole("runtimeAdmin")(next)).ServeHTTP(response, newHttpRequest(token_containAdmin))
+
+		t.Run("Then next handler is called", func(t *testing.T) {
+			assert.True(t, next.Called)
+		})
+	})
+
+	t.Run("When user has another role", func(t *testing.T) {
+		next := &mockHandler{}
+		response := httptest.NewRecorder()
+		req := newHttpRequest(token_containAdmin)
+		req = req.WithContext(context.WithValue(req.Context(), "userInfo", UserInfo{ID: "user", Role: "runtimeOperator"}))
+		RequireRole("runtimeAdmin")(next).ServeHTTP(response, req)
+
+		t.Run("Then next handler is not called", func(t *testing.T) {
+			assert.False(t, next.Called)
+		})
+		t.Run("Then request is rejected with status code forbidden", func(t *testing.T) {
+			assert.Equal(t, http.StatusForbidden, response.Code)
+		})
+	})
+
+	t.Run("When user info is missing", func(t *testing.T) {
+		next := &mockHandler{}
+		response := httptest.NewRecorder()
+		RequireRole("runtimeAdmin")(next).ServeHTTP(response, newHttpRequest(token_containAdmin))
+
+		t.Run("Then request is rejected with status code forbidden", func(t *testing.T) {
+			assert.Equal(t, http.StatusForbidden, response.Code)
+		})
+	})
+}
+
 type mockAuthenticator struct {
 	UserInfo   user.Info
 	Authorised bool
@@ -195,3 +234,12 @@ func parseResponseBody(body io.Reader) string {
 	}
 	return strings.TrimSpace(string(b))
 }
+
+func TestValidateToken(t *testing.T) {
+	userInfo, errMsg, code := ValidateToken(newHttpRequest(token_containAdmin))
+
+	assert.Empty(t, errMsg)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "runtimeAdmin", userInfo.Role)
+	assert.Equal(t, []string{"runtimeAdmin", "runtimeOperator"}, userInfo.Groups)
+}
@@ -2,13 +2,16 @@ package endpoints
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
-	"sync"
 	"time"
 
 	"github.com/gorilla/mux"
+	"github.com/kyma-project/control-plane/components/kubeconfig-service/pkg/audit"
 	authn "github.com/kyma-project/control-plane/components/kubeconfig-service/pkg/authn"
 	"github.com/kyma-project/control-plane/components/kubeconfig-service/pkg/caller"
+	"github.com/kyma-project/control-plane/components/kubeconfig-service/pkg/grant"
+	"github.com/kyma-project/control-plane/components/kubeconfig-service/pkg/roles"
 	run "github.com/kyma-project/control-plane/components/kubeconfig-service/pkg/runtime"
 	"github.com/kyma-project/control-plane/components/kubeconfig-service/pkg/transformer"
 	log "github.com/sirupsen/logrus"
@@ -17,20 +20,37 @@ import (
 const (
 	mimeTypeYaml = "application/x-yaml"
 	mimeTypeText = "text/plain"
-)
 
-// mutex to avoid critical section in config map deployment
-var mu sync.Mutex
+	// minTTL is the minimal expiration of the tokens accepted by the TokenRequest API
+	minTTL = 10 * time.Minute
+)
 
 // EndpointClient Wrpper for Endpoints
 type EndpointClient struct {
-	gqlURL string
+	gqlURL    string
+	grants    grant.Registry
+	catalogue *roles.Catalogue
+	auditor   audit.Recorder
+	issuer    string
+	ttl       time.Duration
+	maxTTL    time.Duration
+	nowFunc   func() time.Time
 }
 
 // NewEndpointClient return new instance of EndpointClient
-func NewEndpointClient(gqlURL string) *EndpointClient {
+// the kubeconfigs expire after the ttl, users can request shorter or longer expiration up to the maxTTL
+// the role granted with the kubeconfig is resolved from the groups of the user by the catalogue
+// every issued kubeconfig is recorded by the auditor with the issuer of the user tokens, no kubeconfig is issued if recording fails
+func NewEndpointClient(gqlURL string, grants grant.Registry, catalogue *roles.Catalogue, auditor audit.Recorder, issuer string, ttl, maxTTL time.Duration) *EndpointClient {
 	return &EndpointClient{
-		gqlURL: gqlURL,
+		gqlURL:    gqlURL,
+		grants:    grants,
+		catalogue: catalogue,
+		auditor:   auditor,
+		issuer:    issuer,
+		ttl:       ttl,
+		maxTTL:    maxTTL,
+		nowFunc:   time.Now,
 	}
 }
 
@@ -40,25 +60,50 @@ func (ec EndpointClient) GetKubeConfig(w http.ResponseWriter, req *http.Request)
 	tenant := vars["tenantID"]
 	runtime := vars["runtimeID"]
 
-	var err error
-	var kubeConfig []byte
 	userInfo, ok := req.Context().Value("userInfo").(authn.UserInfo)
-	if ok {
-		log.Infof("Generating kubeconfig for %s/%s %s", tenant, runtime, userInfo)
-		kubeConfig, err = ec.generateKubeConfig(tenant, runtime, userInfo)
-	} else {
-		err = errors.New("User info is null")
+	if !ok {
+		writeError(w, http.StatusInternalServerError, errors.New("User info is null"))
+		return
+	}
+
+	role, err := ec.catalogue.Resolve(userInfo.Groups, req.URL.Query().Get("role"))
+	if errors.Is(err, roles.ErrForbidden) {
+		writeError(w, http.StatusForbidden, err)
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	namespaces, err := parseNamespaces(req, role)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
 	}
 
+	ttl, err := ec.parseTTL(req, role)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	log.Infof("Generating kubeconfig for %s/%s %s with role %s valid for %s", tenant, runtime, userInfo.ID, role.Name, ttl)
+	record := audit.Record{
+		UserID:          userInfo.ID,
+		Issuer:          ec.issuer,
+		Groups:          userInfo.Groups,
+		GlobalAccountID: tenant,
+		RuntimeID:       runtime,
+		Role:            role.Name,
+		SourceIP:        audit.SourceIP(req),
+		UserAgent:       req.UserAgent(),
+	}
+	kubeConfig, err := ec.generateKubeConfig(tenant, runtime, userInfo, role, namespaces, ttl, record)
 	if err != nil {
-		w.Header().Add("Content-Type", mimeTypeText)
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Header().Set("Content-Security-Policy", "default-src 'none';")
-		_, err2 := w.Write([]byte(err.Error()))
 		log.Errorf("Error while processing the kubeconfig file: %s", err)
-		if err2 != nil {
-			log.Errorf("Error while sending response: %s", err2)
-		}
+		writeError(w, http.StatusInternalServerError, err)
+		return
 	}
 	w.Header().Add("Content-Type", mimeTypeYaml)
 	_, err = w.Write(kubeConfig)
@@ -72,6 +117,53 @@ func (ec EndpointClient) GetHealthStatus(w http.ResponseWriter, req *http.Reques
 	w.WriteHeader(http.StatusOK)
 }
 
+// parseTTL returns the expiration requested with the ttl query parameter or the default one, both limited by the role
+func (ec EndpointClient) parseTTL(req *http.Request, role roles.Role) (time.Duration, error) {
+	value := req.URL.Query().Get("ttl")
+	if value == "" {
+		return role.LimitTTL(ec.ttl), nil
+	}
+	ttl, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid ttl %q: %s", value, err)
+	}
+	maxTTL := role.LimitTTL(ec.maxTTL)
+	if ttl < minTTL || ttl > maxTTL {
+		return 0, fmt.Errorf("ttl must be between %s and %s for role %s", minTTL, maxTTL, role.Name)
+	}
+	return ttl, nil
+}
+
+// parseNamespaces returns the namespaces requested with the namespace query parameters, they are required only for namespaced roles
+func parseNamespaces(req *http.Request, role roles.Role) ([]string, error) {
+	namespaces := req.URL.Query()["namespace"]
+	if !role.Namespaced {
+		if len(namespaces) > 0 {
+			return nil, fmt.Errorf("role %s is not namespaced", role.Name)
+		}
+		return nil, nil
+	}
+	if len(namespaces) == 0 {
+		return nil, fmt.Errorf("role %s requires the namespace query parameter", role.Name)
+	}
+	for _, namespace := range namespaces {
+		if !role.AllowsNamespace(namespace) {
+			return nil, fmt.Errorf("namespace %s not allowed for role %s", namespace, role.Name)
+		}
+	}
+	return namespaces, nil
+}
+
+func writeError(w http.ResponseWriter, code int, err error) {
+	w.Header().Add("Content-Type", mimeTypeText)
+	w.Header().Set("Content-Security-Policy", "default-src 'none';")
+	w.WriteHeader(code)
+	_, err2 := w.Write([]byte(err.Error()))
+	if err2 != nil {
+		log.Errorf("Error while sending response: %s", err2)
+	}
+}
+
 func (ec EndpointClient) callGQL(tenantID, runtimeID string) (string, error) {
 	c := caller.NewCaller(ec.gqlURL, tenantID)
 	status, err := c.RuntimeStatus(runtimeID)
@@ -81,7 +173,7 @@ func (ec EndpointClient) callGQL(tenantID, runtimeID string) (string, error) {
 	return *status.RuntimeConfiguration.Kubeconfig, nil
 }
 
-func (ec EndpointClient) generateKubeConfig(tenant, runtime string, userInfo authn.UserInfo) ([]byte, error) {
+func (ec EndpointClient) generateKubeConfig(tenant, runtime string, userInfo authn.UserInfo, role roles.Role, namespaces []string, ttl time.Duration, record audit.Record) ([]byte, error) {
 	rawConfig, err := ec.callGQL(tenant, runtime)
 	if err != nil || rawConfig == "" {
 		return nil, err
@@ -92,12 +184,13 @@ func (ec EndpointClient) generateKubeConfig(tenant, runtime string, userInfo aut
 		return nil, err
 	}
 
-	runtimeClient, err := run.NewRuntimeClient([]byte(rawConfig), userInfo.ID, userInfo.Role, tenant)
+	runtimeClient, err := run.NewRuntimeClient([]byte(rawConfig), userInfo.ID, role, tenant)
 	if err != nil {
 		return nil, err
 	}
+	runtimeClient.Namespaces = namespaces
 
-	tc.SaToken, err = runtimeClient.Run()
+	tc.SaToken, err = runtimeClient.Run(ttl)
 	if err != nil {
 		return nil, err
 	}
@@ -107,16 +200,37 @@ func (ec EndpointClient) generateKubeConfig(tenant, runtime string, userInfo aut
 		return nil, err
 	}
 
-	mu.Lock()
-	startTime := time.Now()
-	err = runtimeClient.DeployConfigMap(runtime, userInfo.Role, startTime)
-	mu.Unlock()
+	issuedAt := ec.nowFunc()
+	err = ec.grants.Save(grant.Grant{
+		UserID:    userInfo.ID,
+		TenantID:  tenant,
+		RuntimeID: runtime,
+		Role:      role.Name,
+		IssuedAt:  issuedAt,
+		ExpiresAt: issuedAt.Add(ttl),
+	})
 	if err != nil {
-		log.Errorf("Cannot generate config map, %s", err.Error())
+		log.Errorf("Cannot save grant, %s", err.Error())
+		return nil, err
+	}
+
+	record.ExpiresAt = issuedAt.Add(ttl)
+	if err := ec.auditor.Record(record); err != nil {
+		log.Errorf("Cannot record issued kubeconfig, %s", err.Error())
 		return nil, err
 	}
 
-	go runtimeClient.SetupTimer(startTime, runtime)
+	log.WithFields(log.Fields{
+		"audit":      "kubeconfig-issued",
+		"userID":     userInfo.ID,
+		"groups":     userInfo.Groups,
+		"tenantID":   tenant,
+		"runtimeID":  runtime,
+		"role":       role.Name,
+		"namespaces": namespaces,
+		"issuedAt":   issuedAt.UTC().Format(time.RFC3339),
+		"expiresAt":  issuedAt.Add(ttl).UTC().Format(time.RFC3339),
+	}).Info("Issued kubeconfig")
 
 	return saKubeConfig, nil
 }
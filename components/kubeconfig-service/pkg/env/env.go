@@ -1,6 +1,8 @@
 package env
 
 import (
+	"time"
+
 	"github.com/vrischmann/envconfig"
 )
 
@@ -12,6 +14,20 @@ type EnvConfig struct {
 	Port struct {
 		Service int `envconfig:"default=8000"`
 		Health  int `envconfig:"default=9000"`
+		Admin   int `envconfig:"default=9001"`
+	}
+	Grant struct {
+		TTL               time.Duration `envconfig:"default=24h"`
+		MaxTTL            time.Duration `envconfig:"default=24h"`
+		ReconcileInterval time.Duration `envconfig:"default=5m"`
+	}
+	Roles struct {
+		CataloguePath string `envconfig:"optional"`
+	}
+	Audit struct {
+		// URL of Kyma Environment Broker storing the audit records of the issued kubeconfigs, the records are not stored if empty
+		URL     string        `envconfig:"optional"`
+		Timeout time.Duration `envconfig:"default=10s"`
 	}
 	GraphqlURL string `envconfig:"default=http://127.0.0.1:3000/graphql"`
 	OIDC       struct {
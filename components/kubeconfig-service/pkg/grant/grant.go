@@ -0,0 +1,186 @@
+package grant
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	grantLabel      = "service"
+	grantLabelValue = "kubeconfig-grant"
+	namePrefix      = "kubeconfig-grant-"
+
+	userIDKey    = "userID"
+	tenantIDKey  = "tenantID"
+	runtimeIDKey = "runtimeID"
+	roleKey      = "role"
+	issuedAtKey  = "issuedAt"
+	expiresAtKey = "expiresAt"
+)
+
+var (
+	ErrNotFound = errors.New("grant not found")
+	// ErrRenewed is returned when the grant was renewed after it was read
+	ErrRenewed = errors.New("grant was renewed")
+)
+
+// Grant is the access to the runtime given to the user with a kubeconfig
+type Grant struct {
+	UserID    string    `json:"userID"`
+	TenantID  string    `json:"tenantID"`
+	RuntimeID string    `json:"runtimeID"`
+	Role      string    `json:"role"`
+	IssuedAt  time.Time `json:"issuedAt"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+func (g Grant) Expired(now time.Time) bool {
+	return !now.Before(g.ExpiresAt)
+}
+
+// Registry stores the active grants, a grant is identified by the runtime and the user
+type Registry interface {
+	Save(grant Grant) error
+	Get(runtimeID, userID string) (Grant, error)
+	List() ([]Grant, error)
+	// Delete removes the grant unless it was renewed, a renewed grant is kept and ErrRenewed is returned
+	Delete(grant Grant) error
+}
+
+// ConfigMapRegistry stores each grant in a ConfigMap, so the grants survive restarts of the service
+type ConfigMapRegistry struct {
+	k8s       kubernetes.Interface
+	namespace string
+}
+
+func NewConfigMapRegistry(k8s kubernetes.Interface, namespace string) *ConfigMapRegistry {
+	return &ConfigMapRegistry{
+		k8s:       k8s,
+		namespace: namespace,
+	}
+}
+
+// Save stores the grant, the existing grant of the user for the runtime is replaced
+func (r *ConfigMapRegistry) Save(grant Grant) error {
+	configMap := r.toConfigMap(grant)
+
+	_, err := r.k8s.CoreV1().ConfigMaps(r.namespace).Create(context.Background(), configMap, metav1.CreateOptions{})
+	if k8serrors.IsAlreadyExists(err) {
+		_, err = r.k8s.CoreV1().ConfigMaps(r.namespace).Update(context.Background(), configMap, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		return errors.Wrapf(err, "while saving grant of user %s for runtime %s", grant.UserID, grant.RuntimeID)
+	}
+	return nil
+}
+
+func (r *ConfigMapRegistry) Get(runtimeID, userID string) (Grant, error) {
+	configMap, err := r.k8s.CoreV1().ConfigMaps(r.namespace).Get(context.Background(), configMapName(runtimeID, userID), metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return Grant{}, ErrNotFound
+	}
+	if err != nil {
+		return Grant{}, errors.Wrapf(err, "while getting grant of user %s for runtime %s", userID, runtimeID)
+	}
+	return fromConfigMap(*configMap)
+}
+
+func (r *ConfigMapRegistry) List() ([]Grant, error) {
+	configMaps, err := r.k8s.CoreV1().ConfigMaps(r.namespace).List(context.Background(), metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", grantLabel, grantLabelValue),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing grants")
+	}
+
+	grants := make([]Grant, 0, len(configMaps.Items))
+	for _, configMap := range configMaps.Items {
+		grant, err := fromConfigMap(configMap)
+		if err != nil {
+			return nil, err
+		}
+		grants = append(grants, grant)
+	}
+	return grants, nil
+}
+
+// Delete removes the stored grant only if it was issued at the same time as the given one,
+// the resource version precondition makes sure the grant is not renewed between the check and the deletion
+func (r *ConfigMapRegistry) Delete(grant Grant) error {
+	configMap, err := r.k8s.CoreV1().ConfigMaps(r.namespace).Get(context.Background(), configMapName(grant.RuntimeID, grant.UserID), metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrapf(err, "while getting grant of user %s for runtime %s", grant.UserID, grant.RuntimeID)
+	}
+	stored, err := fromConfigMap(*configMap)
+	if err != nil {
+		return err
+	}
+	if stored.IssuedAt.Unix() != grant.IssuedAt.Unix() {
+		return ErrRenewed
+	}
+
+	err = r.k8s.CoreV1().ConfigMaps(r.namespace).Delete(context.Background(), configMap.Name, metav1.DeleteOptions{
+		Preconditions: &metav1.Preconditions{ResourceVersion: &configMap.ResourceVersion},
+	})
+	if k8serrors.IsConflict(err) {
+		return ErrRenewed
+	}
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return errors.Wrapf(err, "while deleting grant of user %s for runtime %s", grant.UserID, grant.RuntimeID)
+	}
+	return nil
+}
+
+func (r *ConfigMapRegistry) toConfigMap(grant Grant) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      configMapName(grant.RuntimeID, grant.UserID),
+			Namespace: r.namespace,
+			Labels:    map[string]string{grantLabel: grantLabelValue},
+		},
+		Data: map[string]string{
+			userIDKey:    grant.UserID,
+			tenantIDKey:  grant.TenantID,
+			runtimeIDKey: grant.RuntimeID,
+			roleKey:      grant.Role,
+			issuedAtKey:  grant.IssuedAt.UTC().Format(time.RFC3339),
+			expiresAtKey: grant.ExpiresAt.UTC().Format(time.RFC3339),
+		},
+	}
+}
+
+func fromConfigMap(configMap corev1.ConfigMap) (Grant, error) {
+	issuedAt, err := time.Parse(time.RFC3339, configMap.Data[issuedAtKey])
+	if err != nil {
+		return Grant{}, errors.Wrapf(err, "while parsing issue time of grant %s", configMap.Name)
+	}
+	expiresAt, err := time.Parse(time.RFC3339, configMap.Data[expiresAtKey])
+	if err != nil {
+		return Grant{}, errors.Wrapf(err, "while parsing expiration time of grant %s", configMap.Name)
+	}
+
+	return Grant{
+		UserID:    configMap.Data[userIDKey],
+		TenantID:  configMap.Data[tenantIDKey],
+		RuntimeID: configMap.Data[runtimeIDKey],
+		Role:      configMap.Data[roleKey],
+		IssuedAt:  issuedAt,
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+// configMapName is derived from the runtime and the user, user IDs are not valid in resource names
+func configMapName(runtimeID, userID string) string {
+	return fmt.Sprintf("%s%x", namePrefix, sha256.Sum256([]byte(runtimeID+"/"+userID)))
+}
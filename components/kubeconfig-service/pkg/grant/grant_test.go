@@ -0,0 +1,249 @@
+package grant
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+const namespace = "kcp-system"
+
+type fakeRevoker struct {
+	revoked []Grant
+	err     error
+}
+
+func (r *fakeRevoker) Revoke(grant Grant) error {
+	if r.err != nil {
+		return r.err
+	}
+	r.revoked = append(r.revoked, grant)
+	return nil
+}
+
+func TestConfigMapRegistry(t *testing.T) {
+	// given
+	registry := NewConfigMapRegistry(fake.NewSimpleClientset(), namespace)
+	now := time.Now().UTC().Truncate(time.Second)
+	grant := fixGrant("runtime1", "user@example.com", now, time.Hour)
+
+	// when
+	require.NoError(t, registry.Save(grant))
+	require.NoError(t, registry.Save(fixGrant("runtime2", "user@example.com", now, time.Hour)))
+
+	// then
+	got, err := registry.Get("runtime1", "user@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, grant, got)
+
+	// when
+	renewed := fixGrant("runtime1", "user@example.com", now.Add(time.Minute), 2*time.Hour)
+	require.NoError(t, registry.Save(renewed))
+
+	// then
+	got, err = registry.Get("runtime1", "user@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, renewed, got)
+	grants, err := registry.List()
+	require.NoError(t, err)
+	assert.Len(t, grants, 2)
+
+	// when
+	err = registry.Delete(grant)
+
+	// then
+	assert.ErrorIs(t, err, ErrRenewed)
+	_, err = registry.Get("runtime1", "user@example.com")
+	require.NoError(t, err)
+
+	// when
+	require.NoError(t, registry.Delete(renewed))
+	require.NoError(t, registry.Delete(renewed))
+
+	// then
+	_, err = registry.Get("runtime1", "user@example.com")
+	assert.ErrorIs(t, err, ErrNotFound)
+	grants, err = registry.List()
+	require.NoError(t, err)
+	assert.Len(t, grants, 1)
+}
+
+func TestReconciler_Reconcile(t *testing.T) {
+	t.Run("should revoke expired grants", func(t *testing.T) {
+		// given
+		registry := NewConfigMapRegistry(fake.NewSimpleClientset(), namespace)
+		now := time.Now().UTC().Truncate(time.Second)
+		expired := fixGrant("runtime1", "user1", now.Add(-2*time.Hour), time.Hour)
+		active := fixGrant("runtime1", "user2", now, time.Hour)
+		require.NoError(t, registry.Save(expired))
+		require.NoError(t, registry.Save(active))
+		revoker := &fakeRevoker{}
+		reconciler := NewReconciler(registry, revoker, time.Minute)
+
+		// when
+		err := reconciler.Reconcile()
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []Grant{expired}, revoker.revoked)
+		grants, err := registry.List()
+		require.NoError(t, err)
+		assert.Equal(t, []Grant{active}, grants)
+	})
+
+	t.Run("should keep grant when revocation failed", func(t *testing.T) {
+		// given
+		registry := NewConfigMapRegistry(fake.NewSimpleClientset(), namespace)
+		expired := fixGrant("runtime1", "user1", time.Now().UTC().Add(-2*time.Hour).Truncate(time.Second), time.Hour)
+		require.NoError(t, registry.Save(expired))
+		reconciler := NewReconciler(registry, &fakeRevoker{err: fmt.Errorf("runtime not reachable")}, time.Minute)
+
+		// when
+		err := reconciler.Reconcile()
+
+		// then
+		require.Error(t, err)
+		_, err = registry.Get("runtime1", "user1")
+		assert.NoError(t, err)
+	})
+
+	t.Run("should keep grant renewed while it was revoked", func(t *testing.T) {
+		// given
+		registry := NewConfigMapRegistry(fake.NewSimpleClientset(), namespace)
+		now := time.Now().UTC().Truncate(time.Second)
+		expired := fixGrant("runtime1", "user1", now.Add(-2*time.Hour), time.Hour)
+		renewed := fixGrant("runtime1", "user1", now, time.Hour)
+		require.NoError(t, registry.Save(expired))
+		revoker := &renewingRevoker{registry: registry, renewed: renewed}
+		reconciler := NewReconciler(registry, revoker, time.Minute)
+
+		// when
+		err := reconciler.Reconcile()
+
+		// then
+		require.NoError(t, err)
+		got, err := registry.Get("runtime1", "user1")
+		require.NoError(t, err)
+		assert.Equal(t, renewed, got)
+	})
+}
+
+// renewingRevoker renews the grant while it is revoked
+type renewingRevoker struct {
+	registry Registry
+	renewed  Grant
+}
+
+func (r *renewingRevoker) Revoke(Grant) error {
+	return r.registry.Save(r.renewed)
+}
+
+func TestHandler(t *testing.T) {
+	// given
+	registry := NewConfigMapRegistry(fake.NewSimpleClientset(), namespace)
+	now := time.Now().UTC().Truncate(time.Second)
+	first := fixGrant("runtime1", "user1", now, time.Hour)
+	second := fixGrant("runtime2", "user1", now, 2*time.Hour)
+	require.NoError(t, registry.Save(second))
+	require.NoError(t, registry.Save(first))
+	revoker := &fakeRevoker{}
+	router := mux.NewRouter()
+	NewHandler(registry, NewReconciler(registry, revoker, time.Minute)).AttachRoutes(router)
+
+	t.Run("should list grants", func(t *testing.T) {
+		var grants []Grant
+		resp := doRequest(router, http.MethodGet, "/admin/grants", &grants)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, []Grant{first, second}, grants)
+	})
+
+	t.Run("should filter grants", func(t *testing.T) {
+		var grants []Grant
+		resp := doRequest(router, http.MethodGet, "/admin/grants?runtimeID=runtime2&userID=user1", &grants)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, []Grant{second}, grants)
+	})
+
+	t.Run("should revoke grant", func(t *testing.T) {
+		var grant Grant
+		resp := doRequest(router, http.MethodDelete, "/admin/grants/runtime1/user1", &grant)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, first, grant)
+		assert.Equal(t, []Grant{first}, revoker.revoked)
+		_, err := registry.Get("runtime1", "user1")
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("should return not found for unknown grant", func(t *testing.T) {
+		resp := doRequest(router, http.MethodDelete, "/admin/grants/runtime1/user1", nil)
+
+		assert.Equal(t, http.StatusNotFound, resp.Code)
+	})
+}
+
+func TestMigrateLegacyConfigMaps(t *testing.T) {
+	// given
+	k8s := fake.NewSimpleClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "user1",
+			Namespace:   namespace,
+			Labels:      map[string]string{"service": "kubeconfig"},
+			Annotations: map[string]string{"role": "runtimeOperator", "tenant": "tenant1"},
+		},
+		Data: map[string]string{"runtime1": "2023-08-01 10:00:00 +0000 UTC"},
+	})
+	registry := NewConfigMapRegistry(k8s, namespace)
+
+	// when
+	err := MigrateLegacyConfigMaps(k8s, namespace, registry)
+
+	// then
+	require.NoError(t, err)
+	grant, err := registry.Get("runtime1", "user1")
+	require.NoError(t, err)
+	issuedAt := time.Date(2023, 8, 1, 10, 0, 0, 0, time.UTC)
+	assert.Equal(t, Grant{
+		UserID:    "user1",
+		TenantID:  "tenant1",
+		RuntimeID: "runtime1",
+		Role:      "runtimeOperator",
+		IssuedAt:  issuedAt,
+		ExpiresAt: issuedAt.Add(24 * time.Hour),
+	}, grant)
+	_, err = k8s.CoreV1().ConfigMaps(namespace).Get(context.Background(), "user1", metav1.GetOptions{})
+	assert.Error(t, err)
+}
+
+func fixGrant(runtimeID, userID string, issuedAt time.Time, ttl time.Duration) Grant {
+	return Grant{
+		UserID:    userID,
+		TenantID:  "tenant",
+		RuntimeID: runtimeID,
+		Role:      "runtimeOperator",
+		IssuedAt:  issuedAt,
+		ExpiresAt: issuedAt.Add(ttl),
+	}
+}
+
+func doRequest(router *mux.Router, method, path string, response interface{}) *httptest.ResponseRecorder {
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, httptest.NewRequest(method, path, nil))
+	if response != nil {
+		_ = json.Unmarshal(resp.Body.Bytes(), response)
+	}
+	return resp
+}
@@ -0,0 +1,100 @@
+package grant
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	mimeTypeJSON = "application/json"
+)
+
+// Handler exposes the admin endpoints to list and revoke the active grants
+type Handler struct {
+	registry   Registry
+	reconciler *Reconciler
+}
+
+func NewHandler(registry Registry, reconciler *Reconciler) *Handler {
+	return &Handler{
+		registry:   registry,
+		reconciler: reconciler,
+	}
+}
+
+func (h *Handler) AttachRoutes(router *mux.Router) {
+	router.Methods("GET").Path("/admin/grants").HandlerFunc(h.ListGrants)
+	router.Methods("DELETE").Path("/admin/grants/{runtimeID}/{userID}").HandlerFunc(h.RevokeGrant)
+}
+
+// ListGrants returns the active grants, filtered by the tenantID, runtimeID and userID query parameters
+func (h *Handler) ListGrants(w http.ResponseWriter, req *http.Request) {
+	grants, err := h.registry.List()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	query := req.URL.Query()
+	filtered := make([]Grant, 0, len(grants))
+	for _, grant := range grants {
+		if !matches(query.Get("tenantID"), grant.TenantID) ||
+			!matches(query.Get("runtimeID"), grant.RuntimeID) ||
+			!matches(query.Get("userID"), grant.UserID) {
+			continue
+		}
+		filtered = append(filtered, grant)
+	}
+	sort.Slice(filtered, func(i, j int) bool {
+		return filtered[i].ExpiresAt.Before(filtered[j].ExpiresAt)
+	})
+
+	writeResponse(w, http.StatusOK, filtered)
+}
+
+// RevokeGrant removes the access of the user from the runtime before the grant expires
+func (h *Handler) RevokeGrant(w http.ResponseWriter, req *http.Request) {
+	vars := mux.Vars(req)
+	grant, err := h.registry.Get(vars["runtimeID"], vars["userID"])
+	if errors.Is(err, ErrNotFound) {
+		writeError(w, http.StatusNotFound, err)
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	err = h.reconciler.Revoke(grant)
+	if errors.Is(err, ErrRenewed) {
+		writeError(w, http.StatusConflict, err)
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeResponse(w, http.StatusOK, grant)
+}
+
+func matches(filter, value string) bool {
+	return filter == "" || filter == value
+}
+
+func writeResponse(w http.ResponseWriter, code int, response interface{}) {
+	w.Header().Set("Content-Type", mimeTypeJSON)
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		log.Errorf("Error while sending response: %s", err)
+	}
+}
+
+func writeError(w http.ResponseWriter, code int, err error) {
+	log.Errorf("Error while processing grants request: %s", err)
+	writeResponse(w, code, map[string]string{"error": err.Error()})
+}
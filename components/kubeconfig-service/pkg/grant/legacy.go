@@ -0,0 +1,55 @@
+package grant
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	legacyLabelSelector = "service=kubeconfig"
+	legacyTimeLayout    = "2006-01-02 15:04:05 +0000 UTC"
+	legacyTTL           = 24 * time.Hour
+)
+
+// MigrateLegacyConfigMaps moves the access tracked in the per-user ConfigMaps of the previous versions to the registry.
+// The ConfigMaps contain the start times of the access to the runtimes, which expired after 24 hours.
+func MigrateLegacyConfigMaps(k8s kubernetes.Interface, namespace string, registry Registry) error {
+	configMaps, err := k8s.CoreV1().ConfigMaps(namespace).List(context.Background(), metav1.ListOptions{LabelSelector: legacyLabelSelector})
+	if err != nil {
+		return errors.Wrap(err, "while listing legacy ConfigMaps")
+	}
+
+	for _, configMap := range configMaps.Items {
+		for runtimeID, startTime := range configMap.Data {
+			issuedAt, err := time.Parse(legacyTimeLayout, startTime)
+			if err != nil {
+				log.Warnf("Invalid start time %q of runtime %s in ConfigMap %s, the access expires now", startTime, runtimeID, configMap.Name)
+				issuedAt = time.Now().Add(-legacyTTL)
+			}
+			grant := Grant{
+				UserID:    configMap.Name,
+				TenantID:  configMap.Annotations["tenant"],
+				RuntimeID: runtimeID,
+				Role:      configMap.Annotations["role"],
+				IssuedAt:  issuedAt,
+				ExpiresAt: issuedAt.Add(legacyTTL),
+			}
+			if err := registry.Save(grant); err != nil {
+				return err
+			}
+		}
+
+		err := k8s.CoreV1().ConfigMaps(namespace).Delete(context.Background(), configMap.Name, metav1.DeleteOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return errors.Wrapf(err, "while deleting legacy ConfigMap %s", configMap.Name)
+		}
+		log.Infof("Migrated legacy ConfigMap of user %s", configMap.Name)
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package grant
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+)
+
+// Revoker removes the access of the user from the runtime
+type Revoker interface {
+	Revoke(grant Grant) error
+}
+
+// Reconciler revokes the expired grants
+type Reconciler struct {
+	registry Registry
+	revoker  Revoker
+	interval time.Duration
+	now      func() time.Time
+}
+
+func NewReconciler(registry Registry, revoker Revoker, interval time.Duration) *Reconciler {
+	return &Reconciler{
+		registry: registry,
+		revoker:  revoker,
+		interval: interval,
+		now:      time.Now,
+	}
+}
+
+// Run revokes the expired grants immediately, so grants which expired while the service was down are revoked, and then in the interval
+func (r *Reconciler) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := r.Reconcile(); err != nil {
+			log.Errorf("Failed to revoke expired grants: %s", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Reconcile revokes all expired grants, a failed revocation is retried in the next reconciliation
+func (r *Reconciler) Reconcile() error {
+	grants, err := r.registry.List()
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, grant := range grants {
+		if !grant.Expired(r.now()) {
+			continue
+		}
+		// the grant could have been renewed in the meantime
+		current, err := r.registry.Get(grant.RuntimeID, grant.UserID)
+		if errors.Is(err, ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if !current.Expired(r.now()) {
+			continue
+		}
+
+		log.Infof("Grant of user %s for runtime %s expired at %s", current.UserID, current.RuntimeID, current.ExpiresAt)
+		err = r.Revoke(current)
+		if errors.Is(err, ErrRenewed) {
+			log.Warnf("Grant of user %s for runtime %s was renewed while it was revoked, the user must request a new kubeconfig", current.UserID, current.RuntimeID)
+			continue
+		}
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// Revoke removes the access of the user from the runtime and deletes the grant, the grant is kept if it was renewed in the meantime
+func (r *Reconciler) Revoke(grant Grant) error {
+	if err := r.revoker.Revoke(grant); err != nil {
+		return errors.Wrapf(err, "while revoking grant of user %s for runtime %s", grant.UserID, grant.RuntimeID)
+	}
+	if err := r.registry.Delete(grant); err != nil {
+		return err
+	}
+	log.Infof("Revoked grant of user %s for runtime %s", grant.UserID, grant.RuntimeID)
+	return nil
+}
@@ -0,0 +1,238 @@
+package roles
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	rbacv1helpers "k8s.io/kubernetes/pkg/apis/rbac/v1"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	ReadOnly        = "readOnly"
+	NamespaceAdmin  = "namespaceAdmin"
+	RuntimeOperator = "runtimeOperator"
+	RuntimeAdmin    = "runtimeAdmin"
+	BreakGlass      = "breakGlass"
+
+	RuntimeOperatorGroup   = "runtimeOperator"
+	RuntimeAdminGroup      = "runtimeAdmin"
+	RuntimeBreakGlassGroup = "runtimeBreakGlass"
+)
+
+var (
+	ErrUnknownRole = errors.New("unknown role")
+	ErrForbidden   = errors.New("role not allowed")
+)
+
+// Role is the access to the runtime which can be granted with a kubeconfig
+type Role struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	// Rules are granted with the ClusterRole of the role
+	Rules []rbacv1.PolicyRule `json:"rules,omitempty"`
+	// AggregationSelectors select the ClusterRoles of the runtime aggregated to the ClusterRole of the role
+	AggregationSelectors []metav1.LabelSelector `json:"aggregationSelectors,omitempty"`
+	// Namespaced roles are bound with RoleBindings in the requested namespaces instead of a ClusterRoleBinding
+	Namespaced bool `json:"namespaced,omitempty"`
+	// Namespaces which can be requested for a namespaced role, all namespaces can be requested if empty
+	Namespaces []string `json:"namespaces,omitempty"`
+	// MaxTTL limits the lifetime of the access, the lifetime of the service applies if not set
+	MaxTTL *metav1.Duration `json:"maxTTL,omitempty"`
+}
+
+// AllowsNamespace returns true if the namespace can be requested for the role
+func (r Role) AllowsNamespace(namespace string) bool {
+	if len(r.Namespaces) == 0 {
+		return true
+	}
+	for _, ns := range r.Namespaces {
+		if ns == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+// LimitTTL returns the ttl capped by the MaxTTL of the role
+func (r Role) LimitTTL(ttl time.Duration) time.Duration {
+	if r.MaxTTL != nil && r.MaxTTL.Duration < ttl {
+		return r.MaxTTL.Duration
+	}
+	return ttl
+}
+
+// Catalogue contains the roles and the OIDC groups allowed to request them
+type Catalogue struct {
+	Roles []Role `json:"roles"`
+	// Groups maps the OIDC groups to the names of the roles the members can request
+	Groups map[string][]string `json:"groups"`
+	// DefaultRoles are issued in the order of preference when the user requests no role
+	DefaultRoles []string `json:"defaultRoles"`
+}
+
+// DefaultCatalogue returns the catalogue used when no catalogue is configured,
+// runtimeOperator and runtimeAdmin are the L2 and L3 roles granted by the previous versions
+func DefaultCatalogue() *Catalogue {
+	return &Catalogue{
+		Roles: []Role{
+			{
+				Name:        ReadOnly,
+				Description: "Read access to the resources except secrets",
+				AggregationSelectors: []metav1.LabelSelector{
+					{MatchLabels: map[string]string{"rbac.authorization.k8s.io/aggregate-to-view": "true"}},
+				},
+			},
+			{
+				Name:        NamespaceAdmin,
+				Description: "Admin access to the requested namespaces",
+				AggregationSelectors: []metav1.LabelSelector{
+					{MatchLabels: map[string]string{"rbac.authorization.k8s.io/aggregate-to-admin": "true"}},
+				},
+				Namespaced: true,
+			},
+			{
+				Name:        RuntimeOperator,
+				Description: "L2 operator, read access to all resources and edit access to the workloads",
+				Rules: []rbacv1.PolicyRule{
+					rbacv1helpers.NewRule("get", "list", "watch").Groups("*").Resources("*").RuleOrDie(),
+					rbacv1helpers.NewRule("get", "list", "watch").URLs("*").RuleOrDie(),
+				},
+				AggregationSelectors: []metav1.LabelSelector{
+					{MatchLabels: map[string]string{"rbac.authorization.k8s.io/aggregate-to-edit": "true"}},
+				},
+			},
+			{
+				Name:        RuntimeAdmin,
+				Description: "L3 operator, full access to the runtime",
+				Rules: []rbacv1.PolicyRule{
+					rbacv1helpers.NewRule("*").Groups("*").Resources("*").RuleOrDie(),
+					rbacv1helpers.NewRule("*").URLs("*").RuleOrDie(),
+				},
+				AggregationSelectors: []metav1.LabelSelector{
+					{MatchLabels: map[string]string{"rbac.authorization.k8s.io/aggregate-to-admin": "true"}},
+				},
+			},
+			{
+				Name:        BreakGlass,
+				Description: "Emergency full access to the runtime, limited to one hour",
+				Rules: []rbacv1.PolicyRule{
+					rbacv1helpers.NewRule("*").Groups("*").Resources("*").RuleOrDie(),
+					rbacv1helpers.NewRule("*").URLs("*").RuleOrDie(),
+				},
+				MaxTTL: &metav1.Duration{Duration: time.Hour},
+			},
+		},
+		Groups: map[string][]string{
+			RuntimeOperatorGroup:   {RuntimeOperator, ReadOnly},
+			RuntimeAdminGroup:      {RuntimeAdmin, RuntimeOperator, ReadOnly, NamespaceAdmin},
+			RuntimeBreakGlassGroup: {BreakGlass},
+		},
+		DefaultRoles: []string{RuntimeAdmin, RuntimeOperator, ReadOnly},
+	}
+}
+
+// Load reads the catalogue from the YAML or JSON file, the default catalogue is returned if the path is empty
+func Load(path string) (*Catalogue, error) {
+	if path == "" {
+		return DefaultCatalogue(), nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while reading role catalogue %s", path)
+	}
+	catalogue := &Catalogue{}
+	if err := yaml.UnmarshalStrict(data, catalogue); err != nil {
+		return nil, errors.Wrapf(err, "while parsing role catalogue %s", path)
+	}
+	if err := catalogue.Validate(); err != nil {
+		return nil, errors.Wrapf(err, "invalid role catalogue %s", path)
+	}
+	return catalogue, nil
+}
+
+// Validate checks that the roles grant access and the groups and default roles refer to roles of the catalogue.
+// The ClusterRoles of the role are named after the lowercased role name, so the names must be valid and unique in lowercase.
+func (c *Catalogue) Validate() error {
+	names := map[string]bool{}
+	lowercased := map[string]bool{}
+	for _, role := range c.Roles {
+		if role.Name == "" {
+			return fmt.Errorf("role without name")
+		}
+		if lowercased[strings.ToLower(role.Name)] {
+			return fmt.Errorf("role %s defined more than once", role.Name)
+		}
+		if msgs := validation.IsDNS1123Label(strings.ToLower(role.Name)); len(msgs) > 0 {
+			return fmt.Errorf("role %s: invalid name: %s", role.Name, strings.Join(msgs, ", "))
+		}
+		if len(role.Rules) == 0 && len(role.AggregationSelectors) == 0 {
+			return fmt.Errorf("role %s grants no access", role.Name)
+		}
+		names[role.Name] = true
+		lowercased[strings.ToLower(role.Name)] = true
+	}
+	for group, roles := range c.Groups {
+		for _, name := range roles {
+			if !names[name] {
+				return fmt.Errorf("group %s refers to %s: %w", group, name, ErrUnknownRole)
+			}
+		}
+	}
+	for _, name := range c.DefaultRoles {
+		if !names[name] {
+			return fmt.Errorf("default role %s: %w", name, ErrUnknownRole)
+		}
+	}
+	return nil
+}
+
+// Get returns the role by name
+func (c *Catalogue) Get(name string) (Role, error) {
+	for _, role := range c.Roles {
+		if role.Name == name {
+			return role, nil
+		}
+	}
+	return Role{}, fmt.Errorf("role %s: %w", name, ErrUnknownRole)
+}
+
+// Allowed returns the names of the roles the members of the groups can request
+func (c *Catalogue) Allowed(groups []string) map[string]bool {
+	allowed := map[string]bool{}
+	for _, group := range groups {
+		for _, name := range c.Groups[group] {
+			allowed[name] = true
+		}
+	}
+	return allowed
+}
+
+// Resolve returns the requested role if the groups allow it, or the first allowed default role if no role is requested
+func (c *Catalogue) Resolve(groups []string, requested string) (Role, error) {
+	allowed := c.Allowed(groups)
+	if requested == "" {
+		for _, name := range c.DefaultRoles {
+			if allowed[name] {
+				return c.Get(name)
+			}
+		}
+		return Role{}, fmt.Errorf("no default role for groups %v: %w", groups, ErrForbidden)
+	}
+
+	role, err := c.Get(requested)
+	if err != nil {
+		return Role{}, err
+	}
+	if !allowed[requested] {
+		return Role{}, fmt.Errorf("role %s for groups %v: %w", requested, groups, ErrForbidden)
+	}
+	return role, nil
+}
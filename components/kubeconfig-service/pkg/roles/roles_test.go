@@ -0,0 +1,198 @@
+package roles
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultCatalogue(t *testing.T) {
+	assert.NoError(t, DefaultCatalogue().Validate())
+}
+
+func TestCatalogue_Resolve(t *testing.T) {
+	catalogue := DefaultCatalogue()
+
+	for name, tc := range map[string]struct {
+		groups    []string
+		requested string
+		expected  string
+		err       error
+	}{
+		"admin gets admin role by default": {
+			groups:   []string{RuntimeOperatorGroup, RuntimeAdminGroup},
+			expected: RuntimeAdmin,
+		},
+		"operator gets operator role by default": {
+			groups:   []string{"other", RuntimeOperatorGroup},
+			expected: RuntimeOperator,
+		},
+		"admin can request read only role": {
+			groups:    []string{RuntimeAdminGroup},
+			requested: ReadOnly,
+			expected:  ReadOnly,
+		},
+		"operator cannot request admin role": {
+			groups:    []string{RuntimeOperatorGroup},
+			requested: RuntimeAdmin,
+			err:       ErrForbidden,
+		},
+		"break glass must be requested": {
+			groups: []string{RuntimeBreakGlassGroup},
+			err:    ErrForbidden,
+		},
+		"break glass can be requested": {
+			groups:    []string{RuntimeBreakGlassGroup},
+			requested: BreakGlass,
+			expected:  BreakGlass,
+		},
+		"unknown role": {
+			groups:    []string{RuntimeAdminGroup},
+			requested: "superuser",
+			err:       ErrUnknownRole,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// when
+			role, err := catalogue.Resolve(tc.groups, tc.requested)
+
+			// then
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, role.Name)
+		})
+	}
+}
+
+func TestRole_LimitTTL(t *testing.T) {
+	role, err := DefaultCatalogue().Get(BreakGlass)
+	require.NoError(t, err)
+
+	assert.Equal(t, time.Hour, role.LimitTTL(24*time.Hour))
+	assert.Equal(t, 30*time.Minute, role.LimitTTL(30*time.Minute))
+	assert.Equal(t, 24*time.Hour, Role{}.LimitTTL(24*time.Hour))
+}
+
+func TestLoad(t *testing.T) {
+	t.Run("should load catalogue", func(t *testing.T) {
+		// given
+		path := writeCatalogue(t, `
+roles:
+- name: secretReader
+  rules:
+  - apiGroups: [""]
+    resources: ["secrets"]
+    verbs: ["get", "list"]
+  namespaced: true
+  namespaces: ["kyma-system"]
+  maxTTL: 2h
+groups:
+  runtimeOperator: [secretReader]
+defaultRoles: []
+`)
+
+		// when
+		catalogue, err := Load(path)
+
+		// then
+		require.NoError(t, err)
+		role, err := catalogue.Resolve([]string{RuntimeOperatorGroup}, "secretReader")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"secrets"}, role.Rules[0].Resources)
+		assert.True(t, role.Namespaced)
+		assert.True(t, role.AllowsNamespace("kyma-system"))
+		assert.False(t, role.AllowsNamespace("default"))
+		assert.Equal(t, 2*time.Hour, role.LimitTTL(24*time.Hour))
+	})
+
+	t.Run("should reject group mapped to unknown role", func(t *testing.T) {
+		// given
+		path := writeCatalogue(t, `
+roles:
+- name: viewer
+  aggregationSelectors:
+  - matchLabels:
+      rbac.authorization.k8s.io/aggregate-to-view: "true"
+groups:
+  runtimeOperator: [viewer, admin]
+`)
+
+		// when
+		_, err := Load(path)
+
+		// then
+		assert.ErrorIs(t, err, ErrUnknownRole)
+	})
+
+	t.Run("should reject role without access", func(t *testing.T) {
+		// given
+		path := writeCatalogue(t, `
+roles:
+- name: nothing
+`)
+
+		// when
+		_, err := Load(path)
+
+		// then
+		assert.Error(t, err)
+	})
+
+	t.Run("should reject roles differing only in case", func(t *testing.T) {
+		// given
+		path := writeCatalogue(t, `
+roles:
+- name: readOnly
+  aggregationSelectors:
+  - matchLabels:
+      rbac.authorization.k8s.io/aggregate-to-view: "true"
+- name: readonly
+  aggregationSelectors:
+  - matchLabels:
+      rbac.authorization.k8s.io/aggregate-to-view: "true"
+`)
+
+		// when
+		_, err := Load(path)
+
+		// then
+		assert.Error(t, err)
+	})
+
+	t.Run("should reject role name which is not valid resource name", func(t *testing.T) {
+		// given
+		path := writeCatalogue(t, `
+roles:
+- name: read_only
+  aggregationSelectors:
+  - matchLabels:
+      rbac.authorization.k8s.io/aggregate-to-view: "true"
+`)
+
+		// when
+		_, err := Load(path)
+
+		// then
+		assert.Error(t, err)
+	})
+
+	t.Run("should use default catalogue without path", func(t *testing.T) {
+		catalogue, err := Load("")
+
+		require.NoError(t, err)
+		assert.Equal(t, DefaultCatalogue(), catalogue)
+	})
+}
+
+func writeCatalogue(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "roles.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	return path
+}
@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/avast/retry-go"
+	"github.com/kyma-project/control-plane/components/kubeconfig-service/pkg/roles"
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 
@@ -19,7 +21,6 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/clientcmd"
-	rbacv1helpers "k8s.io/kubernetes/pkg/apis/rbac/v1"
 )
 
 type SAInfo struct {
@@ -36,52 +37,31 @@ type SAInfo struct {
 const SA = "SA"
 const ClusterRole = "ClusterRole"
 const ClusterRoleBinding = "ClusterRoleBinding"
+const RoleBinding = "RoleBinding"
 const Namespace = "kube-system"
 const RUNTIME_ADMIN = "runtimeAdmin"
 const RUNTIME_OPERATOR = "runtimeOperator"
 const ServiceAccount = "ServiceAccount"
 const Token = "token"
 
-var L2L3OperatorPolicyRule = map[string][]rbacv1.PolicyRule{
-	RUNTIME_ADMIN: {
-		rbacv1helpers.NewRule("*").Groups("*").Resources("*").RuleOrDie(),
-		rbacv1helpers.NewRule("*").URLs("*").RuleOrDie(),
-	},
-	RUNTIME_OPERATOR: {
-		rbacv1helpers.NewRule("get", "list", "watch").Groups("*").Resources("*").RuleOrDie(),
-		rbacv1helpers.NewRule("get", "list", "watch").URLs("*").RuleOrDie(),
-	},
-}
-
-var L2L3OperatorAggregationRule = map[string][]metav1.LabelSelector{
-	RUNTIME_ADMIN: {
-		{
-			MatchLabels: map[string]string{
-				"rbac.authorization.k8s.io/aggregate-to-admin": "true",
-			},
-		},
-	},
-	RUNTIME_OPERATOR: {
-		{
-			MatchLabels: map[string]string{
-				"rbac.authorization.k8s.io/aggregate-to-edit": "true",
-			},
-		},
-	},
-}
+// ManagedByLabel marks the RoleBindings created for the namespaced roles, so they can be found for the cleanup
+const ManagedByLabel = "app.kubernetes.io/managed-by"
+const ManagedByValue = "kubeconfig-service"
 
 type RollbackE struct {
 	Data []string
 }
 type RuntimeClient struct {
-	K8s               kubernetes.Interface
-	KcpK8s            kubernetes.Interface
-	User              SAInfo
-	L2L3OperatiorRole string
-	RollbackE         RollbackE
+	K8s       kubernetes.Interface
+	KcpK8s    kubernetes.Interface
+	User      SAInfo
+	Role      roles.Role
+	RollbackE RollbackE
+	// Namespaces the namespaced role is bound in
+	Namespaces []string
 }
 
-func NewRuntimeClient(kubeConfig []byte, userID string, L2L3OperatiorRole string, tenant string) (*RuntimeClient, error) {
+func NewRuntimeClient(kubeConfig []byte, userID string, role roles.Role, tenant string) (*RuntimeClient, error) {
 	config, err := clientcmd.RESTConfigFromKubeConfig([]byte(kubeConfig))
 	if err != nil {
 		return nil, err
@@ -96,21 +76,29 @@ func NewRuntimeClient(kubeConfig []byte, userID string, L2L3OperatiorRole string
 		return nil, err
 	}
 
-	user := SAInfo{
+	user := NewSAInfo(userID, role, tenant)
+	RollbackE := RollbackE{}
+	return &RuntimeClient{K8s: clientset, KcpK8s: coreClientset, User: user, Role: role, RollbackE: RollbackE}, nil
+}
+
+// NewSAInfo returns the names of the resources granting the role to the user, the sa and the bindings are created per user
+// while the clusterroles are created per role and shared by all users with the role
+func NewSAInfo(userID string, role roles.Role, tenant string) SAInfo {
+	clusterRoleName := fmt.Sprintf("%s-%s", ManagedByValue, strings.ToLower(role.Name))
+	return SAInfo{
 		ServiceAccountName:     userID,
-		ClusterRoleName:        userID,
-		ClusterRoleAggrLabel:   fmt.Sprintf("rbac.authorization.k8s.io/aggregate-to-%s", userID),
-		ClusterRoleRulesName:   fmt.Sprintf("%s-rules", userID),
+		ClusterRoleName:        clusterRoleName,
+		ClusterRoleAggrLabel:   fmt.Sprintf("rbac.authorization.k8s.io/aggregate-to-%s", clusterRoleName),
+		ClusterRoleRulesName:   fmt.Sprintf("%s-rules", clusterRoleName),
 		ClusterRoleBindingName: userID,
 		Namespace:              Namespace,
 		TenantID:               tenant,
 	}
-	RollbackE := RollbackE{}
-	return &RuntimeClient{clientset, coreClientset, user, L2L3OperatiorRole, RollbackE}, nil
 }
 
-// kubeconfig access runtime, create sa and clusterrole and clusterrolebinding according to userID and l2L3OperatiorRole
-func (rtc *RuntimeClient) Run() (string, error) {
+// kubeconfig access runtime, create sa and clusterrole and clusterrolebinding (or rolebindings for namespaced roles) according to userID and role
+// the returned token of the sa expires after the ttl
+func (rtc *RuntimeClient) Run(ttl time.Duration) (string, error) {
 	var resultE error
 	defer func() {
 		if err := rtc.Cleaner(); err != nil {
@@ -131,20 +119,20 @@ func (rtc *RuntimeClient) Run() (string, error) {
 
 	err = rtc.createClusterRole()
 	if err != nil {
-		rtc.RollbackE.Data = append(rtc.RollbackE.Data, SA, ClusterRole)
+		rtc.RollbackE.Data = append(rtc.RollbackE.Data, SA)
 		return "", errors.Wrapf(err, "while createClusterRole %s", rtc.User.ClusterRoleName)
 	}
 
-	saToken, err := rtc.getServiceAccountToken()
+	saToken, err := rtc.getServiceAccountToken(ttl)
 	if err != nil {
-		rtc.RollbackE.Data = append(rtc.RollbackE.Data, SA, ClusterRole)
+		rtc.RollbackE.Data = append(rtc.RollbackE.Data, SA)
 		return "", errors.Wrapf(err, "while getServiceAccountToken from %s", rtc.User.ServiceAccountName)
 	}
 
-	err = rtc.createClusterRoleBinding()
+	err = rtc.createBindings()
 	if err != nil {
-		rtc.RollbackE.Data = append(rtc.RollbackE.Data, SA, ClusterRole)
-		return "", errors.Wrapf(err, "while createClusterRoleBinding %s", rtc.User.ClusterRoleBindingName)
+		rtc.RollbackE.Data = append(rtc.RollbackE.Data, SA, ClusterRoleBinding, RoleBinding)
+		return "", errors.Wrapf(err, "while creating bindings of %s", rtc.User.ClusterRoleName)
 	}
 	return saToken, resultE
 }
@@ -164,11 +152,11 @@ func (rtc *RuntimeClient) createServiceAccount() error {
 }
 
 func (rtc *RuntimeClient) createClusterRoleRules() error {
-	if rtc.L2L3OperatiorRole != RUNTIME_ADMIN && rtc.L2L3OperatiorRole != RUNTIME_OPERATOR {
-		return fmt.Errorf("role %s not in [%s,%s]", rtc.L2L3OperatiorRole, RUNTIME_ADMIN, RUNTIME_OPERATOR)
+	if len(rtc.Role.Rules) == 0 && len(rtc.Role.AggregationSelectors) == 0 {
+		return fmt.Errorf("role %s grants no access", rtc.Role.Name)
 	}
 
-	crExist, err := rtc.verifyClusterRoleRules(rtc.L2L3OperatiorRole)
+	crExist, err := rtc.verifyClusterRoleRules(rtc.Role)
 	if err != nil {
 		return errors.Wrapf(err, "in verifyClusterRoleRules")
 	}
@@ -176,14 +164,14 @@ func (rtc *RuntimeClient) createClusterRoleRules() error {
 		return nil
 	}
 
-	clusterrole := initClusterRoleRules(rtc.User.ClusterRoleRulesName, rtc.L2L3OperatiorRole, rtc.User.ClusterRoleAggrLabel)
+	clusterrole := initClusterRoleRules(rtc.User.ClusterRoleRulesName, rtc.Role, rtc.User.ClusterRoleAggrLabel)
 	_, err = rtc.K8s.RbacV1().ClusterRoles().Create(context.TODO(), clusterrole, metav1.CreateOptions{})
 	return err
 }
 
 func (rtc *RuntimeClient) createClusterRole() error {
 
-	crExist, err := rtc.verifyClusterRole(rtc.L2L3OperatiorRole, rtc.User.ClusterRoleAggrLabel)
+	crExist, err := rtc.verifyClusterRole(rtc.Role, rtc.User.ClusterRoleAggrLabel)
 	if err != nil {
 		return errors.Wrapf(err, "in verifyClusterRoleAggregation")
 	}
@@ -191,11 +179,80 @@ func (rtc *RuntimeClient) createClusterRole() error {
 		return nil
 	}
 
-	clusterrole := initClusterRole(rtc.User.ClusterRoleName, rtc.L2L3OperatiorRole, rtc.User.ClusterRoleAggrLabel)
+	clusterrole := initClusterRole(rtc.User.ClusterRoleName, rtc.Role, rtc.User.ClusterRoleAggrLabel)
 	_, err = rtc.K8s.RbacV1().ClusterRoles().Create(context.TODO(), clusterrole, metav1.CreateOptions{})
 	return err
 }
 
+// createBindings binds the clusterrole cluster wide or, for namespaced roles, in the namespaces
+// the bindings of the previously granted role are removed
+func (rtc *RuntimeClient) createBindings() error {
+	if !rtc.Role.Namespaced {
+		if err := rtc.deleteRoleBindings(nil); err != nil {
+			return errors.Wrapf(err, "in deleteRoleBindings")
+		}
+		return rtc.createClusterRoleBinding()
+	}
+
+	if len(rtc.Namespaces) == 0 {
+		return fmt.Errorf("role %s requires namespaces", rtc.Role.Name)
+	}
+	if err := rtc.deleteCRBinding(); err != nil {
+		return errors.Wrapf(err, "in deleteCRBinding")
+	}
+	if err := rtc.deleteRoleBindings(rtc.Namespaces); err != nil {
+		return errors.Wrapf(err, "in deleteRoleBindings")
+	}
+	for _, namespace := range rtc.Namespaces {
+		if err := rtc.createRoleBinding(namespace); err != nil {
+			return errors.Wrapf(err, "in namespace %s", namespace)
+		}
+	}
+	return nil
+}
+
+func (rtc *RuntimeClient) createRoleBinding(namespace string) error {
+	_, roleRef, subjects := initCRBindingE(rtc.User)
+	rb, err := rtc.K8s.RbacV1().RoleBindings(namespace).Get(context.TODO(), rtc.User.ClusterRoleBindingName, metav1.GetOptions{})
+	if err == nil && reflect.DeepEqual(rb.Subjects, subjects) && reflect.DeepEqual(rb.RoleRef, roleRef) {
+		return nil
+	}
+	if err == nil {
+		err = rtc.K8s.RbacV1().RoleBindings(namespace).Delete(context.TODO(), rtc.User.ClusterRoleBindingName, metav1.DeleteOptions{})
+	}
+	if err != nil && !apierr.IsNotFound(err) {
+		return err
+	}
+
+	_, err = rtc.K8s.RbacV1().RoleBindings(namespace).Create(context.TODO(), initRoleBinding(rtc.User, namespace, roleRef, subjects), metav1.CreateOptions{})
+	return err
+}
+
+// deleteRoleBindings removes the rolebindings of the user except the ones in the namespaces to keep
+func (rtc *RuntimeClient) deleteRoleBindings(keep []string) error {
+	rbs, err := rtc.K8s.RbacV1().RoleBindings(metav1.NamespaceAll).List(context.TODO(), metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", ManagedByLabel, ManagedByValue),
+	})
+	if err != nil {
+		return err
+	}
+
+	kept := map[string]bool{}
+	for _, namespace := range keep {
+		kept[namespace] = true
+	}
+	for _, rb := range rbs.Items {
+		if rb.Name != rtc.User.ClusterRoleBindingName || kept[rb.Namespace] {
+			continue
+		}
+		err := rtc.K8s.RbacV1().RoleBindings(rb.Namespace).Delete(context.TODO(), rb.Name, metav1.DeleteOptions{})
+		if err != nil && !apierr.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
 func (rtc *RuntimeClient) createClusterRoleBinding() error {
 	objectMeta, roleRef, subjects := initCRBindingE(rtc.User)
 	existed, err := rtc.verifyCRBinding(roleRef, subjects)
@@ -246,10 +303,10 @@ func (rtc *RuntimeClient) verifyServiceAccount() (bool, error) {
 	return false, err
 }
 
-func (rtc *RuntimeClient) verifyClusterRoleRules(l2L3OperatiorRole string) (bool, error) {
+func (rtc *RuntimeClient) verifyClusterRoleRules(role roles.Role) (bool, error) {
 	cr, err := rtc.K8s.RbacV1().ClusterRoles().Get(context.TODO(), rtc.User.ClusterRoleRulesName, metav1.GetOptions{})
 	if cr != nil && err == nil {
-		if reflect.DeepEqual(cr.Rules, L2L3OperatorPolicyRule[l2L3OperatiorRole]) {
+		if reflect.DeepEqual(cr.Rules, role.Rules) {
 			return true, nil
 		} else {
 			_, err = rtc.deleteClusterRole(rtc.User.ClusterRoleRulesName)
@@ -267,11 +324,11 @@ func (rtc *RuntimeClient) verifyClusterRoleRules(l2L3OperatiorRole string) (bool
 	return false, err
 }
 
-func (rtc *RuntimeClient) verifyClusterRole(l2L3OperatiorRole string, aggregationLabel string) (bool, error) {
+func (rtc *RuntimeClient) verifyClusterRole(role roles.Role, aggregationLabel string) (bool, error) {
 	cr, err := rtc.K8s.RbacV1().ClusterRoles().Get(context.TODO(), rtc.User.ClusterRoleName, metav1.GetOptions{})
 	if cr != nil && err == nil {
 		expectedSelectors := []metav1.LabelSelector{}
-		expectedSelectors = append(expectedSelectors, L2L3OperatorAggregationRule[l2L3OperatiorRole]...)
+		expectedSelectors = append(expectedSelectors, role.AggregationSelectors...)
 		expectedSelectors = append(expectedSelectors, metav1.LabelSelector{
 			MatchLabels: map[string]string{
 				aggregationLabel: "true",
@@ -316,16 +373,19 @@ func (rtc *RuntimeClient) verifyCRBinding(roleRef rbacv1.RoleRef, subjects []rba
 	return false, err
 }
 
-func (rtc *RuntimeClient) getServiceAccountToken() (string, error) {
-	var expirationSeconds int64 = int64(ExpireTime.Seconds())
+func (rtc *RuntimeClient) getServiceAccountToken(ttl time.Duration) (string, error) {
+	var expirationSeconds int64 = int64(ttl.Seconds())
 	tokenRequest := authenticationv1.TokenRequest{
 		Spec: authenticationv1.TokenRequestSpec{
 			ExpirationSeconds: &expirationSeconds,
 		},
 	}
 	req, err := rtc.K8s.CoreV1().ServiceAccounts(rtc.User.Namespace).CreateToken(context.TODO(), rtc.User.ServiceAccountName, &tokenRequest, metav1.CreateOptions{})
+	if err != nil {
+		return "", err
+	}
 
-	return req.Status.Token, err
+	return req.Status.Token, nil
 }
 
 func initServiceAccount(user SAInfo) *corev1.ServiceAccount {
@@ -337,7 +397,7 @@ func initServiceAccount(user SAInfo) *corev1.ServiceAccount {
 	}
 }
 
-func initClusterRoleRules(clusterRoleName string, l2L3OperatiorRole string, aggregationLabel string) *rbacv1.ClusterRole {
+func initClusterRoleRules(clusterRoleName string, role roles.Role, aggregationLabel string) *rbacv1.ClusterRole {
 	return &rbacv1.ClusterRole{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: clusterRoleName,
@@ -345,11 +405,11 @@ func initClusterRoleRules(clusterRoleName string, l2L3OperatiorRole string, aggr
 				aggregationLabel: "true",
 			},
 		},
-		Rules: L2L3OperatorPolicyRule[l2L3OperatiorRole],
+		Rules: role.Rules,
 	}
 }
 
-func initClusterRole(clusterRoleName string, l2L3OperatiorRole string, aggregationLabel string) *rbacv1.ClusterRole {
+func initClusterRole(clusterRoleName string, role roles.Role, aggregationLabel string) *rbacv1.ClusterRole {
 	clusterrole := &rbacv1.ClusterRole{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: clusterRoleName,
@@ -358,7 +418,7 @@ func initClusterRole(clusterRoleName string, l2L3OperatiorRole string, aggregati
 			ClusterRoleSelectors: []metav1.LabelSelector{},
 		},
 	}
-	clusterrole.AggregationRule.ClusterRoleSelectors = append(clusterrole.AggregationRule.ClusterRoleSelectors, L2L3OperatorAggregationRule[l2L3OperatiorRole]...)
+	clusterrole.AggregationRule.ClusterRoleSelectors = append(clusterrole.AggregationRule.ClusterRoleSelectors, role.AggregationSelectors...)
 	clusterrole.AggregationRule.ClusterRoleSelectors = append(clusterrole.AggregationRule.ClusterRoleSelectors, metav1.LabelSelector{
 		MatchLabels: map[string]string{
 			aggregationLabel: "true",
@@ -388,6 +448,20 @@ func initCRBindingE(user SAInfo) (metav1.ObjectMeta, rbacv1.RoleRef, []rbacv1.Su
 	return objectMeta, roleRef, subjects
 }
 
+func initRoleBinding(user SAInfo, namespace string, roleRef rbacv1.RoleRef, subjects []rbacv1.Subject) *rbacv1.RoleBinding {
+	return &rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      user.ClusterRoleBindingName,
+			Namespace: namespace,
+			Labels: map[string]string{
+				ManagedByLabel: ManagedByValue,
+			},
+		},
+		RoleRef:  roleRef,
+		Subjects: subjects,
+	}
+}
+
 func initCRBinding(objectMeta metav1.ObjectMeta, roleRef rbacv1.RoleRef, subjects []rbacv1.Subject) *rbacv1.ClusterRoleBinding {
 	return &rbacv1.ClusterRoleBinding{
 		ObjectMeta: objectMeta,
@@ -396,6 +470,13 @@ func initCRBinding(objectMeta metav1.ObjectMeta, roleRef rbacv1.RoleRef, subject
 	}
 }
 
+// RevokeAccess removes sa, clusterrolebinding and rolebindings of the user, the tokens of the sa are invalidated with the sa.
+// The clusterroles of the role are shared with other users and are kept, only the per user clusterroles created by the previous versions are removed
+func (rtc *RuntimeClient) RevokeAccess() error {
+	rtc.RollbackE.Data = append(rtc.RollbackE.Data, SA, ClusterRole, ClusterRoleBinding, RoleBinding)
+	return rtc.Cleaner()
+}
+
 // Clean service account and cluster role
 func (rtc *RuntimeClient) Cleaner() error {
 	if len(rtc.RollbackE.Data) == 0 {
@@ -415,6 +496,8 @@ func (rtc *RuntimeClient) Cleaner() error {
 			go rtc.RetryDeleteClusterRoles(&wg, errorCh)
 		case ClusterRoleBinding:
 			go rtc.RetryDeleteClusterRoleBinding(&wg, errorCh)
+		case RoleBinding:
+			go rtc.RetryDeleteRoleBindings(&wg, errorCh)
 		default:
 			wg.Done()
 		}
@@ -472,7 +555,8 @@ func (rtc *RuntimeClient) RetryDeleteServiceAccount(wg *sync.WaitGroup, errorCh
 
 func (rtc *RuntimeClient) RetryDeleteClusterRoles(wg *sync.WaitGroup, errorCh chan error) {
 	defer wg.Done()
-	clusterroles := []string{rtc.User.ClusterRoleName, rtc.User.ClusterRoleRulesName}
+	// clusterroles were named after the user before they were shared per role
+	clusterroles := []string{rtc.User.ServiceAccountName, fmt.Sprintf("%s-rules", rtc.User.ServiceAccountName)}
 	var err error
 
 	err = retry.Do(func() error {
@@ -480,12 +564,10 @@ func (rtc *RuntimeClient) RetryDeleteClusterRoles(wg *sync.WaitGroup, errorCh ch
 			err = rtc.K8s.RbacV1().ClusterRoles().Delete(context.TODO(), name, metav1.DeleteOptions{})
 
 			if err != nil && !apierr.IsNotFound(err) {
-				errorCh <- err
-			} else if apierr.IsNotFound(err) {
-				return nil
+				return errors.Wrapf(err, "ClusterRole \"%s\" still exists", name)
 			}
 		}
-		return errors.Wrapf(err, "ClusterRoles \"%v\" still exist", clusterroles)
+		return nil
 	},
 		retry.Attempts(20),
 		retry.Delay(15*time.Second),
@@ -522,3 +604,20 @@ func (rtc *RuntimeClient) RetryDeleteClusterRoleBinding(wg *sync.WaitGroup, erro
 	}
 	log.Infof(fmt.Sprintf("Cluster Role Binding \"%s\" is removed", rtc.User.ClusterRoleName))
 }
+
+func (rtc *RuntimeClient) RetryDeleteRoleBindings(wg *sync.WaitGroup, errorCh chan error) {
+	defer wg.Done()
+
+	err := retry.Do(func() error {
+		return rtc.deleteRoleBindings(nil)
+	},
+		retry.Attempts(20),
+		retry.Delay(15*time.Second),
+		retry.LastErrorOnly(true),
+	)
+	if err != nil {
+		errorCh <- errors.Wrapf(err, "Role Bindings \"%s\" still exist", rtc.User.ClusterRoleBindingName)
+		return
+	}
+	log.Infof(fmt.Sprintf("Role Bindings \"%s\" are removed", rtc.User.ClusterRoleBindingName))
+}
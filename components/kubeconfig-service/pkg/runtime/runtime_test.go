@@ -2,12 +2,12 @@ package runtime
 
 import (
 	"context"
-	"fmt"
 	"testing"
-	"time"
 
+	"github.com/kyma-project/control-plane/components/kubeconfig-service/pkg/roles"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/fake"
@@ -20,21 +20,18 @@ var sa1 = corev1.ServiceAccount{
 	},
 }
 
-func NewRuntimeClientTest(kubeConfig []byte, userID string, L2L3OperatiorRole string, tenant string) (*RuntimeClient, error) {
+func NewRuntimeClientTest(kubeConfig []byte, userID string, roleName string, tenant string) (*RuntimeClient, error) {
 	clientset := fake.NewSimpleClientset()
 	coreClientset := fake.NewSimpleClientset()
 
-	user := SAInfo{
-		ServiceAccountName:     userID,
-		ClusterRoleName:        userID,
-		ClusterRoleRulesName:   fmt.Sprintf("%s-rules", userID),
-		ClusterRoleAggrLabel:   fmt.Sprintf("rbac.authorization.k8s.io/aggregate-to-%s", userID),
-		ClusterRoleBindingName: userID,
-		Namespace:              "default",
-		TenantID:               tenant,
+	role, err := roles.DefaultCatalogue().Get(roleName)
+	if err != nil {
+		role = roles.Role{Name: roleName}
 	}
+	user := NewSAInfo(userID, role, tenant)
+	user.Namespace = "default"
 	rollbackE := RollbackE{}
-	return &RuntimeClient{clientset, coreClientset, user, L2L3OperatiorRole, rollbackE}, nil
+	return &RuntimeClient{K8s: clientset, KcpK8s: coreClientset, User: user, Role: role, RollbackE: rollbackE}, nil
 }
 
 func TestCreateserviceaccount(t *testing.T) {
@@ -74,8 +71,7 @@ func TestCreateserviceaccount(t *testing.T) {
 	})
 
 	t.Run("If no clusterrolerules exists one is created", func(t *testing.T) {
-		var clusterRoleName = "sa1"
-		rtc, err := NewRuntimeClientTest([]byte("kubeconfig"), clusterRoleName, "runtimeOperator", "tenantID")
+		rtc, err := NewRuntimeClientTest([]byte("kubeconfig"), "sa1", "runtimeOperator", "tenantID")
 		assert.NoError(t, err)
 
 		err = rtc.createClusterRoleRules()
@@ -85,13 +81,15 @@ func TestCreateserviceaccount(t *testing.T) {
 		cr, err := crClient.Get(context.TODO(), rtc.User.ClusterRoleRulesName, v1.GetOptions{})
 		assert.NotNil(t, cr)
 		assert.NoError(t, err)
+		assert.Equal(t, "kubeconfig-service-runtimeoperator-rules", cr.Name)
 		assert.Equal(t, expectedTenantID, rtc.User.TenantID)
 		assert.Contains(t, cr.ObjectMeta.Labels, rtc.User.ClusterRoleAggrLabel)
-		assert.Equal(t, L2L3OperatorPolicyRule["runtimeOperator"], cr.Rules)
+		assert.Equal(t, rtc.Role.Rules, cr.Rules)
+		assert.NotEmpty(t, cr.Rules)
 	})
 
 	t.Run("If input clusterrole not supported no one is created", func(t *testing.T) {
-		//`unSupportedOperation` not belong to `runtimeAdmin`/`runtimeOperator`
+		//`unSupportedOperation` is not in the role catalogue
 		rtc, err := NewRuntimeClientTest([]byte("kubeconfig"), "sa1", "unSupportedOperation", "tenantID")
 		assert.NoError(t, err)
 
@@ -105,8 +103,7 @@ func TestCreateserviceaccount(t *testing.T) {
 	})
 
 	t.Run("If no clusterrole exists one is created", func(t *testing.T) {
-		var clusterRoleName = "sa1"
-		rtc, err := NewRuntimeClientTest([]byte("kubeconfig"), clusterRoleName, "runtimeOperator", "tenantID")
+		rtc, err := NewRuntimeClientTest([]byte("kubeconfig"), "sa1", "runtimeOperator", "tenantID")
 		assert.NoError(t, err)
 
 		err = rtc.createClusterRole()
@@ -116,6 +113,7 @@ func TestCreateserviceaccount(t *testing.T) {
 		cr, err := crClient.Get(context.TODO(), rtc.User.ClusterRoleName, v1.GetOptions{})
 		assert.NotNil(t, cr)
 		assert.NoError(t, err)
+		assert.Equal(t, "kubeconfig-service-runtimeoperator", cr.Name)
 		assert.Equal(t, expectedTenantID, rtc.User.TenantID)
 		assert.NotNil(t, cr.AggregationRule)
 
@@ -127,7 +125,7 @@ func TestCreateserviceaccount(t *testing.T) {
 			},
 			{
 				MatchLabels: map[string]string{
-					"rbac.authorization.k8s.io/aggregate-to-sa1": "true",
+					"rbac.authorization.k8s.io/aggregate-to-kubeconfig-service-runtimeoperator": "true",
 				},
 			},
 		}
@@ -150,173 +148,72 @@ func TestCreateserviceaccount(t *testing.T) {
 		assert.Equal(t, expectedTenantID, rtc.User.TenantID)
 	})
 
-	t.Run("If kcp config map is updated with remove method", func(t *testing.T) {
-		var runtimeName = "runtime2"
+	t.Run("If namespaced role is requested rolebindings are created instead of clusterrolebinding", func(t *testing.T) {
 		rtc, err := NewRuntimeClientTest([]byte("kubeconfig"), "sa1", "runtimeOperator", "tenantID")
 		assert.NoError(t, err)
+		assert.NoError(t, rtc.createBindings())
 
-		configmap := &corev1.ConfigMap{
-			ObjectMeta: v1.ObjectMeta{
-				Name:        "sa1",
-				Namespace:   "kcp-system",
-				Labels:      map[string]string{"service": "kubeconfig"},
-				Annotations: map[string]string{"role": "runtimeOperator", "tenant": "tenantID"},
-			},
-			Data: map[string]string{"runtime1": "startTime1", "runtime2": "startTime2"},
-		}
-		cm, err := rtc.KcpK8s.CoreV1().ConfigMaps("kcp-system").Create(context.Background(), configmap, v1.CreateOptions{})
-		assert.NoError(t, err)
-		assert.NotNil(t, cm)
-
-		err = rtc.UpdateConfigMap(runtimeName)
+		rtc.Role, err = roles.DefaultCatalogue().Get(roles.NamespaceAdmin)
 		assert.NoError(t, err)
-
-		cm, err = rtc.KcpK8s.CoreV1().ConfigMaps("kcp-system").Get(context.Background(), "sa1", v1.GetOptions{})
-		assert.NotNil(t, cm)
+		rtc.Namespaces = []string{"default", "team"}
+		err = rtc.createBindings()
 		assert.NoError(t, err)
-		assert.Equal(t, "startTime1", cm.Data["runtime1"])
-		assert.Empty(t, cm.Data["runtime2"])
-	})
 
-	t.Run("If kcp config map deployed when no user exists", func(t *testing.T) {
-		var runtimeName, namespaceName = "runtime1", "kcp-system"
-		rtc, err := NewRuntimeClientTest([]byte("kubeconfig"), "sa1", "runtimeOperator", "tenantID")
-		assert.NoError(t, err)
+		_, err = rtc.K8s.RbacV1().ClusterRoleBindings().Get(context.TODO(), rtc.User.ClusterRoleBindingName, v1.GetOptions{})
+		assert.True(t, k8serrors.IsNotFound(err))
+		for _, namespace := range rtc.Namespaces {
+			rb, err := rtc.K8s.RbacV1().RoleBindings(namespace).Get(context.TODO(), rtc.User.ClusterRoleBindingName, v1.GetOptions{})
+			assert.NoError(t, err)
+			assert.Equal(t, rtc.User.ClusterRoleName, rb.RoleRef.Name)
+			assert.Equal(t, ManagedByValue, rb.Labels[ManagedByLabel])
+		}
 
-		startTime := time.Now()
-		err = rtc.DeployConfigMap(runtimeName, "runtimeOperator", startTime)
+		rtc.Namespaces = []string{"team"}
+		err = rtc.createBindings()
 		assert.NoError(t, err)
 
-		cm, err := rtc.KcpK8s.CoreV1().ConfigMaps(namespaceName).Get(context.Background(), "sa1", v1.GetOptions{})
-		assert.NotNil(t, cm)
-		assert.NoError(t, err)
-		assert.Equal(t, expectedSaName, cm.Name)
-		assert.Equal(t, namespaceName, cm.Namespace)
-		assert.Equal(t, "kubeconfig", cm.Labels["service"])
-		assert.Equal(t, "runtimeOperator", cm.Annotations["role"])
-		assert.Equal(t, expectedTenantID, cm.Annotations["tenant"])
-		assert.NotEmpty(t, cm.Data["runtime1"])
+		_, err = rtc.K8s.RbacV1().RoleBindings("default").Get(context.TODO(), rtc.User.ClusterRoleBindingName, v1.GetOptions{})
+		assert.True(t, k8serrors.IsNotFound(err))
 	})
 
-	t.Run("If kcp config map deployed when user and runtime existed", func(t *testing.T) {
-		var runtimeName, namespaceName = "runtime1", "kcp-system"
-		rtc, err := NewRuntimeClientTest([]byte("kubeconfig"), "sa1", "runtimeOperator", "tenantID")
+	t.Run("If namespaced role is requested without namespaces nothing is created", func(t *testing.T) {
+		rtc, err := NewRuntimeClientTest([]byte("kubeconfig"), "sa1", roles.NamespaceAdmin, "tenantID")
 		assert.NoError(t, err)
 
-		configmap := &corev1.ConfigMap{
-			ObjectMeta: v1.ObjectMeta{
-				Name:        "sa1",
-				Namespace:   "kcp-system",
-				Labels:      map[string]string{"service": "kubeconfig"},
-				Annotations: map[string]string{"role": "runtimeOperator", "tenant": "tenantID"},
-			},
-			Data: map[string]string{"runtime1": "startTime1"},
-		}
-		cm, err := rtc.KcpK8s.CoreV1().ConfigMaps("kcp-system").Create(context.Background(), configmap, v1.CreateOptions{})
-		assert.NoError(t, err)
-		assert.NotNil(t, cm)
-
-		startTime := time.Now()
-		err = rtc.DeployConfigMap(runtimeName, "runtimeOperator", startTime)
-		assert.NoError(t, err)
-
-		cm, err = rtc.KcpK8s.CoreV1().ConfigMaps("kcp-system").Get(context.Background(), "sa1", v1.GetOptions{})
-		assert.NotNil(t, cm)
-		assert.NoError(t, err)
-		assert.Equal(t, expectedSaName, cm.Name)
-		assert.Equal(t, namespaceName, cm.Namespace)
-		assert.Equal(t, "kubeconfig", cm.Labels["service"])
-		assert.Equal(t, "runtimeOperator", cm.Annotations["role"])
-		assert.Equal(t, expectedTenantID, cm.Annotations["tenant"])
-		assert.NotEmpty(t, cm.Data["runtime1"])
-		assert.NotEqual(t, "startTime1", cm.Data["runtime1"])
+		err = rtc.createBindings()
+		assert.Error(t, err)
 	})
 
-	t.Run("If kcp config map deployed when user existed but runtime not", func(t *testing.T) {
-		var runtimeName, namespaceName = "runtime2", "kcp-system"
+	t.Run("If access is revoked everything of the user is removed", func(t *testing.T) {
 		rtc, err := NewRuntimeClientTest([]byte("kubeconfig"), "sa1", "runtimeOperator", "tenantID")
 		assert.NoError(t, err)
-
-		configmap := &corev1.ConfigMap{
-			ObjectMeta: v1.ObjectMeta{
-				Name:        "sa1",
-				Namespace:   "kcp-system",
-				Labels:      map[string]string{"service": "kubeconfig"},
-				Annotations: map[string]string{"role": "runtimeOperator", "tenant": "tenantID"},
-			},
-			Data: map[string]string{"runtime1": "startTime1"},
+		for _, legacy := range []string{"sa1", "sa1-rules"} {
+			_, err = rtc.K8s.RbacV1().ClusterRoles().Create(context.TODO(), &rbacv1.ClusterRole{ObjectMeta: v1.ObjectMeta{Name: legacy}}, v1.CreateOptions{})
+			assert.NoError(t, err)
 		}
-		cm, err := rtc.KcpK8s.CoreV1().ConfigMaps("kcp-system").Create(context.Background(), configmap, v1.CreateOptions{})
-		assert.NoError(t, err)
-		assert.NotNil(t, cm)
+		assert.NoError(t, rtc.createServiceAccount())
+		assert.NoError(t, rtc.createClusterRoleRules())
+		assert.NoError(t, rtc.createClusterRole())
+		assert.NoError(t, rtc.createClusterRoleBinding())
+		assert.NoError(t, rtc.createRoleBinding("team"))
 
-		startTime := time.Now()
-		err = rtc.DeployConfigMap(runtimeName, "runtimeOperator", startTime)
-		assert.NoError(t, err)
-
-		cm, err = rtc.KcpK8s.CoreV1().ConfigMaps("kcp-system").Get(context.Background(), "sa1", v1.GetOptions{})
-		assert.NotNil(t, cm)
-		assert.NoError(t, err)
-		assert.Equal(t, expectedSaName, cm.Name)
-		assert.Equal(t, namespaceName, cm.Namespace)
-		assert.Equal(t, "kubeconfig", cm.Labels["service"])
-		assert.Equal(t, "runtimeOperator", cm.Annotations["role"])
-		assert.Equal(t, expectedTenantID, cm.Annotations["tenant"])
-		assert.Equal(t, "startTime1", cm.Data["runtime1"])
-		assert.NotEmpty(t, cm.Data["runtime2"])
-	})
-
-	t.Run("If kcp config map deployed when user existed with runtime removed", func(t *testing.T) {
-		var runtimeName, namespaceName = "runtime1", "kcp-system"
-		rtc, err := NewRuntimeClientTest([]byte("kubeconfig"), "sa1", "runtimeOperator", "tenantID")
+		err = rtc.RevokeAccess()
 		assert.NoError(t, err)
 
-		configmap := &corev1.ConfigMap{
-			ObjectMeta: v1.ObjectMeta{
-				Name:        "sa1",
-				Namespace:   "kcp-system",
-				Labels:      map[string]string{"service": "kubeconfig"},
-				Annotations: map[string]string{"role": "runtimeOperator", "tenant": "tenantID"},
-			},
-			Data: map[string]string{runtimeName: "startTime1"},
+		_, err = rtc.K8s.CoreV1().ServiceAccounts(rtc.User.Namespace).Get(context.TODO(), rtc.User.ServiceAccountName, v1.GetOptions{})
+		assert.True(t, k8serrors.IsNotFound(err))
+		for _, legacy := range []string{"sa1", "sa1-rules"} {
+			_, err = rtc.K8s.RbacV1().ClusterRoles().Get(context.TODO(), legacy, v1.GetOptions{})
+			assert.True(t, k8serrors.IsNotFound(err))
 		}
-		cm, err := rtc.KcpK8s.CoreV1().ConfigMaps("kcp-system").Create(context.Background(), configmap, v1.CreateOptions{})
+		// the clusterroles of the role are shared with other users
+		_, err = rtc.K8s.RbacV1().ClusterRoles().Get(context.TODO(), rtc.User.ClusterRoleName, v1.GetOptions{})
 		assert.NoError(t, err)
-		assert.NotNil(t, cm)
-
-		/*
-			var patches []*JsonPatchType
-			patch := &JsonPatchType{
-				Op:   "remove",
-				Path: "/data/runtime1",
-			}
-			patches = append(patches, patch)
-			payload, err := json.Marshal(patches)
-			assert.NoError(t, err)
-			cm, err = rtc.KcpK8s.CoreV1().ConfigMaps("kcp-system").Patch(context.Background(), "sa1", types.JSONPatchType, payload, metav1.PatchOptions{})
-			assert.NoError(t, err)
-			assert.Empty(t, cm.Data)
-		*/
-
-		err = rtc.UpdateConfigMap(runtimeName)
+		_, err = rtc.K8s.RbacV1().ClusterRoles().Get(context.TODO(), rtc.User.ClusterRoleRulesName, v1.GetOptions{})
 		assert.NoError(t, err)
-		_, err = rtc.KcpK8s.CoreV1().ConfigMaps("kcp-system").Get(context.Background(), "sa1", v1.GetOptions{})
+		_, err = rtc.K8s.RbacV1().ClusterRoleBindings().Get(context.TODO(), rtc.User.ClusterRoleBindingName, v1.GetOptions{})
+		assert.True(t, k8serrors.IsNotFound(err))
+		_, err = rtc.K8s.RbacV1().RoleBindings("team").Get(context.TODO(), rtc.User.ClusterRoleBindingName, v1.GetOptions{})
 		assert.True(t, k8serrors.IsNotFound(err))
-
-		startTime := time.Now()
-		err = rtc.DeployConfigMap(runtimeName, "runtimeOperator", startTime)
-		assert.NoError(t, err)
-
-		cm, err = rtc.KcpK8s.CoreV1().ConfigMaps("kcp-system").Get(context.Background(), "sa1", v1.GetOptions{})
-		assert.NotNil(t, cm)
-		assert.NoError(t, err)
-		assert.Equal(t, expectedSaName, cm.Name)
-		assert.Equal(t, namespaceName, cm.Namespace)
-		assert.Equal(t, "kubeconfig", cm.Labels["service"])
-		assert.Equal(t, "runtimeOperator", cm.Annotations["role"])
-		assert.Equal(t, expectedTenantID, cm.Annotations["tenant"])
-		assert.NotEmpty(t, cm.Data["runtime1"])
-		assert.NotEqual(t, "startTime1", cm.Data["runtime1"])
 	})
-
 }
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/pivotal-cf/brokerapi/v8/domain/apiresponses"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const bindingRequestBody = `{
+	"service_id": "47c9dcbf-ff30-448e-ab36-d3bad66ba281",
+	"plan_id": "5cb3d976-b85c-42ea-a636-79cadda109a9",
+	"parameters": {
+		"expiration_seconds": 900
+	}
+}`
+
+func TestBinding_AsyncBindAndUnbind(t *testing.T) {
+	// given
+	suite := NewBrokerSuiteTest(t)
+	defer suite.TearDown()
+	iid := suite.provisionRuntimeForBinding()
+	bid := uuid.New().String()
+
+	// when
+	resp := suite.CallAPI("PUT", fmt.Sprintf("oauth/v2/service_instances/%s/service_bindings/%s?accepts_incomplete=true", iid, bid), bindingRequestBody)
+
+	// then
+	require.Equal(t, http.StatusAccepted, resp.StatusCode)
+	suite.waitForBindingState(iid, bid, domain.Succeeded)
+
+	// when
+	resp = suite.CallAPI("GET", fmt.Sprintf("oauth/v2/service_instances/%s/service_bindings/%s", iid, bid), "")
+
+	// then
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var body apiresponses.GetBindingResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	credentials, ok := body.Credentials.(map[string]interface{})
+	require.True(t, ok)
+	assert.Equal(t, fmt.Sprintf("kubeconfig-for-kyma-binding-%s", bid), credentials["kubeconfig"])
+	assert.NotEmpty(t, credentials["expires_at"])
+
+	// when
+	resp = suite.CallAPI("DELETE", fmt.Sprintf("oauth/v2/service_instances/%s/service_bindings/%s?plan_id=5cb3d976-b85c-42ea-a636-79cadda109a9&service_id=47c9dcbf-ff30-448e-ab36-d3bad66ba281", iid, bid), "")
+
+	// then
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Contains(t, suite.bindingManager.Revoked(), bid)
+	resp = suite.CallAPI("GET", fmt.Sprintf("oauth/v2/service_instances/%s/service_bindings/%s", iid, bid), "")
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestBinding_RevokedOnDeprovisioning(t *testing.T) {
+	// given
+	suite := NewBrokerSuiteTest(t)
+	defer suite.TearDown()
+	iid := suite.provisionRuntimeForBinding()
+	bid := uuid.New().String()
+
+	resp := suite.CallAPI("PUT", fmt.Sprintf("oauth/v2/service_instances/%s/service_bindings/%s", iid, bid), bindingRequestBody)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	// when
+	resp = suite.CallAPI("DELETE", fmt.Sprintf("oauth/v2/service_instances/%s?accepts_incomplete=true&plan_id=5cb3d976-b85c-42ea-a636-79cadda109a9&service_id=47c9dcbf-ff30-448e-ab36-d3bad66ba281", iid), "")
+	require.Equal(t, http.StatusAccepted, resp.StatusCode)
+
+	// then
+	err := suite.poller.Invoke(func() (bool, error) {
+		bindings, err := suite.db.Bindings().ListByInstanceID(iid)
+		if err != nil {
+			return false, err
+		}
+		return len(bindings) == 0, nil
+	})
+	require.NoError(t, err)
+	assert.Contains(t, suite.bindingManager.Revoked(), bid)
+}
+
+func (s *BrokerSuiteTest) provisionRuntimeForBinding() string {
+	iid := uuid.New().String()
+	resp := s.CallAPI("PUT", fmt.Sprintf("oauth/v2/service_instances/%s?accepts_incomplete=true", iid),
+		`{
+					"service_id": "47c9dcbf-ff30-448e-ab36-d3bad66ba281",
+					"plan_id": "5cb3d976-b85c-42ea-a636-79cadda109a9",
+					"context": {
+						"globalaccount_id": "g-account-id",
+						"subaccount_id": "sub-id",
+						"user_id": "john.smith@email.com"
+					},
+					"parameters": {
+						"name": "testing-cluster"
+					}
+		}`)
+	opID := s.DecodeOperationID(resp)
+	s.processProvisioningByOperationID(opID)
+	s.WaitForOperationState(opID, domain.Succeeded)
+	return iid
+}
+
+func (s *BrokerSuiteTest) waitForBindingState(iid, bid string, state domain.LastOperationState) {
+	var last apiresponses.LastOperationResponse
+	err := s.poller.Invoke(func() (bool, error) {
+		resp := s.CallAPI("GET", fmt.Sprintf("oauth/v2/service_instances/%s/service_bindings/%s/last_operation?operation=%s", iid, bid, internal.BindingOperationBind), "")
+		if resp.StatusCode != http.StatusOK {
+			return false, nil
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&last); err != nil {
+			return false, err
+		}
+		return last.State == state, nil
+	})
+	assert.NoError(s.t, err, "binding %s did not reach state %s, last state: %s", bid, state, last.State)
+}
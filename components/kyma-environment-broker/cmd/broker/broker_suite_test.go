@@ -11,6 +11,7 @@ import (
 	"path"
 	"reflect"
 	"sort"
+	"sync"
 	"testing"
 	"time"
 
@@ -26,6 +27,7 @@ import (
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/avs"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/binding"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
 	kebConfig "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/config"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/edp"
@@ -33,11 +35,14 @@ import (
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/fixture"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/ias"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/notification"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/operationsteps"
 	kebOrchestration "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/orchestration"
 	orchestrate "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/orchestration/handlers"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/preview"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process/input"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process/provisioning"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process/runtime_task"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process/update"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process/upgrade_cluster"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process/upgrade_kyma"
@@ -109,6 +114,33 @@ type BrokerSuiteTest struct {
 	k8sSKR client.Client
 
 	poller broker.Poller
+
+	bindingManager *fakeBindingManager
+}
+
+// fakeBindingManager issues fake kubeconfigs and records revoked bindings instead of calling the runtime
+type fakeBindingManager struct {
+	mu      sync.Mutex
+	revoked []string
+}
+
+func (m *fakeBindingManager) Issue(binding internal.Binding) (internal.Binding, error) {
+	binding.Kubeconfig = fmt.Sprintf("kubeconfig-for-%s", binding.ServiceAccountName())
+	binding.ExpiresAt = time.Now().Add(time.Duration(binding.ExpirationSeconds) * time.Second)
+	return binding, nil
+}
+
+func (m *fakeBindingManager) Revoke(binding internal.Binding) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.revoked = append(m.revoked, binding.ID)
+	return nil
+}
+
+func (m *fakeBindingManager) Revoked() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]string{}, m.revoked...)
 }
 
 type componentProviderDecorated struct {
@@ -185,6 +217,7 @@ func NewBrokerSuiteTest(t *testing.T, version ...string) *BrokerSuiteTest {
 
 	provisionerClient := provisioner.NewFakeClientWithGardener(gardenerClient, "kcp-system")
 	eventBroker := event.NewPubSub(logs)
+	operationsteps.NewRecorder(eventBroker, db.OperationSteps(), logs)
 
 	runtimeOverrides := runtimeoverrides.NewRuntimeOverrides(ctx, cli)
 	accountVersionMapping := runtimeversion.NewAccountVersionMapping(ctx, cli, cfg.VersionConfig.Namespace, cfg.VersionConfig.Name, logs)
@@ -216,10 +249,11 @@ func NewBrokerSuiteTest(t *testing.T, version ...string) *BrokerSuiteTest {
 	updateQueue.SpeedUp(10000)
 	updateManager.SpeedUp(10000)
 
+	bindingManager := &fakeBindingManager{}
 	deprovisionManager := process.NewStagedManager(db.Operations(), eventBroker, time.Hour, logs.WithField("deprovisioning", "manager"))
 	deprovisioningQueue := NewDeprovisioningProcessingQueue(ctx, workersAmount, deprovisionManager, cfg, db, eventBroker,
 		provisionerClient, avsDel, internalEvalAssistant, externalEvalAssistant,
-		bundleBuilder, edpClient, accountProvider, reconcilerClient, fakeK8sClientProvider(fakeK8sSKRClient), fakeK8sSKRClient, configProvider, logs,
+		bundleBuilder, edpClient, accountProvider, reconcilerClient, bindingManager, fakeK8sClientProvider(fakeK8sSKRClient), fakeK8sSKRClient, configProvider, logs,
 	)
 	deprovisionManager.SpeedUp(10000)
 
@@ -238,9 +272,10 @@ func NewBrokerSuiteTest(t *testing.T, version ...string) *BrokerSuiteTest {
 		k8sKcp:              cli,
 		k8sSKR:              fakeK8sSKRClient,
 		poller:              &broker.DefaultPoller{3 * time.Millisecond, 2 * time.Second},
+		bindingManager:      bindingManager,
 	}
 
-	ts.CreateAPI(inputFactory, cfg, db, provisioningQueue, deprovisioningQueue, updateQueue, logs)
+	ts.CreateAPI(inputFactory, runtimeversion.NewRuntimeVersionConfigurator(cfg.KymaVersion, accountVersionMapping, db.RuntimeStates()), cfg, db, provisioningQueue, deprovisioningQueue, updateQueue, logs)
 
 	notificationFakeClient := notification.NewFakeClient()
 	notificationBundleBuilder := notification.NewBundleBuilder(notificationFakeClient, cfg.Notification)
@@ -248,28 +283,42 @@ func NewBrokerSuiteTest(t *testing.T, version ...string) *BrokerSuiteTest {
 	upgradeEvaluationManager := avs.NewEvaluationManager(avsDel, avs.Config{})
 	runtimeLister := kebOrchestration.NewRuntimeLister(db.Instances(), db.Operations(), kebRuntime.NewConverter(defaultRegion), logs)
 	runtimeResolver := orchestration.NewGardenerRuntimeResolver(gardenerClient, fixedGardenerNamespace, runtimeLister, logs)
+	freezeCalendar := kebOrchestration.NewFreezeCalendar(nil, db.Freezes())
 	kymaQueue := NewKymaOrchestrationProcessingQueue(ctx, db, runtimeOverrides, provisionerClient, eventBroker, inputFactory, &upgrade_kyma.TimeSchedule{
 		Retry:              10 * time.Millisecond,
 		StatusCheck:        100 * time.Millisecond,
 		UpgradeKymaTimeout: 4 * time.Second,
-	}, 250*time.Millisecond, runtimeVerConfigurator, runtimeResolver, upgradeEvaluationManager, cfg, avs.NewInternalEvalAssistant(cfg.Avs), reconcilerClient, notificationBundleBuilder, logs, cli, 1000)
+	}, 250*time.Millisecond, runtimeVerConfigurator, runtimeResolver, upgradeEvaluationManager, cfg, avs.NewInternalEvalAssistant(cfg.Avs), reconcilerClient, notificationBundleBuilder, freezeCalendar, logs, cli, 1000)
 
 	clusterQueue := NewClusterOrchestrationProcessingQueue(ctx, db, provisionerClient, eventBroker, inputFactory, &upgrade_cluster.TimeSchedule{
 		Retry:                 10 * time.Millisecond,
 		StatusCheck:           100 * time.Millisecond,
 		UpgradeClusterTimeout: 4 * time.Second,
-	}, 250*time.Millisecond, runtimeResolver, upgradeEvaluationManager, notificationBundleBuilder, logs, cli, *cfg, 1000)
+	}, 250*time.Millisecond, runtimeResolver, upgradeEvaluationManager, notificationBundleBuilder, freezeCalendar, logs, cli, *cfg, 1000)
+
+	taskQueue := NewRuntimeTaskOrchestrationProcessingQueue(ctx, db, eventBroker, fakeKubeconfigProvider{}, fakeK8sClientProvider(fake.NewClientBuilder().Build()), &runtime_task.TimeSchedule{
+		Retry:       10 * time.Millisecond,
+		StatusCheck: 100 * time.Millisecond,
+	}, 250*time.Millisecond, runtimeResolver, notificationBundleBuilder, freezeCalendar, logs, cli, *cfg, 1000)
 
 	kymaQueue.SpeedUp(1000)
 	clusterQueue.SpeedUp(1000)
+	taskQueue.SpeedUp(1000)
 
 	// TODO: in case of cluster upgrade the same Azure Zones must be send to the Provisioner
-	orchestrationHandler := orchestrate.NewOrchestrationHandler(db, kymaQueue, clusterQueue, cfg.MaxPaginationPage, logs)
+	orchestrationHandler := orchestrate.NewOrchestrationHandler(db, kymaQueue, clusterQueue, taskQueue, cfg.RuntimeTask, freezeCalendar, cfg.MaxPaginationPage, logs)
 	orchestrationHandler.AttachRoutes(ts.router)
 	ts.httpServer = httptest.NewServer(ts.router)
 	return ts
 }
 
+// fakeKubeconfigProvider returns fake admin kubeconfigs, the runtime clients are faked anyway
+type fakeKubeconfigProvider struct{}
+
+func (fakeKubeconfigProvider) AdminKubeconfig(instance *internal.Instance) (string, error) {
+	return fmt.Sprintf("kubeconfig-for-%s", instance.RuntimeID), nil
+}
+
 func fakeK8sClientProvider(k8sCli client.Client) func(s string) (client.Client, error) {
 	return func(s string) (client.Client, error) {
 		return k8sCli, nil
@@ -313,7 +362,7 @@ func (s *BrokerSuiteTest) CallAPI(method string, path string, body string) *http
 	return resp
 }
 
-func (s *BrokerSuiteTest) CreateAPI(inputFactory broker.PlanValidator, cfg *Config, db storage.BrokerStorage, provisioningQueue *process.Queue, deprovisionQueue *process.Queue, updateQueue *process.Queue, logs logrus.FieldLogger) {
+func (s *BrokerSuiteTest) CreateAPI(inputFactory input.CreatorForPlan, runtimeVerConfigurator preview.RuntimeVersionConfigurator, cfg *Config, db storage.BrokerStorage, provisioningQueue *process.Queue, deprovisionQueue *process.Queue, updateQueue *process.Queue, logs logrus.FieldLogger) {
 	servicesConfig := map[string]broker.Service{
 		broker.KymaServiceName: {
 			Description: "",
@@ -336,7 +385,9 @@ func (s *BrokerSuiteTest) CreateAPI(inputFactory broker.PlanValidator, cfg *Conf
 	planDefaults := func(planID string, platformProvider internal.CloudProvider, provider *internal.CloudProvider) (*gqlschema.ClusterConfigInput, error) {
 		return &gqlschema.ClusterConfigInput{}, nil
 	}
-	createAPI(s.router, servicesConfig, inputFactory, cfg, db, provisioningQueue, deprovisionQueue, updateQueue, lager.NewLogger("api"), logs, planDefaults)
+	bindingQueue := process.NewQueue(binding.NewExecutor(db.Bindings(), s.bindingManager, logs), logs)
+	bindingQueue.Run(context.Background().Done(), 1)
+	createAPI(s.router, servicesConfig, inputFactory, runtimeVerConfigurator, cfg, db, provisioningQueue, deprovisionQueue, updateQueue, s.bindingManager, bindingQueue, lager.NewLogger("api"), logs, planDefaults)
 
 	s.httpServer = httptest.NewServer(s.router)
 }
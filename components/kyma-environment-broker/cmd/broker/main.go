@@ -25,8 +25,10 @@ import (
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/hyperscaler"
 	orchestrationExt "github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/accountpool"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/appinfo"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/avs"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/binding"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
 	kebConfig "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/config"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/dashboard"
@@ -41,20 +43,25 @@ import (
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/metrics"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/middleware"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/notification"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/operationsteps"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/orchestration"
 	orchestrate "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/orchestration/handlers"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/orchestration/manager"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/preview"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process/deprovisioning"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process/input"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process/provisioning"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process/runtime_task"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process/steps"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process/update"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process/upgrade_cluster"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process/upgrade_kyma"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/provider"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/provisioner"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/quota"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/reconciler"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/reencryption"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/runtime"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/runtime/components"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/runtimeoverrides"
@@ -63,6 +70,7 @@ import (
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/suspension"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/swagger"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/webhook"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/sirupsen/logrus"
@@ -70,6 +78,7 @@ import (
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
@@ -147,12 +156,18 @@ type Config struct {
 	KymaDashboardConfig dashboard.Config
 
 	OrchestrationConfig orchestration.Config
+	// OrchestrationFreezesFilePath points to the file with orchestration freezes, which cannot be deleted with the API
+	OrchestrationFreezesFilePath string `envconfig:"optional"`
+	RuntimeTask                  orchestration.RuntimeTaskConfig
 
 	TrialRegionMappingFilePath string
 
 	EuAccessWhitelistedGlobalAccountsFilePath string
 	EuAccessRejectionMessage                  string `envconfig:"default=Due to limited availability you need to open support ticket before attempting to provision Kyma clusters in EU Access only regions"`
 
+	// QuotasFilePath points to the file with limits of instances per plan and region for global accounts, no limits are enforced if empty
+	QuotasFilePath string `envconfig:"optional"`
+
 	MaxPaginationPage int `envconfig:"default=100"`
 
 	LogLevel string `envconfig:"default=info"`
@@ -168,6 +183,18 @@ type Config struct {
 	Profiler ProfilerConfig
 
 	Events events.Config
+
+	// RetryPollingInterval defines how often operations with a passed retry time are looked for
+	RetryPollingInterval time.Duration `envconfig:"default=1m"`
+
+	// Leases allows to run several KEB replicas, every operation and orchestration
+	// is processed only by the replica which holds its lease.
+	Leases process.LeaseConfig
+
+	Webhooks webhook.Config
+
+	// Reencryption encrypts the data stored with legacy keys with the active key after a key rotation
+	Reencryption reencryption.Config
 }
 
 type ProfilerConfig struct {
@@ -221,6 +248,11 @@ func main() {
 	cfg.OrchestrationConfig.KymaVersion = cfg.KymaVersion
 	cfg.OrchestrationConfig.KubernetesVersion = cfg.Provisioner.KubernetesVersion
 
+	if cfg.Leases.Enabled && cfg.Leases.Owner == "" {
+		cfg.Leases.Owner, err = os.Hostname()
+		fatalOnError(err)
+	}
+
 	// create logger
 	logger := lager.NewLogger("kyma-env-broker")
 
@@ -238,7 +270,10 @@ func main() {
 	go periodicProfile(logger, cfg.Profiler)
 
 	// create provisioner client
-	provisionerClient := provisioner.NewProvisionerClient(cfg.Provisioner.URL, cfg.DumpProvisionerRequests)
+	var provisionerClient provisioner.Client = provisioner.NewProvisionerClient(cfg.Provisioner.URL, cfg.DumpProvisionerRequests)
+	if cfg.Provisioner.OperationStatusSubscriptions {
+		provisionerClient = provisioner.NewSubscribingClient(provisionerClient, cfg.Provisioner.URL, logs.WithField("service", "provisionerSubscriptions"))
+	}
 
 	reconcilerClient := reconciler.NewReconcilerClient(http.DefaultClient, logs.WithField("service", "reconciler"), &cfg.Reconciler)
 
@@ -249,7 +284,8 @@ func main() {
 	fatalOnError(err)
 
 	// create storage
-	cipher := storage.NewEncrypter(cfg.Database.SecretKey)
+	cipher, err := storage.NewEncrypterFromConfig(cfg.Database)
+	fatalOnError(err)
 	var db storage.BrokerStorage
 	if cfg.DbInMemory {
 		db = storage.NewMemoryStorage()
@@ -260,6 +296,9 @@ func main() {
 		dbStatsCollector := sqlstats.NewStatsCollector("broker", conn)
 		prometheus.MustRegister(dbStatsCollector)
 	}
+	if cfg.Reencryption.Enabled {
+		reencryption.NewJob(db.Reencryption(), cfg.Reencryption, logs.WithField("service", "reencryption")).Run(ctx.Done())
+	}
 
 	// Customer Notification
 	clientHTTPForNotification := httputil.NewClient(60, true)
@@ -297,6 +336,7 @@ func main() {
 	gardenerAccountPool := hyperscaler.NewAccountPool(dynamicGardener, gardenerNamespace)
 	gardenerSharedPool := hyperscaler.NewSharedGardenerAccountPool(dynamicGardener, gardenerNamespace)
 	accountProvider := hyperscaler.NewAccountProvider(gardenerAccountPool, gardenerSharedPool)
+	accountInventory := hyperscaler.NewInventory(dynamicGardener, gardenerNamespace)
 
 	regions, err := provider.ReadPlatformRegionMappingFromFile(cfg.TrialRegionMappingFilePath)
 	fatalOnError(err)
@@ -336,6 +376,16 @@ func main() {
 
 	// metrics collectors
 	metrics.RegisterAll(eventBroker, db.Operations(), db.Instances())
+	prometheus.MustRegister(metrics.NewAccountPoolCollector(accountInventory))
+	// progress of operations for the event stream, subscribed before any operation is processed
+	progressBroadcaster := eventshandler.NewBroadcaster(eventBroker, logs.WithField("service", "eventStream"))
+	// attempts of operation steps for the step timeline
+	operationsteps.NewRecorder(eventBroker, db.OperationSteps(), logs.WithField("service", "operationSteps"))
+	// lifecycle events sent to the webhook subscriptions, subscribed before any operation is processed
+	var webhookHandler *webhook.Handler
+	if cfg.Webhooks.Enabled {
+		webhookHandler = startWebhooks(ctx, &cfg, db, eventBroker, logs.WithField("service", "webhooks"))
+	}
 	metrics.StartOpsMetricService(ctx, db.Operations(), logs)
 	//setup runtime overrides appender
 	runtimeOverrides := runtimeoverrides.NewRuntimeOverrides(ctx, cli)
@@ -344,6 +394,10 @@ func main() {
 	accountVersionMapping := runtimeversion.NewAccountVersionMapping(ctx, cli, cfg.VersionConfig.Namespace, cfg.VersionConfig.Name, logs)
 	runtimeVerConfigurator := runtimeversion.NewRuntimeVersionConfigurator(cfg.KymaVersion, accountVersionMapping, db.RuntimeStates())
 
+	// service bindings issue kubeconfigs with tokens of service accounts created on the runtime
+	kcBuilder := kubeconfig.NewBuilder(provisionerClient)
+	bindingManager := binding.NewManager(cfg.Broker.Binding, db.Instances(), kcBuilder, skrClientsetProvider)
+
 	// run queues
 	const workersAmount = 5
 	provisionManager := process.NewStagedManager(db.Operations(), eventBroker, cfg.OperationTimeout, logs.WithField("provisioning", "manager"))
@@ -354,12 +408,15 @@ func main() {
 	deprovisionManager := process.NewStagedManager(db.Operations(), eventBroker, cfg.OperationTimeout, logs.WithField("deprovisioning", "manager"))
 	deprovisionQueue := NewDeprovisioningProcessingQueue(ctx, workersAmount, deprovisionManager, &cfg, db, eventBroker, provisionerClient,
 		avsDel, internalEvalAssistant, externalEvalAssistant, bundleBuilder, edpClient, accountProvider, reconcilerClient,
-		k8sClientProvider, cli, configProvider, logs)
+		bindingManager, k8sClientProvider, cli, configProvider, logs)
 
 	updateManager := process.NewStagedManager(db.Operations(), eventBroker, cfg.OperationTimeout, logs.WithField("update", "manager"))
 	updateQueue := NewUpdateProcessingQueue(ctx, updateManager, 20, db, inputFactory, provisionerClient, eventBroker,
 		runtimeVerConfigurator, db.RuntimeStates(), componentsProvider, reconcilerClient, cfg, k8sClientProvider, cli, logs)
 
+	bindingQueue := newProcessingQueue(binding.NewExecutor(db.Bindings(), bindingManager, logs.WithField("service", "bindings")), &cfg, db, logs)
+	bindingQueue.Run(ctx.Done(), workersAmount)
+
 	/***/
 	servicesConfig, err := broker.NewServicesConfigFromFile(cfg.CatalogFilePath)
 	fatalOnError(err)
@@ -367,37 +424,85 @@ func main() {
 	// create server
 	router := mux.NewRouter()
 
-	createAPI(router, servicesConfig, inputFactory, &cfg, db, provisionQueue, deprovisionQueue, updateQueue, logger, logs, inputFactory.GetPlanDefaults)
+	createAPI(router, servicesConfig, inputFactory, runtimeVerConfigurator, &cfg, db, provisionQueue, deprovisionQueue, updateQueue, bindingManager, bindingQueue, logger, logs, inputFactory.GetPlanDefaults)
 
 	// create metrics endpoint
 	router.Handle("/metrics", promhttp.Handler())
+	router.Handle("/events/stream", eventshandler.NewStreamHandler(progressBroadcaster, db.Events(), db.Instances(), logs.WithField("service", "eventStream")))
 
 	// create SKR kubeconfig endpoint
-	kcBuilder := kubeconfig.NewBuilder(provisionerClient)
-	kcHandler := kubeconfig.NewHandler(db, kcBuilder, cfg.Kubeconfig.AllowOrigins, logs.WithField("service", "kubeconfigHandle"))
+	kcAuditor := kubeconfig.NewAuditor(db.KubeconfigAudits(), db.Instances(), eventBroker, logs.WithField("service", "kubeconfigAudit"))
+	go kcAuditor.RunRetention(ctx, cfg.Kubeconfig.Audit)
+	kcHandler := kubeconfig.NewHandler(db, kcBuilder, cfg.Kubeconfig.AllowOrigins, kcAuditor, logs.WithField("service", "kubeconfigHandle"))
 	kcHandler.AttachRoutes(router)
 
+	// create /audit endpoints of the issued kubeconfigs
+	kubeconfig.NewAuditHandler(db.KubeconfigAudits(), kcAuditor, cfg.MaxPaginationPage).AttachRoutes(router)
+
 	runtimeLister := orchestration.NewRuntimeLister(db.Instances(), db.Operations(), runtime.NewConverter(cfg.DefaultRequestRegion), logs)
 	runtimeResolver := orchestrationExt.NewGardenerRuntimeResolver(dynamicGardener, gardenerNamespace, runtimeLister, logs)
 
-	kymaQueue := NewKymaOrchestrationProcessingQueue(ctx, db, runtimeOverrides, provisionerClient, eventBroker, inputFactory, nil, time.Minute, runtimeVerConfigurator, runtimeResolver, upgradeEvalManager, &cfg, internalEvalAssistant, reconcilerClient, notificationBuilder, logs, cli, 1)
+	var configuredFreezes []orchestrationExt.Freeze
+	if cfg.OrchestrationFreezesFilePath != "" {
+		configuredFreezes, err = orchestration.ReadFreezesFromFile(cfg.OrchestrationFreezesFilePath)
+		fatalOnError(err)
+		logs.Infof("Configured orchestration freezes: %d", len(configuredFreezes))
+	}
+	freezeCalendar := orchestration.NewFreezeCalendar(configuredFreezes, db.Freezes())
+
+	kymaQueue := NewKymaOrchestrationProcessingQueue(ctx, db, runtimeOverrides, provisionerClient, eventBroker, inputFactory, nil, time.Minute, runtimeVerConfigurator, runtimeResolver, upgradeEvalManager, &cfg, internalEvalAssistant, reconcilerClient, notificationBuilder, freezeCalendar, logs, cli, 1)
 	clusterQueue := NewClusterOrchestrationProcessingQueue(ctx, db, provisionerClient, eventBroker, inputFactory,
-		nil, time.Minute, runtimeResolver, upgradeEvalManager, notificationBuilder, logs, cli, cfg, 1)
+		nil, time.Minute, runtimeResolver, upgradeEvalManager, notificationBuilder, freezeCalendar, logs, cli, cfg, 1)
+	taskQueue := NewRuntimeTaskOrchestrationProcessingQueue(ctx, db, eventBroker, kcBuilder, k8sClientProvider,
+		nil, time.Minute, runtimeResolver, notificationBuilder, freezeCalendar, logs, cli, cfg, 1)
 
 	// TODO: in case of cluster upgrade the same Azure Zones must be send to the Provisioner
-	orchestrationHandler := orchestrate.NewOrchestrationHandler(db, kymaQueue, clusterQueue, cfg.MaxPaginationPage, logs)
+	orchestrationHandler := orchestrate.NewOrchestrationHandler(db, kymaQueue, clusterQueue, taskQueue, cfg.RuntimeTask, freezeCalendar, cfg.MaxPaginationPage, logs)
 
 	if !cfg.DisableProcessOperationsInProgress {
-		err = processOperationsInProgressByType(internal.OperationTypeProvision, db.Operations(), provisionQueue, logs)
-		fatalOnError(err)
-		err = processOperationsInProgressByType(internal.OperationTypeDeprovision, db.Operations(), deprovisionQueue, logs)
-		fatalOnError(err)
-		err = processOperationsInProgressByType(internal.OperationTypeUpdate, db.Operations(), updateQueue, logs)
-		fatalOnError(err)
-		err = reprocessOrchestrations(orchestrationExt.UpgradeKymaOrchestration, db.Orchestrations(), db.Operations(), kymaQueue, logs)
-		fatalOnError(err)
-		err = reprocessOrchestrations(orchestrationExt.UpgradeClusterOrchestration, db.Orchestrations(), db.Operations(), clusterQueue, logs)
-		fatalOnError(err)
+		// with leases, only the operations without a valid lease are resumed, the other ones are processed by the running replicas
+		resumeInProgress := func(leased []string) error {
+			if err := processOperationsInProgressByType(internal.OperationTypeProvision, db.Operations(), provisionQueue.Unleased(leased), logs); err != nil {
+				return err
+			}
+			if err := processOperationsInProgressByType(internal.OperationTypeDeprovision, db.Operations(), deprovisionQueue.Unleased(leased), logs); err != nil {
+				return err
+			}
+			if err := processOperationsInProgressByType(internal.OperationTypeUpdate, db.Operations(), updateQueue.Unleased(leased), logs); err != nil {
+				return err
+			}
+			if err := processBindingsInProgress(db.Bindings(), bindingQueue.Unleased(leased), logs); err != nil {
+				return err
+			}
+			if err := reprocessOrchestrations(orchestrationExt.UpgradeKymaOrchestration, db.Orchestrations(), db.Operations(), kymaQueue.Unleased(leased), logs); err != nil {
+				return err
+			}
+			if err := reprocessOrchestrations(orchestrationExt.UpgradeClusterOrchestration, db.Orchestrations(), db.Operations(), clusterQueue.Unleased(leased), logs); err != nil {
+				return err
+			}
+			return reprocessOrchestrations(orchestrationExt.RuntimeTaskOrchestration, db.Orchestrations(), db.Operations(), taskQueue.Unleased(leased), logs)
+		}
+		fatalOnError(resumeInProgress(nil))
+
+		retryScheduler := process.NewRetryScheduler(db.Operations(), cfg.RetryPollingInterval, logs.WithField("service", "retryScheduler"))
+		retryScheduler.Register(internal.OperationTypeProvision, provisionQueue)
+		retryScheduler.Register(internal.OperationTypeDeprovision, deprovisionQueue)
+		retryScheduler.Register(internal.OperationTypeUpdate, updateQueue)
+		retryScheduler.Run(ctx.Done())
+
+		if cfg.Leases.Enabled {
+			// operations of crashed replicas are taken over when their leases expire
+			go wait.Until(func() {
+				leased, err := db.Leases().ListValid()
+				if err != nil {
+					logs.Errorf("while listing valid leases: %s", err)
+					return
+				}
+				if err := resumeInProgress(leased); err != nil {
+					logs.Errorf("while adopting operations in progress: %s", err)
+				}
+			}, cfg.Leases.AdoptionInterval, ctx.Done())
+		}
 	} else {
 		logger.Info("Skipping processing operation in progress on start")
 	}
@@ -412,10 +517,18 @@ func main() {
 	// create /orchestration
 	orchestrationHandler.AttachRoutes(router)
 
+	// create /webhooks
+	if webhookHandler != nil {
+		webhookHandler.AttachRoutes(router)
+	}
+
 	// create list runtimes endpoint
 	runtimeHandler := runtime.NewHandler(db.Instances(), db.Operations(), db.RuntimeStates(), cfg.MaxPaginationPage, cfg.DefaultRequestRegion)
 	runtimeHandler.AttachRoutes(router)
 
+	// create /accounts endpoints of the hyperscaler account pool inventory
+	accountpool.NewHandler(accountInventory, logs.WithField("service", "accountPool")).AttachRoutes(router)
+
 	router.StrictSlash(true).PathPrefix("/").Handler(http.StripPrefix("/", http.FileServer(http.Dir("/swagger"))))
 	svr := handlers.CustomLoggingHandler(os.Stdout, router, func(writer io.Writer, params handlers.LogFormatterParams) {
 		logs.Infof("Call handled: method=%s url=%s statusCode=%d size=%d", params.Request.Method, params.URL.Path, params.StatusCode, params.Size)
@@ -436,6 +549,15 @@ func k8sClientProvider(kcfg string) (client.Client, error) {
 	return k8sCli, err
 }
 
+func skrClientsetProvider(kcfg string) (kubernetes.Interface, error) {
+	restCfg, err := clientcmd.RESTConfigFromKubeConfig([]byte(kcfg))
+	if err != nil {
+		return nil, err
+	}
+
+	return kubernetes.NewForConfig(restCfg)
+}
+
 func checkDefaultVersions(versions ...string) error {
 	for _, version := range versions {
 		if !isVersionFollowingSemanticVersioning(version) {
@@ -453,7 +575,7 @@ func isVersionFollowingSemanticVersioning(version string) bool {
 	return false
 }
 
-func createAPI(router *mux.Router, servicesConfig broker.ServicesConfig, planValidator broker.PlanValidator, cfg *Config, db storage.BrokerStorage, provisionQueue, deprovisionQueue, updateQueue *process.Queue, logger lager.Logger, logs logrus.FieldLogger, planDefaults broker.PlanDefaults) {
+func createAPI(router *mux.Router, servicesConfig broker.ServicesConfig, inputFactory input.CreatorForPlan, runtimeVerConfigurator preview.RuntimeVersionConfigurator, cfg *Config, db storage.BrokerStorage, provisionQueue, deprovisionQueue, updateQueue *process.Queue, bindingManager broker.BindingManager, bindingQueue *process.Queue, logger lager.Logger, logs logrus.FieldLogger, planDefaults broker.PlanDefaults) {
 	suspensionCtxHandler := suspension.NewContextUpdateHandler(db.Operations(), provisionQueue, deprovisionQueue, logs)
 
 	defaultPlansConfig, err := servicesConfig.DefaultPlansConfig()
@@ -471,22 +593,32 @@ func createAPI(router *mux.Router, servicesConfig broker.ServicesConfig, planVal
 	fatalOnError(err)
 	logs.Infof("Number of globalAccountIds for EU Access: %d\n", len(whitelistedGlobalAccountIds))
 
+	var quotaConfig quota.Config
+	if cfg.QuotasFilePath != "" {
+		quotaConfig, err = quota.ReadConfigFromFile(cfg.QuotasFilePath)
+		fatalOnError(err)
+		logs.Infof("Default quotas: %d, global accounts with quota overrides: %d", len(quotaConfig.Defaults), len(quotaConfig.GlobalAccounts))
+	}
+	quotaEnforcer := quota.NewEnforcer(quotaConfig, db.Instances())
+
 	// create KymaEnvironmentBroker endpoints
+	provisionEndpoint := broker.NewProvision(cfg.Broker, cfg.Gardener, db.Operations(), db.Instances(),
+		provisionQueue, inputFactory, defaultPlansConfig, cfg.EnableOnDemandVersion,
+		planDefaults, whitelistedGlobalAccountIds, cfg.EuAccessRejectionMessage, logs, cfg.KymaDashboardConfig, quotaEnforcer)
+	updateEndpoint := broker.NewUpdate(cfg.Broker, db.Instances(), db.RuntimeStates(), db.Operations(),
+		suspensionCtxHandler, cfg.UpdateProcessingEnabled, cfg.UpdateSubAccountMovementEnabled, updateQueue, defaultPlansConfig,
+		planDefaults, logs, cfg.KymaDashboardConfig, quotaEnforcer)
 	kymaEnvBroker := &broker.KymaEnvironmentBroker{
 		broker.NewServices(cfg.Broker, servicesConfig, logs),
-		broker.NewProvision(cfg.Broker, cfg.Gardener, db.Operations(), db.Instances(),
-			provisionQueue, planValidator, defaultPlansConfig, cfg.EnableOnDemandVersion,
-			planDefaults, whitelistedGlobalAccountIds, cfg.EuAccessRejectionMessage, logs, cfg.KymaDashboardConfig),
+		provisionEndpoint,
 		broker.NewDeprovision(db.Instances(), db.Operations(), deprovisionQueue, logs),
-		broker.NewUpdate(cfg.Broker, db.Instances(), db.RuntimeStates(), db.Operations(),
-			suspensionCtxHandler, cfg.UpdateProcessingEnabled, cfg.UpdateSubAccountMovementEnabled, updateQueue, defaultPlansConfig,
-			planDefaults, logs, cfg.KymaDashboardConfig),
+		updateEndpoint,
 		broker.NewGetInstance(cfg.Broker, db.Instances(), db.Operations(), logs),
 		broker.NewLastOperation(db.Operations(), logs),
-		broker.NewBind(logs),
-		broker.NewUnbind(logs),
-		broker.NewGetBinding(logs),
-		broker.NewLastBindingOperation(logs),
+		broker.NewBind(cfg.Broker.Binding, db.Instances(), db.Operations(), db.Bindings(), bindingManager, bindingQueue, logs),
+		broker.NewUnbind(cfg.Broker.Binding, db.Bindings(), bindingManager, bindingQueue, logs),
+		broker.NewGetBinding(db.Bindings(), logs),
+		broker.NewLastBindingOperation(db.Bindings(), logs),
 	}
 
 	router.Use(middleware.AddRegionToContext(cfg.DefaultRequestRegion))
@@ -502,29 +634,65 @@ func createAPI(router *mux.Router, servicesConfig broker.ServicesConfig, planVal
 	respWriter := httputil.NewResponseWriter(logs, cfg.DevelopmentMode)
 	runtimesInfoHandler := appinfo.NewRuntimeInfoHandler(db.Instances(), db.Operations(), defaultPlansConfig, cfg.DefaultRequestRegion, respWriter)
 	router.Handle("/info/runtimes", runtimesInfoHandler)
-	router.Handle("/events", eventshandler.NewHandler(db.Events(), db.Instances()))
+	router.Handle("/events", eventshandler.NewHandler(db.Events(), db.Instances(), cfg.MaxPaginationPage))
+
+	// create dry-run preview endpoints
+	previewHandler := preview.NewHandler(provisionEndpoint, updateEndpoint, inputFactory, runtimeVerConfigurator, db.RuntimeStates(), logs.WithField("service", "preview"))
+	previewHandler.AttachRoutes(router)
+
+	// create /operations/{operation_id}/steps
+	operationsteps.NewHandler(db.Operations(), db.OperationSteps()).AttachRoutes(router)
+
+	// create /quotas/{global_account_id}
+	quota.NewHandler(quotaEnforcer).AttachRoutes(router)
 }
 
 // queues all in progress operations by type
-func processOperationsInProgressByType(opType internal.OperationType, op storage.Operations, queue *process.Queue, log logrus.FieldLogger) error {
+func processOperationsInProgressByType(opType internal.OperationType, op storage.Operations, queue process.UnleasedQueue, log logrus.FieldLogger) error {
 	operations, err := op.GetNotFinishedOperationsByType(opType)
 	if err != nil {
 		return fmt.Errorf("while getting in progress operations from storage: %w", err)
 	}
 	for _, operation := range operations {
+		if queue.Leased(operation.ID) {
+			continue
+		}
+		if operation.NextRetryAt != nil && operation.NextRetryAt.After(time.Now()) {
+			queue.AddAfter(operation.ID, time.Until(*operation.NextRetryAt))
+			log.Infof("Resuming the processing of %s operation ID: %s at %s", opType, operation.ID, operation.NextRetryAt.Format(time.RFC3339))
+			continue
+		}
 		queue.Add(operation.ID)
 		log.Infof("Resuming the processing of %s operation ID: %s", opType, operation.ID)
 	}
 	return nil
 }
 
-func reprocessOrchestrations(orchestrationType orchestrationExt.Type, orchestrationsStorage storage.Orchestrations, operationsStorage storage.Operations, queue *process.Queue, log logrus.FieldLogger) error {
+func processBindingsInProgress(bindings storage.Bindings, queue process.UnleasedQueue, log logrus.FieldLogger) error {
+	inProgress, err := bindings.ListInProgress()
+	if err != nil {
+		return fmt.Errorf("while getting in progress bindings from storage: %w", err)
+	}
+	for _, binding := range inProgress {
+		if queue.Leased(binding.ID) {
+			continue
+		}
+		queue.Add(binding.ID)
+		log.Infof("Resuming the processing of %s operation of binding ID: %s", binding.LastOperation, binding.ID)
+	}
+	return nil
+}
+
+func reprocessOrchestrations(orchestrationType orchestrationExt.Type, orchestrationsStorage storage.Orchestrations, operationsStorage storage.Operations, queue process.UnleasedQueue, log logrus.FieldLogger) error {
 	if err := processCancelingOrchestrations(orchestrationType, orchestrationsStorage, operationsStorage, queue, log); err != nil {
 		return fmt.Errorf("while processing canceled %s orchestrations: %w", orchestrationType, err)
 	}
 	if err := processOrchestration(orchestrationType, orchestrationExt.InProgress, orchestrationsStorage, queue, log); err != nil {
 		return fmt.Errorf("while processing in progress %s orchestrations: %w", orchestrationType, err)
 	}
+	if err := processOrchestration(orchestrationType, orchestrationExt.Paused, orchestrationsStorage, queue, log); err != nil {
+		return fmt.Errorf("while processing paused %s orchestrations: %w", orchestrationType, err)
+	}
 	if err := processOrchestration(orchestrationType, orchestrationExt.Pending, orchestrationsStorage, queue, log); err != nil {
 		return fmt.Errorf("while processing pending %s orchestrations: %w", orchestrationType, err)
 	}
@@ -534,7 +702,7 @@ func reprocessOrchestrations(orchestrationType orchestrationExt.Type, orchestrat
 	return nil
 }
 
-func processOrchestration(orchestrationType orchestrationExt.Type, state string, orchestrationsStorage storage.Orchestrations, queue *process.Queue, log logrus.FieldLogger) error {
+func processOrchestration(orchestrationType orchestrationExt.Type, state string, orchestrationsStorage storage.Orchestrations, queue process.UnleasedQueue, log logrus.FieldLogger) error {
 	filter := dbmodel.OrchestrationFilter{
 		Types:  []string{string(orchestrationType)},
 		States: []string{state},
@@ -548,6 +716,9 @@ func processOrchestration(orchestrationType orchestrationExt.Type, state string,
 	})
 
 	for _, o := range orchestrations {
+		if queue.Leased(o.OrchestrationID) {
+			continue
+		}
 		queue.Add(o.OrchestrationID)
 		log.Infof("Resuming the processing of %s %s orchestration ID: %s", state, orchestrationType, o.OrchestrationID)
 	}
@@ -556,7 +727,7 @@ func processOrchestration(orchestrationType orchestrationExt.Type, state string,
 
 // processCancelingOrchestrations reprocess orchestrations with canceling state only when some in progress operations exists
 // reprocess only one orchestration to not clog up the orchestration queue on start
-func processCancelingOrchestrations(orchestrationType orchestrationExt.Type, orchestrationsStorage storage.Orchestrations, operationsStorage storage.Operations, queue *process.Queue, log logrus.FieldLogger) error {
+func processCancelingOrchestrations(orchestrationType orchestrationExt.Type, orchestrationsStorage storage.Orchestrations, operationsStorage storage.Operations, queue process.UnleasedQueue, log logrus.FieldLogger) error {
 	filter := dbmodel.OrchestrationFilter{
 		Types:  []string{string(orchestrationType)},
 		States: []string{orchestrationExt.Canceling},
@@ -570,12 +741,17 @@ func processCancelingOrchestrations(orchestrationType orchestrationExt.Type, orc
 	})
 
 	for _, o := range orchestrations {
+		if queue.Leased(o.OrchestrationID) {
+			continue
+		}
 		count := 0
 		err = nil
 		if orchestrationType == orchestrationExt.UpgradeKymaOrchestration {
 			_, count, _, err = operationsStorage.ListUpgradeKymaOperationsByOrchestrationID(o.OrchestrationID, dbmodel.OperationFilter{States: []string{orchestrationExt.InProgress}})
 		} else if orchestrationType == orchestrationExt.UpgradeClusterOrchestration {
 			_, count, _, err = operationsStorage.ListUpgradeClusterOperationsByOrchestrationID(o.OrchestrationID, dbmodel.OperationFilter{States: []string{orchestrationExt.InProgress}})
+		} else if orchestrationType == orchestrationExt.RuntimeTaskOrchestration {
+			_, count, _, err = operationsStorage.ListOperationsByOrchestrationID(o.OrchestrationID, dbmodel.OperationFilter{States: []string{orchestrationExt.InProgress}})
 		}
 		if err != nil {
 			return fmt.Errorf("while listing %s operations for orchestration %s: %w", orchestrationType, o.OrchestrationID, err)
@@ -756,7 +932,7 @@ func NewProvisioningProcessingQueue(ctx context.Context, provisionManager *proce
 		}
 	}
 
-	queue := process.NewQueue(provisionManager, logs)
+	queue := newProcessingQueue(provisionManager, cfg, db, logs)
 	queue.Run(ctx.Done(), workersAmount)
 
 	return queue
@@ -770,7 +946,7 @@ func NewUpdateProcessingQueue(ctx context.Context, manager *process.StagedManage
 	if cfg.ReconcilerIntegrationDisabled {
 		requiresReconcilerUpdate = func(op internal.Operation) bool { return false }
 	}
-	manager.DefineStages([]string{"cluster", "btp-operator", "btp-operator-check", "check"})
+	manager.DefineStages([]string{"cluster", "btp-operator", "btp-operator-check", "check", "hibernation"})
 	updateSteps := []struct {
 		stage     string
 		step      process.Step
@@ -780,39 +956,50 @@ func NewUpdateProcessingQueue(ctx context.Context, manager *process.StagedManage
 			stage: "cluster",
 			step:  update.NewInitialisationStep(db.Instances(), db.Operations(), runtimeVerConfigurator, inputFactory),
 		},
+		{
+			stage:     "cluster",
+			step:      update.NewWakeUpRuntimeStep(db.Operations(), db.Instances(), provisionerClient, time.Hour),
+			condition: update.RequiresWakeUp,
+		},
 		{
 			stage:     "cluster",
 			step:      update.NewUpgradeShootStep(db.Operations(), db.RuntimeStates(), provisionerClient),
-			condition: update.SkipForOwnClusterPlan,
+			condition: update.SkipForHibernationOnly(update.SkipForOwnClusterPlan),
 		},
 		{
-			stage: "btp-operator",
-			step:  update.NewInitKymaVersionStep(db.Operations(), runtimeVerConfigurator, runtimeStatesDb),
+			stage:     "btp-operator",
+			step:      update.NewInitKymaVersionStep(db.Operations(), runtimeVerConfigurator, runtimeStatesDb),
+			condition: update.SkipForHibernationOnly(nil),
 		},
 		{
 			stage:     "btp-operator",
 			step:      update.NewGetKubeconfigStep(db.Operations(), provisionerClient, k8sClientProvider),
-			condition: update.ForBTPOperatorCredentialsProvided,
+			condition: update.SkipForHibernationOnly(update.ForBTPOperatorCredentialsProvided),
 		},
 		{
 			stage:     "btp-operator",
 			step:      update.NewBTPOperatorOverridesStep(db.Operations(), runtimeProvider),
-			condition: update.RequiresBTPOperatorCredentials,
+			condition: update.SkipForHibernationOnly(update.RequiresBTPOperatorCredentials),
 		},
 		{
 			stage:     "btp-operator",
 			step:      update.NewApplyReconcilerConfigurationStep(db.Operations(), db.RuntimeStates(), reconcilerClient),
-			condition: requiresReconcilerUpdate,
+			condition: update.SkipForHibernationOnly(requiresReconcilerUpdate),
 		},
 		{
 			stage:     "btp-operator-check",
 			step:      update.NewCheckReconcilerState(db.Operations(), reconcilerClient),
-			condition: update.CheckReconcilerStatus,
+			condition: update.SkipForHibernationOnly(update.CheckReconcilerStatus),
 		},
 		{
 			stage:     "check",
 			step:      update.NewCheckStep(db.Operations(), provisionerClient, 40*time.Minute),
-			condition: update.SkipForOwnClusterPlan,
+			condition: update.SkipForHibernationOnly(update.SkipForOwnClusterPlan),
+		},
+		{
+			stage:     "hibernation",
+			step:      update.NewHibernateRuntimeStep(db.Operations(), db.Instances(), provisionerClient, time.Hour),
+			condition: update.RequiresHibernation,
 		},
 	}
 
@@ -822,7 +1009,7 @@ func NewUpdateProcessingQueue(ctx context.Context, manager *process.StagedManage
 			fatalOnError(err)
 		}
 	}
-	queue := process.NewQueue(manager, logs)
+	queue := newProcessingQueue(manager, &cfg, db, logs)
 	queue.Run(ctx.Done(), workersAmount)
 
 	return queue
@@ -833,7 +1020,8 @@ func NewDeprovisioningProcessingQueue(ctx context.Context, workersAmount int, de
 	provisionerClient provisioner.Client, avsDel *avs.Delegator, internalEvalAssistant *avs.InternalEvalAssistant,
 	externalEvalAssistant *avs.ExternalEvalAssistant, bundleBuilder ias.BundleBuilder,
 	edpClient deprovisioning.EDPClient, accountProvider hyperscaler.AccountProvider, reconcilerClient reconciler.Client,
-	k8sClientProvider func(kcfg string) (client.Client, error), cli client.Client, configProvider input.ConfigurationProvider, logs logrus.FieldLogger) *process.Queue {
+	bindingRevoker deprovisioning.BindingRevoker, k8sClientProvider func(kcfg string) (client.Client, error), cli client.Client,
+	configProvider input.ConfigurationProvider, logs logrus.FieldLogger) *process.Queue {
 
 	deprovisioningSteps := []struct {
 		disabled bool
@@ -842,6 +1030,9 @@ func NewDeprovisioningProcessingQueue(ctx context.Context, workersAmount int, de
 		{
 			step: deprovisioning.NewInitStep(db.Operations(), db.Instances(), 12*time.Hour),
 		},
+		{
+			step: deprovisioning.NewRemoveBindingsStep(db.Operations(), db.Bindings(), bindingRevoker),
+		},
 		{
 			step: deprovisioning.NewBTPOperatorCleanupStep(db.Operations(), provisionerClient, k8sClientProvider),
 		},
@@ -902,13 +1093,13 @@ func NewDeprovisioningProcessingQueue(ctx context.Context, workersAmount int, de
 		}
 	}
 
-	queue := process.NewQueue(deprovisionManager, logs)
+	queue := newProcessingQueue(deprovisionManager, cfg, db, logs)
 	queue.Run(ctx.Done(), workersAmount)
 
 	return queue
 }
 
-func NewKymaOrchestrationProcessingQueue(ctx context.Context, db storage.BrokerStorage, runtimeOverrides upgrade_kyma.RuntimeOverridesAppender, provisionerClient provisioner.Client, pub event.Publisher, inputFactory input.CreatorForPlan, icfg *upgrade_kyma.TimeSchedule, pollingInterval time.Duration, runtimeVerConfigurator *runtimeversion.RuntimeVersionConfigurator, runtimeResolver orchestrationExt.RuntimeResolver, upgradeEvalManager *avs.EvaluationManager, cfg *Config, internalEvalAssistant *avs.InternalEvalAssistant, reconcilerClient reconciler.Client, notificationBuilder notification.BundleBuilder, logs logrus.FieldLogger, cli client.Client, speedFactor int) *process.Queue {
+func NewKymaOrchestrationProcessingQueue(ctx context.Context, db storage.BrokerStorage, runtimeOverrides upgrade_kyma.RuntimeOverridesAppender, provisionerClient provisioner.Client, pub event.Publisher, inputFactory input.CreatorForPlan, icfg *upgrade_kyma.TimeSchedule, pollingInterval time.Duration, runtimeVerConfigurator *runtimeversion.RuntimeVersionConfigurator, runtimeResolver orchestrationExt.RuntimeResolver, upgradeEvalManager *avs.EvaluationManager, cfg *Config, internalEvalAssistant *avs.InternalEvalAssistant, reconcilerClient reconciler.Client, notificationBuilder notification.BundleBuilder, freezes orchestrationExt.FreezeCalendar, logs logrus.FieldLogger, cli client.Client, speedFactor int) *process.Queue {
 
 	upgradeKymaManager := upgrade_kyma.NewManager(db.Operations(), pub, logs.WithField("upgradeKyma", "manager"))
 	upgradeKymaInit := upgrade_kyma.NewInitialisationStep(db.Operations(), db.Orchestrations(), db.Instances(),
@@ -976,8 +1167,8 @@ func NewKymaOrchestrationProcessingQueue(ctx context.Context, db storage.BrokerS
 
 	orchestrateKymaManager := manager.NewUpgradeKymaManager(db.Orchestrations(), db.Operations(), db.Instances(),
 		upgradeKymaManager, runtimeResolver, pollingInterval, logs.WithField("upgradeKyma", "orchestration"),
-		cli, &cfg.OrchestrationConfig, notificationBuilder, speedFactor)
-	queue := process.NewQueue(orchestrateKymaManager, logs)
+		cli, &cfg.OrchestrationConfig, notificationBuilder, pub, freezes, speedFactor)
+	queue := newProcessingQueue(orchestrateKymaManager, cfg, db, logs)
 
 	queue.Run(ctx.Done(), 3)
 
@@ -986,8 +1177,8 @@ func NewKymaOrchestrationProcessingQueue(ctx context.Context, db storage.BrokerS
 
 func NewClusterOrchestrationProcessingQueue(ctx context.Context, db storage.BrokerStorage, provisionerClient provisioner.Client,
 	pub event.Publisher, inputFactory input.CreatorForPlan, icfg *upgrade_cluster.TimeSchedule, pollingInterval time.Duration,
-	runtimeResolver orchestrationExt.RuntimeResolver, upgradeEvalManager *avs.EvaluationManager, notificationBuilder notification.BundleBuilder, logs logrus.FieldLogger,
-	cli client.Client, cfg Config, speedFactor int) *process.Queue {
+	runtimeResolver orchestrationExt.RuntimeResolver, upgradeEvalManager *avs.EvaluationManager, notificationBuilder notification.BundleBuilder, freezes orchestrationExt.FreezeCalendar,
+	logs logrus.FieldLogger, cli client.Client, cfg Config, speedFactor int) *process.Queue {
 
 	upgradeClusterManager := upgrade_cluster.NewManager(db.Operations(), pub, logs.WithField("upgradeCluster", "manager"))
 	upgradeClusterInit := upgrade_cluster.NewInitialisationStep(db.Operations(), db.Orchestrations(), provisionerClient, inputFactory, upgradeEvalManager, icfg, notificationBuilder)
@@ -1024,14 +1215,71 @@ func NewClusterOrchestrationProcessingQueue(ctx context.Context, db storage.Brok
 
 	orchestrateClusterManager := manager.NewUpgradeClusterManager(db.Orchestrations(), db.Operations(), db.Instances(),
 		upgradeClusterManager, runtimeResolver, pollingInterval, logs.WithField("upgradeCluster", "orchestration"),
-		cli, cfg.OrchestrationConfig, notificationBuilder, speedFactor)
-	queue := process.NewQueue(orchestrateClusterManager, logs)
+		cli, cfg.OrchestrationConfig, notificationBuilder, pub, freezes, speedFactor)
+	queue := newProcessingQueue(orchestrateClusterManager, &cfg, db, logs)
 
 	queue.Run(ctx.Done(), 3)
 
 	return queue
 }
 
+func NewRuntimeTaskOrchestrationProcessingQueue(ctx context.Context, db storage.BrokerStorage, pub event.Publisher,
+	kubeconfigs runtime_task.KubeconfigProvider, k8sClientProvider func(kcfg string) (client.Client, error), icfg *runtime_task.TimeSchedule, pollingInterval time.Duration,
+	runtimeResolver orchestrationExt.RuntimeResolver, notificationBuilder notification.BundleBuilder, freezes orchestrationExt.FreezeCalendar,
+	logs logrus.FieldLogger, cli client.Client, cfg Config, speedFactor int) *process.Queue {
+
+	runtimeTaskManager := runtime_task.NewManager(db.Operations(), pub, logs.WithField("runtimeTask", "manager"))
+	runtimeTaskManager.AddStep(runtime_task.NewInitialisationStep(db.Operations(), db.Orchestrations(), icfg))
+	runtimeTaskManager.AddStep(runtime_task.NewApplyStep(db.Operations(), db.Orchestrations(), db.Instances(), kubeconfigs, k8sClientProvider, cfg.RuntimeTask, icfg))
+
+	orchestrateRuntimeTaskManager := manager.NewRuntimeTaskManager(db.Orchestrations(), db.Operations(), db.Instances(),
+		runtimeTaskManager, runtimeResolver, pollingInterval, logs.WithField("runtimeTask", "orchestration"),
+		cli, cfg.OrchestrationConfig, notificationBuilder, pub, freezes, speedFactor)
+	queue := newProcessingQueue(orchestrateRuntimeTaskManager, &cfg, db, logs)
+
+	queue.Run(ctx.Done(), 3)
+
+	return queue
+}
+
+// startWebhooks starts sending of the stored webhook deliveries and subscribes for the lifecycle events
+func startWebhooks(ctx context.Context, cfg *Config, db storage.BrokerStorage, sub event.Subscriber, logs logrus.FieldLogger) *webhook.Handler {
+	subscriptions, err := webhook.ReadSubscriptionsFromFile(cfg.Webhooks.SubscriptionsFilePath)
+	fatalOnError(err)
+	logs.Infof("Configured webhook subscriptions: %d", len(subscriptions))
+
+	sender := webhook.NewSender(cfg.Webhooks, subscriptions, db.WebhookDeliveries(), logs)
+	queue := newProcessingQueue(sender, cfg, db, logs)
+	queue.Run(ctx.Done(), cfg.Webhooks.Workers)
+
+	notifier := webhook.NewNotifier(subscriptions, db.WebhookDeliveries(), queue, logs)
+	notifier.Subscribe(sub)
+	fatalOnError(notifier.ResumePending(queue))
+	if cfg.Leases.Enabled {
+		// deliveries of crashed replicas are taken over when their leases expire
+		go wait.Until(func() {
+			leased, err := db.Leases().ListValid()
+			if err != nil {
+				logs.Errorf("while listing valid leases: %s", err)
+				return
+			}
+			if err := notifier.ResumePending(queue.Unleased(leased)); err != nil {
+				logs.Errorf("while adopting pending webhook deliveries: %s", err)
+			}
+		}, cfg.Leases.AdoptionInterval, ctx.Done())
+	}
+
+	return webhook.NewHandler(db.WebhookDeliveries(), queue, cfg.MaxPaginationPage, logs)
+}
+
+func newProcessingQueue(executor process.Executor, cfg *Config, db storage.BrokerStorage, logs logrus.FieldLogger) *process.Queue {
+	queue := process.NewQueue(executor, logs)
+	if cfg.Leases.Enabled {
+		queue.WithLeases(db.Leases(), cfg.Leases.Owner, cfg.Leases.TTL)
+	}
+	return queue
+}
+
 func skipForPreviewPlan(operation internal.Operation) bool {
 	return !broker.IsPreviewPlan(operation.ProvisioningParameters.PlanID)
 }
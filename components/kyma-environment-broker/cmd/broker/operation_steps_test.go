@@ -0,0 +1,82 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/runtime"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOperationSteps_Provisioning(t *testing.T) {
+	// given
+	suite := NewBrokerSuiteTest(t)
+	defer suite.TearDown()
+	iid := uuid.New().String()
+
+	resp := suite.CallAPI("PUT", fmt.Sprintf("oauth/v2/service_instances/%s?accepts_incomplete=true", iid),
+		`{
+					"service_id": "47c9dcbf-ff30-448e-ab36-d3bad66ba281",
+					"plan_id": "5cb3d976-b85c-42ea-a636-79cadda109a9",
+					"context": {
+						"globalaccount_id": "g-account-id",
+						"subaccount_id": "sub-id",
+						"user_id": "john.smith@email.com"
+					},
+					"parameters": {
+						"name": "testing-cluster"
+					}
+		}`)
+	opID := suite.DecodeOperationID(resp)
+	suite.processProvisioningByOperationID(opID)
+	suite.WaitForOperationState(opID, domain.Succeeded)
+
+	// when
+	var steps runtime.OperationSteps
+	err := suite.poller.Invoke(func() (bool, error) {
+		resp = suite.CallAPI("GET", fmt.Sprintf("operations/%s/steps", opID), "")
+		if resp.StatusCode != http.StatusOK {
+			return false, nil
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&steps); err != nil {
+			return false, err
+		}
+		return len(steps.Data) > 0 && hasStep(steps.Data, "Check_Runtime"), nil
+	})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, opID, steps.OperationID)
+	assert.Equal(t, runtime.Provision, steps.Type)
+	assert.Equal(t, string(domain.Succeeded), steps.State)
+	for _, step := range steps.Data {
+		assert.NotZero(t, step.Attempts, step.StepName)
+		assert.False(t, step.StartedAt.After(step.FinishedAt), step.StepName)
+	}
+}
+
+func TestOperationSteps_NotExistingOperation(t *testing.T) {
+	// given
+	suite := NewBrokerSuiteTest(t)
+	defer suite.TearDown()
+
+	// when
+	resp := suite.CallAPI("GET", "operations/not-existing/steps", "")
+
+	// then
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func hasStep(steps []runtime.OperationStep, name string) bool {
+	for _, step := range steps {
+		if step.StepName == name {
+			return true
+		}
+	}
+	return false
+}
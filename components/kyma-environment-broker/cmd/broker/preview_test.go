@@ -0,0 +1,150 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/preview"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPreview_Provisioning(t *testing.T) {
+	// given
+	suite := NewBrokerSuiteTest(t)
+	defer suite.TearDown()
+	iid := uuid.New().String()
+
+	// when
+	resp := suite.CallAPI("PUT", fmt.Sprintf("preview/cf-eu10/service_instances/%s", iid),
+		`{
+			"service_id": "47c9dcbf-ff30-448e-ab36-d3bad66ba281",
+			"plan_id": "361c511f-f939-4621-b228-d0fb79a1fe15",
+			"context": {
+				"globalaccount_id": "g-account-id",
+				"subaccount_id": "sub-id",
+				"user_id": "john.smith@email.com"
+			},
+			"parameters": {
+				"name": "testing-cluster",
+				"region": "eu-central-1",
+				"machineType": "m5.2xlarge"
+			}
+		}`)
+
+	// then
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var result preview.ProvisioningPreview
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+	gardenerConfig := result.ProvisionRuntimeInput.ClusterConfig.GardenerConfig
+	assert.Equal(t, "eu-central-1", gardenerConfig.Region)
+	assert.Equal(t, "m5.2xlarge", gardenerConfig.MachineType)
+	assert.NotEmpty(t, gardenerConfig.ProviderSpecificConfig.AwsConfig.AwsZones)
+	assert.Equal(t, []string{"john.smith@email.com"}, result.ProvisionRuntimeInput.ClusterConfig.Administrators)
+
+	_, err := suite.db.Instances().GetByID(iid)
+	assert.True(t, dberr.IsNotFound(err))
+	_, err = suite.db.Operations().GetProvisioningOperationByInstanceID(iid)
+	assert.True(t, dberr.IsNotFound(err))
+}
+
+func TestPreview_ProvisioningValidation(t *testing.T) {
+	// given
+	suite := NewBrokerSuiteTest(t)
+	defer suite.TearDown()
+
+	// when
+	resp := suite.CallAPI("PUT", fmt.Sprintf("preview/cf-eu10/service_instances/%s", uuid.New().String()),
+		`{
+			"service_id": "47c9dcbf-ff30-448e-ab36-d3bad66ba281",
+			"plan_id": "361c511f-f939-4621-b228-d0fb79a1fe15",
+			"context": {
+				"globalaccount_id": "g-account-id",
+				"subaccount_id": "sub-id",
+				"user_id": "john.smith@email.com"
+			},
+			"parameters": {
+				"name": "testing-cluster",
+				"region": "not-existing-region"
+			}
+		}`)
+
+	// then
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestPreview_Update(t *testing.T) {
+	// given
+	suite := NewBrokerSuiteTest(t)
+	defer suite.TearDown()
+	iid := uuid.New().String()
+
+	resp := suite.CallAPI("PUT", fmt.Sprintf("oauth/cf-eu10/v2/service_instances/%s?accepts_incomplete=true&plan_id=361c511f-f939-4621-b228-d0fb79a1fe15&service_id=47c9dcbf-ff30-448e-ab36-d3bad66ba281", iid),
+		`{
+			"service_id": "47c9dcbf-ff30-448e-ab36-d3bad66ba281",
+			"plan_id": "361c511f-f939-4621-b228-d0fb79a1fe15",
+			"context": {
+				"globalaccount_id": "g-account-id",
+				"subaccount_id": "sub-id",
+				"user_id": "john.smith@email.com"
+			},
+			"parameters": {
+				"name": "testing-cluster"
+			}
+		}`)
+	opID := suite.DecodeOperationID(resp)
+	suite.processProvisioningAndReconcilingByOperationID(opID)
+
+	// when
+	resp = suite.CallAPI("PATCH", fmt.Sprintf("preview/cf-eu10/service_instances/%s", iid),
+		`{
+			"service_id": "47c9dcbf-ff30-448e-ab36-d3bad66ba281",
+			"plan_id": "361c511f-f939-4621-b228-d0fb79a1fe15",
+			"context": {
+				"globalaccount_id": "g-account-id",
+				"user_id": "john.smith@email.com"
+			},
+			"parameters": {
+				"machineType": "m5.4xlarge",
+				"autoScalerMin": 4,
+				"autoScalerMax": 6
+			}
+		}`)
+
+	// then
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var result preview.UpdatePreview
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+	assert.Equal(t, suite.GetInstance(iid).RuntimeID, result.RuntimeID)
+	assert.Equal(t, "m5.4xlarge", *result.UpgradeShootInput.GardenerConfig.MachineType)
+	assert.Equal(t, 4, *result.UpgradeShootInput.GardenerConfig.AutoScalerMin)
+	assert.Equal(t, 6, *result.UpgradeShootInput.GardenerConfig.AutoScalerMax)
+
+	instance := suite.GetInstance(iid)
+	assert.Nil(t, instance.Parameters.Parameters.MachineType)
+	operations, err := suite.db.Operations().ListOperationsByInstanceID(iid)
+	require.NoError(t, err)
+	assert.Len(t, operations, 1)
+}
+
+func TestPreview_UpdateNotExistingInstance(t *testing.T) {
+	// given
+	suite := NewBrokerSuiteTest(t)
+	defer suite.TearDown()
+
+	// when
+	resp := suite.CallAPI("PATCH", fmt.Sprintf("preview/cf-eu10/service_instances/%s", uuid.New().String()),
+		`{
+			"service_id": "47c9dcbf-ff30-448e-ab36-d3bad66ba281",
+			"plan_id": "361c511f-f939-4621-b228-d0fb79a1fe15",
+			"context": {},
+			"parameters": {}
+		}`)
+
+	// then
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
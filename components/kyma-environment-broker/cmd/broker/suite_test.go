@@ -166,13 +166,13 @@ func NewOrchestrationSuite(t *testing.T, additionalKymaVersions []string) *Orche
 		Retry:              2 * time.Millisecond,
 		StatusCheck:        20 * time.Millisecond,
 		UpgradeKymaTimeout: 4 * time.Second,
-	}, 250*time.Millisecond, runtimeVerConfigurator, runtimeResolver, upgradeEvaluationManager, &cfg, avs.NewInternalEvalAssistant(cfg.Avs), reconcilerClient, notificationBundleBuilder, logs, cli, 1000)
+	}, 250*time.Millisecond, runtimeVerConfigurator, runtimeResolver, upgradeEvaluationManager, &cfg, avs.NewInternalEvalAssistant(cfg.Avs), reconcilerClient, notificationBundleBuilder, nil, logs, cli, 1000)
 
 	clusterQueue := NewClusterOrchestrationProcessingQueue(ctx, db, provisionerClient, eventBroker, inputFactory, &upgrade_cluster.TimeSchedule{
 		Retry:                 2 * time.Millisecond,
 		StatusCheck:           20 * time.Millisecond,
 		UpgradeClusterTimeout: 4 * time.Second,
-	}, 250*time.Millisecond, runtimeResolver, upgradeEvaluationManager, notificationBundleBuilder, logs, cli, cfg, 1000)
+	}, 250*time.Millisecond, runtimeResolver, upgradeEvaluationManager, notificationBundleBuilder, nil, logs, cli, cfg, 1000)
 
 	kymaQueue.SpeedUp(1000)
 	clusterQueue.SpeedUp(1000)
@@ -999,6 +999,14 @@ func fixConfig() *Config {
 		UpdateProcessingEnabled: true,
 		Broker: broker.Config{
 			EnablePlans: []string{"azure", "trial", "aws", "own_cluster", "preview"},
+			Binding: broker.BindingConfig{
+				Enabled:              true,
+				ExpirationSeconds:    600,
+				MinExpirationSeconds: 600,
+				MaxExpirationSeconds: 7200,
+				Namespace:            "kyma-system",
+				ClusterRole:          "cluster-admin",
+			},
 		},
 		Avs: avs.Config{},
 		IAS: ias.Config{
@@ -1012,6 +1020,11 @@ func fixConfig() *Config {
 			Namespace:   "kcp-system",
 			Name:        "orchestration-config",
 		},
+		RuntimeTask: kebOrchestration.RuntimeTaskConfig{
+			AllowedKinds:        []string{"ConfigMap", "Secret"},
+			ProtectedNamespaces: []string{"kube-system"},
+			Timeout:             time.Second,
+		},
 		MaxPaginationPage:                         100,
 		FreemiumProviders:                         []string{"aws", "azure"},
 		EuAccessWhitelistedGlobalAccountsFilePath: "testdata/eu_access_whitelist.yaml",
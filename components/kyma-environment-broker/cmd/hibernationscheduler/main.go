@@ -0,0 +1,190 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/events"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
+	"github.com/kyma-project/control-plane/components/schema-migrator/cleaner"
+	"github.com/robfig/cron/v3"
+	log "github.com/sirupsen/logrus"
+	"github.com/vrischmann/envconfig"
+)
+
+type BrokerClient interface {
+	SendHibernationRequest(instance internal.Instance, hibernated bool) (bool, error)
+}
+
+type Config struct {
+	Database storage.Config
+	Broker   broker.ClientConfig
+	DryRun   bool `envconfig:"default=true"`
+	// Interval must be equal to the period of the job schedule, schedules triggered within the last interval are applied
+	Interval time.Duration `envconfig:"default=15m"`
+}
+
+type HibernationSchedulerService struct {
+	cfg             Config
+	instanceStorage storage.Instances
+	brokerClient    BrokerClient
+	now             func() time.Time
+}
+
+func main() {
+	log.SetFormatter(&log.JSONFormatter{})
+	log.Info("Starting hibernation scheduler job")
+
+	// create and fill config
+	var cfg Config
+	err := envconfig.InitWithPrefix(&cfg, "APP")
+	fatalOnError(err)
+
+	if cfg.DryRun {
+		log.Info("Dry run only - no changes")
+	}
+
+	log.Infof("Interval: %+v", cfg.Interval)
+
+	ctx := context.Background()
+	brokerClient := broker.NewClient(ctx, cfg.Broker)
+
+	// create storage connection
+	cipher, err := storage.NewEncrypterFromConfig(cfg.Database)
+	fatalOnError(err)
+	db, conn, err := storage.NewFromConfig(cfg.Database, events.Config{}, cipher, log.WithField("service", "storage"))
+	fatalOnError(err)
+	svc := newHibernationSchedulerService(cfg, brokerClient, db.Instances())
+
+	err = svc.PerformScheduling()
+
+	fatalOnError(err)
+
+	log.Info("Hibernation scheduler job finished successfully!")
+
+	err = conn.Close()
+	if err != nil {
+		fatalOnError(err)
+	}
+
+	cleaner.HaltIstioSidecar()
+	// do not use defer, close must be done before halting
+	err = cleaner.Halt()
+	fatalOnError(err)
+}
+
+func newHibernationSchedulerService(cfg Config, brokerClient BrokerClient, instances storage.Instances) *HibernationSchedulerService {
+	return &HibernationSchedulerService{
+		cfg:             cfg,
+		instanceStorage: instances,
+		brokerClient:    brokerClient,
+		now:             time.Now,
+	}
+}
+
+// PerformScheduling hibernates or wakes up runtimes which hibernation schedules were triggered within the last interval.
+// Only schedule triggers change the hibernation, so a runtime hibernated or woken up manually stays in that state until the next trigger.
+func (s *HibernationSchedulerService) PerformScheduling() error {
+	filter := dbmodel.InstanceFilter{PlanIDs: []string{broker.AWSPlanID, broker.AzurePlanID, broker.GCPPlanID}}
+	instances, _, _, err := s.instanceStorage.List(filter)
+	if err != nil {
+		log.Error(fmt.Sprintf("while getting instances: %s", err))
+		return err
+	}
+
+	now := s.now()
+	var scheduled, accepted, failures int
+	for _, instance := range instances {
+		hibernated, triggered := s.triggeredHibernation(instance, now.Add(-s.cfg.Interval), now)
+		if !triggered {
+			continue
+		}
+		// runtimes which were never hibernated have no hibernation state and are awake
+		if instance.IsHibernated() == hibernated {
+			continue
+		}
+		scheduled++
+
+		log.Infof("instanceId: %+v servicePlanName: %+v hibernated: %t", instance.InstanceID, instance.ServicePlanName, hibernated)
+		if s.cfg.DryRun {
+			continue
+		}
+		ok, err := s.brokerClient.SendHibernationRequest(instance, hibernated)
+		switch {
+		case err != nil:
+			// ignoring errors - only logging
+			log.Error(fmt.Sprintf("while sending hibernation request for instanceID: %s, error: %s", instance.InstanceID, err))
+			failures++
+		case ok:
+			accepted++
+		default:
+			failures++
+		}
+	}
+
+	log.Infof("Instances: %+v, scheduled hibernation changes: %+v, accepted: %+v, failures: %+v", len(instances), scheduled, accepted, failures)
+	return nil
+}
+
+// triggeredHibernation returns the hibernation state requested by the last schedule trigger in the (from, to] range
+func (s *HibernationSchedulerService) triggeredHibernation(instance internal.Instance, from, to time.Time) (hibernated bool, triggered bool) {
+	var last time.Time
+	for _, schedule := range instance.Parameters.Parameters.HibernationSchedules {
+		location := time.UTC
+		if schedule.Location != "" {
+			loc, err := time.LoadLocation(schedule.Location)
+			if err != nil {
+				log.Warnf("invalid hibernation schedule location of instanceID %s: %s", instance.InstanceID, err)
+				continue
+			}
+			location = loc
+		}
+
+		for _, trigger := range []struct {
+			expression string
+			hibernate  bool
+		}{{schedule.Start, true}, {schedule.End, false}} {
+			if trigger.expression == "" {
+				continue
+			}
+			at, found, err := lastTrigger(trigger.expression, from.In(location), to.In(location))
+			if err != nil {
+				log.Warnf("invalid hibernation schedule of instanceID %s: %s", instance.InstanceID, err)
+				continue
+			}
+			// wake-up wins when both are triggered at the same time
+			if found && (at.After(last) || (at.Equal(last) && !trigger.hibernate)) {
+				last, hibernated, triggered = at, trigger.hibernate, true
+			}
+		}
+	}
+	return hibernated, triggered
+}
+
+// lastTrigger returns the last time in the (from, to] range the cron expression was triggered
+func lastTrigger(expression string, from, to time.Time) (time.Time, bool, error) {
+	schedule, err := cron.ParseStandard(expression)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+
+	var last time.Time
+	found := false
+	for next := schedule.Next(from); !next.IsZero() && !next.After(to); next = schedule.Next(next) {
+		last, found = next, true
+	}
+	return last, found, nil
+}
+
+func fatalOnError(err error) {
+	if err != nil {
+		// exit with 0 to avoid any side effects - we ignore all errors only logging those
+		log.Error(err)
+		os.Exit(0)
+	}
+}
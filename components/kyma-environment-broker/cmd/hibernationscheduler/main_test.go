@@ -0,0 +1,116 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/ptr"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeBrokerClient struct {
+	requests map[string]bool
+}
+
+func (c *fakeBrokerClient) SendHibernationRequest(instance internal.Instance, hibernated bool) (bool, error) {
+	c.requests[instance.InstanceID] = hibernated
+	return true, nil
+}
+
+func TestHibernationSchedulerService_PerformScheduling(t *testing.T) {
+	// Monday 2023-08-14 20:05 Europe/Berlin
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+	now := time.Date(2023, 8, 14, 20, 5, 0, 0, berlin)
+
+	workdays := internal.HibernationScheduleDTO{Start: "00 20 * * 1-5", End: "00 08 * * 1-5", Location: "Europe/Berlin"}
+	weekends := internal.HibernationScheduleDTO{Start: "00 20 * * 5", End: "00 08 * * 1"}
+
+	for _, tc := range []struct {
+		name               string
+		schedules          []internal.HibernationScheduleDTO
+		hibernated         *bool
+		now                time.Time
+		expectedHibernated *bool
+	}{
+		{
+			name:               "should hibernate when start is triggered",
+			schedules:          []internal.HibernationScheduleDTO{workdays},
+			now:                now,
+			expectedHibernated: ptr.Bool(true),
+		},
+		{
+			name:               "should wake up when end is triggered",
+			schedules:          []internal.HibernationScheduleDTO{workdays},
+			hibernated:         ptr.Bool(true),
+			now:                now.Add(12 * time.Hour),
+			expectedHibernated: ptr.Bool(false),
+		},
+		{
+			name:       "should not change hibernation between triggers",
+			schedules:  []internal.HibernationScheduleDTO{workdays},
+			hibernated: ptr.Bool(false),
+			now:        now.Add(2 * time.Hour),
+		},
+		{
+			name:      "should not wake up runtime which was never hibernated",
+			schedules: []internal.HibernationScheduleDTO{workdays},
+			now:       now.Add(12 * time.Hour),
+		},
+		{
+			name:       "should not request the current state",
+			schedules:  []internal.HibernationScheduleDTO{workdays},
+			hibernated: ptr.Bool(true),
+			now:        now,
+		},
+		{
+			name:      "should evaluate schedules in UTC when location is not set",
+			schedules: []internal.HibernationScheduleDTO{weekends},
+			// Friday 20:05 Europe/Berlin is 18:05 UTC
+			now: now.Add(4 * 24 * time.Hour),
+		},
+		{
+			name:      "should not request changes without schedules",
+			schedules: nil,
+			now:       now,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			db := storage.NewMemoryStorage()
+			err := db.Instances().Insert(internal.Instance{
+				InstanceID:    "instance-id",
+				ServicePlanID: broker.AWSPlanID,
+				Parameters: internal.ProvisioningParameters{
+					PlanID: broker.AWSPlanID,
+					Parameters: internal.ProvisioningParametersDTO{
+						Hibernated:           tc.hibernated,
+						HibernationSchedules: tc.schedules,
+					},
+				},
+			})
+			require.NoError(t, err)
+
+			client := &fakeBrokerClient{requests: map[string]bool{}}
+			svc := newHibernationSchedulerService(Config{Interval: 15 * time.Minute}, client, db.Instances())
+			svc.now = func() time.Time { return tc.now }
+
+			// when
+			err = svc.PerformScheduling()
+
+			// then
+			require.NoError(t, err)
+			hibernated, requested := client.requests["instance-id"]
+			if tc.expectedHibernated == nil {
+				assert.False(t, requested)
+				return
+			}
+			assert.True(t, requested)
+			assert.Equal(t, *tc.expectedHibernated, hibernated)
+		})
+	}
+}
@@ -49,7 +49,8 @@ func main() {
 	}
 	logs.Infof("runtime-listener runing as dry run? %t", cfg.DryRun)
 
-	cipher := storage.NewEncrypter(cfg.Database.SecretKey)
+	cipher, err := storage.NewEncrypterFromConfig(cfg.Database)
+	fatalOnError(err)
 
 	db, _, err := storage.NewFromConfig(cfg.Database, cfg.Events, cipher, logs.WithField("service", "storage"))
 	fatalOnError(err)
@@ -7,6 +7,7 @@ import (
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/cmd/subscriptioncleanup/cloudprovider"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/cmd/subscriptioncleanup/model"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/gardener"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/hyperscaler"
 	"github.com/sirupsen/logrus"
 	apiv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -130,7 +131,7 @@ func (p *cleaner) returnSecretBindingToThePool(secretBinding unstructured.Unstru
 }
 
 func (p *cleaner) getSecretBindingsToRelease() ([]unstructured.Unstructured, error) {
-	labelSelector := fmt.Sprintf("dirty=true")
+	labelSelector := fmt.Sprintf("dirty=true, !%s", hyperscaler.QuarantinedLabel)
 
 	return getSecretBindings(p.context, p.secretBindingsClient, labelSelector)
 }
@@ -0,0 +1,156 @@
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/pagination"
+)
+
+const defaultPageSize = 100
+
+// Client is the interface to interact with the KEB /audit API as an HTTP client using OIDC ID token in JWT format.
+type Client interface {
+	ListKubeconfigRecords(params ListParameters) (KubeconfigRecordsPage, error)
+	RecordKubeconfig(record KubeconfigRecordDTO) error
+}
+
+type client struct {
+	url        string
+	httpClient *http.Client
+}
+
+// NewClient constructs and returns new Client for KEB /audit API
+// It takes the following arguments:
+//   - url        : base url of all KEB APIs, e.g. https://kyma-env-broker.kyma.local
+//   - httpClient : underlying HTTP client used for API call to KEB
+func NewClient(url string, httpClient *http.Client) Client {
+	return &client{
+		url:        url,
+		httpClient: httpClient,
+	}
+}
+
+// ListKubeconfigRecords fetches the kubeconfig audit records from KEB according to the given parameters.
+// If params.Page or params.PageSize is not set (zero), the client will fetch and return all records.
+func (c *client) ListKubeconfigRecords(params ListParameters) (KubeconfigRecordsPage, error) {
+	records := KubeconfigRecordsPage{}
+	getAll := params.Page == 0 || params.PageSize == 0
+	if getAll {
+		params.Page = 1
+		params.PageSize = defaultPageSize
+	}
+
+	for {
+		page, err := c.listKubeconfigRecordsPage(params)
+		if err != nil {
+			return records, err
+		}
+		records.TotalCount = page.TotalCount
+		records.Count += page.Count
+		records.Data = append(records.Data, page.Data...)
+		if !getAll || page.Count == 0 || records.Count >= page.TotalCount {
+			return records, nil
+		}
+		params.Page++
+	}
+}
+
+func (c *client) listKubeconfigRecordsPage(params ListParameters) (page KubeconfigRecordsPage, err error) {
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/audit/kubeconfigs", c.url), nil)
+	if err != nil {
+		return page, fmt.Errorf("while creating request: %w", err)
+	}
+	setQuery(req.URL, params)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return page, fmt.Errorf("while calling %s: %w", req.URL.String(), err)
+	}
+	defer func() {
+		if derr := drainResponseBody(resp.Body); derr != nil && err == nil {
+			err = derr
+		}
+		if cerr := resp.Body.Close(); cerr != nil && err == nil {
+			err = cerr
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return page, fmt.Errorf("calling %s returned %d (%s) status", req.URL.String(), resp.StatusCode, resp.Status)
+	}
+
+	if err = json.NewDecoder(resp.Body).Decode(&page); err != nil {
+		return page, fmt.Errorf("while decoding response body: %w", err)
+	}
+	return page, nil
+}
+
+// RecordKubeconfig stores the audit record of a kubeconfig issued outside of KEB
+func (c *client) RecordKubeconfig(record KubeconfigRecordDTO) (err error) {
+	body, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("while encoding kubeconfig audit record: %w", err)
+	}
+	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/audit/kubeconfigs", c.url), bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("while creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("while calling %s: %w", req.URL.String(), err)
+	}
+	defer func() {
+		if derr := drainResponseBody(resp.Body); derr != nil && err == nil {
+			err = derr
+		}
+		if cerr := resp.Body.Close(); cerr != nil && err == nil {
+			err = cerr
+		}
+	}()
+
+	if resp.StatusCode != http.StatusCreated {
+		return fmt.Errorf("calling %s returned %d (%s) status", req.URL.String(), resp.StatusCode, resp.Status)
+	}
+	return nil
+}
+
+func setQuery(url *url.URL, params ListParameters) {
+	query := url.Query()
+	query.Add(pagination.PageParam, strconv.Itoa(params.Page))
+	query.Add(pagination.PageSizeParam, strconv.Itoa(params.PageSize))
+	setParamList(query, InstanceIDParam, params.InstanceIDs)
+	setParamList(query, RuntimeIDParam, params.RuntimeIDs)
+	setParamList(query, UserIDParam, params.UserIDs)
+	setParamList(query, SourceParam, params.Sources)
+	if !params.From.IsZero() {
+		query.Add(FromParam, params.From.Format(time.RFC3339))
+	}
+	if !params.To.IsZero() {
+		query.Add(ToParam, params.To.Format(time.RFC3339))
+	}
+	url.RawQuery = query.Encode()
+}
+
+func setParamList(query url.Values, key string, values []string) {
+	for _, value := range values {
+		query.Add(key, value)
+	}
+}
+
+func drainResponseBody(body io.Reader) error {
+	if body == nil {
+		return nil
+	}
+	_, err := io.Copy(ioutil.Discard, io.LimitReader(body, 4096))
+	return err
+}
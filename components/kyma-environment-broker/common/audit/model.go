@@ -0,0 +1,48 @@
+package audit
+
+import "time"
+
+const (
+	InstanceIDParam = "instance_id"
+	RuntimeIDParam  = "runtime_id"
+	UserIDParam     = "user_id"
+	SourceParam     = "source"
+	FromParam       = "from"
+	ToParam         = "to"
+)
+
+// KubeconfigRecordDTO is the audit record of a kubeconfig issued to a caller
+type KubeconfigRecordDTO struct {
+	ID              string     `json:"id"`
+	Source          string     `json:"source"`
+	UserID          string     `json:"userID"`
+	Issuer          string     `json:"issuer,omitempty"`
+	Groups          []string   `json:"groups,omitempty"`
+	InstanceID      string     `json:"instanceID,omitempty"`
+	RuntimeID       string     `json:"runtimeID"`
+	GlobalAccountID string     `json:"globalAccountID,omitempty"`
+	SubAccountID    string     `json:"subAccountID,omitempty"`
+	Role            string     `json:"role,omitempty"`
+	SourceIP        string     `json:"sourceIP,omitempty"`
+	UserAgent       string     `json:"userAgent,omitempty"`
+	ExpiresAt       *time.Time `json:"expiresAt,omitempty"`
+	CreatedAt       time.Time  `json:"createdAt"`
+}
+
+type KubeconfigRecordsPage struct {
+	Data       []KubeconfigRecordDTO `json:"data"`
+	Count      int                   `json:"count"`
+	TotalCount int                   `json:"totalCount"`
+}
+
+// ListParameters hold attributes of list kubeconfig audit records query
+type ListParameters struct {
+	Page        int
+	PageSize    int
+	InstanceIDs []string
+	RuntimeIDs  []string
+	UserIDs     []string
+	Sources     []string
+	From        time.Time
+	To          time.Time
+}
@@ -1,6 +1,7 @@
 package events
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -17,23 +18,72 @@ const (
 	ErrorEventLevel EventLevel = "error"
 )
 
+type SortOrder string
+
+const (
+	AscendingSortOrder  SortOrder = "asc"
+	DescendingSortOrder SortOrder = "desc"
+)
+
+// NextCursorHeader is the response header of the KEB /events API holding the cursor of the next page,
+// the header is not set when there are no more events
+const NextCursorHeader = "X-Next-Cursor"
+
 type EventDTO struct {
-	ID          string
-	Level       EventLevel
-	InstanceID  *string
-	OperationID *string
-	Message     string
-	CreatedAt   time.Time
+	ID              string
+	Level           EventLevel
+	InstanceID      *string
+	OperationID     *string
+	GlobalAccountID *string
+	SubAccountID    *string
+	PlanID          *string
+	StepName        *string
+	Message         string
+	CreatedAt       time.Time
+}
+
+// EventSource describes the instance, operation and step an event is recorded for
+type EventSource struct {
+	InstanceID      string
+	OperationID     string
+	GlobalAccountID string
+	SubAccountID    string
+	PlanID          string
+	StepName        string
 }
 
 type EventFilter struct {
-	InstanceIDs  []string
-	OperationIDs []string
+	InstanceIDs      []string
+	OperationIDs     []string
+	Levels           []EventLevel
+	GlobalAccountIDs []string
+	SubAccountIDs    []string
+	PlanIDs          []string
+	StepNames        []string
+	// Message selects events which message contains the given text, ignoring case
+	Message string
+	// From and To limit the creation time of events, zero value does not limit it
+	From time.Time
+	To   time.Time
+	// Cursor selects events following the event the cursor was created for, in the sort order
+	Cursor string
+	// Limit is the maximum number of returned events, 0 returns all events
+	Limit int
+	// Sort orders events by the creation time, ascending by default
+	Sort SortOrder
+}
+
+// EventsPage is a single page of events returned by the KEB /events API
+type EventsPage struct {
+	Data       []EventDTO
+	NextCursor string
 }
 
 // Client is the interface to interact with the KEB /events API as an HTTP client using OIDC ID token in JWT format.
 type Client interface {
 	ListEvents(instanceIDs []string) ([]EventDTO, error)
+	SearchEvents(filter EventFilter) (EventsPage, error)
+	StreamEvents(ctx context.Context, filter StreamFilter, handle func(StreamEvent) error) error
 }
 
 type client struct {
@@ -0,0 +1,91 @@
+package events
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SearchEvents calls the KEB /events API and returns a single page of events matching the filter
+func (c *client) SearchEvents(filter EventFilter) (EventsPage, error) {
+	var page EventsPage
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/events", c.url), nil)
+	if err != nil {
+		return page, fmt.Errorf("while creating request: %v", err)
+	}
+	q := req.URL.Query()
+	setQueryList(q, "instance_ids", filter.InstanceIDs)
+	setQueryList(q, "operation_ids", filter.OperationIDs)
+	setQueryList(q, "global_account_ids", filter.GlobalAccountIDs)
+	setQueryList(q, "subaccount_ids", filter.SubAccountIDs)
+	setQueryList(q, "plan_ids", filter.PlanIDs)
+	setQueryList(q, "step_names", filter.StepNames)
+	levels := make([]string, 0, len(filter.Levels))
+	for _, l := range filter.Levels {
+		levels = append(levels, string(l))
+	}
+	setQueryList(q, "levels", levels)
+	if filter.Message != "" {
+		q.Set("message", filter.Message)
+	}
+	if !filter.From.IsZero() {
+		q.Set("from", filter.From.Format(time.RFC3339))
+	}
+	if !filter.To.IsZero() {
+		q.Set("to", filter.To.Format(time.RFC3339))
+	}
+	if filter.Cursor != "" {
+		q.Set("cursor", filter.Cursor)
+	}
+	if filter.Limit > 0 {
+		q.Set("limit", strconv.Itoa(filter.Limit))
+	}
+	if filter.Sort != "" {
+		q.Set("sort", string(filter.Sort))
+	}
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return page, fmt.Errorf("while calling %s: %v", req.URL.String(), err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return page, fmt.Errorf("calling %s returned %d (%s) status", req.URL.String(), resp.StatusCode, resp.Status)
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(&page.Data)
+	if err != nil {
+		return page, fmt.Errorf("while decoding response body: %v", err)
+	}
+	page.NextCursor = resp.Header.Get(NextCursorHeader)
+
+	return page, nil
+}
+
+// EncodeCursor returns the cursor pointing at the given event
+func EncodeCursor(ev EventDTO) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%s|%s", ev.CreatedAt.UTC().Format(time.RFC3339Nano), ev.ID)))
+}
+
+// DecodeCursor returns the creation time and the ID of the event the cursor points at
+func DecodeCursor(cursor string) (time.Time, string, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return time.Time{}, "", fmt.Errorf("invalid cursor: %v", err)
+	}
+	parts := strings.SplitN(string(raw), "|", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return time.Time{}, "", fmt.Errorf("invalid cursor %q", cursor)
+	}
+	createdAt, err := time.Parse(time.RFC3339Nano, parts[0])
+	if err != nil {
+		return time.Time{}, "", fmt.Errorf("invalid cursor: %v", err)
+	}
+	return createdAt, parts[1], nil
+}
@@ -0,0 +1,125 @@
+package events
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// ProgressStreamEvent is the name of stream events carrying OperationProgress
+	ProgressStreamEvent = "progress"
+	// StoredStreamEvent is the name of stream events carrying EventDTO from the events store
+	StoredStreamEvent = "event"
+)
+
+// OperationProgress describes a processed step of an operation
+type OperationProgress struct {
+	OperationID     string    `json:"operationID"`
+	InstanceID      string    `json:"instanceID"`
+	RuntimeID       string    `json:"runtimeID,omitempty"`
+	OrchestrationID string    `json:"orchestrationID,omitempty"`
+	Type            string    `json:"type"`
+	State           string    `json:"state"`
+	Description     string    `json:"description"`
+	StepName        string    `json:"stepName"`
+	Error           string    `json:"error,omitempty"`
+	CreatedAt       time.Time `json:"createdAt"`
+}
+
+// StreamFilter selects the operations which progress is streamed, empty filter streams progress of all operations
+type StreamFilter struct {
+	InstanceIDs      []string
+	RuntimeIDs       []string
+	OperationIDs     []string
+	OrchestrationIDs []string
+}
+
+// StreamEvent is a single event received from the KEB /events/stream API, either Progress or Event is set
+type StreamEvent struct {
+	Progress *OperationProgress
+	Event    *EventDTO
+}
+
+// StreamEvents calls the KEB /events/stream API and calls handle for every received event until the context is canceled,
+// the stream is closed by KEB or handle returns an error
+func (c *client) StreamEvents(ctx context.Context, filter StreamFilter, handle func(StreamEvent) error) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/events/stream", c.url), nil)
+	if err != nil {
+		return fmt.Errorf("while creating request: %v", err)
+	}
+	q := req.URL.Query()
+	setQueryList(q, "instance_ids", filter.InstanceIDs)
+	setQueryList(q, "runtime_ids", filter.RuntimeIDs)
+	setQueryList(q, "operation_ids", filter.OperationIDs)
+	setQueryList(q, "orchestration_ids", filter.OrchestrationIDs)
+	req.URL.RawQuery = q.Encode()
+	req.Header.Set("Accept", "text/event-stream")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("while calling %s: %v", req.URL.String(), err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("calling %s returned %d (%s) status", req.URL.String(), resp.StatusCode, resp.Status)
+	}
+
+	var name, data string
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case line == "":
+			if data != "" {
+				if err := dispatchStreamEvent(name, data, handle); err != nil {
+					return err
+				}
+			}
+			name, data = "", ""
+		case strings.HasPrefix(line, ":"):
+			// comment, e.g. keep-alive
+		case strings.HasPrefix(line, "event:"):
+			name = strings.TrimSpace(strings.TrimPrefix(line, "event:"))
+		case strings.HasPrefix(line, "data:"):
+			data += strings.TrimSpace(strings.TrimPrefix(line, "data:"))
+		}
+	}
+	if err := scanner.Err(); err != nil && ctx.Err() == nil {
+		return fmt.Errorf("while reading event stream: %v", err)
+	}
+
+	return nil
+}
+
+func dispatchStreamEvent(name, data string, handle func(StreamEvent) error) error {
+	var ev StreamEvent
+	switch name {
+	case ProgressStreamEvent:
+		ev.Progress = &OperationProgress{}
+		if err := json.Unmarshal([]byte(data), ev.Progress); err != nil {
+			return fmt.Errorf("while decoding %s event: %v", name, err)
+		}
+	case StoredStreamEvent:
+		ev.Event = &EventDTO{}
+		if err := json.Unmarshal([]byte(data), ev.Event); err != nil {
+			return fmt.Errorf("while decoding %s event: %v", name, err)
+		}
+	default:
+		return nil
+	}
+	return handle(ev)
+}
+
+func setQueryList(q url.Values, key string, values []string) {
+	if len(values) > 0 {
+		q.Set(key, strings.Join(values, ","))
+	}
+}
@@ -139,7 +139,7 @@ func (p *secretBindingsAccountPool) CredentialsSecretBinding(hyperscalerType Typ
 	p.mux.Lock()
 	defer p.mux.Unlock()
 
-	labelSelector = fmt.Sprintf("shared!=true, !tenantName, !dirty, hyperscalerType=%s", hyperscalerType)
+	labelSelector = fmt.Sprintf("shared!=true, !tenantName, !dirty, !%s, hyperscalerType=%s", QuarantinedLabel, hyperscalerType)
 	labelSelector = addEuAccessSelector(labelSelector, euAccess)
 	secretBinding, err = p.getSecretBinding(labelSelector)
 	if err != nil {
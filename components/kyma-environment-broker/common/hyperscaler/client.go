@@ -0,0 +1,123 @@
+package hyperscaler
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+const (
+	HyperscalerTypeParam = "hyperscaler_type"
+	StateParam           = "state"
+	TenantNameParam      = "tenant_name"
+	EuAccessParam        = "eu_access"
+)
+
+// Client is the interface to interact with the KEB /accounts API as an HTTP client using OIDC ID token in JWT format.
+type Client interface {
+	ListAccounts(filter AccountFilter) ([]AccountDTO, error)
+	Capacity() ([]PoolCapacityDTO, error)
+	ReleaseAccount(name string) (AccountDTO, error)
+	QuarantineAccount(name string) (AccountDTO, error)
+}
+
+type client struct {
+	url        string
+	httpClient *http.Client
+}
+
+// NewClient constructs and returns new Client for KEB /accounts API
+// It takes the following arguments:
+//   - url        : base url of all KEB APIs, e.g. https://kyma-env-broker.kyma.local
+//   - httpClient : underlying HTTP client used for API call to KEB
+func NewClient(url string, httpClient *http.Client) Client {
+	return &client{
+		url:        url,
+		httpClient: httpClient,
+	}
+}
+
+// ListAccounts fetches secret bindings of the account pool matching the filter
+func (c *client) ListAccounts(filter AccountFilter) ([]AccountDTO, error) {
+	query := url.Values{}
+	if filter.HyperscalerType != "" {
+		query.Set(HyperscalerTypeParam, filter.HyperscalerType)
+	}
+	if filter.State != "" {
+		query.Set(StateParam, string(filter.State))
+	}
+	if filter.TenantName != "" {
+		query.Set(TenantNameParam, filter.TenantName)
+	}
+	if filter.EuAccess != nil {
+		query.Set(EuAccessParam, strconv.FormatBool(*filter.EuAccess))
+	}
+
+	var accounts []AccountDTO
+	err := c.call(http.MethodGet, fmt.Sprintf("%s/accounts?%s", c.url, query.Encode()), &accounts)
+	return accounts, err
+}
+
+// Capacity fetches the number of secret bindings of every pool by their state
+func (c *client) Capacity() ([]PoolCapacityDTO, error) {
+	var capacity []PoolCapacityDTO
+	err := c.call(http.MethodGet, fmt.Sprintf("%s/accounts/capacity", c.url), &capacity)
+	return capacity, err
+}
+
+// ReleaseAccount returns the secret binding to the pool
+func (c *client) ReleaseAccount(name string) (AccountDTO, error) {
+	var account AccountDTO
+	err := c.call(http.MethodPost, fmt.Sprintf("%s/accounts/%s/release", c.url, url.PathEscape(name)), &account)
+	return account, err
+}
+
+// QuarantineAccount excludes the secret binding from assignment to new tenants
+func (c *client) QuarantineAccount(name string) (AccountDTO, error) {
+	var account AccountDTO
+	err := c.call(http.MethodPost, fmt.Sprintf("%s/accounts/%s/quarantine", c.url, url.PathEscape(name)), &account)
+	return account, err
+}
+
+func (c *client) call(method, url string, response interface{}) (err error) {
+	req, err := http.NewRequest(method, url, nil)
+	if err != nil {
+		return fmt.Errorf("while creating request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("while calling %s: %w", req.URL.String(), err)
+	}
+	defer func() {
+		if derr := drainResponseBody(resp.Body); derr != nil && err == nil {
+			err = derr
+		}
+		if cerr := resp.Body.Close(); cerr != nil && err == nil {
+			err = cerr
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("calling %s returned %d (%s) status", req.URL.String(), resp.StatusCode, resp.Status)
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(response)
+	if err != nil {
+		return fmt.Errorf("while decoding response body: %w", err)
+	}
+
+	return nil
+}
+
+func drainResponseBody(body io.Reader) error {
+	if body == nil {
+		return nil
+	}
+	_, err := io.Copy(ioutil.Discard, io.LimitReader(body, 4096))
+	return err
+}
@@ -0,0 +1,306 @@
+package hyperscaler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/gardener"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/dynamic"
+)
+
+// QuarantinedLabel marks secret bindings which must not be assigned to tenants nor cleaned up by the subscription cleanup job
+const QuarantinedLabel = "quarantined"
+
+type AccountState string
+
+const (
+	AccountStateFree        AccountState = "free"
+	AccountStateAssigned    AccountState = "assigned"
+	AccountStateDirty       AccountState = "dirty"
+	AccountStateShared      AccountState = "shared"
+	AccountStateQuarantined AccountState = "quarantined"
+)
+
+var (
+	ErrAccountNotFound = errors.New("secret binding not found")
+	ErrAccountInUse    = errors.New("secret binding is used by shoots")
+)
+
+// AccountDTO describes a secret binding of the hyperscaler account pool
+type AccountDTO struct {
+	Name            string       `json:"name"`
+	SecretName      string       `json:"secretName"`
+	HyperscalerType string       `json:"hyperscalerType"`
+	TenantName      string       `json:"tenantName,omitempty"`
+	EuAccess        bool         `json:"euAccess"`
+	Shared          bool         `json:"shared"`
+	Dirty           bool         `json:"dirty"`
+	Internal        bool         `json:"internal"`
+	Quarantined     bool         `json:"quarantined"`
+	State           AccountState `json:"state"`
+	Shoots          int          `json:"shoots"`
+}
+
+// PoolCapacityDTO counts secret bindings of one pool by their state, every hyperscaler type has separate pools
+// for EU access and other regions
+type PoolCapacityDTO struct {
+	HyperscalerType string `json:"hyperscalerType"`
+	EuAccess        bool   `json:"euAccess"`
+	Total           int    `json:"total"`
+	Free            int    `json:"free"`
+	Assigned        int    `json:"assigned"`
+	Dirty           int    `json:"dirty"`
+	Shared          int    `json:"shared"`
+	Quarantined     int    `json:"quarantined"`
+}
+
+// Exhausted returns true if the pool has dedicated accounts but none of them can be assigned to a new tenant
+func (p PoolCapacityDTO) Exhausted() bool {
+	return p.Free == 0 && p.Total > p.Shared
+}
+
+type AccountFilter struct {
+	HyperscalerType string
+	State           AccountState
+	TenantName      string
+	// EuAccess selects accounts of the EU access or other pools, nil selects both
+	EuAccess *bool
+}
+
+// Inventory lists secret bindings of the hyperscaler account pool and allows operators to manage them
+type Inventory interface {
+	ListAccounts(filter AccountFilter) ([]AccountDTO, error)
+	Capacity() ([]PoolCapacityDTO, error)
+	ReleaseAccount(name string) (AccountDTO, error)
+	QuarantineAccount(name string) (AccountDTO, error)
+}
+
+func NewInventory(gardenerClient dynamic.Interface, gardenerNamespace string) Inventory {
+	return &secretBindingsInventory{
+		gardenerClient: gardenerClient,
+		gardenerNS:     gardenerNamespace,
+	}
+}
+
+type secretBindingsInventory struct {
+	gardenerClient dynamic.Interface
+	gardenerNS     string
+}
+
+func (i *secretBindingsInventory) ListAccounts(filter AccountFilter) ([]AccountDTO, error) {
+	secretBindings, err := i.listSecretBindings()
+	if err != nil {
+		return nil, err
+	}
+	usage, err := i.shootsPerSecretBinding()
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := make([]AccountDTO, 0)
+	for _, sb := range secretBindings {
+		account := toAccountDTO(gardener.SecretBinding{Unstructured: sb}, usage)
+		if filter.matches(account) {
+			accounts = append(accounts, account)
+		}
+	}
+	sort.Slice(accounts, func(a, b int) bool {
+		return accounts[a].Name < accounts[b].Name
+	})
+
+	return accounts, nil
+}
+
+func (i *secretBindingsInventory) Capacity() ([]PoolCapacityDTO, error) {
+	secretBindings, err := i.listSecretBindings()
+	if err != nil {
+		return nil, err
+	}
+
+	type poolKey struct {
+		hyperscalerType string
+		euAccess        bool
+	}
+	pools := map[poolKey]*PoolCapacityDTO{}
+	for _, sb := range secretBindings {
+		account := toAccountDTO(gardener.SecretBinding{Unstructured: sb}, nil)
+		key := poolKey{hyperscalerType: account.HyperscalerType, euAccess: account.EuAccess}
+		pool, found := pools[key]
+		if !found {
+			pool = &PoolCapacityDTO{HyperscalerType: key.hyperscalerType, EuAccess: key.euAccess}
+			pools[key] = pool
+		}
+		pool.Total++
+		switch account.State {
+		case AccountStateFree:
+			pool.Free++
+		case AccountStateAssigned:
+			pool.Assigned++
+		case AccountStateDirty:
+			pool.Dirty++
+		case AccountStateShared:
+			pool.Shared++
+		case AccountStateQuarantined:
+			pool.Quarantined++
+		}
+	}
+
+	capacity := make([]PoolCapacityDTO, 0, len(pools))
+	for _, pool := range pools {
+		capacity = append(capacity, *pool)
+	}
+	sort.Slice(capacity, func(a, b int) bool {
+		if capacity[a].HyperscalerType != capacity[b].HyperscalerType {
+			return capacity[a].HyperscalerType < capacity[b].HyperscalerType
+		}
+		return !capacity[a].EuAccess && capacity[b].EuAccess
+	})
+
+	return capacity, nil
+}
+
+// ReleaseAccount returns the secret binding to the pool by removing the tenant assignment, dirty and quarantined labels.
+// Dedicated secret bindings used by shoots cannot be released.
+func (i *secretBindingsInventory) ReleaseAccount(name string) (AccountDTO, error) {
+	sb, err := i.getSecretBinding(name)
+	if err != nil {
+		return AccountDTO{}, err
+	}
+	usage, err := i.shootsPerSecretBinding()
+	if err != nil {
+		return AccountDTO{}, err
+	}
+	if sb.GetLabels()["shared"] != "true" && usage[name] > 0 {
+		return AccountDTO{}, fmt.Errorf("releasing secret binding %s: %w", name, ErrAccountInUse)
+	}
+
+	labels := sb.GetLabels()
+	delete(labels, "tenantName")
+	delete(labels, "dirty")
+	delete(labels, QuarantinedLabel)
+	sb.SetLabels(labels)
+
+	return i.updateSecretBinding(sb, usage)
+}
+
+// QuarantineAccount excludes the secret binding from assignment to new tenants and from the subscription cleanup
+func (i *secretBindingsInventory) QuarantineAccount(name string) (AccountDTO, error) {
+	sb, err := i.getSecretBinding(name)
+	if err != nil {
+		return AccountDTO{}, err
+	}
+	usage, err := i.shootsPerSecretBinding()
+	if err != nil {
+		return AccountDTO{}, err
+	}
+
+	labels := sb.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labels[QuarantinedLabel] = "true"
+	sb.SetLabels(labels)
+
+	return i.updateSecretBinding(sb, usage)
+}
+
+func (i *secretBindingsInventory) getSecretBinding(name string) (*unstructured.Unstructured, error) {
+	sb, err := i.gardenerClient.Resource(gardener.SecretBindingResource).Namespace(i.gardenerNS).Get(context.Background(), name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, fmt.Errorf("getting secret binding %s: %w", name, ErrAccountNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting secret binding %s: %w", name, err)
+	}
+	return sb, nil
+}
+
+// updateSecretBinding fails with a conflict if the secret binding was modified after it was read,
+// for example, assigned to a tenant by the account pool
+func (i *secretBindingsInventory) updateSecretBinding(sb *unstructured.Unstructured, usage map[string]int) (AccountDTO, error) {
+	updated, err := i.gardenerClient.Resource(gardener.SecretBindingResource).Namespace(i.gardenerNS).Update(context.Background(), sb, metav1.UpdateOptions{})
+	if err != nil {
+		return AccountDTO{}, fmt.Errorf("updating secret binding %s: %w", sb.GetName(), err)
+	}
+	return toAccountDTO(gardener.SecretBinding{Unstructured: *updated}, usage), nil
+}
+
+func (i *secretBindingsInventory) listSecretBindings() ([]unstructured.Unstructured, error) {
+	secretBindings, err := i.gardenerClient.Resource(gardener.SecretBindingResource).Namespace(i.gardenerNS).List(context.Background(), metav1.ListOptions{
+		LabelSelector: "hyperscalerType",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing secret bindings: %w", err)
+	}
+	return secretBindings.Items, nil
+}
+
+func (i *secretBindingsInventory) shootsPerSecretBinding() (map[string]int, error) {
+	shoots, err := i.gardenerClient.Resource(gardener.ShootResource).Namespace(i.gardenerNS).List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("listing Gardener shoots: %w", err)
+	}
+	usage := map[string]int{}
+	for _, shoot := range shoots.Items {
+		usage[gardener.Shoot{Unstructured: shoot}.GetSpecSecretBindingName()]++
+	}
+	return usage, nil
+}
+
+func toAccountDTO(sb gardener.SecretBinding, usage map[string]int) AccountDTO {
+	labels := sb.GetLabels()
+	account := AccountDTO{
+		Name:            sb.GetName(),
+		SecretName:      sb.GetSecretRefName(),
+		HyperscalerType: labels["hyperscalerType"],
+		TenantName:      labels["tenantName"],
+		EuAccess:        isTrue(labels["euAccess"]),
+		Shared:          isTrue(labels["shared"]),
+		Dirty:           isTrue(labels["dirty"]),
+		Internal:        isTrue(labels["internal"]),
+		Quarantined:     isTrue(labels[QuarantinedLabel]),
+		Shoots:          usage[sb.GetName()],
+	}
+
+	switch {
+	case account.Quarantined:
+		account.State = AccountStateQuarantined
+	case account.Shared:
+		account.State = AccountStateShared
+	case account.Dirty:
+		account.State = AccountStateDirty
+	case account.TenantName != "":
+		account.State = AccountStateAssigned
+	default:
+		account.State = AccountStateFree
+	}
+
+	return account
+}
+
+func (f AccountFilter) matches(account AccountDTO) bool {
+	if f.HyperscalerType != "" && f.HyperscalerType != account.HyperscalerType {
+		return false
+	}
+	if f.State != "" && f.State != account.State {
+		return false
+	}
+	if f.TenantName != "" && f.TenantName != account.TenantName {
+		return false
+	}
+	if f.EuAccess != nil && *f.EuAccess != account.EuAccess {
+		return false
+	}
+	return true
+}
+
+func isTrue(value string) bool {
+	b, _ := strconv.ParseBool(value)
+	return b
+}
@@ -0,0 +1,189 @@
+package hyperscaler
+
+import (
+	"testing"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/gardener"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+)
+
+func TestInventory_ListAccounts(t *testing.T) {
+	// given
+	inventory, _ := newTestInventory()
+
+	// when
+	accounts, err := inventory.ListAccounts(AccountFilter{})
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, accounts, 6)
+	assert.Equal(t, AccountDTO{
+		Name:            "sb-assigned",
+		SecretName:      "secret-sb-assigned",
+		HyperscalerType: "gcp",
+		TenantName:      "tenant1",
+		State:           AccountStateAssigned,
+		Shoots:          2,
+	}, accounts[0])
+	assert.Equal(t, AccountStateDirty, accounts[1].State)
+	assert.Equal(t, AccountStateFree, accounts[2].State)
+	assert.True(t, accounts[3].EuAccess)
+	assert.Equal(t, AccountStateQuarantined, accounts[4].State)
+	assert.Equal(t, AccountStateShared, accounts[5].State)
+	assert.Equal(t, 1, accounts[5].Shoots)
+}
+
+func TestInventory_ListAccountsWithFilter(t *testing.T) {
+	// given
+	inventory, _ := newTestInventory()
+	euAccess := false
+
+	// when
+	accounts, err := inventory.ListAccounts(AccountFilter{HyperscalerType: "gcp", State: AccountStateFree, EuAccess: &euAccess})
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, accounts, 1)
+	assert.Equal(t, "sb-free", accounts[0].Name)
+}
+
+func TestInventory_Capacity(t *testing.T) {
+	// given
+	inventory, _ := newTestInventory()
+
+	// when
+	capacity, err := inventory.Capacity()
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []PoolCapacityDTO{
+		{HyperscalerType: "azure", Total: 1, Shared: 1},
+		{HyperscalerType: "gcp", Total: 4, Free: 1, Assigned: 1, Dirty: 1, Quarantined: 1},
+		{HyperscalerType: "gcp", EuAccess: true, Total: 1, Free: 1},
+	}, capacity)
+	assert.False(t, capacity[0].Exhausted())
+	assert.False(t, capacity[1].Exhausted())
+}
+
+func TestInventory_ReleaseAccount(t *testing.T) {
+	t.Run("should return the dirty secret binding to the pool", func(t *testing.T) {
+		// given
+		inventory, _ := newTestInventory()
+
+		// when
+		account, err := inventory.ReleaseAccount("sb-dirty")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, AccountStateFree, account.State)
+		assert.Empty(t, account.TenantName)
+	})
+
+	t.Run("should return the quarantined secret binding to the pool", func(t *testing.T) {
+		// given
+		inventory, _ := newTestInventory()
+
+		// when
+		account, err := inventory.ReleaseAccount("sb-quarantined")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, AccountStateFree, account.State)
+	})
+
+	t.Run("should not release the secret binding used by shoots", func(t *testing.T) {
+		// given
+		inventory, _ := newTestInventory()
+
+		// when
+		_, err := inventory.ReleaseAccount("sb-assigned")
+
+		// then
+		assert.ErrorIs(t, err, ErrAccountInUse)
+	})
+
+	t.Run("should fail for not existing secret binding", func(t *testing.T) {
+		// given
+		inventory, _ := newTestInventory()
+
+		// when
+		_, err := inventory.ReleaseAccount("sb-missing")
+
+		// then
+		assert.ErrorIs(t, err, ErrAccountNotFound)
+	})
+}
+
+func TestInventory_QuarantineAccount(t *testing.T) {
+	// given
+	inventory, client := newTestInventory()
+	pool := NewAccountPool(client, testNamespace)
+
+	// when
+	account, err := inventory.QuarantineAccount("sb-free")
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, AccountStateQuarantined, account.State)
+
+	_, err = pool.CredentialsSecretBinding(GCP, "tenant2", false)
+	assert.EqualError(t, err, "failed to find unassigned secret binding for hyperscalerType: gcp")
+
+	capacity, err := inventory.Capacity()
+	require.NoError(t, err)
+	assert.True(t, capacity[1].Exhausted())
+}
+
+func newTestInventory() (Inventory, dynamic.Interface) {
+	client := gardener.NewDynamicFakeClient(
+		fixInventorySecretBinding("sb-assigned", map[string]interface{}{"hyperscalerType": "gcp", "tenantName": "tenant1"}),
+		fixInventorySecretBinding("sb-dirty", map[string]interface{}{"hyperscalerType": "gcp", "tenantName": "tenant3", "dirty": "true"}),
+		fixInventorySecretBinding("sb-free", map[string]interface{}{"hyperscalerType": "gcp"}),
+		fixInventorySecretBinding("sb-free-eu", map[string]interface{}{"hyperscalerType": "gcp", "euAccess": "true"}),
+		fixInventorySecretBinding("sb-quarantined", map[string]interface{}{"hyperscalerType": "gcp", "quarantined": "true"}),
+		fixInventorySecretBinding("sb-shared", map[string]interface{}{"hyperscalerType": "azure", "shared": "true"}),
+		fixInventorySecretBinding("not-in-pool", map[string]interface{}{}),
+		fixInventoryShoot("shoot1", "sb-assigned"),
+		fixInventoryShoot("shoot2", "sb-assigned"),
+		fixInventoryShoot("shoot3", "sb-shared"),
+	)
+	return NewInventory(client, testNamespace), client
+}
+
+func fixInventorySecretBinding(name string, labels map[string]interface{}) runtime.Object {
+	sb := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"name":      name,
+				"namespace": testNamespace,
+				"labels":    labels,
+			},
+			"secretRef": map[string]interface{}{
+				"name":      "secret-" + name,
+				"namespace": testNamespace,
+			},
+		},
+	}
+	sb.SetGroupVersionKind(secretBindingGVK)
+	return sb
+}
+
+func fixInventoryShoot(name, secretBindingName string) runtime.Object {
+	shoot := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"name":      name,
+				"namespace": testNamespace,
+			},
+			"spec": map[string]interface{}{
+				"secretBindingName": secretBindingName,
+			},
+		},
+	}
+	shoot.SetGroupVersionKind(shootGVK)
+	return shoot
+}
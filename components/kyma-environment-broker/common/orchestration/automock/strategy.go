@@ -52,6 +52,16 @@ func (_m *Strategy) Insert(execID string, operations []orchestration.RuntimeOper
 	return r0
 }
 
+// Pause provides a mock function with given fields: executionID
+func (_m *Strategy) Pause(executionID string) {
+	_m.Called(executionID)
+}
+
+// Resume provides a mock function with given fields: executionID
+func (_m *Strategy) Resume(executionID string) {
+	_m.Called(executionID)
+}
+
 // SpeedUp provides a mock function with given fields: speedFactor
 func (_m *Strategy) SpeedUp(speedFactor int) {
 	_m.Called(speedFactor)
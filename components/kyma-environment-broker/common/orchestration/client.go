@@ -29,6 +29,8 @@ type Client interface {
 	UpgradeKyma(params Parameters) (UpgradeResponse, error)
 	UpgradeCluster(params Parameters) (UpgradeResponse, error)
 	CancelOrchestration(orchestrationID string) error
+	PauseOrchestration(orchestrationID string) error
+	ResumeOrchestration(orchestrationID string) error
 	RetryOrchestration(orchestrationID string, operationIDs []string, now bool) (RetryResponse, error)
 }
 
@@ -398,11 +400,24 @@ func (c client) RetryOrchestration(orchestrationID string, operationIDs []string
 }
 
 func (c client) CancelOrchestration(orchestrationID string) error {
-	url := fmt.Sprintf("%s/orchestrations/%s/cancel", c.url, orchestrationID)
+	return c.changeOrchestrationState(orchestrationID, "cancel")
+}
+
+func (c client) PauseOrchestration(orchestrationID string) error {
+	return c.changeOrchestrationState(orchestrationID, "pause")
+}
+
+func (c client) ResumeOrchestration(orchestrationID string) error {
+	return c.changeOrchestrationState(orchestrationID, "resume")
+}
+
+// changeOrchestrationState calls the PUT /orchestrations/{id}/{action} endpoint, e.g. cancel, pause or resume
+func (c client) changeOrchestrationState(orchestrationID, action string) error {
+	url := fmt.Sprintf("%s/orchestrations/%s/%s", c.url, orchestrationID, action)
 
 	req, err := http.NewRequest(http.MethodPut, url, nil)
 	if err != nil {
-		return fmt.Errorf("while creating cancel request: %w", err)
+		return fmt.Errorf("while creating %s request: %w", action, err)
 	}
 
 	resp, err := c.httpClient.Do(req)
@@ -2,6 +2,7 @@ package orchestration
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"time"
@@ -20,6 +21,8 @@ type Parameters struct {
 	RetryOperation RetryOperationParameters `json:"retryoperation,omitempty"`
 	// customer notification
 	Notification bool `json:"notification,omitempty"`
+	// runtime task specific parameters
+	Task *RuntimeTaskParameters `json:"task,omitempty"`
 }
 
 type RetryOperationParameters struct {
@@ -38,6 +41,48 @@ type KymaParameters struct {
 	Version string `json:"version,omitempty"`
 }
 
+// RuntimeTaskParameters hold the changes applied to the runtimes by runtime task orchestrations.
+// The objects of the manifest are applied first, then the patches are applied in the given order.
+type RuntimeTaskParameters struct {
+	// Manifest contains YAML documents with the objects applied with the server-side apply
+	Manifest string             `json:"manifest,omitempty"`
+	Patches  []RuntimeTaskPatch `json:"patches,omitempty"`
+}
+
+type PatchType string
+
+const (
+	MergePatch          PatchType = "merge"
+	StrategicMergePatch PatchType = "strategic"
+)
+
+// RuntimeTaskPatch is a patch of an existing object in the runtime
+type RuntimeTaskPatch struct {
+	APIVersion string          `json:"apiVersion"`
+	Kind       string          `json:"kind"`
+	Namespace  string          `json:"namespace,omitempty"`
+	Name       string          `json:"name"`
+	Type       PatchType       `json:"type"`
+	Patch      json.RawMessage `json:"patch"`
+}
+
+type RuntimeTaskAction string
+
+const (
+	RuntimeTaskApplied RuntimeTaskAction = "applied"
+	RuntimeTaskPatched RuntimeTaskAction = "patched"
+)
+
+// RuntimeTaskResult is the result of applying one object or patch of a runtime task in a runtime
+type RuntimeTaskResult struct {
+	APIVersion string            `json:"apiVersion"`
+	Kind       string            `json:"kind"`
+	Namespace  string            `json:"namespace,omitempty"`
+	Name       string            `json:"name"`
+	Action     RuntimeTaskAction `json:"action"`
+	Error      string            `json:"error,omitempty"`
+}
+
 const (
 	// StateParam parameter used in list orchestrations / operations queries to filter by state
 	StateParam = "state"
@@ -49,6 +94,7 @@ const (
 	InProgress = "in progress"
 	Canceling  = "canceling"
 	Retrying   = "retrying" // to signal a retry sign before marking it to pending
+	Paused     = "paused"
 	Canceled   = "canceled"
 	Succeeded  = "succeeded"
 	Failed     = "failed"
@@ -90,12 +136,14 @@ type Type string
 const (
 	UpgradeKymaOrchestration    Type = "upgradeKyma"
 	UpgradeClusterOrchestration Type = "upgradeCluster"
+	RuntimeTaskOrchestration    Type = "runtimeTask"
 )
 
 type StrategyType string
 
 const (
 	ParallelStrategy StrategyType = "parallel"
+	CanaryStrategy   StrategyType = "canary"
 )
 
 type ScheduleType string
@@ -111,6 +159,17 @@ type ParallelStrategySpec struct {
 	Workers int `json:"workers"`
 }
 
+// CanaryStrategySpec defines parameters for the canary orchestration strategy
+type CanaryStrategySpec struct {
+	// Waves lists the sizes of consecutive waves, either as a number of runtimes (e.g. "1") or as a percentage of all runtimes (e.g. "10%").
+	// Runtimes not covered by the listed waves are processed in the last wave.
+	Waves []string `json:"waves"`
+	// SoakTime is the time to wait after a wave finished before the next one starts, e.g. "30m"
+	SoakTime string `json:"soakTime,omitempty"`
+	// FailureThreshold is the highest ratio (0-1) of failed operations in a wave which does not halt the orchestration
+	FailureThreshold float64 `json:"failureThreshold"`
+}
+
 // StrategySpec is the strategy part common for all orchestration trigger/status API
 type StrategySpec struct {
 	Type              StrategyType `json:"type"`
@@ -118,6 +177,7 @@ type StrategySpec struct {
 	ScheduleTime      time.Time
 	MaintenanceWindow bool                 `json:"maintenanceWindow,omitempty"`
 	Parallel          ParallelStrategySpec `json:"parallel,omitempty"`
+	Canary            CanaryStrategySpec   `json:"canary,omitempty"`
 }
 
 // TargetSpec is the targets part common for all orchestration trigger/status API
@@ -164,6 +224,7 @@ type OperationDetailResponse struct {
 
 	KymaConfig    *gqlschema.KymaConfigInput     `json:"kymaConfig,omitempty"`
 	ClusterConfig *gqlschema.GardenerConfigInput `json:"clusterConfig,omitempty"`
+	TaskResults   []RuntimeTaskResult            `json:"taskResults,omitempty"`
 }
 
 type StatusResponseList struct {
@@ -206,6 +267,40 @@ type MaintenancePolicy struct {
 	Default MaintenancePolicyEntry  `json:"default"`
 }
 
+// Freeze is a period in which orchestration operations must not start, e.g. end of quarter
+type Freeze struct {
+	ID string `json:"id" yaml:"id"`
+	// GlobalAccountID limits the freeze to runtimes of the given global account, empty value means all runtimes
+	GlobalAccountID string    `json:"globalAccountID,omitempty" yaml:"globalAccountID,omitempty"`
+	Start           time.Time `json:"start" yaml:"start"`
+	End             time.Time `json:"end" yaml:"end"`
+	Reason          string    `json:"reason,omitempty" yaml:"reason,omitempty"`
+	// Configured is true for freezes defined in the configuration file, which cannot be deleted with the API
+	Configured bool `json:"configured,omitempty" yaml:"-"`
+}
+
+// Covers returns true if the freeze applies to the runtime at the given time
+func (f Freeze) Covers(r Runtime, at time.Time) bool {
+	if f.GlobalAccountID != "" && f.GlobalAccountID != r.GlobalAccountID {
+		return false
+	}
+	return !at.Before(f.Start) && at.Before(f.End)
+}
+
+// PostponedDescription describes an operation postponed because of the freeze
+func (f Freeze) PostponedDescription(until time.Time) string {
+	description := fmt.Sprintf("Operation postponed until %s because of orchestration freeze %s", until.Format(time.RFC3339), f.ID)
+	if f.Reason != "" {
+		description += ": " + f.Reason
+	}
+	return description
+}
+
+type FreezeResponseList struct {
+	Data  []Freeze `json:"data"`
+	Count int      `json:"count"`
+}
+
 type stringBoolean bool
 
 func (sb *stringBoolean) UnmarshalJSON(data []byte) error {
@@ -44,6 +44,24 @@ type OperationExecutor interface {
 	Reschedule(operationID string, maintenanceWindowBegin, maintenanceWindowEnd time.Time) error
 }
 
+// OperationPostponer is implemented by executors which can store the reason why an operation was rescheduled.
+type OperationPostponer interface {
+	// Postpone reschedules the operation like OperationExecutor's Reschedule(...) and sets the description of the operation.
+	Postpone(operationID string, maintenanceWindowBegin, maintenanceWindowEnd time.Time, description string) error
+}
+
+// FreezeCalendar provides the periods in which orchestration operations must not start.
+type FreezeCalendar interface {
+	// Freeze returns the freeze which applies to the runtime at the given time, or nil if there is none.
+	Freeze(runtime Runtime, at time.Time) (*Freeze, error)
+}
+
+// OperationResultReader reads the result of operations performed by the OperationExecutor.
+type OperationResultReader interface {
+	// IsFailed returns true if the operation with the given ID has failed.
+	IsFailed(operationID string) (bool, error)
+}
+
 // Strategy interface encapsulates the strategy how the orchestration is performed.
 //
 //go:generate mockery --name=Strategy --output=automock --outpkg=automock --case=underscore
@@ -55,12 +73,38 @@ type Strategy interface {
 	Wait(executionID string)
 	// Cancel shutdowns a given execution.
 	Cancel(executionID string)
+	// Pause stops scheduling new operations of a given execution, operations already in progress are continued.
+	Pause(executionID string)
+	// Resume continues scheduling operations of a paused execution.
+	Resume(executionID string)
 	// Insert operations into the delaying queue of a given execution ID
 	Insert(execID string, operations []RuntimeOperation, strategySpec StrategySpec) error
 	// SpeedUp makes the retries speedFactor times faster, used for unit testing
 	SpeedUp(speedFactor int)
 }
 
+// HaltingStrategy is implemented by strategies which can stop an execution on their own before all operations are processed.
+type HaltingStrategy interface {
+	// Halted returns the reason why the execution with the given ID was halted, or an empty string if it was not.
+	// The reason of a finished execution may be returned only once.
+	Halted(executionID string) string
+}
+
+// WindowAfterFreeze returns the first maintenance window, based on the given one, which starts after the freeze ends.
+// If the runtime has no maintenance window, the end of the freeze is returned.
+func WindowAfterFreeze(begin, end time.Time, days []string, freeze Freeze) (time.Time, time.Time) {
+	if begin.IsZero() {
+		return freeze.End, freeze.End
+	}
+	availableDays := ConvertSliceOfDaysToMap(days)
+	for begin.Before(freeze.End) {
+		diff := NextAvailableDayDiff(begin.Weekday(), availableDays)
+		begin = begin.AddDate(0, 0, diff)
+		end = end.AddDate(0, 0, diff)
+	}
+	return begin, end
+}
+
 func ConvertSliceOfDaysToMap(days []string) map[time.Weekday]bool {
 	m := make(map[time.Weekday]bool)
 	for _, day := range days {
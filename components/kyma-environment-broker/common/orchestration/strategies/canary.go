@@ -0,0 +1,411 @@
+package strategies
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/sirupsen/logrus"
+)
+
+type CanaryOrchestrationStrategy struct {
+	parallel    orchestration.Strategy
+	results     orchestration.OperationResultReader
+	executions  map[string]*canaryExecution
+	mux         sync.RWMutex
+	log         logrus.FieldLogger
+	speedFactor int
+}
+
+type canaryExecution struct {
+	waves    [][]orchestration.RuntimeOperation // waves which were not started yet
+	waveNum  int                                // number of started waves
+	current  string                             // execution ID of the wave processed by the parallel strategy
+	finished bool
+	halted   string
+	paused   chan struct{} // closed when the paused execution is resumed
+	cancel   chan struct{}
+	done     chan struct{}
+}
+
+// NewCanaryOrchestrationStrategy returns a new canary orchestration strategy, which executes operations in consecutive waves.
+// Every wave is executed with the parallel strategy. After a wave is finished, the strategy waits for the soak time
+// and starts the next wave, unless the ratio of failed operations in the wave exceeded the failure threshold.
+func NewCanaryOrchestrationStrategy(executor orchestration.OperationExecutor, results orchestration.OperationResultReader, freezes orchestration.FreezeCalendar, log logrus.FieldLogger, rescheduleDelay time.Duration) orchestration.Strategy {
+	return &CanaryOrchestrationStrategy{
+		parallel:    NewParallelOrchestrationStrategy(executor, freezes, log, rescheduleDelay),
+		results:     results,
+		executions:  map[string]*canaryExecution{},
+		log:         log,
+		speedFactor: 1,
+	}
+}
+
+func (c *CanaryOrchestrationStrategy) SpeedUp(factor int) {
+	c.mux.Lock()
+	c.speedFactor = factor
+	c.mux.Unlock()
+	c.parallel.SpeedUp(factor)
+}
+
+// Execute splits the operations into waves and starts processing the first one.
+func (c *CanaryOrchestrationStrategy) Execute(operations []orchestration.RuntimeOperation, strategySpec orchestration.StrategySpec) (string, error) {
+	if len(operations) == 0 {
+		return "", nil
+	}
+
+	sizes, err := CanaryWaves(strategySpec.Canary, len(operations))
+	if err != nil {
+		return "", fmt.Errorf("while resolving canary waves: %w", err)
+	}
+	soakTime, err := canarySoakTime(strategySpec.Canary)
+	if err != nil {
+		return "", fmt.Errorf("while resolving canary soak time: %w", err)
+	}
+
+	exec := &canaryExecution{
+		cancel: make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+	start := 0
+	for _, size := range sizes {
+		exec.waves = append(exec.waves, operations[start:start+size])
+		start += size
+	}
+
+	execID := uuid.New().String()
+	c.mux.Lock()
+	c.executions[execID] = exec
+	c.mux.Unlock()
+
+	go c.run(execID, exec, strategySpec, soakTime)
+
+	return execID, nil
+}
+
+// Insert adds the operations as an additional wave of a given execution.
+func (c *CanaryOrchestrationStrategy) Insert(execID string, operations []orchestration.RuntimeOperation, strategySpec orchestration.StrategySpec) error {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	exec, exist := c.executions[execID]
+	if !exist {
+		return fmt.Errorf("no execution for the execution ID: %s", execID)
+	}
+	if exec.finished {
+		return fmt.Errorf("the execution ID %s is finished", execID)
+	}
+	if len(operations) > 0 {
+		exec.waves = append(exec.waves, operations)
+	}
+
+	return nil
+}
+
+func (c *CanaryOrchestrationStrategy) run(execID string, exec *canaryExecution, strategySpec orchestration.StrategySpec, soakTime time.Duration) {
+	log := c.log.WithField("canaryExecutionID", execID)
+	defer func() {
+		c.forget(execID, exec)
+		close(exec.done)
+	}()
+
+	for {
+		c.waitIfPaused(exec)
+		wave, waveNum, ok := c.nextWave(exec)
+		if !ok {
+			log.Infof("all waves are finished")
+			return
+		}
+
+		log.Infof("starting wave %d with %d operations", waveNum, len(wave))
+		waveID, err := c.parallel.Execute(wave, strategySpec)
+		if err != nil {
+			c.halt(exec, fmt.Sprintf("wave %d could not be started: %s", waveNum, err))
+			return
+		}
+		c.mux.Lock()
+		exec.current = waveID
+		canceled := isClosed(exec.cancel)
+		paused := exec.paused != nil
+		c.mux.Unlock()
+		if canceled {
+			c.parallel.Cancel(waveID)
+		}
+		if paused {
+			c.parallel.Pause(waveID)
+		}
+		c.parallel.Wait(waveID)
+
+		failed := c.countFailed(wave, log)
+		ratio := float64(failed) / float64(len(wave))
+		log.Infof("wave %d finished, %d of %d operations failed", waveNum, failed, len(wave))
+		if ratio > strategySpec.Canary.FailureThreshold {
+			c.halt(exec, fmt.Sprintf("halted after wave %d: %d of %d operations failed, which exceeds the failure threshold %.2f", waveNum, failed, len(wave), strategySpec.Canary.FailureThreshold))
+			log.Warnf("execution halted, %d of %d operations failed in wave %d", failed, len(wave), waveNum)
+			return
+		}
+
+		if !c.hasNextWave(exec) {
+			continue
+		}
+		log.Infof("soaking for %v before the next wave", soakTime)
+		c.mux.RLock()
+		speedFactor := c.speedFactor
+		c.mux.RUnlock()
+		select {
+		case <-exec.cancel:
+		case <-time.After(time.Duration(int64(soakTime) / int64(speedFactor))):
+		}
+	}
+}
+
+// nextWave returns the next wave to process, or false if the execution is finished or canceled.
+func (c *CanaryOrchestrationStrategy) nextWave(exec *canaryExecution) ([]orchestration.RuntimeOperation, int, bool) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	if len(exec.waves) == 0 || isClosed(exec.cancel) {
+		exec.finished = true
+		return nil, 0, false
+	}
+	wave := exec.waves[0]
+	exec.waves = exec.waves[1:]
+	exec.waveNum++
+
+	return wave, exec.waveNum, true
+}
+
+func (c *CanaryOrchestrationStrategy) hasNextWave(exec *canaryExecution) bool {
+	c.mux.RLock()
+	defer c.mux.RUnlock()
+
+	return len(exec.waves) > 0
+}
+
+func (c *CanaryOrchestrationStrategy) halt(exec *canaryExecution, reason string) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	exec.finished = true
+	exec.halted = reason
+}
+
+// forget removes the finished execution, unless it was halted. The halted execution is kept until the halt reason is read.
+func (c *CanaryOrchestrationStrategy) forget(execID string, exec *canaryExecution) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	if exec.halted == "" {
+		delete(c.executions, execID)
+	}
+}
+
+// countFailed returns the number of failed operations in a wave. Operations which result could not be read
+// are counted as failed, so the next wave is not started without knowing the outcome of the previous one.
+func (c *CanaryOrchestrationStrategy) countFailed(wave []orchestration.RuntimeOperation, log logrus.FieldLogger) int {
+	failed := 0
+	for _, op := range wave {
+		isFailed, err := c.results.IsFailed(op.ID)
+		if err != nil {
+			log.Errorf("while reading result of operation %s: %v", op.ID, err)
+			failed++
+			continue
+		}
+		if isFailed {
+			failed++
+		}
+	}
+	return failed
+}
+
+func (c *CanaryOrchestrationStrategy) Wait(executionID string) {
+	c.mux.RLock()
+	exec := c.executions[executionID]
+	c.mux.RUnlock()
+	if exec != nil {
+		<-exec.done
+	}
+}
+
+func (c *CanaryOrchestrationStrategy) Cancel(executionID string) {
+	if executionID == "" {
+		return
+	}
+	c.log.Infof("Cancelling strategy execution %s", executionID)
+
+	c.mux.Lock()
+	exec := c.executions[executionID]
+	if exec == nil {
+		c.mux.Unlock()
+		return
+	}
+	if !isClosed(exec.cancel) {
+		close(exec.cancel)
+	}
+	current := exec.current
+	c.mux.Unlock()
+
+	c.parallel.Cancel(current)
+}
+
+// Pause pauses the wave in progress and does not start next waves until the execution is resumed.
+func (c *CanaryOrchestrationStrategy) Pause(executionID string) {
+	if executionID == "" {
+		return
+	}
+	c.log.Infof("Pausing strategy execution %s", executionID)
+
+	c.mux.Lock()
+	exec := c.executions[executionID]
+	if exec == nil {
+		c.mux.Unlock()
+		return
+	}
+	if exec.paused == nil {
+		exec.paused = make(chan struct{})
+	}
+	current := exec.current
+	c.mux.Unlock()
+
+	c.parallel.Pause(current)
+}
+
+func (c *CanaryOrchestrationStrategy) Resume(executionID string) {
+	if executionID == "" {
+		return
+	}
+	c.log.Infof("Resuming strategy execution %s", executionID)
+
+	c.mux.Lock()
+	exec := c.executions[executionID]
+	if exec == nil {
+		c.mux.Unlock()
+		return
+	}
+	if exec.paused != nil {
+		close(exec.paused)
+		exec.paused = nil
+	}
+	current := exec.current
+	c.mux.Unlock()
+
+	c.parallel.Resume(current)
+}
+
+func (c *CanaryOrchestrationStrategy) waitIfPaused(exec *canaryExecution) {
+	c.mux.RLock()
+	resumed := exec.paused
+	c.mux.RUnlock()
+	if resumed == nil {
+		return
+	}
+	select {
+	case <-resumed:
+	case <-exec.cancel:
+	}
+}
+
+// Halted returns the reason why the execution was halted, or an empty string if it was not.
+// The halted execution is removed once its reason is returned.
+func (c *CanaryOrchestrationStrategy) Halted(executionID string) string {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	exec := c.executions[executionID]
+	if exec == nil {
+		return ""
+	}
+	if exec.halted != "" && isClosed(exec.done) {
+		delete(c.executions, executionID)
+	}
+	return exec.halted
+}
+
+func isClosed(ch chan struct{}) bool {
+	select {
+	case <-ch:
+		return true
+	default:
+		return false
+	}
+}
+
+// ValidateCanaryStrategySpec checks if the parameters of the canary strategy are valid.
+func ValidateCanaryStrategySpec(spec orchestration.CanaryStrategySpec) error {
+	if len(spec.Waves) == 0 {
+		return fmt.Errorf("canary strategy requires at least one wave")
+	}
+	for _, wave := range spec.Waves {
+		if _, _, err := parseWave(wave); err != nil {
+			return err
+		}
+	}
+	if _, err := canarySoakTime(spec); err != nil {
+		return err
+	}
+	if spec.FailureThreshold < 0 || spec.FailureThreshold > 1 {
+		return fmt.Errorf("canary failure threshold must be between 0 and 1, got %v", spec.FailureThreshold)
+	}
+	return nil
+}
+
+// CanaryWaves returns the sizes of consecutive waves for the given number of operations.
+// Operations not covered by the waves defined in the spec are added as the last wave.
+func CanaryWaves(spec orchestration.CanaryStrategySpec, total int) ([]int, error) {
+	var sizes []int
+	remaining := total
+	for _, wave := range spec.Waves {
+		if remaining == 0 {
+			break
+		}
+		value, percentage, err := parseWave(wave)
+		if err != nil {
+			return nil, err
+		}
+		size := int(value)
+		if percentage {
+			size = int(math.Ceil(float64(total) * value / 100))
+		}
+		if size > remaining {
+			size = remaining
+		}
+		sizes = append(sizes, size)
+		remaining -= size
+	}
+	if remaining > 0 {
+		sizes = append(sizes, remaining)
+	}
+	return sizes, nil
+}
+
+// parseWave parses a wave size given as a number of runtimes or as a percentage
+func parseWave(wave string) (float64, bool, error) {
+	if strings.HasSuffix(wave, "%") {
+		value, err := strconv.ParseFloat(strings.TrimSuffix(wave, "%"), 64)
+		if err != nil || value <= 0 || value > 100 {
+			return 0, true, fmt.Errorf("invalid canary wave %q: percentage must be greater than 0%% and not greater than 100%%", wave)
+		}
+		return value, true, nil
+	}
+	value, err := strconv.Atoi(wave)
+	if err != nil || value <= 0 {
+		return 0, false, fmt.Errorf("invalid canary wave %q: must be a positive number of runtimes or a percentage", wave)
+	}
+	return float64(value), false, nil
+}
+
+func canarySoakTime(spec orchestration.CanaryStrategySpec) (time.Duration, error) {
+	if spec.SoakTime == "" {
+		return 0, nil
+	}
+	soakTime, err := time.ParseDuration(spec.SoakTime)
+	if err != nil || soakTime < 0 {
+		return 0, fmt.Errorf("invalid canary soak time %q: must be a non-negative duration, e.g. 30m", spec.SoakTime)
+	}
+	return soakTime, nil
+}
@@ -0,0 +1,146 @@
+package strategies
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/rand"
+)
+
+type testResultReader struct {
+	mux    sync.Mutex
+	failed map[string]bool
+}
+
+func (t *testResultReader) IsFailed(operationID string) (bool, error) {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+	return t.failed[operationID], nil
+}
+
+type recordingExecutor struct {
+	mux      sync.Mutex
+	executed []string
+}
+
+func (r *recordingExecutor) Execute(opID string) (time.Duration, error) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	r.executed = append(r.executed, opID)
+	return 0, nil
+}
+
+func (r *recordingExecutor) Reschedule(operationID string, maintenanceWindowBegin, maintenanceWindowEnd time.Time) error {
+	return nil
+}
+
+func (r *recordingExecutor) executedIDs() []string {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	return append([]string{}, r.executed...)
+}
+
+func canaryOperations(n int) []orchestration.RuntimeOperation {
+	ops := make([]orchestration.RuntimeOperation, n)
+	for i := range ops {
+		ops[i] = orchestration.RuntimeOperation{
+			ID: rand.String(5),
+		}
+	}
+	return ops
+}
+
+func TestCanaryOrchestrationStrategy_AllWaves(t *testing.T) {
+	// given
+	executor := &recordingExecutor{}
+	s := NewCanaryOrchestrationStrategy(executor, &testResultReader{failed: map[string]bool{}}, nil, logrus.New(), 0)
+	ops := canaryOperations(5)
+
+	// when
+	id, err := s.Execute(ops, orchestration.StrategySpec{
+		Type:     orchestration.CanaryStrategy,
+		Schedule: time.Now().Format(time.RFC3339),
+		Parallel: orchestration.ParallelStrategySpec{Workers: 2},
+		Canary:   orchestration.CanaryStrategySpec{Waves: []string{"1", "50%"}, SoakTime: "10ms"},
+	})
+
+	// then
+	require.NoError(t, err)
+	s.Wait(id)
+	executed := executor.executedIDs()
+	assert.Len(t, executed, 5)
+	assert.Equal(t, ops[0].ID, executed[0])
+	assert.Empty(t, s.(orchestration.HaltingStrategy).Halted(id))
+	assert.Empty(t, s.(*CanaryOrchestrationStrategy).executions)
+}
+
+func TestCanaryOrchestrationStrategy_HaltsOnFailures(t *testing.T) {
+	// given
+	executor := &recordingExecutor{}
+	ops := canaryOperations(4)
+	results := &testResultReader{failed: map[string]bool{ops[0].ID: true}}
+	s := NewCanaryOrchestrationStrategy(executor, results, nil, logrus.New(), 0)
+
+	// when
+	id, err := s.Execute(ops, orchestration.StrategySpec{
+		Type:     orchestration.CanaryStrategy,
+		Schedule: time.Now().Format(time.RFC3339),
+		Parallel: orchestration.ParallelStrategySpec{Workers: 1},
+		Canary:   orchestration.CanaryStrategySpec{Waves: []string{"1"}, FailureThreshold: 0.5},
+	})
+
+	// then
+	require.NoError(t, err)
+	s.Wait(id)
+	assert.Equal(t, []string{ops[0].ID}, executor.executedIDs())
+	assert.Contains(t, s.(orchestration.HaltingStrategy).Halted(id), "halted after wave 1")
+	assert.Empty(t, s.(*CanaryOrchestrationStrategy).executions)
+}
+
+func TestCanaryWaves(t *testing.T) {
+	for tn, tc := range map[string]struct {
+		waves    []string
+		total    int
+		expected []int
+	}{
+		"numbers": {
+			waves:    []string{"1", "2"},
+			total:    10,
+			expected: []int{1, 2, 7},
+		},
+		"percentages are rounded up": {
+			waves:    []string{"10%", "25%"},
+			total:    15,
+			expected: []int{2, 4, 9},
+		},
+		"waves bigger than the number of operations": {
+			waves:    []string{"3", "100%"},
+			total:    2,
+			expected: []int{2},
+		},
+	} {
+		t.Run(tn, func(t *testing.T) {
+			// when
+			sizes, err := CanaryWaves(orchestration.CanaryStrategySpec{Waves: tc.waves}, tc.total)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, sizes)
+		})
+	}
+}
+
+func TestValidateCanaryStrategySpec(t *testing.T) {
+	assert.NoError(t, ValidateCanaryStrategySpec(orchestration.CanaryStrategySpec{Waves: []string{"1", "10%"}, SoakTime: "1h", FailureThreshold: 0.1}))
+	assert.Error(t, ValidateCanaryStrategySpec(orchestration.CanaryStrategySpec{}))
+	assert.Error(t, ValidateCanaryStrategySpec(orchestration.CanaryStrategySpec{Waves: []string{"0"}}))
+	assert.Error(t, ValidateCanaryStrategySpec(orchestration.CanaryStrategySpec{Waves: []string{"120%"}}))
+	assert.Error(t, ValidateCanaryStrategySpec(orchestration.CanaryStrategySpec{Waves: []string{"1"}, SoakTime: "soon"}))
+	assert.Error(t, ValidateCanaryStrategySpec(orchestration.CanaryStrategySpec{Waves: []string{"1"}, FailureThreshold: 2}))
+}
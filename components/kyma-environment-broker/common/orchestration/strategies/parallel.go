@@ -14,6 +14,7 @@ import (
 
 type ParallelOrchestrationStrategy struct {
 	executor        orchestration.OperationExecutor
+	freezes         orchestration.FreezeCalendar
 	dq              map[string]workqueue.DelayingInterface // scheduling queue, delaying queue for all pending & in progress ops
 	pq              map[string]workqueue.DelayingInterface // processing queue, delaying queue for the in progress ops
 	wg              map[string]*sync.WaitGroup
@@ -21,20 +22,24 @@ type ParallelOrchestrationStrategy struct {
 	log             logrus.FieldLogger
 	rescheduleDelay time.Duration
 	scheduleNum     map[string]int
+	paused          map[string]chan struct{} // closed when the paused execution is resumed
 	speedFactor     int
 }
 
 // NewParallelOrchestrationStrategy returns a new parallel orchestration strategy, which
 // executes operations in parallel using a pool of workers and a delaying queue to support time-based scheduling.
-func NewParallelOrchestrationStrategy(executor orchestration.OperationExecutor, log logrus.FieldLogger, rescheduleDelay time.Duration) orchestration.Strategy {
+// Operations are not started during freezes provided by the freeze calendar, which is optional.
+func NewParallelOrchestrationStrategy(executor orchestration.OperationExecutor, freezes orchestration.FreezeCalendar, log logrus.FieldLogger, rescheduleDelay time.Duration) orchestration.Strategy {
 	strategy := &ParallelOrchestrationStrategy{
 		executor:        executor,
+		freezes:         freezes,
 		dq:              map[string]workqueue.DelayingInterface{},
 		pq:              map[string]workqueue.DelayingInterface{},
 		wg:              map[string]*sync.WaitGroup{},
 		log:             log,
 		rescheduleDelay: rescheduleDelay,
 		scheduleNum:     map[string]int{},
+		paused:          map[string]chan struct{}{},
 		speedFactor:     1,
 	}
 
@@ -134,6 +139,9 @@ func (p *ParallelOrchestrationStrategy) scheduleOperationsLoop(execID string, st
 
 		op := item.(*orchestration.RuntimeOperation)
 
+		// do not schedule operations of a paused execution
+		p.waitIfPaused(execID)
+
 		// check the window before process for the case if op Get is not in time
 		duration, err := p.updateMaintenanceWindow(execID, op, strategy)
 		if err != nil {
@@ -233,7 +241,48 @@ func (p *ParallelOrchestrationStrategy) updateMaintenanceWindow(execID string, o
 		duration = time.Until(strategy.ScheduleTime)
 	}
 
-	return duration, nil
+	return p.postponeForFreeze(op, strategy, duration)
+}
+
+// postponeForFreeze reschedules the operation to the first window after the freeze, if the operation would start during one
+func (p *ParallelOrchestrationStrategy) postponeForFreeze(op *orchestration.RuntimeOperation, strategy orchestration.StrategySpec, duration time.Duration) (time.Duration, error) {
+	if p.freezes == nil {
+		return duration, nil
+	}
+
+	start := time.Now()
+	if duration > 0 {
+		start = start.Add(duration)
+	}
+	freeze, err := p.freezes.Freeze(op.Runtime, start)
+	if err != nil {
+		return duration, fmt.Errorf("while checking orchestration freezes: %w", err)
+	}
+	if freeze == nil {
+		return duration, nil
+	}
+
+	var begin, end time.Time
+	if strategy.MaintenanceWindow {
+		begin, end = orchestration.WindowAfterFreeze(op.MaintenanceWindowBegin, op.MaintenanceWindowEnd, op.MaintenanceDays, *freeze)
+		op.MaintenanceWindowBegin = begin
+		op.MaintenanceWindowEnd = end
+	} else {
+		begin, end = orchestration.WindowAfterFreeze(time.Time{}, time.Time{}, nil, *freeze)
+	}
+
+	description := freeze.PostponedDescription(begin)
+	if postponer, ok := p.executor.(orchestration.OperationPostponer); ok {
+		err = postponer.Postpone(op.ID, begin, end, description)
+	} else {
+		err = p.executor.Reschedule(op.ID, begin, end)
+	}
+	if err != nil {
+		return duration, fmt.Errorf("while postponing operation by executor: %w", err)
+	}
+	p.log.WithField("operationID", op.ID).Info(description)
+
+	return time.Until(begin), nil
 }
 
 func (p *ParallelOrchestrationStrategy) Wait(executionID string) {
@@ -263,6 +312,51 @@ func (p *ParallelOrchestrationStrategy) Cancel(executionID string) {
 	if pq != nil {
 		pq.ShutDown()
 	}
+
+	// release the workers waiting for the paused execution to be resumed
+	p.resume(executionID)
+}
+
+// Pause stops scheduling new operations of the given execution. The workers wait with the operations taken from
+// the scheduling queue until the execution is resumed or canceled.
+func (p *ParallelOrchestrationStrategy) Pause(executionID string) {
+	if executionID == "" {
+		return
+	}
+	p.log.Infof("Pausing strategy execution %s", executionID)
+
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	if _, paused := p.paused[executionID]; !paused {
+		p.paused[executionID] = make(chan struct{})
+	}
+}
+
+func (p *ParallelOrchestrationStrategy) Resume(executionID string) {
+	if executionID == "" {
+		return
+	}
+	p.log.Infof("Resuming strategy execution %s", executionID)
+
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	p.resume(executionID)
+}
+
+func (p *ParallelOrchestrationStrategy) resume(executionID string) {
+	if resumed, paused := p.paused[executionID]; paused {
+		close(resumed)
+		delete(p.paused, executionID)
+	}
+}
+
+func (p *ParallelOrchestrationStrategy) waitIfPaused(execID string) {
+	p.mux.RLock()
+	resumed := p.paused[execID]
+	p.mux.RUnlock()
+	if resumed != nil {
+		<-resumed
+	}
 }
 
 func (p *ParallelOrchestrationStrategy) handleRescheduleErrorOperation(execID string, op *orchestration.RuntimeOperation) {
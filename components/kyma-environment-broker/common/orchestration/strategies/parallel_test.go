@@ -9,6 +9,7 @@ import (
 
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/util/rand"
 )
 
@@ -38,7 +39,7 @@ func (t *testExecutor) Reschedule(operationID string, maintenanceWindowBegin, ma
 func TestNewParallelOrchestrationStrategy_Immediate(t *testing.T) {
 	// given
 	executor := &testExecutor{opCalled: map[string]bool{}}
-	s := NewParallelOrchestrationStrategy(executor, logrus.New(), 0)
+	s := NewParallelOrchestrationStrategy(executor, nil, logrus.New(), 0)
 
 	ops := make([]orchestration.RuntimeOperation, 3)
 	for i := range ops {
@@ -58,7 +59,7 @@ func TestNewParallelOrchestrationStrategy_Immediate(t *testing.T) {
 func TestNewParallelOrchestrationStrategy_MaintenanceWindow(t *testing.T) {
 	// given
 	executor := &testExecutor{opCalled: map[string]bool{}}
-	s := NewParallelOrchestrationStrategy(executor, logrus.New(), 0)
+	s := NewParallelOrchestrationStrategy(executor, nil, logrus.New(), 0)
 
 	start := time.Now().Add(3 * time.Second)
 
@@ -84,7 +85,7 @@ func TestNewParallelOrchestrationStrategy_MaintenanceWindow(t *testing.T) {
 func TestNewParallelOrchestrationStrategy_Reschedule(t *testing.T) {
 	// given
 	executor := &testExecutor{opCalled: map[string]bool{}}
-	s := NewParallelOrchestrationStrategy(executor, logrus.New(), 5*time.Second)
+	s := NewParallelOrchestrationStrategy(executor, nil, logrus.New(), 5*time.Second)
 
 	start := time.Now().Add(-5 * time.Second)
 
@@ -106,3 +107,83 @@ func TestNewParallelOrchestrationStrategy_Reschedule(t *testing.T) {
 	assert.NoError(t, err)
 	s.Wait(id)
 }
+
+func TestNewParallelOrchestrationStrategy_PauseResume(t *testing.T) {
+	// given
+	executor := &recordingExecutor{}
+	s := NewParallelOrchestrationStrategy(executor, nil, logrus.New(), 0)
+
+	ops := make([]orchestration.RuntimeOperation, 3)
+	for i := range ops {
+		ops[i] = orchestration.RuntimeOperation{
+			ID: rand.String(5),
+		}
+	}
+
+	// when
+	id, err := s.Execute(ops, orchestration.StrategySpec{ScheduleTime: time.Now().Add(time.Second), Parallel: orchestration.ParallelStrategySpec{Workers: 2}})
+	require.NoError(t, err)
+	s.Pause(id)
+	time.Sleep(2 * time.Second)
+
+	// then
+	assert.Empty(t, executor.executedIDs())
+
+	// when
+	s.Resume(id)
+	s.Wait(id)
+
+	// then
+	assert.Len(t, executor.executedIDs(), 3)
+}
+
+type testFreezeCalendar struct {
+	freeze orchestration.Freeze
+}
+
+func (t *testFreezeCalendar) Freeze(runtime orchestration.Runtime, at time.Time) (*orchestration.Freeze, error) {
+	if !t.freeze.Covers(runtime, at) {
+		return nil, nil
+	}
+	return &t.freeze, nil
+}
+
+type postponingExecutor struct {
+	recordingExecutor
+	descriptions map[string]string
+}
+
+func (p *postponingExecutor) Postpone(operationID string, maintenanceWindowBegin, maintenanceWindowEnd time.Time, description string) error {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	p.descriptions[operationID] = description
+	return nil
+}
+
+func TestNewParallelOrchestrationStrategy_Freeze(t *testing.T) {
+	// given
+	executor := &postponingExecutor{descriptions: map[string]string{}}
+	freezes := &testFreezeCalendar{freeze: orchestration.Freeze{
+		ID:              "freeze-id",
+		GlobalAccountID: "frozen-ga",
+		Start:           time.Now().Add(-time.Hour),
+		End:             time.Now().Add(time.Second),
+		Reason:          "end of quarter",
+	}}
+	s := NewParallelOrchestrationStrategy(executor, freezes, logrus.New(), 0)
+
+	ops := []orchestration.RuntimeOperation{
+		{ID: "frozen", Runtime: orchestration.Runtime{GlobalAccountID: "frozen-ga"}},
+		{ID: "not-frozen", Runtime: orchestration.Runtime{GlobalAccountID: "other-ga"}},
+	}
+
+	// when
+	id, err := s.Execute(ops, orchestration.StrategySpec{Schedule: time.Now().Format(time.RFC3339), Parallel: orchestration.ParallelStrategySpec{Workers: 2}})
+	require.NoError(t, err)
+	s.Wait(id)
+
+	// then
+	assert.Equal(t, []string{"not-frozen", "frozen"}, executor.executedIDs())
+	assert.Len(t, executor.descriptions, 1)
+	assert.Contains(t, executor.descriptions["frozen"], "because of orchestration freeze freeze-id: end of quarter")
+}
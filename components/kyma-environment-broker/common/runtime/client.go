@@ -17,6 +17,7 @@ const defaultPageSize = 100
 // Client is the interface to interact with the KEB /runtimes API as an HTTP client using OIDC ID token in JWT format.
 type Client interface {
 	ListRuntimes(params ListParameters) (RuntimesPage, error)
+	ListOperationSteps(operationID string) (OperationSteps, error)
 }
 
 type client struct {
@@ -96,6 +97,39 @@ func (c *client) ListRuntimes(params ListParameters) (RuntimesPage, error) {
 	return runtimes, nil
 }
 
+// ListOperationSteps fetches the execution records of steps of the given operation
+func (c *client) ListOperationSteps(operationID string) (OperationSteps, error) {
+	steps := OperationSteps{}
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/operations/%s/steps", c.url, url.PathEscape(operationID)), nil)
+	if err != nil {
+		return steps, fmt.Errorf("while creating request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return steps, fmt.Errorf("while calling %s: %w", req.URL.String(), err)
+	}
+	defer func() {
+		if derr := drainResponseBody(resp.Body); derr != nil && err == nil {
+			err = derr
+		}
+		if cerr := resp.Body.Close(); cerr != nil && err == nil {
+			err = cerr
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return steps, fmt.Errorf("calling %s returned %d (%s) status", req.URL.String(), resp.StatusCode, resp.Status)
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(&steps)
+	if err != nil {
+		return steps, fmt.Errorf("while decoding response body: %w", err)
+	}
+
+	return steps, nil
+}
+
 func setQuery(url *url.URL, params ListParameters) {
 	query := url.Query()
 	query.Add(pagination.PageParam, strconv.Itoa(params.Page))
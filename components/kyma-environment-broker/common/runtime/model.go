@@ -100,6 +100,30 @@ type Operation struct {
 	FinishedStages               []string      `json:"finishedStages"`
 	ExecutedButNotCompletedSteps []string      `json:"executedButNotCompletedSteps,omitempty"`
 	RuntimeVersion               string        `json:"runtimeVersion"`
+	NextRetryAt                  *time.Time    `json:"nextRetryAt,omitempty"`
+}
+
+// OperationStep is the execution record of a single step of an operation, durations are given in milliseconds
+type OperationStep struct {
+	StepName         string    `json:"stepName"`
+	State            string    `json:"state"`
+	Attempts         int       `json:"attempts"`
+	LastError        string    `json:"lastError,omitempty"`
+	StartedAt        time.Time `json:"startedAt"`
+	FinishedAt       time.Time `json:"finishedAt"`
+	DurationMs       int64     `json:"durationMs"`
+	ProcessingTimeMs int64     `json:"processingTimeMs"`
+	NextDelayMs      int64     `json:"nextDelayMs,omitempty"`
+}
+
+type OperationSteps struct {
+	OperationID string          `json:"operationID"`
+	Type        OperationType   `json:"type,omitempty"`
+	State       string          `json:"state"`
+	CreatedAt   time.Time       `json:"createdAt"`
+	UpdatedAt   time.Time       `json:"updatedAt"`
+	Data        []OperationStep `json:"data"`
+	Count       int             `json:"count"`
 }
 
 type RuntimesPage struct {
@@ -0,0 +1,112 @@
+package accountpool
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/hyperscaler"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/httputil"
+	"github.com/sirupsen/logrus"
+)
+
+type Handler struct {
+	inventory hyperscaler.Inventory
+	log       logrus.FieldLogger
+}
+
+func NewHandler(inventory hyperscaler.Inventory, log logrus.FieldLogger) *Handler {
+	return &Handler{
+		inventory: inventory,
+		log:       log,
+	}
+}
+
+func (h *Handler) AttachRoutes(router *mux.Router) {
+	router.HandleFunc("/accounts", h.listAccounts).Methods(http.MethodGet)
+	router.HandleFunc("/accounts/capacity", h.capacity).Methods(http.MethodGet)
+	router.HandleFunc("/accounts/{name}/release", h.releaseAccount).Methods(http.MethodPost)
+	router.HandleFunc("/accounts/{name}/quarantine", h.quarantineAccount).Methods(http.MethodPost)
+}
+
+func (h *Handler) listAccounts(w http.ResponseWriter, r *http.Request) {
+	filter, err := filterFromQuery(r)
+	if err != nil {
+		httputil.WriteErrorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	accounts, err := h.inventory.ListAccounts(filter)
+	if err != nil {
+		httputil.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Errorf("while listing accounts: %w", err))
+		return
+	}
+	httputil.WriteResponse(w, http.StatusOK, accounts)
+}
+
+func (h *Handler) capacity(w http.ResponseWriter, _ *http.Request) {
+	capacity, err := h.inventory.Capacity()
+	if err != nil {
+		httputil.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Errorf("while counting accounts: %w", err))
+		return
+	}
+	httputil.WriteResponse(w, http.StatusOK, capacity)
+}
+
+func (h *Handler) releaseAccount(w http.ResponseWriter, r *http.Request) {
+	name := mux.Vars(r)["name"]
+	account, err := h.inventory.ReleaseAccount(name)
+	if err != nil {
+		h.writeUpdateError(w, err)
+		return
+	}
+	h.log.Infof("secret binding %s released to the account pool", name)
+	httputil.WriteResponse(w, http.StatusOK, account)
+}
+
+func (h *Handler) quarantineAccount(w http.ResponseWriter, r *http.Request) {
+	name := mux.Vars(r)["name"]
+	account, err := h.inventory.QuarantineAccount(name)
+	if err != nil {
+		h.writeUpdateError(w, err)
+		return
+	}
+	h.log.Infof("secret binding %s quarantined", name)
+	httputil.WriteResponse(w, http.StatusOK, account)
+}
+
+func (h *Handler) writeUpdateError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, hyperscaler.ErrAccountNotFound):
+		httputil.WriteErrorResponse(w, http.StatusNotFound, err)
+	case errors.Is(err, hyperscaler.ErrAccountInUse):
+		httputil.WriteErrorResponse(w, http.StatusConflict, err)
+	default:
+		httputil.WriteErrorResponse(w, http.StatusInternalServerError, err)
+	}
+}
+
+func filterFromQuery(r *http.Request) (hyperscaler.AccountFilter, error) {
+	query := r.URL.Query()
+	filter := hyperscaler.AccountFilter{
+		HyperscalerType: query.Get(hyperscaler.HyperscalerTypeParam),
+		State:           hyperscaler.AccountState(query.Get(hyperscaler.StateParam)),
+		TenantName:      query.Get(hyperscaler.TenantNameParam),
+	}
+	switch filter.State {
+	case "", hyperscaler.AccountStateFree, hyperscaler.AccountStateAssigned, hyperscaler.AccountStateDirty,
+		hyperscaler.AccountStateShared, hyperscaler.AccountStateQuarantined:
+	default:
+		return filter, fmt.Errorf("invalid value for %s: %s", hyperscaler.StateParam, filter.State)
+	}
+	if value := query.Get(hyperscaler.EuAccessParam); value != "" {
+		euAccess, err := strconv.ParseBool(value)
+		if err != nil {
+			return filter, fmt.Errorf("invalid value for %s: %s", hyperscaler.EuAccessParam, value)
+		}
+		filter.EuAccess = &euAccess
+	}
+	return filter, nil
+}
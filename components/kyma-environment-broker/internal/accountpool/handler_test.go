@@ -0,0 +1,135 @@
+package accountpool
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/gardener"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/hyperscaler"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const testNamespace = "garden-test"
+
+func TestHandler_ListAccounts(t *testing.T) {
+	// given
+	router := fixRouter()
+
+	// when
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/accounts?hyperscaler_type=gcp&state=free", nil))
+
+	// then
+	require.Equal(t, http.StatusOK, rec.Code)
+	var accounts []hyperscaler.AccountDTO
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &accounts))
+	require.Len(t, accounts, 1)
+	assert.Equal(t, "sb-free", accounts[0].Name)
+}
+
+func TestHandler_ListAccountsWithInvalidFilter(t *testing.T) {
+	// given
+	router := fixRouter()
+
+	for _, query := range []string{"state=unknown", "eu_access=maybe"} {
+		// when
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/accounts?"+query, nil))
+
+		// then
+		assert.Equal(t, http.StatusBadRequest, rec.Code, query)
+	}
+}
+
+func TestHandler_Capacity(t *testing.T) {
+	// given
+	router := fixRouter()
+
+	// when
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/accounts/capacity", nil))
+
+	// then
+	require.Equal(t, http.StatusOK, rec.Code)
+	var capacity []hyperscaler.PoolCapacityDTO
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &capacity))
+	assert.Equal(t, []hyperscaler.PoolCapacityDTO{{HyperscalerType: "gcp", Total: 2, Free: 1, Assigned: 1}}, capacity)
+}
+
+func TestHandler_ReleaseAndQuarantine(t *testing.T) {
+	for name, tc := range map[string]struct {
+		path         string
+		expectedCode int
+	}{
+		"quarantine free account":    {path: "/accounts/sb-free/quarantine", expectedCode: http.StatusOK},
+		"release unused account":     {path: "/accounts/sb-free/release", expectedCode: http.StatusOK},
+		"release used account":       {path: "/accounts/sb-assigned/release", expectedCode: http.StatusConflict},
+		"release missing account":    {path: "/accounts/sb-missing/release", expectedCode: http.StatusNotFound},
+		"quarantine missing account": {path: "/accounts/sb-missing/quarantine", expectedCode: http.StatusNotFound},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// given
+			router := fixRouter()
+
+			// when
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, tc.path, nil))
+
+			// then
+			assert.Equal(t, tc.expectedCode, rec.Code)
+		})
+	}
+}
+
+func fixRouter() *mux.Router {
+	client := gardener.NewDynamicFakeClient(
+		fixSecretBinding("sb-assigned", map[string]interface{}{"hyperscalerType": "gcp", "tenantName": "tenant1"}),
+		fixSecretBinding("sb-free", map[string]interface{}{"hyperscalerType": "gcp"}),
+		fixShoot("shoot1", "sb-assigned"),
+	)
+	router := mux.NewRouter()
+	NewHandler(hyperscaler.NewInventory(client, testNamespace), logrus.New()).AttachRoutes(router)
+	return router
+}
+
+func fixSecretBinding(name string, labels map[string]interface{}) runtime.Object {
+	sb := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"name":      name,
+				"namespace": testNamespace,
+				"labels":    labels,
+			},
+			"secretRef": map[string]interface{}{
+				"name":      name,
+				"namespace": testNamespace,
+			},
+		},
+	}
+	sb.SetGroupVersionKind(schema.GroupVersionKind{Group: "core.gardener.cloud", Version: "v1beta1", Kind: "SecretBinding"})
+	return sb
+}
+
+func fixShoot(name, secretBindingName string) runtime.Object {
+	shoot := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"name":      name,
+				"namespace": testNamespace,
+			},
+			"spec": map[string]interface{}{
+				"secretBindingName": secretBindingName,
+			},
+		},
+	}
+	shoot.SetGroupVersionKind(schema.GroupVersionKind{Group: "core.gardener.cloud", Version: "v1beta1", Kind: "Shoot"})
+	return shoot
+}
@@ -0,0 +1,96 @@
+package binding
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	retryInterval = 10 * time.Second
+	// operationTimeout is the time after which a bind or unbind operation which keeps failing is marked as failed
+	operationTimeout = 10 * time.Minute
+)
+
+// Executor processes asynchronous bind and unbind operations, it is used as the executor of the bindings queue
+type Executor struct {
+	bindings storage.Bindings
+	manager  broker.BindingManager
+	log      logrus.FieldLogger
+}
+
+func NewExecutor(bindings storage.Bindings, manager broker.BindingManager, log logrus.FieldLogger) *Executor {
+	return &Executor{
+		bindings: bindings,
+		manager:  manager,
+		log:      log,
+	}
+}
+
+func (e *Executor) Execute(bindingID string) (time.Duration, error) {
+	log := e.log.WithField("bindingID", bindingID)
+
+	binding, err := e.bindings.GetByID(bindingID)
+	switch {
+	case dberr.IsNotFound(err):
+		return 0, nil
+	case err != nil:
+		log.Errorf("unable to get binding, retrying: %s", err)
+		return retryInterval, nil
+	}
+	if binding.State != domain.InProgress {
+		return 0, nil
+	}
+
+	switch binding.LastOperation {
+	case internal.BindingOperationBind:
+		issued, err := e.manager.Issue(*binding)
+		if err != nil {
+			return e.retry(*binding, err, log)
+		}
+		issued.State = domain.Succeeded
+		issued.Description = ""
+		issued.UpdatedAt = time.Now()
+		if err := e.bindings.Update(issued); err != nil {
+			log.Errorf("unable to update binding, retrying: %s", err)
+			return retryInterval, nil
+		}
+		log.Infof("binding created, the credentials expire at %s", issued.ExpiresAt)
+	case internal.BindingOperationUnbind:
+		if err := e.manager.Revoke(*binding); err != nil {
+			return e.retry(*binding, err, log)
+		}
+		if err := e.bindings.Delete(binding.ID); err != nil {
+			log.Errorf("unable to delete binding, retrying: %s", err)
+			return retryInterval, nil
+		}
+		log.Info("binding deleted")
+	default:
+		return 0, fmt.Errorf("unknown binding operation %q", binding.LastOperation)
+	}
+
+	return 0, nil
+}
+
+func (e *Executor) retry(binding internal.Binding, err error, log logrus.FieldLogger) (time.Duration, error) {
+	if time.Since(binding.UpdatedAt) < operationTimeout {
+		log.Warnf("%s operation failed, retrying in %s: %s", binding.LastOperation, retryInterval, err)
+		return retryInterval, nil
+	}
+
+	log.Errorf("%s operation failed after %s of retries: %s", binding.LastOperation, operationTimeout, err)
+	binding.State = domain.Failed
+	binding.Description = fmt.Sprintf("%s operation failed: %s", binding.LastOperation, err)
+	binding.UpdatedAt = time.Now()
+	if err := e.bindings.Update(binding); err != nil {
+		log.Errorf("unable to update binding, retrying: %s", err)
+		return retryInterval, nil
+	}
+	return 0, nil
+}
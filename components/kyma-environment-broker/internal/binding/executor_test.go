@@ -0,0 +1,122 @@
+package binding
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecutor_Execute(t *testing.T) {
+	t.Run("should issue credentials of binding in progress", func(t *testing.T) {
+		// given
+		bindings := storage.NewMemoryStorage().Bindings()
+		require.NoError(t, bindings.Insert(fixBinding(internal.BindingOperationBind, time.Now())))
+		executor := NewExecutor(bindings, &fakeManager{}, logrus.New())
+
+		// when
+		when, err := executor.Execute(bindingID)
+
+		// then
+		require.NoError(t, err)
+		assert.Zero(t, when)
+		binding, err := bindings.GetByID(bindingID)
+		require.NoError(t, err)
+		assert.Equal(t, domain.Succeeded, binding.State)
+		assert.Equal(t, "issued-kubeconfig", binding.Kubeconfig)
+	})
+
+	t.Run("should revoke credentials and delete binding", func(t *testing.T) {
+		// given
+		bindings := storage.NewMemoryStorage().Bindings()
+		require.NoError(t, bindings.Insert(fixBinding(internal.BindingOperationUnbind, time.Now())))
+		manager := &fakeManager{}
+		executor := NewExecutor(bindings, manager, logrus.New())
+
+		// when
+		when, err := executor.Execute(bindingID)
+
+		// then
+		require.NoError(t, err)
+		assert.Zero(t, when)
+		assert.Equal(t, []string{bindingID}, manager.revoked)
+		_, err = bindings.GetByID(bindingID)
+		assert.True(t, dberr.IsNotFound(err))
+	})
+
+	t.Run("should retry failing operation", func(t *testing.T) {
+		// given
+		bindings := storage.NewMemoryStorage().Bindings()
+		require.NoError(t, bindings.Insert(fixBinding(internal.BindingOperationBind, time.Now())))
+		executor := NewExecutor(bindings, &fakeManager{err: fmt.Errorf("runtime not reachable")}, logrus.New())
+
+		// when
+		when, err := executor.Execute(bindingID)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, retryInterval, when)
+		binding, err := bindings.GetByID(bindingID)
+		require.NoError(t, err)
+		assert.Equal(t, domain.InProgress, binding.State)
+	})
+
+	t.Run("should fail operation after timeout", func(t *testing.T) {
+		// given
+		bindings := storage.NewMemoryStorage().Bindings()
+		require.NoError(t, bindings.Insert(fixBinding(internal.BindingOperationUnbind, time.Now().Add(-operationTimeout))))
+		executor := NewExecutor(bindings, &fakeManager{err: fmt.Errorf("runtime not reachable")}, logrus.New())
+
+		// when
+		when, err := executor.Execute(bindingID)
+
+		// then
+		require.NoError(t, err)
+		assert.Zero(t, when)
+		binding, err := bindings.GetByID(bindingID)
+		require.NoError(t, err)
+		assert.Equal(t, domain.Failed, binding.State)
+		assert.Equal(t, "unbind operation failed: runtime not reachable", binding.Description)
+	})
+}
+
+func fixBinding(operation string, updatedAt time.Time) internal.Binding {
+	return internal.Binding{
+		ID:                bindingID,
+		InstanceID:        instanceID,
+		ExpirationSeconds: 600,
+		LastOperation:     operation,
+		State:             domain.InProgress,
+		CreatedAt:         updatedAt,
+		UpdatedAt:         updatedAt,
+	}
+}
+
+type fakeManager struct {
+	err     error
+	revoked []string
+}
+
+func (m *fakeManager) Issue(binding internal.Binding) (internal.Binding, error) {
+	if m.err != nil {
+		return binding, m.err
+	}
+	binding.Kubeconfig = "issued-kubeconfig"
+	binding.ExpiresAt = time.Now().Add(time.Duration(binding.ExpirationSeconds) * time.Second)
+	return binding, nil
+}
+
+func (m *fakeManager) Revoke(binding internal.Binding) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.revoked = append(m.revoked, binding.ID)
+	return nil
+}
@@ -0,0 +1,144 @@
+package binding
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	bindingIDLabel  = "kyma-project.io/binding-id"
+	instanceIDLabel = "kyma-project.io/instance-id"
+)
+
+// KubeconfigBuilder builds kubeconfigs of runtimes
+type KubeconfigBuilder interface {
+	AdminKubeconfig(instance *internal.Instance) (string, error)
+	BuildForToken(adminKubeconfig, token string) (string, error)
+}
+
+// ClientProvider creates the client of a runtime from its kubeconfig
+type ClientProvider func(kubeconfig string) (kubernetes.Interface, error)
+
+// Manager issues and revokes the credentials of service bindings. Every binding gets its own service account
+// on the runtime, the credentials are a kubeconfig with a token of the service account.
+type Manager struct {
+	cfg            broker.BindingConfig
+	instances      storage.Instances
+	kubeconfigs    KubeconfigBuilder
+	clientProvider ClientProvider
+}
+
+func NewManager(cfg broker.BindingConfig, instances storage.Instances, kubeconfigs KubeconfigBuilder, clientProvider ClientProvider) *Manager {
+	return &Manager{
+		cfg:            cfg,
+		instances:      instances,
+		kubeconfigs:    kubeconfigs,
+		clientProvider: clientProvider,
+	}
+}
+
+// Issue creates the service account of the binding on the runtime and returns the binding with a kubeconfig
+// containing a new token of the service account, valid for the expiration seconds of the binding
+func (m *Manager) Issue(binding internal.Binding) (internal.Binding, error) {
+	instance, err := m.instances.GetByID(binding.InstanceID)
+	if err != nil {
+		return binding, fmt.Errorf("while getting instance %s: %w", binding.InstanceID, err)
+	}
+	adminKubeconfig, err := m.kubeconfigs.AdminKubeconfig(instance)
+	if err != nil {
+		return binding, fmt.Errorf("while getting admin kubeconfig: %w", err)
+	}
+	cli, err := m.clientProvider(adminKubeconfig)
+	if err != nil {
+		return binding, fmt.Errorf("while creating runtime client: %w", err)
+	}
+
+	ctx := context.Background()
+	name := binding.ServiceAccountName()
+	labels := map[string]string{
+		bindingIDLabel:  binding.ID,
+		instanceIDLabel: binding.InstanceID,
+	}
+	_, err = cli.CoreV1().ServiceAccounts(m.cfg.Namespace).Create(ctx, &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: m.cfg.Namespace, Labels: labels},
+	}, metav1.CreateOptions{})
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		return binding, fmt.Errorf("while creating service account %s: %w", name, err)
+	}
+	_, err = cli.RbacV1().ClusterRoleBindings().Create(ctx, &rbacv1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "ClusterRole",
+			Name:     m.cfg.ClusterRole,
+		},
+		Subjects: []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Name: name, Namespace: m.cfg.Namespace}},
+	}, metav1.CreateOptions{})
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		return binding, fmt.Errorf("while creating cluster role binding %s: %w", name, err)
+	}
+
+	expirationSeconds := binding.ExpirationSeconds
+	token, err := cli.CoreV1().ServiceAccounts(m.cfg.Namespace).CreateToken(ctx, name, &authenticationv1.TokenRequest{
+		Spec: authenticationv1.TokenRequestSpec{ExpirationSeconds: &expirationSeconds},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return binding, fmt.Errorf("while requesting token of service account %s: %w", name, err)
+	}
+
+	kubeconfig, err := m.kubeconfigs.BuildForToken(adminKubeconfig, token.Status.Token)
+	if err != nil {
+		return binding, fmt.Errorf("while building kubeconfig: %w", err)
+	}
+	binding.Kubeconfig = kubeconfig
+	binding.ExpiresAt = token.Status.ExpirationTimestamp.Time
+
+	return binding, nil
+}
+
+// Revoke deletes the service account of the binding from the runtime, which invalidates all tokens issued for it.
+// Nothing is done when the instance or its runtime does not exist anymore.
+func (m *Manager) Revoke(binding internal.Binding) error {
+	instance, err := m.instances.GetByID(binding.InstanceID)
+	switch {
+	case dberr.IsNotFound(err):
+		return nil
+	case err != nil:
+		return fmt.Errorf("while getting instance %s: %w", binding.InstanceID, err)
+	}
+	if instance.RuntimeID == "" {
+		return nil
+	}
+	adminKubeconfig, err := m.kubeconfigs.AdminKubeconfig(instance)
+	if err != nil {
+		return fmt.Errorf("while getting admin kubeconfig: %w", err)
+	}
+	cli, err := m.clientProvider(adminKubeconfig)
+	if err != nil {
+		return fmt.Errorf("while creating runtime client: %w", err)
+	}
+
+	ctx := context.Background()
+	name := binding.ServiceAccountName()
+	err = cli.RbacV1().ClusterRoleBindings().Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("while deleting cluster role binding %s: %w", name, err)
+	}
+	err = cli.CoreV1().ServiceAccounts(m.cfg.Namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("while deleting service account %s: %w", name, err)
+	}
+
+	return nil
+}
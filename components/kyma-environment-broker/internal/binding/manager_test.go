@@ -0,0 +1,146 @@
+package binding
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+const (
+	instanceID = "instance-id"
+	runtimeID  = "runtime-id"
+	bindingID  = "binding-id"
+	namespace  = "kyma-system"
+)
+
+func TestManager_Issue(t *testing.T) {
+	// given
+	expiresAt := time.Now().Add(10 * time.Minute).Truncate(time.Second)
+	cli := fake.NewSimpleClientset()
+	cli.PrependReactor("create", "serviceaccounts", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() != "token" {
+			return false, nil, nil
+		}
+		request := action.(k8stesting.CreateAction).GetObject().(*authenticationv1.TokenRequest)
+		assert.Equal(t, int64(900), *request.Spec.ExpirationSeconds)
+		return true, &authenticationv1.TokenRequest{Status: authenticationv1.TokenRequestStatus{
+			Token:               "token",
+			ExpirationTimestamp: metav1.NewTime(expiresAt),
+		}}, nil
+	})
+	manager := fixManager(t, cli)
+
+	// when
+	binding, err := manager.Issue(internal.Binding{ID: bindingID, InstanceID: instanceID, ExpirationSeconds: 900})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "admin-kubeconfig-with-token", binding.Kubeconfig)
+	assert.Equal(t, expiresAt, binding.ExpiresAt)
+
+	sa, err := cli.CoreV1().ServiceAccounts(namespace).Get(context.Background(), "kyma-binding-binding-id", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, bindingID, sa.Labels[bindingIDLabel])
+	assert.Equal(t, instanceID, sa.Labels[instanceIDLabel])
+
+	crb, err := cli.RbacV1().ClusterRoleBindings().Get(context.Background(), "kyma-binding-binding-id", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "cluster-admin", crb.RoleRef.Name)
+	require.Len(t, crb.Subjects, 1)
+	assert.Equal(t, "kyma-binding-binding-id", crb.Subjects[0].Name)
+	assert.Equal(t, namespace, crb.Subjects[0].Namespace)
+}
+
+func TestManager_Revoke(t *testing.T) {
+	t.Run("should delete service account and cluster role binding", func(t *testing.T) {
+		// given
+		cli := fake.NewSimpleClientset()
+		cli.PrependReactor("create", "serviceaccounts", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			if action.GetSubresource() != "token" {
+				return false, nil, nil
+			}
+			return true, &authenticationv1.TokenRequest{Status: authenticationv1.TokenRequestStatus{Token: "token"}}, nil
+		})
+		manager := fixManager(t, cli)
+		binding, err := manager.Issue(internal.Binding{ID: bindingID, InstanceID: instanceID, ExpirationSeconds: 600})
+		require.NoError(t, err)
+
+		// when
+		err = manager.Revoke(binding)
+
+		// then
+		require.NoError(t, err)
+		sas, err := cli.CoreV1().ServiceAccounts(namespace).List(context.Background(), metav1.ListOptions{})
+		require.NoError(t, err)
+		assert.Empty(t, sas.Items)
+		crbs, err := cli.RbacV1().ClusterRoleBindings().List(context.Background(), metav1.ListOptions{})
+		require.NoError(t, err)
+		assert.Empty(t, crbs.Items)
+	})
+
+	t.Run("should ignore not existing resources", func(t *testing.T) {
+		// given
+		manager := fixManager(t, fake.NewSimpleClientset())
+
+		// when
+		err := manager.Revoke(internal.Binding{ID: bindingID, InstanceID: instanceID})
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should do nothing when instance does not exist", func(t *testing.T) {
+		// given
+		manager := NewManager(fixBindingConfig(), storage.NewMemoryStorage().Instances(), &fakeKubeconfigBuilder{},
+			func(string) (kubernetes.Interface, error) {
+				return nil, fmt.Errorf("client must not be created")
+			})
+
+		// when
+		err := manager.Revoke(internal.Binding{ID: bindingID, InstanceID: instanceID})
+
+		// then
+		assert.NoError(t, err)
+	})
+}
+
+func fixManager(t *testing.T, cli kubernetes.Interface) *Manager {
+	instances := storage.NewMemoryStorage().Instances()
+	require.NoError(t, instances.Insert(internal.Instance{InstanceID: instanceID, RuntimeID: runtimeID}))
+
+	return NewManager(fixBindingConfig(), instances, &fakeKubeconfigBuilder{}, func(kubeconfig string) (kubernetes.Interface, error) {
+		assert.Equal(t, "admin-kubeconfig", kubeconfig)
+		return cli, nil
+	})
+}
+
+func fixBindingConfig() broker.BindingConfig {
+	return broker.BindingConfig{
+		Enabled:     true,
+		Namespace:   namespace,
+		ClusterRole: "cluster-admin",
+	}
+}
+
+type fakeKubeconfigBuilder struct{}
+
+func (b *fakeKubeconfigBuilder) AdminKubeconfig(instance *internal.Instance) (string, error) {
+	return "admin-kubeconfig", nil
+}
+
+func (b *fakeKubeconfigBuilder) BuildForToken(adminKubeconfig, token string) (string, error) {
+	return fmt.Sprintf("%s-with-%s", adminKubeconfig, token), nil
+}
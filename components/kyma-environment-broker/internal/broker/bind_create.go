@@ -2,28 +2,204 @@ package broker
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"time"
 
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
 	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/pivotal-cf/brokerapi/v8/domain/apiresponses"
 	"github.com/sirupsen/logrus"
 )
 
+// BindingManager issues and revokes the credentials of service bindings on the runtime
+type BindingManager interface {
+	Issue(binding internal.Binding) (internal.Binding, error)
+	Revoke(binding internal.Binding) error
+}
+
+// BindingParameters are the parameters of the bind request
+type BindingParameters struct {
+	ExpirationSeconds int64 `json:"expiration_seconds,omitempty"`
+}
+
+// BindingCredentials are the credentials of a binding returned to the platform
+type BindingCredentials struct {
+	Kubeconfig string    `json:"kubeconfig"`
+	ExpiresAt  time.Time `json:"expires_at"`
+}
+
 type BindEndpoint struct {
+	cfg        BindingConfig
+	instances  storage.Instances
+	operations storage.Operations
+	bindings   storage.Bindings
+	manager    BindingManager
+	queue      Queue
+
 	log logrus.FieldLogger
 }
 
-func NewBind(log logrus.FieldLogger) *BindEndpoint {
-	return &BindEndpoint{log: log.WithField("service", "BindEndpoint")}
+func NewBind(cfg BindingConfig, instances storage.Instances, operations storage.Operations, bindings storage.Bindings, manager BindingManager, queue Queue, log logrus.FieldLogger) *BindEndpoint {
+	return &BindEndpoint{
+		cfg:        cfg,
+		instances:  instances,
+		operations: operations,
+		bindings:   bindings,
+		manager:    manager,
+		queue:      queue,
+		log:        log.WithField("service", "BindEndpoint"),
+	}
 }
 
 // Bind creates a new service binding
 //
 //	PUT /v2/service_instances/{instance_id}/service_bindings/{binding_id}
 func (b *BindEndpoint) Bind(ctx context.Context, instanceID, bindingID string, details domain.BindDetails, asyncAllowed bool) (domain.Binding, error) {
-	b.log.Infof("Bind instanceID:", instanceID)
-	b.log.Infof("Bind parameters: %s", string(details.RawParameters))
-	b.log.Infof("Bind context: %s", string(details.RawContext))
-	b.log.Infof("Bind asyncAllowed:", asyncAllowed)
+	logger := b.log.WithFields(logrus.Fields{"instanceID": instanceID, "bindingID": bindingID})
+	logger.Infof("Bind triggered, asyncAllowed: %v", asyncAllowed)
+
+	if !b.cfg.Enabled {
+		return domain.Binding{}, errBindingsDisabled
+	}
+
+	instance, err := b.instances.GetByID(instanceID)
+	switch {
+	case dberr.IsNotFound(err):
+		return domain.Binding{}, apiresponses.ErrInstanceDoesNotExist
+	case err != nil:
+		logger.Errorf("unable to get instance from the storage: %s", err)
+		return domain.Binding{}, apiresponses.NewFailureResponse(fmt.Errorf("unable to get instance from the storage"), http.StatusInternalServerError, "getting instance")
+	}
+	if err := b.validateInstance(instance); err != nil {
+		logger.Warnf("instance cannot be bound: %s", err)
+		return domain.Binding{}, apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, "validating instance")
+	}
+
+	params, err := b.parameters(details.RawParameters)
+	if err != nil {
+		return domain.Binding{}, apiresponses.NewFailureResponse(err, http.StatusBadRequest, "validating parameters")
+	}
+
+	existing, err := b.bindings.GetByID(bindingID)
+	switch {
+	case err == nil:
+		return b.existingBinding(*existing, instanceID, params)
+	case !dberr.IsNotFound(err):
+		logger.Errorf("unable to get binding from the storage: %s", err)
+		return domain.Binding{}, apiresponses.NewFailureResponse(fmt.Errorf("unable to get binding from the storage"), http.StatusInternalServerError, "getting binding")
+	}
+
+	now := time.Now()
+	binding := internal.Binding{
+		ID:                bindingID,
+		InstanceID:        instanceID,
+		ExpirationSeconds: params.ExpirationSeconds,
+		LastOperation:     internal.BindingOperationBind,
+		State:             domain.InProgress,
+		CreatedAt:         now,
+		UpdatedAt:         now,
+	}
+	err = b.bindings.Insert(binding)
+	switch {
+	case dberr.IsAlreadyExists(err):
+		return domain.Binding{}, apiresponses.ErrBindingAlreadyExists
+	case err != nil:
+		logger.Errorf("unable to insert binding: %s", err)
+		return domain.Binding{}, apiresponses.NewFailureResponse(fmt.Errorf("unable to insert binding"), http.StatusInternalServerError, "inserting binding")
+	}
+
+	if asyncAllowed {
+		b.queue.Add(bindingID)
+		return domain.Binding{IsAsync: true, OperationData: internal.BindingOperationBind}, nil
+	}
 
-	return domain.Binding{}, fmt.Errorf("not supported")
+	issued, err := b.manager.Issue(binding)
+	if err != nil {
+		logger.Errorf("unable to issue credentials: %s", err)
+		b.cleanUp(binding, logger)
+		return domain.Binding{}, apiresponses.NewFailureResponse(fmt.Errorf("unable to issue credentials"), http.StatusInternalServerError, "issuing credentials")
+	}
+	issued.State = domain.Succeeded
+	issued.UpdatedAt = time.Now()
+	if err := b.bindings.Update(issued); err != nil {
+		logger.Errorf("unable to update binding: %s", err)
+		b.cleanUp(binding, logger)
+		return domain.Binding{}, apiresponses.NewFailureResponse(fmt.Errorf("unable to update binding"), http.StatusInternalServerError, "updating binding")
+	}
+
+	return domain.Binding{Credentials: bindingCredentials(issued)}, nil
+}
+
+func (b *BindEndpoint) validateInstance(instance *internal.Instance) error {
+	if IsOwnClusterPlan(instance.ServicePlanID) {
+		return fmt.Errorf("bindings are not supported for the %s plan", OwnClusterPlanName)
+	}
+	lastOp, err := b.operations.GetLastOperation(instance.InstanceID)
+	if err != nil {
+		return fmt.Errorf("unable to get the last operation of the instance: %w", err)
+	}
+	if lastOp.Type == internal.OperationTypeDeprovision {
+		return fmt.Errorf("instance is being deprovisioned or suspended")
+	}
+	if lastOp.Type == internal.OperationTypeProvision && lastOp.State != domain.Succeeded {
+		return fmt.Errorf("instance is not provisioned")
+	}
+	if instance.RuntimeID == "" {
+		return fmt.Errorf("instance has no runtime")
+	}
+	return nil
+}
+
+func (b *BindEndpoint) parameters(raw json.RawMessage) (BindingParameters, error) {
+	var params BindingParameters
+	if len(raw) > 0 {
+		if err := json.Unmarshal(raw, &params); err != nil {
+			return params, fmt.Errorf("while unmarshaling binding parameters: %w", err)
+		}
+	}
+	if params.ExpirationSeconds == 0 {
+		params.ExpirationSeconds = b.cfg.ExpirationSeconds
+	}
+	if params.ExpirationSeconds < b.cfg.MinExpirationSeconds || params.ExpirationSeconds > b.cfg.MaxExpirationSeconds {
+		return params, fmt.Errorf("expiration_seconds must be between %d and %d", b.cfg.MinExpirationSeconds, b.cfg.MaxExpirationSeconds)
+	}
+	return params, nil
+}
+
+// existingBinding handles a repeated bind request, which returns the binding if it was created with the same parameters
+func (b *BindEndpoint) existingBinding(binding internal.Binding, instanceID string, params BindingParameters) (domain.Binding, error) {
+	if binding.InstanceID != instanceID || binding.ExpirationSeconds != params.ExpirationSeconds || binding.LastOperation != internal.BindingOperationBind {
+		return domain.Binding{}, apiresponses.ErrBindingAlreadyExists
+	}
+	switch binding.State {
+	case domain.Succeeded:
+		return domain.Binding{AlreadyExists: true, Credentials: bindingCredentials(binding)}, nil
+	case domain.InProgress:
+		return domain.Binding{IsAsync: true, OperationData: internal.BindingOperationBind}, nil
+	default:
+		return domain.Binding{}, apiresponses.ErrBindingAlreadyExists
+	}
+}
+
+// cleanUp removes the binding whose credentials could not be issued, so the platform can retry the bind request
+func (b *BindEndpoint) cleanUp(binding internal.Binding, logger logrus.FieldLogger) {
+	if err := b.manager.Revoke(binding); err != nil {
+		logger.Warnf("unable to revoke credentials: %s", err)
+	}
+	if err := b.bindings.Delete(binding.ID); err != nil {
+		logger.Warnf("unable to delete binding: %s", err)
+	}
 }
+
+func bindingCredentials(binding internal.Binding) BindingCredentials {
+	return BindingCredentials{
+		Kubeconfig: binding.Kubeconfig,
+		ExpiresAt:  binding.ExpiresAt,
+	}
+}
+
+var errBindingsDisabled = apiresponses.NewFailureResponse(fmt.Errorf("bindings are not supported"), http.StatusUnprocessableEntity, "bindings disabled")
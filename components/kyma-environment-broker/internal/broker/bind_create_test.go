@@ -0,0 +1,242 @@
+package broker_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker/automock"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/fixture"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/pivotal-cf/brokerapi/v8/domain/apiresponses"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	bindingInstanceID = "binding-instance"
+	bindingID         = "binding-001"
+)
+
+func TestBindEndpoint_Bind(t *testing.T) {
+	t.Run("should issue credentials synchronously", func(t *testing.T) {
+		// given
+		st := fixBindingStorage(t)
+		svc := broker.NewBind(fixBindingConfig(), st.Instances(), st.Operations(), st.Bindings(), &fakeBindingManager{}, &automock.Queue{}, logrus.New())
+
+		// when
+		resp, err := svc.Bind(context.Background(), bindingInstanceID, bindingID, domain.BindDetails{}, false)
+
+		// then
+		require.NoError(t, err)
+		assert.False(t, resp.IsAsync)
+		credentials := resp.Credentials.(broker.BindingCredentials)
+		assert.Equal(t, "kubeconfig-kyma-binding-binding-001", credentials.Kubeconfig)
+
+		binding, err := st.Bindings().GetByID(bindingID)
+		require.NoError(t, err)
+		assert.Equal(t, domain.Succeeded, binding.State)
+		assert.Equal(t, int64(600), binding.ExpirationSeconds)
+	})
+
+	t.Run("should queue binding when async allowed", func(t *testing.T) {
+		// given
+		st := fixBindingStorage(t)
+		queue := &automock.Queue{}
+		queue.On("Add", bindingID).Return()
+		svc := broker.NewBind(fixBindingConfig(), st.Instances(), st.Operations(), st.Bindings(), &fakeBindingManager{}, queue, logrus.New())
+
+		// when
+		resp, err := svc.Bind(context.Background(), bindingInstanceID, bindingID, domain.BindDetails{RawParameters: json.RawMessage(`{"expiration_seconds": 3600}`)}, true)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, resp.IsAsync)
+		assert.Equal(t, internal.BindingOperationBind, resp.OperationData)
+		queue.AssertExpectations(t)
+
+		binding, err := st.Bindings().GetByID(bindingID)
+		require.NoError(t, err)
+		assert.Equal(t, domain.InProgress, binding.State)
+		assert.Equal(t, int64(3600), binding.ExpirationSeconds)
+	})
+
+	t.Run("should return existing binding for the same request", func(t *testing.T) {
+		// given
+		st := fixBindingStorage(t)
+		svc := broker.NewBind(fixBindingConfig(), st.Instances(), st.Operations(), st.Bindings(), &fakeBindingManager{}, &automock.Queue{}, logrus.New())
+		_, err := svc.Bind(context.Background(), bindingInstanceID, bindingID, domain.BindDetails{}, false)
+		require.NoError(t, err)
+
+		// when
+		resp, err := svc.Bind(context.Background(), bindingInstanceID, bindingID, domain.BindDetails{}, false)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, resp.AlreadyExists)
+
+		// when
+		_, err = svc.Bind(context.Background(), bindingInstanceID, bindingID, domain.BindDetails{RawParameters: json.RawMessage(`{"expiration_seconds": 3600}`)}, false)
+
+		// then
+		assert.Equal(t, apiresponses.ErrBindingAlreadyExists, err)
+	})
+
+	t.Run("should remove binding when credentials cannot be issued", func(t *testing.T) {
+		// given
+		st := fixBindingStorage(t)
+		manager := &fakeBindingManager{err: fmt.Errorf("runtime not reachable")}
+		svc := broker.NewBind(fixBindingConfig(), st.Instances(), st.Operations(), st.Bindings(), manager, &automock.Queue{}, logrus.New())
+
+		// when
+		_, err := svc.Bind(context.Background(), bindingInstanceID, bindingID, domain.BindDetails{}, false)
+
+		// then
+		assertFailureStatus(t, err, http.StatusInternalServerError)
+		_, err = st.Bindings().GetByID(bindingID)
+		assert.Error(t, err)
+	})
+
+	for name, tc := range map[string]struct {
+		cfg        func(cfg broker.BindingConfig) broker.BindingConfig
+		instanceID string
+		params     string
+		lastOp     func(op internal.Operation) internal.Operation
+		status     int
+	}{
+		"bindings disabled": {
+			cfg: func(cfg broker.BindingConfig) broker.BindingConfig {
+				cfg.Enabled = false
+				return cfg
+			},
+			status: http.StatusUnprocessableEntity,
+		},
+		"instance does not exist": {
+			instanceID: "not-existing",
+			status:     http.StatusGone,
+		},
+		"expiration out of range": {
+			params: `{"expiration_seconds": 60}`,
+			status: http.StatusBadRequest,
+		},
+		"instance not provisioned": {
+			lastOp: func(op internal.Operation) internal.Operation {
+				op.State = domain.InProgress
+				return op
+			},
+			status: http.StatusUnprocessableEntity,
+		},
+		"instance deprovisioned": {
+			lastOp: func(op internal.Operation) internal.Operation {
+				op.Type = internal.OperationTypeDeprovision
+				return op
+			},
+			status: http.StatusUnprocessableEntity,
+		},
+	} {
+		t.Run(fmt.Sprintf("should reject binding when %s", name), func(t *testing.T) {
+			// given
+			st := storage.NewMemoryStorage()
+			require.NoError(t, st.Instances().Insert(fixture.FixInstance(bindingInstanceID)))
+			op := fixture.FixProvisioningOperation("op-id", bindingInstanceID)
+			if tc.lastOp != nil {
+				op = tc.lastOp(op)
+			}
+			require.NoError(t, st.Operations().InsertOperation(op))
+			cfg := fixBindingConfig()
+			if tc.cfg != nil {
+				cfg = tc.cfg(cfg)
+			}
+			instanceID := bindingInstanceID
+			if tc.instanceID != "" {
+				instanceID = tc.instanceID
+			}
+			details := domain.BindDetails{}
+			if tc.params != "" {
+				details.RawParameters = json.RawMessage(tc.params)
+			}
+			svc := broker.NewBind(cfg, st.Instances(), st.Operations(), st.Bindings(), &fakeBindingManager{}, &automock.Queue{}, logrus.New())
+
+			// when
+			_, err := svc.Bind(context.Background(), instanceID, bindingID, details, false)
+
+			// then
+			assertFailureStatus(t, err, tc.status)
+		})
+	}
+}
+
+func TestGetBindingEndpoint_GetBinding(t *testing.T) {
+	// given
+	st := fixBindingStorage(t)
+	svc := broker.NewBind(fixBindingConfig(), st.Instances(), st.Operations(), st.Bindings(), &fakeBindingManager{}, &automock.Queue{}, logrus.New())
+	_, err := svc.Bind(context.Background(), bindingInstanceID, bindingID, domain.BindDetails{}, false)
+	require.NoError(t, err)
+	getSvc := broker.NewGetBinding(st.Bindings(), logrus.New())
+
+	// when
+	resp, err := getSvc.GetBinding(context.Background(), bindingInstanceID, bindingID, domain.FetchBindingDetails{})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "kubeconfig-kyma-binding-binding-001", resp.Credentials.(broker.BindingCredentials).Kubeconfig)
+
+	// when
+	_, err = getSvc.GetBinding(context.Background(), "other-instance", bindingID, domain.FetchBindingDetails{})
+
+	// then
+	assertFailureStatus(t, err, http.StatusNotFound)
+}
+
+func fixBindingStorage(t *testing.T) storage.BrokerStorage {
+	st := storage.NewMemoryStorage()
+	require.NoError(t, st.Instances().Insert(fixture.FixInstance(bindingInstanceID)))
+	require.NoError(t, st.Operations().InsertOperation(fixture.FixProvisioningOperation("op-id", bindingInstanceID)))
+	return st
+}
+
+func fixBindingConfig() broker.BindingConfig {
+	return broker.BindingConfig{
+		Enabled:              true,
+		ExpirationSeconds:    600,
+		MinExpirationSeconds: 600,
+		MaxExpirationSeconds: 7200,
+		Namespace:            "kyma-system",
+		ClusterRole:          "cluster-admin",
+	}
+}
+
+func assertFailureStatus(t *testing.T, err error, status int) {
+	require.Error(t, err)
+	require.IsType(t, &apiresponses.FailureResponse{}, err)
+	assert.Equal(t, status, err.(*apiresponses.FailureResponse).ValidatedStatusCode(nil))
+}
+
+type fakeBindingManager struct {
+	err     error
+	revoked []string
+}
+
+func (m *fakeBindingManager) Issue(binding internal.Binding) (internal.Binding, error) {
+	if m.err != nil {
+		return binding, m.err
+	}
+	binding.Kubeconfig = fmt.Sprintf("kubeconfig-%s", binding.ServiceAccountName())
+	binding.ExpiresAt = time.Now().Add(time.Duration(binding.ExpirationSeconds) * time.Second)
+	return binding, nil
+}
+
+func (m *fakeBindingManager) Revoke(binding internal.Binding) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.revoked = append(m.revoked, binding.ID)
+	return nil
+}
@@ -3,26 +3,89 @@ package broker
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"time"
 
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
 	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/pivotal-cf/brokerapi/v8/domain/apiresponses"
 	"github.com/sirupsen/logrus"
 )
 
 type UnbindEndpoint struct {
+	cfg      BindingConfig
+	bindings storage.Bindings
+	manager  BindingManager
+	queue    Queue
+
 	log logrus.FieldLogger
 }
 
-func NewUnbind(log logrus.FieldLogger) *UnbindEndpoint {
-	return &UnbindEndpoint{log: log.WithField("service", "UnbindEndpoint")}
+func NewUnbind(cfg BindingConfig, bindings storage.Bindings, manager BindingManager, queue Queue, log logrus.FieldLogger) *UnbindEndpoint {
+	return &UnbindEndpoint{
+		cfg:      cfg,
+		bindings: bindings,
+		manager:  manager,
+		queue:    queue,
+		log:      log.WithField("service", "UnbindEndpoint"),
+	}
 }
 
 // Unbind deletes an existing service binding
 //
 //	DELETE /v2/service_instances/{instance_id}/service_bindings/{binding_id}
 func (b *UnbindEndpoint) Unbind(ctx context.Context, instanceID, bindingID string, details domain.UnbindDetails, asyncAllowed bool) (domain.UnbindSpec, error) {
-	b.log.Infof("Unbind instanceID: %s", instanceID)
-	b.log.Infof("Unbind details: %+v", details)
-	b.log.Infof("Unbind asyncAllowed: %v", asyncAllowed)
+	logger := b.log.WithFields(logrus.Fields{"instanceID": instanceID, "bindingID": bindingID})
+	logger.Infof("Unbind triggered, asyncAllowed: %v", asyncAllowed)
+
+	if !b.cfg.Enabled {
+		return domain.UnbindSpec{}, errBindingsDisabled
+	}
+
+	binding, err := getInstanceBinding(b.bindings, instanceID, bindingID, logger)
+	if err != nil {
+		return domain.UnbindSpec{}, err
+	}
+	if binding == nil {
+		return domain.UnbindSpec{}, apiresponses.ErrBindingDoesNotExist
+	}
+	if asyncAllowed && binding.LastOperation == internal.BindingOperationUnbind && binding.State == domain.InProgress {
+		return domain.UnbindSpec{IsAsync: true, OperationData: internal.BindingOperationUnbind}, nil
+	}
+	// the credentials of the binding being created are stored when the bind operation finishes, which would overwrite the unbind operation
+	if binding.LastOperation == internal.BindingOperationBind && binding.State == domain.InProgress {
+		logger.Info("bind operation is in progress, rejecting unbind")
+		return domain.UnbindSpec{}, apiresponses.ErrConcurrentInstanceAccess
+	}
+
+	binding.LastOperation = internal.BindingOperationUnbind
+	binding.State = domain.InProgress
+	binding.Description = ""
+	binding.UpdatedAt = time.Now()
+	if err := b.bindings.Update(*binding); err != nil {
+		logger.Errorf("unable to update binding: %s", err)
+		return domain.UnbindSpec{}, apiresponses.NewFailureResponse(fmt.Errorf("unable to update binding"), http.StatusInternalServerError, "updating binding")
+	}
+
+	if asyncAllowed {
+		b.queue.Add(bindingID)
+		return domain.UnbindSpec{IsAsync: true, OperationData: internal.BindingOperationUnbind}, nil
+	}
+
+	if err := b.manager.Revoke(*binding); err != nil {
+		logger.Errorf("unable to revoke credentials: %s", err)
+		binding.State = domain.Failed
+		binding.Description = fmt.Sprintf("unbind operation failed: %s", err)
+		if err := b.bindings.Update(*binding); err != nil {
+			logger.Errorf("unable to update binding: %s", err)
+		}
+		return domain.UnbindSpec{}, apiresponses.NewFailureResponse(fmt.Errorf("unable to revoke credentials"), http.StatusInternalServerError, "revoking credentials")
+	}
+	if err := b.bindings.Delete(bindingID); err != nil {
+		logger.Errorf("unable to delete binding: %s", err)
+		return domain.UnbindSpec{}, apiresponses.NewFailureResponse(fmt.Errorf("unable to delete binding"), http.StatusInternalServerError, "deleting binding")
+	}
 
-	return domain.UnbindSpec{}, fmt.Errorf("not supported")
+	return domain.UnbindSpec{}, nil
 }
@@ -0,0 +1,121 @@
+package broker_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker/automock"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/pivotal-cf/brokerapi/v8/domain/apiresponses"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnbindEndpoint_Unbind(t *testing.T) {
+	t.Run("should revoke credentials and delete binding", func(t *testing.T) {
+		// given
+		st, manager := fixBoundStorage(t)
+		svc := broker.NewUnbind(fixBindingConfig(), st.Bindings(), manager, &automock.Queue{}, logrus.New())
+
+		// when
+		resp, err := svc.Unbind(context.Background(), bindingInstanceID, bindingID, domain.UnbindDetails{}, false)
+
+		// then
+		require.NoError(t, err)
+		assert.False(t, resp.IsAsync)
+		assert.Equal(t, []string{bindingID}, manager.revoked)
+		_, err = st.Bindings().GetByID(bindingID)
+		assert.True(t, dberr.IsNotFound(err))
+	})
+
+	t.Run("should queue unbind when async allowed", func(t *testing.T) {
+		// given
+		st, manager := fixBoundStorage(t)
+		queue := &automock.Queue{}
+		queue.On("Add", bindingID).Return()
+		svc := broker.NewUnbind(fixBindingConfig(), st.Bindings(), manager, queue, logrus.New())
+
+		// when
+		resp, err := svc.Unbind(context.Background(), bindingInstanceID, bindingID, domain.UnbindDetails{}, true)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, resp.IsAsync)
+		assert.Equal(t, internal.BindingOperationUnbind, resp.OperationData)
+		queue.AssertExpectations(t)
+
+		lastOpSvc := broker.NewLastBindingOperation(st.Bindings(), logrus.New())
+		lastOp, err := lastOpSvc.LastBindingOperation(context.Background(), bindingInstanceID, bindingID, domain.PollDetails{OperationData: resp.OperationData})
+		require.NoError(t, err)
+		assert.Equal(t, domain.InProgress, lastOp.State)
+	})
+
+	t.Run("should mark binding as failed when credentials cannot be revoked", func(t *testing.T) {
+		// given
+		st, manager := fixBoundStorage(t)
+		manager.err = fmt.Errorf("runtime not reachable")
+		svc := broker.NewUnbind(fixBindingConfig(), st.Bindings(), manager, &automock.Queue{}, logrus.New())
+
+		// when
+		_, err := svc.Unbind(context.Background(), bindingInstanceID, bindingID, domain.UnbindDetails{}, false)
+
+		// then
+		assertFailureStatus(t, err, http.StatusInternalServerError)
+		binding, err := st.Bindings().GetByID(bindingID)
+		require.NoError(t, err)
+		assert.Equal(t, domain.Failed, binding.State)
+		assert.Equal(t, "unbind operation failed: runtime not reachable", binding.Description)
+	})
+
+	t.Run("should reject unbind while bind is in progress", func(t *testing.T) {
+		// given
+		st := fixBindingStorage(t)
+		manager := &fakeBindingManager{}
+		queue := &automock.Queue{}
+		queue.On("Add", bindingID).Return()
+		_, err := broker.NewBind(fixBindingConfig(), st.Instances(), st.Operations(), st.Bindings(), manager, queue, logrus.New()).
+			Bind(context.Background(), bindingInstanceID, bindingID, domain.BindDetails{}, true)
+		require.NoError(t, err)
+		svc := broker.NewUnbind(fixBindingConfig(), st.Bindings(), manager, queue, logrus.New())
+
+		// when
+		_, err = svc.Unbind(context.Background(), bindingInstanceID, bindingID, domain.UnbindDetails{}, true)
+
+		// then
+		assert.Equal(t, apiresponses.ErrConcurrentInstanceAccess, err)
+		assert.Empty(t, manager.revoked)
+		binding, err := st.Bindings().GetByID(bindingID)
+		require.NoError(t, err)
+		assert.Equal(t, internal.BindingOperationBind, binding.LastOperation)
+		assert.Equal(t, domain.InProgress, binding.State)
+		queue.AssertNumberOfCalls(t, "Add", 1)
+	})
+
+	t.Run("should return gone for not existing binding", func(t *testing.T) {
+		// given
+		st := storage.NewMemoryStorage()
+		svc := broker.NewUnbind(fixBindingConfig(), st.Bindings(), &fakeBindingManager{}, &automock.Queue{}, logrus.New())
+
+		// when
+		_, err := svc.Unbind(context.Background(), bindingInstanceID, bindingID, domain.UnbindDetails{}, false)
+
+		// then
+		assert.Equal(t, apiresponses.ErrBindingDoesNotExist, err)
+	})
+}
+
+func fixBoundStorage(t *testing.T) (storage.BrokerStorage, *fakeBindingManager) {
+	st := fixBindingStorage(t)
+	manager := &fakeBindingManager{}
+	svc := broker.NewBind(fixBindingConfig(), st.Instances(), st.Operations(), st.Bindings(), manager, &automock.Queue{}, logrus.New())
+	_, err := svc.Bind(context.Background(), bindingInstanceID, bindingID, domain.BindDetails{}, false)
+	require.NoError(t, err)
+	return st, manager
+}
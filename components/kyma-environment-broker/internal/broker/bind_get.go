@@ -3,25 +3,61 @@ package broker
 import (
 	"context"
 	"fmt"
+	"net/http"
 
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
 	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/pivotal-cf/brokerapi/v8/domain/apiresponses"
 	"github.com/sirupsen/logrus"
 )
 
 type GetBindingEndpoint struct {
+	bindings storage.Bindings
+
 	log logrus.FieldLogger
 }
 
-func NewGetBinding(log logrus.FieldLogger) *GetBindingEndpoint {
-	return &GetBindingEndpoint{log: log.WithField("service", "GetBindingEndpoint")}
+func NewGetBinding(bindings storage.Bindings, log logrus.FieldLogger) *GetBindingEndpoint {
+	return &GetBindingEndpoint{
+		bindings: bindings,
+		log:      log.WithField("service", "GetBindingEndpoint"),
+	}
 }
 
 // GetBinding fetches an existing service binding
 //
 //	GET /v2/service_instances/{instance_id}/service_bindings/{binding_id}
 func (b *GetBindingEndpoint) GetBinding(_ context.Context, instanceID, bindingID string, _ domain.FetchBindingDetails) (domain.GetBindingSpec, error) {
-	b.log.Infof("GetBinding instanceID: %s", instanceID)
-	b.log.Infof("GetBinding bindingID: %s", bindingID)
+	logger := b.log.WithFields(logrus.Fields{"instanceID": instanceID, "bindingID": bindingID})
+
+	binding, err := getInstanceBinding(b.bindings, instanceID, bindingID, logger)
+	if err != nil {
+		return domain.GetBindingSpec{}, err
+	}
+	if binding == nil || binding.LastOperation != internal.BindingOperationBind || binding.State != domain.Succeeded {
+		return domain.GetBindingSpec{}, apiresponses.ErrBindingNotFound
+	}
+
+	return domain.GetBindingSpec{
+		Credentials: bindingCredentials(*binding),
+		Parameters:  BindingParameters{ExpirationSeconds: binding.ExpirationSeconds},
+	}, nil
+}
 
-	return domain.GetBindingSpec{}, fmt.Errorf("not supported")
+// getInstanceBinding returns the binding of the instance or nil if the instance has no binding with the given ID
+func getInstanceBinding(bindings storage.Bindings, instanceID, bindingID string, logger logrus.FieldLogger) (*internal.Binding, error) {
+	binding, err := bindings.GetByID(bindingID)
+	switch {
+	case dberr.IsNotFound(err):
+		return nil, nil
+	case err != nil:
+		logger.Errorf("unable to get binding from the storage: %s", err)
+		return nil, apiresponses.NewFailureResponse(fmt.Errorf("unable to get binding from the storage"), http.StatusInternalServerError, "getting binding")
+	}
+	if binding.InstanceID != instanceID {
+		return nil, nil
+	}
+	return binding, nil
 }
@@ -2,27 +2,43 @@ package broker
 
 import (
 	"context"
-	"fmt"
 
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
 	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/pivotal-cf/brokerapi/v8/domain/apiresponses"
 	"github.com/sirupsen/logrus"
 )
 
 type LastBindingOperationEndpoint struct {
+	bindings storage.Bindings
+
 	log logrus.FieldLogger
 }
 
-func NewLastBindingOperation(log logrus.FieldLogger) *LastBindingOperationEndpoint {
-	return &LastBindingOperationEndpoint{log: log.WithField("service", "LastBindingOperationEndpoint")}
+func NewLastBindingOperation(bindings storage.Bindings, log logrus.FieldLogger) *LastBindingOperationEndpoint {
+	return &LastBindingOperationEndpoint{
+		bindings: bindings,
+		log:      log.WithField("service", "LastBindingOperationEndpoint"),
+	}
 }
 
 // LastBindingOperation fetches last operation state for a service binding
 //
 //	GET /v2/service_instances/{instance_id}/service_bindings/{binding_id}/last_operation
 func (b *LastBindingOperationEndpoint) LastBindingOperation(ctx context.Context, instanceID, bindingID string, details domain.PollDetails) (domain.LastOperation, error) {
-	b.log.Infof("LastBindingOperation instanceID: %s", instanceID)
-	b.log.Infof("LastBindingOperation bindingID: %s", bindingID)
-	b.log.Infof("LastBindingOperation details: %+v", details)
+	logger := b.log.WithFields(logrus.Fields{"instanceID": instanceID, "bindingID": bindingID, "operation": details.OperationData})
+
+	binding, err := getInstanceBinding(b.bindings, instanceID, bindingID, logger)
+	if err != nil {
+		return domain.LastOperation{}, err
+	}
+	// the binding is removed when the unbind operation succeeds
+	if binding == nil {
+		return domain.LastOperation{}, apiresponses.ErrBindingDoesNotExist
+	}
 
-	return domain.LastOperation{}, fmt.Errorf("not supported")
+	return domain.LastOperation{
+		State:       binding.State,
+		Description: binding.Description,
+	}, nil
 }
@@ -41,6 +41,22 @@ type Config struct {
 	ShowTrialExpirationInfo                 bool   `envconfig:"default=false"`
 	SubaccountsIdsToShowTrialExpirationInfo string `envconfig:"default="`
 	TrialDocsURL                            string `envconfig:"default="`
+	Binding                                 BindingConfig
+}
+
+// BindingConfig configures service bindings, which issue kubeconfigs with tokens of service accounts created on the runtime
+type BindingConfig struct {
+	Enabled bool `envconfig:"default=false"`
+	// ExpirationSeconds is the validity of the issued token used when the binding parameters do not specify it,
+	// MinExpirationSeconds and MaxExpirationSeconds limit the requested value
+	ExpirationSeconds    int64 `envconfig:"default=600"`
+	MinExpirationSeconds int64 `envconfig:"default=600"`
+	MaxExpirationSeconds int64 `envconfig:"default=7200"`
+	// Namespace is the runtime namespace in which the service accounts of bindings are created
+	Namespace string `envconfig:"default=kyma-system"`
+	// ClusterRole is bound to the service accounts of bindings, the default read-only view role exists in every cluster,
+	// cluster-admin must be configured explicitly
+	ClusterRole string `envconfig:"default=view"`
 }
 
 type ServicesConfig map[string]Service
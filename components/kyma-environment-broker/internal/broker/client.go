@@ -33,7 +33,8 @@ type (
 	}
 
 	parametersDTO struct {
-		Expired *bool `json:"expired"`
+		Expired    *bool `json:"expired,omitempty"`
+		Hibernated *bool `json:"hibernated,omitempty"`
 	}
 
 	serviceUpdatePatchDTO struct {
@@ -144,6 +145,36 @@ func (c *Client) SendExpirationRequest(instance internal.Instance) (suspensionUn
 	return processResponse(instance.InstanceID, resp.StatusCode, resp)
 }
 
+// SendHibernationRequest requests Runtime hibernation (hibernated is true) or wake-up (hibernated is false)
+func (c *Client) SendHibernationRequest(instance internal.Instance, hibernated bool) (accepted bool, err error) {
+	jsonPayload, err := json.Marshal(serviceUpdatePatchDTO{
+		ServiceID: KymaServiceID,
+		PlanID:    instance.ServicePlanID,
+		Context: contextDTO{
+			GlobalAccountID: instance.GlobalAccountID,
+			SubAccountID:    instance.SubAccountID,
+		},
+		Parameters: parametersDTO{Hibernated: &hibernated}})
+	if err != nil {
+		return false, fmt.Errorf("while marshaling payload for instanceID: %s: %w", instance.InstanceID, err)
+	}
+
+	log.Infof("Requesting hibernated=%t of the environment with instance id: %q", hibernated, instance.InstanceID)
+	request, err := newPatchRequest(instance.InstanceID, c.brokerConfig.URL, jsonPayload)
+	if err != nil {
+		return false, err
+	}
+
+	resp, err := c.httpClient.Do(request)
+	if err != nil {
+		return false, fmt.Errorf("while executing request URL: %s for instanceID: %s: %w", request.URL,
+			instance.InstanceID, err)
+	}
+	defer c.warnOnError(resp.Body.Close)
+
+	return processResponse(instance.InstanceID, resp.StatusCode, resp)
+}
+
 func (c *Client) GetInstanceRequest(instanceID string) (response *http.Response, err error) {
 	request, err := prepareGetRequest(instanceID, c.brokerConfig.URL)
 	if err != nil {
@@ -215,8 +246,6 @@ func decodeErrorResponse(resp *http.Response) (string, string, error) {
 }
 
 func preparePatchRequest(instance internal.Instance, brokerConfigURL string) (*http.Request, error) {
-	updateInstanceUrl := fmt.Sprintf(updateInstanceTmpl, brokerConfigURL, instancesURL, instance.InstanceID)
-
 	jsonPayload, err := preparePayload(instance)
 	if err != nil {
 		return nil, fmt.Errorf("while marshaling payload for instanceID: %s: %w", instance.InstanceID, err)
@@ -224,9 +253,15 @@ func preparePatchRequest(instance internal.Instance, brokerConfigURL string) (*h
 
 	log.Infof("Requesting expiration of the environment with instance id: %q", instance.InstanceID)
 
+	return newPatchRequest(instance.InstanceID, brokerConfigURL, jsonPayload)
+}
+
+func newPatchRequest(instanceID, brokerConfigURL string, jsonPayload []byte) (*http.Request, error) {
+	updateInstanceUrl := fmt.Sprintf(updateInstanceTmpl, brokerConfigURL, instancesURL, instanceID)
+
 	request, err := http.NewRequest(http.MethodPatch, updateInstanceUrl, bytes.NewBuffer(jsonPayload))
 	if err != nil {
-		return nil, fmt.Errorf("while creating request for instanceID: %s: %w", instance.InstanceID, err)
+		return nil, fmt.Errorf("while creating request for instanceID: %s: %w", instanceID, err)
 	}
 	request.Header.Set("X-Broker-API-Version", "2.14")
 	return request, nil
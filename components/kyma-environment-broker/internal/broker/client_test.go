@@ -12,6 +12,7 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 const (
@@ -203,6 +204,65 @@ func TestClient_ExpirationRequest(t *testing.T) {
 	})
 }
 
+func TestClient_HibernationRequest(t *testing.T) {
+	for _, hibernated := range []bool{true, false} {
+		t.Run(fmt.Sprintf("should send hibernated=%t", hibernated), func(t *testing.T) {
+			// given
+			var received serviceUpdatePatchDTO
+			testServer := fixHTTPServer(func(w http.ResponseWriter, r *http.Request) {
+				if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				w.WriteHeader(http.StatusAccepted)
+				w.Write([]byte(fmt.Sprintf(`{"operation": "%s"}`, fixOpID)))
+			})
+			defer testServer.Close()
+
+			client := NewClientWithPoller(context.Background(), ClientConfig{URL: testServer.URL}, NewPassthroughPoller())
+			client.setHttpClient(testServer.Client())
+
+			instance := internal.Instance{
+				InstanceID:      fixInstanceID,
+				RuntimeID:       fixRuntimeID,
+				GlobalAccountID: "global-account-id",
+				ServicePlanID:   AWSPlanID,
+			}
+
+			// when
+			accepted, err := client.SendHibernationRequest(instance, hibernated)
+
+			// then
+			require.NoError(t, err)
+			assert.True(t, accepted)
+			assert.Equal(t, AWSPlanID, received.PlanID)
+			assert.Equal(t, "global-account-id", received.Context.GlobalAccountID)
+			assert.Nil(t, received.Context.Active)
+			assert.Nil(t, received.Parameters.Expired)
+			assert.Equal(t, hibernated, *received.Parameters.Hibernated)
+		})
+	}
+
+	t.Run("should return false when update is unprocessable", func(t *testing.T) {
+		// given
+		testServer := fixHTTPServer(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			w.Write([]byte(`{"error": "error", "description": "hibernation is not supported"}`))
+		})
+		defer testServer.Close()
+
+		client := NewClientWithPoller(context.Background(), ClientConfig{URL: testServer.URL}, NewPassthroughPoller())
+		client.setHttpClient(testServer.Client())
+
+		// when
+		accepted, err := client.SendHibernationRequest(internal.Instance{InstanceID: fixInstanceID, ServicePlanID: TrialPlanID}, true)
+
+		// then
+		require.NoError(t, err)
+		assert.False(t, accepted)
+	})
+}
+
 func fixHTTPServer(requestFailureFunc func(http.ResponseWriter, *http.Request)) *httptest.Server {
 	if requestFailureFunc != nil {
 		r := mux.NewRouter()
@@ -37,6 +37,10 @@ type (
 	PlanValidator interface {
 		IsPlanSupport(planID string) bool
 	}
+
+	QuotaChecker interface {
+		Check(globalAccountID, planName, platformRegion string) error
+	}
 )
 
 type ProvisionEndpoint struct {
@@ -59,6 +63,8 @@ type ProvisionEndpoint struct {
 	euAccessWhitelist        euaccess.WhitelistSet
 	euAccessRejectionMessage string
 
+	quotas QuotaChecker
+
 	log logrus.FieldLogger
 }
 
@@ -75,6 +81,7 @@ func NewProvision(cfg Config,
 	euRejectMessage string,
 	log logrus.FieldLogger,
 	dashboardConfig dashboard.Config,
+	quotas QuotaChecker,
 ) *ProvisionEndpoint {
 	enabledPlanIDs := map[string]struct{}{}
 	for _, planName := range cfg.EnablePlans {
@@ -99,6 +106,7 @@ func NewProvision(cfg Config,
 		euAccessWhitelist:        euAccessWhitelist,
 		euAccessRejectionMessage: euRejectMessage,
 		dashboardConfig:          dashboardConfig,
+		quotas:                   quotas,
 	}
 }
 
@@ -110,35 +118,14 @@ func (b *ProvisionEndpoint) Provision(ctx context.Context, instanceID string, de
 	logger := b.log.WithFields(logrus.Fields{"instanceID": instanceID, "operationID": operationID, "planID": details.PlanID})
 	logger.Infof("Provision called with context: %s", marshallRawContext(hideSensitiveDataFromRawContext(details.RawContext)))
 
-	region, found := middleware.RegionFromContext(ctx)
-	if !found {
-		err := fmt.Errorf("No region specified in request.")
-		return domain.ProvisionedServiceSpec{}, apiresponses.NewFailureResponse(err, http.StatusInternalServerError, "provisioning")
-	}
-	platformProvider, found := middleware.ProviderFromContext(ctx)
-	if !found {
-		err := fmt.Errorf("No provider specified in request.")
-		return domain.ProvisionedServiceSpec{}, apiresponses.NewFailureResponse(err, http.StatusInternalServerError, "provisioning")
-	}
-
-	// validation of incoming input
-	ersContext, parameters, err := b.validateAndExtract(details, platformProvider, ctx, logger)
+	provisioningParameters, err := b.provisioningParameters(ctx, instanceID, details, logger)
 	if err != nil {
-		errMsg := fmt.Sprintf("[instanceID: %s] %s", instanceID, err)
-		return domain.ProvisionedServiceSpec{}, apiresponses.NewFailureResponse(err, http.StatusBadRequest, errMsg)
-	}
-
-	provisioningParameters := internal.ProvisioningParameters{
-		PlanID:           details.PlanID,
-		ServiceID:        details.ServiceID,
-		ErsContext:       ersContext,
-		Parameters:       parameters,
-		PlatformRegion:   region,
-		PlatformProvider: platformProvider,
+		return domain.ProvisionedServiceSpec{}, err
 	}
+	ersContext, parameters := provisioningParameters.ErsContext, provisioningParameters.Parameters
 
 	logger.Infof("Starting provisioning runtime: Name=%s, GlobalAccountID=%s, SubAccountID=%s PlatformRegion=%s, ProvisioningParameterts.Region=%s, ProvisioningParameterts.MachineType=%s",
-		parameters.Name, ersContext.GlobalAccountID, ersContext.SubAccountID, region, valueOfPtr(parameters.Region), valueOfPtr(parameters.MachineType))
+		parameters.Name, ersContext.GlobalAccountID, ersContext.SubAccountID, provisioningParameters.PlatformRegion, valueOfPtr(parameters.Region), valueOfPtr(parameters.MachineType))
 	logParametersWithMaskedKubeconfig(parameters, logger)
 
 	// check if operation with instance ID already created
@@ -151,27 +138,17 @@ func (b *ProvisionEndpoint) Provision(ctx context.Context, instanceID string, de
 		return b.handleExistingOperation(existingOperation, provisioningParameters)
 	}
 
-	shootName := gardener.CreateShootName()
-	shootDomainSuffix := strings.Trim(b.shootDomain, ".")
-
-	dashboardURL := b.createDashboardURL(details.PlanID, instanceID)
+	err = checkQuota(b.quotas, ersContext.GlobalAccountID, provisioningParameters.PlanID, provisioningParameters.PlatformRegion, "provisioning", logger)
+	if err != nil {
+		return domain.ProvisionedServiceSpec{}, err
+	}
 
 	// create and save new operation
-	operation, err := internal.NewProvisioningOperationWithID(operationID, instanceID, provisioningParameters)
+	operation, err := b.newProvisioningOperation(operationID, instanceID, provisioningParameters)
 	if err != nil {
 		logger.Errorf("cannot create new operation: %s", err)
 		return domain.ProvisionedServiceSpec{}, fmt.Errorf("cannot create new operation")
 	}
-
-	operation.ShootName = shootName
-	operation.ShootDomain = fmt.Sprintf("%s.%s", shootName, shootDomainSuffix)
-	operation.ShootDNSProviders = b.shootDnsProviders
-	operation.DashboardURL = dashboardURL
-	// for own cluster plan - KEB uses provided shoot name and shoot domain
-	if IsOwnClusterPlan(provisioningParameters.PlanID) {
-		operation.ShootName = provisioningParameters.Parameters.ShootName
-		operation.ShootDomain = provisioningParameters.Parameters.ShootDomain
-	}
 	logger.Infof("Runtime ShootDomain: %s", operation.ShootDomain)
 
 	err = b.operationsStorage.InsertOperation(operation.Operation)
@@ -188,7 +165,7 @@ func (b *ProvisionEndpoint) Provision(ctx context.Context, instanceID string, de
 		ServiceName:     KymaServiceName,
 		ServicePlanID:   provisioningParameters.PlanID,
 		ServicePlanName: PlanNamesMapping[provisioningParameters.PlanID],
-		DashboardURL:    dashboardURL,
+		DashboardURL:    operation.DashboardURL,
 		Parameters:      operation.ProvisioningParameters,
 	}
 	err = b.instanceStorage.Insert(instance)
@@ -203,13 +180,81 @@ func (b *ProvisionEndpoint) Provision(ctx context.Context, instanceID string, de
 	return domain.ProvisionedServiceSpec{
 		IsAsync:       true,
 		OperationData: operation.ID,
-		DashboardURL:  dashboardURL,
+		DashboardURL:  operation.DashboardURL,
 		Metadata: domain.InstanceMetadata{
 			Labels: ResponseLabels(operation, instance, b.config.URL, b.config.EnableKubeconfigURLLabel),
 		},
 	}, nil
 }
 
+// PreviewOperation validates the provisioning request and returns the operation which would be created for it.
+// Nothing is persisted and no operation is queued.
+func (b *ProvisionEndpoint) PreviewOperation(ctx context.Context, instanceID string, details domain.ProvisionDetails) (internal.ProvisioningOperation, error) {
+	logger := b.log.WithFields(logrus.Fields{"instanceID": instanceID, "planID": details.PlanID, "preview": true})
+
+	provisioningParameters, err := b.provisioningParameters(ctx, instanceID, details, logger)
+	if err != nil {
+		return internal.ProvisioningOperation{}, err
+	}
+	err = checkQuota(b.quotas, provisioningParameters.ErsContext.GlobalAccountID, provisioningParameters.PlanID, provisioningParameters.PlatformRegion, "provisioning", logger)
+	if err != nil {
+		return internal.ProvisioningOperation{}, err
+	}
+
+	return b.newProvisioningOperation(uuid.New().String(), instanceID, provisioningParameters)
+}
+
+func (b *ProvisionEndpoint) provisioningParameters(ctx context.Context, instanceID string, details domain.ProvisionDetails, logger *logrus.Entry) (internal.ProvisioningParameters, error) {
+	region, found := middleware.RegionFromContext(ctx)
+	if !found {
+		err := fmt.Errorf("No region specified in request.")
+		return internal.ProvisioningParameters{}, apiresponses.NewFailureResponse(err, http.StatusInternalServerError, "provisioning")
+	}
+	platformProvider, found := middleware.ProviderFromContext(ctx)
+	if !found {
+		err := fmt.Errorf("No provider specified in request.")
+		return internal.ProvisioningParameters{}, apiresponses.NewFailureResponse(err, http.StatusInternalServerError, "provisioning")
+	}
+
+	// validation of incoming input
+	ersContext, parameters, err := b.validateAndExtract(details, platformProvider, ctx, logger)
+	if err != nil {
+		errMsg := fmt.Sprintf("[instanceID: %s] %s", instanceID, err)
+		return internal.ProvisioningParameters{}, apiresponses.NewFailureResponse(err, http.StatusBadRequest, errMsg)
+	}
+
+	return internal.ProvisioningParameters{
+		PlanID:           details.PlanID,
+		ServiceID:        details.ServiceID,
+		ErsContext:       ersContext,
+		Parameters:       parameters,
+		PlatformRegion:   region,
+		PlatformProvider: platformProvider,
+	}, nil
+}
+
+func (b *ProvisionEndpoint) newProvisioningOperation(operationID, instanceID string, provisioningParameters internal.ProvisioningParameters) (internal.ProvisioningOperation, error) {
+	operation, err := internal.NewProvisioningOperationWithID(operationID, instanceID, provisioningParameters)
+	if err != nil {
+		return operation, err
+	}
+
+	shootName := gardener.CreateShootName()
+	shootDomainSuffix := strings.Trim(b.shootDomain, ".")
+
+	operation.ShootName = shootName
+	operation.ShootDomain = fmt.Sprintf("%s.%s", shootName, shootDomainSuffix)
+	operation.ShootDNSProviders = b.shootDnsProviders
+	operation.DashboardURL = b.createDashboardURL(provisioningParameters.PlanID, instanceID)
+	// for own cluster plan - KEB uses provided shoot name and shoot domain
+	if IsOwnClusterPlan(provisioningParameters.PlanID) {
+		operation.ShootName = provisioningParameters.Parameters.ShootName
+		operation.ShootDomain = provisioningParameters.Parameters.ShootDomain
+	}
+
+	return operation, nil
+}
+
 func logParametersWithMaskedKubeconfig(parameters internal.ProvisioningParametersDTO, logger *logrus.Entry) {
 	parameters.Kubeconfig = "*****"
 	logger.Infof("Runtime parameters: %+v", parameters)
@@ -19,7 +19,9 @@ import (
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/fixture"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/middleware"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/ptr"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/quota"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
 	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
 	"github.com/pivotal-cf/brokerapi/v8/domain"
 	"github.com/sirupsen/logrus"
@@ -85,6 +87,7 @@ func TestProvision_Provision(t *testing.T) {
 			"request rejected, your globalAccountId is not whitelisted",
 			logrus.StandardLogger(),
 			dashboardConfig,
+			nil,
 		)
 
 		// when
@@ -158,6 +161,7 @@ func TestProvision_Provision(t *testing.T) {
 			"request rejected, your globalAccountId is not whitelisted",
 			logrus.StandardLogger(),
 			dashboardConfig,
+			nil,
 		)
 
 		// when
@@ -235,6 +239,7 @@ func TestProvision_Provision(t *testing.T) {
 			"request rejected, your globalAccountId is not whitelisted",
 			logrus.StandardLogger(),
 			dashboardConfig,
+			nil,
 		)
 
 		// when
@@ -284,6 +289,7 @@ func TestProvision_Provision(t *testing.T) {
 			"request rejected, your globalAccountId is not whitelisted",
 			logrus.StandardLogger(),
 			dashboardConfig,
+			nil,
 		)
 
 		// when shootDomain is missing
@@ -357,6 +363,7 @@ func TestProvision_Provision(t *testing.T) {
 			"request rejected, your globalAccountId is not whitelisted",
 			logrus.StandardLogger(),
 			dashboardConfig,
+			nil,
 		)
 
 		// when
@@ -432,6 +439,7 @@ func TestProvision_Provision(t *testing.T) {
 			"request rejected, your globalAccountId is not whitelisted",
 			logrus.StandardLogger(),
 			dashboardConfig,
+			nil,
 		)
 
 		// when
@@ -481,6 +489,7 @@ func TestProvision_Provision(t *testing.T) {
 			"request rejected, your globalAccountId is not whitelisted",
 			logrus.StandardLogger(),
 			dashboardConfig,
+			nil,
 		)
 
 		// when
@@ -495,6 +504,63 @@ func TestProvision_Provision(t *testing.T) {
 		assert.EqualError(t, err, "trial Kyma was created for the global account, but there is only one allowed")
 	})
 
+	t.Run("quota of the global account is exceeded", func(t *testing.T) {
+		// given
+		memoryStorage := storage.NewMemoryStorage()
+		err := memoryStorage.Instances().Insert(internal.Instance{
+			InstanceID:      instanceID,
+			GlobalAccountID: globalAccountID,
+			ServiceID:       serviceID,
+			ServicePlanID:   broker.AzurePlanID,
+			ServicePlanName: broker.AzurePlanName,
+			Parameters:      internal.ProvisioningParameters{PlatformRegion: "dummy"},
+		})
+		assert.NoError(t, err)
+
+		factoryBuilder := &automock.PlanValidator{}
+		factoryBuilder.On("IsPlanSupport", broker.AzurePlanID).Return(true)
+
+		planDefaults := func(planID string, platformProvider internal.CloudProvider, provider *internal.CloudProvider) (*gqlschema.ClusterConfigInput, error) {
+			return &gqlschema.ClusterConfigInput{}, nil
+		}
+		quotas := quota.NewEnforcer(quota.Config{
+			Defaults: []quota.Rule{{Plan: broker.AzurePlanName, Region: "dummy", Limit: 1}},
+		}, memoryStorage.Instances())
+		provisionEndpoint := broker.NewProvision(
+			broker.Config{EnablePlans: []string{"gcp", "azure"}},
+			gardener.Config{Project: "test", ShootDomain: "example.com", DNSProviders: fixDNSProviders()},
+			memoryStorage.Operations(),
+			memoryStorage.Instances(),
+			nil,
+			factoryBuilder,
+			broker.PlansConfig{},
+			false,
+			planDefaults,
+			euaccess.WhitelistSet{},
+			"request rejected, your globalAccountId is not whitelisted",
+			logrus.StandardLogger(),
+			dashboardConfig,
+			quotas,
+		)
+
+		// when
+		_, err = provisionEndpoint.Provision(fixRequestContext(t, "dummy"), otherInstanceID, domain.ProvisionDetails{
+			ServiceID:     serviceID,
+			PlanID:        broker.AzurePlanID,
+			RawParameters: json.RawMessage(fmt.Sprintf(`{"name": "%s"}`, clusterName)),
+			RawContext:    json.RawMessage(fmt.Sprintf(`{"globalaccount_id": "%s", "subaccount_id": "%s", "user_id": "%s"}`, globalAccountID, subAccountID, userID)),
+		}, true)
+
+		// then
+		require.Error(t, err)
+		assert.IsType(t, &apiresponses.FailureResponse{}, err)
+		apierr := err.(*apiresponses.FailureResponse)
+		assert.Equal(t, http.StatusUnprocessableEntity, apierr.ValidatedStatusCode(nil))
+		assert.Contains(t, err.Error(), "has 1 of 1 allowed azure instances in the region dummy")
+		_, err = memoryStorage.Instances().GetByID(otherInstanceID)
+		assert.True(t, dberr.IsNotFound(err))
+	})
+
 	t.Run("more than one trial is allowed", func(t *testing.T) {
 		// given
 		memoryStorage := storage.NewMemoryStorage()
@@ -531,6 +597,7 @@ func TestProvision_Provision(t *testing.T) {
 			"request rejected, your globalAccountId is not whitelisted",
 			logrus.StandardLogger(),
 			dashboardConfig,
+			nil,
 		)
 
 		// when
@@ -594,6 +661,7 @@ func TestProvision_Provision(t *testing.T) {
 			"request rejected, your globalAccountId is not whitelisted",
 			logrus.StandardLogger(),
 			dashboardConfig,
+			nil,
 		)
 
 		// when
@@ -657,6 +725,7 @@ func TestProvision_Provision(t *testing.T) {
 			"request rejected, your globalAccountId is not whitelisted",
 			logrus.StandardLogger(),
 			dashboardConfig,
+			nil,
 		)
 
 		// when
@@ -701,6 +770,7 @@ func TestProvision_Provision(t *testing.T) {
 			"request rejected, your globalAccountId is not whitelisted",
 			logrus.StandardLogger(),
 			dashboardConfig,
+			nil,
 		)
 
 		// when
@@ -743,6 +813,7 @@ func TestProvision_Provision(t *testing.T) {
 			"request rejected, your globalAccountId is not whitelisted",
 			logrus.StandardLogger(),
 			dashboardConfig,
+			nil,
 		)
 
 		// when
@@ -786,6 +857,7 @@ func TestProvision_Provision(t *testing.T) {
 			"request rejected, your globalAccountId is not whitelisted",
 			logrus.StandardLogger(),
 			dashboardConfig,
+			nil,
 		)
 
 		// when
@@ -827,6 +899,7 @@ func TestProvision_Provision(t *testing.T) {
 			"request rejected, your globalAccountId is not whitelisted",
 			logrus.StandardLogger(),
 			dashboardConfig,
+			nil,
 		)
 
 		// when
@@ -875,6 +948,7 @@ func TestProvision_Provision(t *testing.T) {
 			"request rejected, your globalAccountId is not whitelisted",
 			logrus.StandardLogger(),
 			dashboardConfig,
+			nil,
 		)
 
 		// when
@@ -920,6 +994,7 @@ func TestProvision_Provision(t *testing.T) {
 			"request rejected, your globalAccountId is not whitelisted",
 			logrus.StandardLogger(),
 			dashboardConfig,
+			nil,
 		)
 
 		// when
@@ -971,6 +1046,7 @@ func TestProvision_Provision(t *testing.T) {
 			"request rejected, your globalAccountId is not whitelisted",
 			logrus.StandardLogger(),
 			dashboardConfig,
+			nil,
 		)
 
 		oidcParams := `"clientID":"client-id"`
@@ -1028,6 +1104,7 @@ func TestProvision_Provision(t *testing.T) {
 			"request rejected, your globalAccountId is not whitelisted",
 			logrus.StandardLogger(),
 			dashboardConfig,
+			nil,
 		)
 
 		oidcParams := `"issuerURL":"https://test.local"`
@@ -1085,6 +1162,7 @@ func TestProvision_Provision(t *testing.T) {
 			"request rejected, your globalAccountId is not whitelisted",
 			logrus.StandardLogger(),
 			dashboardConfig,
+			nil,
 		)
 
 		oidcParams := `"clientID":"client-id","issuerURL":"https://test.local","signingAlgs":["RS256","notValid"]`
@@ -1142,6 +1220,7 @@ func TestProvision_Provision(t *testing.T) {
 			"request rejected, your globalAccountId is not whitelisted",
 			logrus.StandardLogger(),
 			dashboardConfig,
+			nil,
 		)
 
 		oidcParams := `"clientID":"client-id","issuerURL":"https://test.local","signingAlgs":["RS256"]`
@@ -1192,6 +1271,7 @@ func TestProvision_Provision(t *testing.T) {
 			"request rejected, your globalAccountId is not whitelisted",
 			logrus.StandardLogger(),
 			dashboardConfig,
+			nil,
 		)
 
 		oidcParams := `"clientID":"client-id","issuerURL":"https://test.local","signingAlgs":["RS256"]`
@@ -1296,6 +1376,7 @@ func TestRegionValidation(t *testing.T) {
 				"request rejected, your globalAccountId is not whitelisted",
 				logrus.StandardLogger(),
 				dashboardConfig,
+				nil,
 			)
 
 			// when
@@ -65,6 +65,7 @@ func TestGetEndpoint_GetProvisioningInstance(t *testing.T) {
 		"request rejected, your globalAccountId is not whitelisted",
 		logrus.StandardLogger(),
 		dashboardConfig,
+		nil,
 	)
 	getSvc := broker.NewGetInstance(broker.Config{EnableKubeconfigURLLabel: true}, st.Instances(), st.Operations(), logrus.New())
 
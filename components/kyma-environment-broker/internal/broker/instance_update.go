@@ -47,6 +47,8 @@ type UpdateEndpoint struct {
 	planDefaults PlanDefaults
 
 	dashboardConfig dashboard.Config
+
+	quotas QuotaChecker
 }
 
 func NewUpdate(cfg Config,
@@ -61,6 +63,7 @@ func NewUpdate(cfg Config,
 	planDefaults PlanDefaults,
 	log logrus.FieldLogger,
 	dashboardConfig dashboard.Config,
+	quotas QuotaChecker,
 ) *UpdateEndpoint {
 	return &UpdateEndpoint{
 		config:                    cfg,
@@ -75,6 +78,7 @@ func NewUpdate(cfg Config,
 		plansConfig:               plansConfig,
 		planDefaults:              planDefaults,
 		dashboardConfig:           dashboardConfig,
+		quotas:                    quotas,
 	}
 }
 
@@ -147,6 +151,14 @@ func (b *UpdateEndpoint) Update(_ context.Context, instanceID string, details do
 	}
 
 	if b.processingEnabled {
+		// the instance counts against the quota of the global account it is moved to
+		if b.subAccountMovementEnabled && ersContext.GlobalAccountID != "" && ersContext.GlobalAccountID != instance.GlobalAccountID {
+			err := checkQuota(b.quotas, ersContext.GlobalAccountID, instance.ServicePlanID, instance.Parameters.PlatformRegion, "update", logger)
+			if err != nil {
+				return domain.UpdateServiceSpec{}, err
+			}
+		}
+
 		instance, suspendStatusChange, err := b.processContext(instance, details, lastProvisioningOperation, logger)
 		if err != nil {
 			return domain.UpdateServiceSpec{}, err
@@ -209,21 +221,15 @@ func (b *UpdateEndpoint) processUpdateParameters(instance *internal.Instance, de
 	if !asyncAllowed {
 		return domain.UpdateServiceSpec{}, apiresponses.ErrAsyncRequired
 	}
-	var params internal.UpdatingParametersDTO
-	if len(details.RawParameters) != 0 {
-		err := json.Unmarshal(details.RawParameters, &params)
-		if err != nil {
-			logger.Errorf("unable to unmarshal parameters: %s", err.Error())
-			return domain.UpdateServiceSpec{}, fmt.Errorf("unable to unmarshal parameters")
-		}
-		logger.Debugf("Updating with params: %+v", params)
+	params, err := b.extractUpdatingParameters(details, logger)
+	if err != nil {
+		return domain.UpdateServiceSpec{}, err
 	}
-
-	if params.OIDC.IsProvided() {
-		if err := params.OIDC.Validate(); err != nil {
-			logger.Errorf("invalid OIDC parameters: %s", err.Error())
-			return domain.UpdateServiceSpec{}, apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, err.Error())
-		}
+	if err := validateHibernationParameters(instance, params); err != nil {
+		return domain.UpdateServiceSpec{}, err
+	}
+	if err := validateHibernatedRuntimeUpdate(instance, params); err != nil {
+		return domain.UpdateServiceSpec{}, err
 	}
 
 	operationID := uuid.New().String()
@@ -231,23 +237,8 @@ func (b *UpdateEndpoint) processUpdateParameters(instance *internal.Instance, de
 
 	logger.Debugf("creating update operation %v", params)
 	operation := internal.NewUpdateOperation(operationID, instance, params)
-	planID := instance.Parameters.PlanID
-	if len(details.PlanID) != 0 {
-		planID = details.PlanID
-	}
-	defaults, err := b.planDefaults(planID, instance.Provider, &instance.Provider)
-	if err != nil {
-		logger.Errorf("unable to obtain plan defaults: %s", err.Error())
-		return domain.UpdateServiceSpec{}, fmt.Errorf("unable to obtain plan defaults")
-	}
-	var autoscalerMin, autoscalerMax int
-	if defaults.GardenerConfig != nil {
-		p := defaults.GardenerConfig
-		autoscalerMin, autoscalerMax = p.AutoScalerMin, p.AutoScalerMax
-	}
-	if err := operation.ProvisioningParameters.Parameters.AutoScalerParameters.Validate(autoscalerMin, autoscalerMax); err != nil {
-		logger.Errorf("invalid autoscaler parameters: %s", err.Error())
-		return domain.UpdateServiceSpec{}, apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, err.Error())
+	if err := b.validateAutoScalerParameters(instance, details, operation, logger); err != nil {
+		return domain.UpdateServiceSpec{}, err
 	}
 	err = b.operationStorage.InsertOperation(operation)
 	if err != nil {
@@ -270,6 +261,9 @@ func (b *UpdateEndpoint) processUpdateParameters(instance *internal.Instance, de
 	if params.UpdateAutoScaler(&instance.Parameters.Parameters) {
 		updateStorage = append(updateStorage, "Auto Scaler parameters")
 	}
+	if params.UpdateHibernationSchedules(&instance.Parameters.Parameters) {
+		updateStorage = append(updateStorage, "Hibernation schedules")
+	}
 	if params.MachineType != nil && *params.MachineType != "" {
 		instance.Parameters.Parameters.MachineType = params.MachineType
 	}
@@ -300,6 +294,118 @@ func (b *UpdateEndpoint) processUpdateParameters(instance *internal.Instance, de
 	}, nil
 }
 
+// PreviewOperation validates the update request and returns the operation which would be created for it.
+// Nothing is persisted and no operation is queued.
+func (b *UpdateEndpoint) PreviewOperation(instanceID string, details domain.UpdateDetails) (internal.Operation, error) {
+	logger := b.log.WithFields(logrus.Fields{"instanceID": instanceID, "preview": true})
+
+	instance, err := b.instanceStorage.GetByID(instanceID)
+	if err != nil && dberr.IsNotFound(err) {
+		return internal.Operation{}, apiresponses.NewFailureResponse(err, http.StatusNotFound, fmt.Sprintf("could not preview update for instanceID %s", instanceID))
+	} else if err != nil {
+		logger.Errorf("unable to get instance: %s", err.Error())
+		return internal.Operation{}, fmt.Errorf("unable to get instance")
+	}
+
+	if err := b.validateWithJsonSchemaValidator(details, instance); err != nil {
+		return internal.Operation{}, apiresponses.NewFailureResponse(err, http.StatusBadRequest, err.Error())
+	}
+	params, err := b.extractUpdatingParameters(details, logger)
+	if err != nil {
+		return internal.Operation{}, err
+	}
+	if err := validateHibernationParameters(instance, params); err != nil {
+		return internal.Operation{}, err
+	}
+	if err := validateHibernatedRuntimeUpdate(instance, params); err != nil {
+		return internal.Operation{}, err
+	}
+
+	operation := internal.NewUpdateOperation(uuid.New().String(), instance, params)
+	if err := b.validateAutoScalerParameters(instance, details, operation, logger); err != nil {
+		return internal.Operation{}, err
+	}
+	if operation.RuntimeID == "" {
+		operation.RuntimeID = instance.RuntimeID
+	}
+
+	return operation, nil
+}
+
+func (b *UpdateEndpoint) extractUpdatingParameters(details domain.UpdateDetails, logger logrus.FieldLogger) (internal.UpdatingParametersDTO, error) {
+	var params internal.UpdatingParametersDTO
+	if len(details.RawParameters) != 0 {
+		err := json.Unmarshal(details.RawParameters, &params)
+		if err != nil {
+			logger.Errorf("unable to unmarshal parameters: %s", err.Error())
+			return params, fmt.Errorf("unable to unmarshal parameters")
+		}
+		logger.Debugf("Updating with params: %+v", params)
+	}
+
+	if params.OIDC.IsProvided() {
+		if err := params.OIDC.Validate(); err != nil {
+			logger.Errorf("invalid OIDC parameters: %s", err.Error())
+			return params, apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, err.Error())
+		}
+	}
+
+	for _, schedule := range params.HibernationSchedules {
+		if err := schedule.Validate(); err != nil {
+			logger.Errorf("invalid hibernation schedule: %s", err.Error())
+			return params, apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, err.Error())
+		}
+	}
+
+	return params, nil
+}
+
+func validateHibernationParameters(instance *internal.Instance, params internal.UpdatingParametersDTO) error {
+	if params.Hibernated == nil && params.HibernationSchedules == nil {
+		return nil
+	}
+	if !IsHibernationSupportedPlan(instance.ServicePlanID) {
+		err := fmt.Errorf("hibernation is not supported for the %s plan", instance.ServicePlanName)
+		return apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, err.Error())
+	}
+	return nil
+}
+
+// validateHibernatedRuntimeUpdate rejects the updates of a hibernated runtime which would change the cluster,
+// the runtime must be woken up first, in the same or in a previous update
+func validateHibernatedRuntimeUpdate(instance *internal.Instance, params internal.UpdatingParametersDTO) error {
+	if !instance.IsHibernated() || params.HibernationOnly() {
+		return nil
+	}
+	if params.Hibernated != nil && !*params.Hibernated {
+		return nil
+	}
+	err := fmt.Errorf("the runtime is hibernated, wake it up to change parameters other than the hibernation")
+	return apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, err.Error())
+}
+
+func (b *UpdateEndpoint) validateAutoScalerParameters(instance *internal.Instance, details domain.UpdateDetails, operation internal.Operation, logger logrus.FieldLogger) error {
+	planID := instance.Parameters.PlanID
+	if len(details.PlanID) != 0 {
+		planID = details.PlanID
+	}
+	defaults, err := b.planDefaults(planID, instance.Provider, &instance.Provider)
+	if err != nil {
+		logger.Errorf("unable to obtain plan defaults: %s", err.Error())
+		return fmt.Errorf("unable to obtain plan defaults")
+	}
+	var autoscalerMin, autoscalerMax int
+	if defaults.GardenerConfig != nil {
+		p := defaults.GardenerConfig
+		autoscalerMin, autoscalerMax = p.AutoScalerMin, p.AutoScalerMax
+	}
+	if err := operation.ProvisioningParameters.Parameters.AutoScalerParameters.Validate(autoscalerMin, autoscalerMax); err != nil {
+		logger.Errorf("invalid autoscaler parameters: %s", err.Error())
+		return apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, err.Error())
+	}
+	return nil
+}
+
 func (b *UpdateEndpoint) processContext(instance *internal.Instance, details domain.UpdateDetails, lastProvisioningOperation *internal.ProvisioningOperation, logger logrus.FieldLogger) (*internal.Instance, bool, error) {
 	var ersContext internal.ERSContext
 	err := json.Unmarshal(details.RawContext, &ersContext)
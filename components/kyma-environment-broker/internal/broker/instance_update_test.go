@@ -78,7 +78,8 @@ func TestUpdateEndpoint_UpdateSuspension(t *testing.T) {
 		PlansConfig{},
 		planDefaults,
 		logrus.New(),
-		dashboardConfig)
+		dashboardConfig,
+		nil)
 
 	// when
 	response, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{
@@ -128,7 +129,7 @@ func TestUpdateEndpoint_UpdateExpirationOfTrial(t *testing.T) {
 		return &gqlschema.ClusterConfigInput{}, nil
 	}
 	svc := NewUpdate(Config{}, st.Instances(), st.RuntimeStates(), st.Operations(), handler, true, false, q, PlansConfig{},
-		planDefaults, logrus.New(), dashboardConfig)
+		planDefaults, logrus.New(), dashboardConfig, nil)
 
 	// when
 	response, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{
@@ -182,7 +183,7 @@ func TestUpdateEndpoint_UpdateExpirationOfExpiredTrial(t *testing.T) {
 		return &gqlschema.ClusterConfigInput{}, nil
 	}
 	svc := NewUpdate(Config{}, st.Instances(), st.RuntimeStates(), st.Operations(), handler, true, false, q, PlansConfig{},
-		planDefaults, logrus.New(), dashboardConfig)
+		planDefaults, logrus.New(), dashboardConfig, nil)
 
 	// when
 	response, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{
@@ -235,7 +236,7 @@ func TestUpdateEndpoint_UpdateOfExpiredTrial(t *testing.T) {
 		return &gqlschema.ClusterConfigInput{}, nil
 	}
 	svc := NewUpdate(Config{}, st.Instances(), st.RuntimeStates(), st.Operations(), handler, true, false, q, PlansConfig{},
-		planDefaults, logrus.New(), dashboardConfig)
+		planDefaults, logrus.New(), dashboardConfig, nil)
 
 	// when
 	response, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{
@@ -278,7 +279,7 @@ func TestUpdateEndpoint_UpdateAutoscalerParams(t *testing.T) {
 		return &gqlschema.ClusterConfigInput{}, nil
 	}
 	svc := NewUpdate(Config{}, st.Instances(), st.RuntimeStates(), st.Operations(), handler, true, false, q, PlansConfig{},
-		planDefaults, logrus.New(), dashboardConfig)
+		planDefaults, logrus.New(), dashboardConfig, nil)
 
 	t.Run("Should fail on invalid (too low) autoScalerMin and autoScalerMax", func(t *testing.T) {
 
@@ -332,6 +333,162 @@ func TestUpdateEndpoint_UpdateAutoscalerParams(t *testing.T) {
 	})
 }
 
+func TestUpdateEndpoint_UpdateHibernationParams(t *testing.T) {
+	// given
+	fixInstance := func(planID string) internal.Instance {
+		return internal.Instance{
+			InstanceID:    instanceID,
+			ServicePlanID: planID,
+			Parameters: internal.ProvisioningParameters{
+				PlanID: planID,
+				ErsContext: internal.ERSContext{
+					Active: ptr.Bool(true),
+				},
+			},
+		}
+	}
+	planDefaults := func(planID string, platformProvider internal.CloudProvider, provider *internal.CloudProvider) (*gqlschema.ClusterConfigInput, error) {
+		return &gqlschema.ClusterConfigInput{}, nil
+	}
+
+	t.Run("Should store hibernation parameters", func(t *testing.T) {
+		// given
+		st := storage.NewMemoryStorage()
+		st.Instances().Insert(fixInstance(AWSPlanID))
+		st.Operations().InsertProvisioningOperation(fixProvisioningOperation("01"))
+		q := &automock.Queue{}
+		q.On("Add", mock.AnythingOfType("string"))
+		svc := NewUpdate(Config{}, st.Instances(), st.RuntimeStates(), st.Operations(), &handler{}, true, false, q, PlansConfig{},
+			planDefaults, logrus.New(), dashboardConfig, nil)
+
+		// when
+		response, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{
+			PlanID:        AWSPlanID,
+			RawParameters: json.RawMessage(`{"hibernated": true, "hibernationSchedules": [{"start": "00 20 * * 1-5", "end": "00 08 * * 1-5", "location": "Europe/Berlin"}]}`),
+			RawContext:    json.RawMessage("{\"active\":true}"),
+		}, true)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, response.IsAsync)
+
+		operation, err := st.Operations().GetOperationByID(response.OperationData)
+		require.NoError(t, err)
+		assert.True(t, *operation.UpdatingParameters.Hibernated)
+
+		instance, err := st.Instances().GetByID(instanceID)
+		require.NoError(t, err)
+		assert.Nil(t, instance.Parameters.Parameters.Hibernated, "hibernation state must be stored only after the runtime is hibernated")
+		assert.Equal(t, []internal.HibernationScheduleDTO{{Start: "00 20 * * 1-5", End: "00 08 * * 1-5", Location: "Europe/Berlin"}}, instance.Parameters.Parameters.HibernationSchedules)
+	})
+
+	t.Run("Should fail on invalid hibernation schedule", func(t *testing.T) {
+		// given
+		st := storage.NewMemoryStorage()
+		st.Instances().Insert(fixInstance(AWSPlanID))
+		st.Operations().InsertProvisioningOperation(fixProvisioningOperation("01"))
+		svc := NewUpdate(Config{}, st.Instances(), st.RuntimeStates(), st.Operations(), &handler{}, true, false, &automock.Queue{}, PlansConfig{},
+			planDefaults, logrus.New(), dashboardConfig, nil)
+
+		// when
+		_, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{
+			PlanID:        AWSPlanID,
+			RawParameters: json.RawMessage(`{"hibernationSchedules": [{"start": "every evening", "location": "Mars/Olympus"}]}`),
+			RawContext:    json.RawMessage("{\"active\":true}"),
+		}, true)
+
+		// then
+		require.Error(t, err)
+		apierr, ok := err.(*apiresponses.FailureResponse)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusUnprocessableEntity, apierr.ValidatedStatusCode(nil))
+		assert.ErrorContains(t, err, "start must be a valid cron expression")
+		assert.ErrorContains(t, err, "location must be a valid time zone")
+	})
+
+	t.Run("Should fail on hibernation of a plan without hibernation support", func(t *testing.T) {
+		// given
+		st := storage.NewMemoryStorage()
+		st.Instances().Insert(fixInstance(TrialPlanID))
+		st.Operations().InsertProvisioningOperation(fixProvisioningOperation("01"))
+		svc := NewUpdate(Config{}, st.Instances(), st.RuntimeStates(), st.Operations(), &handler{}, true, false, &automock.Queue{}, PlansConfig{},
+			planDefaults, logrus.New(), dashboardConfig, nil)
+
+		// when
+		_, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{
+			PlanID:        TrialPlanID,
+			RawParameters: json.RawMessage(`{"hibernated": true}`),
+			RawContext:    json.RawMessage("{\"active\":true}"),
+		}, true)
+
+		// then
+		require.Error(t, err)
+		apierr, ok := err.(*apiresponses.FailureResponse)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusUnprocessableEntity, apierr.ValidatedStatusCode(nil))
+	})
+}
+
+func TestUpdateEndpoint_UpdateHibernatedRuntime(t *testing.T) {
+	// given
+	planDefaults := func(planID string, platformProvider internal.CloudProvider, provider *internal.CloudProvider) (*gqlschema.ClusterConfigInput, error) {
+		return &gqlschema.ClusterConfigInput{}, nil
+	}
+
+	for name, tc := range map[string]struct {
+		parameters string
+		accepted   bool
+	}{
+		"should reject changes of a hibernated runtime": {
+			parameters: `{"administrators": ["newAdmin1@kyma.cx"]}`,
+		},
+		"should accept changes together with the wake-up": {
+			parameters: `{"hibernated": false, "administrators": ["newAdmin1@kyma.cx"]}`,
+			accepted:   true,
+		},
+		"should accept changes of the hibernation schedules": {
+			parameters: `{"hibernationSchedules": []}`,
+			accepted:   true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			st := storage.NewMemoryStorage()
+			st.Instances().Insert(internal.Instance{
+				InstanceID:    instanceID,
+				ServicePlanID: AWSPlanID,
+				Parameters: internal.ProvisioningParameters{
+					PlanID:     AWSPlanID,
+					ErsContext: internal.ERSContext{Active: ptr.Bool(true)},
+					Parameters: internal.ProvisioningParametersDTO{Hibernated: ptr.Bool(true)},
+				},
+			})
+			st.Operations().InsertProvisioningOperation(fixProvisioningOperation("01"))
+			q := &automock.Queue{}
+			q.On("Add", mock.AnythingOfType("string"))
+			svc := NewUpdate(Config{}, st.Instances(), st.RuntimeStates(), st.Operations(), &handler{}, true, false, q, PlansConfig{},
+				planDefaults, logrus.New(), dashboardConfig, nil)
+
+			// when
+			response, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{
+				PlanID:        AWSPlanID,
+				RawParameters: json.RawMessage(tc.parameters),
+				RawContext:    json.RawMessage("{\"active\":true}"),
+			}, true)
+
+			// then
+			if tc.accepted {
+				require.NoError(t, err)
+				assert.True(t, response.IsAsync)
+				return
+			}
+			require.Error(t, err)
+			apierr, ok := err.(*apiresponses.FailureResponse)
+			require.True(t, ok)
+			assert.Equal(t, http.StatusUnprocessableEntity, apierr.ValidatedStatusCode(nil))
+		})
+	}
+}
+
 func TestUpdateEndpoint_UpdateUnsuspension(t *testing.T) {
 	// given
 	instance := internal.Instance{
@@ -359,7 +516,7 @@ func TestUpdateEndpoint_UpdateUnsuspension(t *testing.T) {
 		return &gqlschema.ClusterConfigInput{}, nil
 	}
 	svc := NewUpdate(Config{}, st.Instances(), st.RuntimeStates(), st.Operations(), handler, true, false, q, PlansConfig{},
-		planDefaults, logrus.New(), dashboardConfig)
+		planDefaults, logrus.New(), dashboardConfig, nil)
 
 	// when
 	svc.Update(context.Background(), instanceID, domain.UpdateDetails{
@@ -406,7 +563,7 @@ func TestUpdateEndpoint_UpdateInstanceWithWrongActiveValue(t *testing.T) {
 		return &gqlschema.ClusterConfigInput{}, nil
 	}
 	svc := NewUpdate(Config{}, st.Instances(), st.RuntimeStates(), st.Operations(), handler, true, false, q, PlansConfig{},
-		planDefaults, logrus.New(), dashboardConfig)
+		planDefaults, logrus.New(), dashboardConfig, nil)
 
 	// when
 	svc.Update(context.Background(), instanceID, domain.UpdateDetails{
@@ -436,7 +593,7 @@ func TestUpdateEndpoint_UpdateNonExistingInstance(t *testing.T) {
 		return &gqlschema.ClusterConfigInput{}, nil
 	}
 	svc := NewUpdate(Config{}, st.Instances(), st.RuntimeStates(), st.Operations(), handler, true, false, q, PlansConfig{},
-		planDefaults, logrus.New(), dashboardConfig)
+		planDefaults, logrus.New(), dashboardConfig, nil)
 
 	// when
 	_, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{
@@ -497,7 +654,7 @@ func TestUpdateEndpoint_UpdateGlobalAccountID(t *testing.T) {
 		return &gqlschema.ClusterConfigInput{}, nil
 	}
 	svc := NewUpdate(Config{}, st.Instances(), st.RuntimeStates(), st.Operations(), handler, true, true, q, PlansConfig{},
-		planDefaults, logrus.New(), dashboardConfig)
+		planDefaults, logrus.New(), dashboardConfig, nil)
 
 	// when
 	response, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{
@@ -539,7 +696,7 @@ func TestUpdateEndpoint_UpdateParameters(t *testing.T) {
 	}
 
 	svc := NewUpdate(Config{}, st.Instances(), st.RuntimeStates(), st.Operations(), handler, true, true, q, PlansConfig{},
-		planDefaults, logrus.New(), dashboardConfig)
+		planDefaults, logrus.New(), dashboardConfig, nil)
 
 	t.Run("Should fail on invalid OIDC params", func(t *testing.T) {
 		// given
@@ -667,7 +824,7 @@ func TestUpdateEndpoint_UpdateWithEnabledDashboard(t *testing.T) {
 		return &gqlschema.ClusterConfigInput{}, nil
 	}
 	svc := NewUpdate(Config{}, st.Instances(), st.RuntimeStates(), st.Operations(), handler, true, false, q, PlansConfig{},
-		planDefaults, logrus.New(), dashboardConfig)
+		planDefaults, logrus.New(), dashboardConfig, nil)
 
 	// when
 	response, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{
@@ -127,6 +127,9 @@ func GCPSchema(machineTypesDisplay map[string]string, machineTypes []string, add
 	properties := NewProvisioningProperties(machineTypesDisplay, machineTypes, GCPRegions(), update)
 	properties.AutoScalerMax.Minimum = 3
 	properties.AutoScalerMin.Minimum = 3
+	if update {
+		properties.IncludeHibernation()
+	}
 	return createSchemaWithProperties(properties, additionalParams, update)
 }
 
@@ -134,6 +137,9 @@ func AWSSchema(machineTypesDisplay map[string]string, machineTypes []string, add
 	properties := NewProvisioningProperties(machineTypesDisplay, machineTypes, AWSRegions(euAccessRestricted), update)
 	properties.AutoScalerMax.Minimum = 3
 	properties.AutoScalerMin.Minimum = 3
+	if update {
+		properties.IncludeHibernation()
+	}
 	return createSchemaWithProperties(properties, additionalParams, update)
 }
 
@@ -141,6 +147,9 @@ func AzureSchema(machineTypesDisplay map[string]string, machineTypes []string, a
 	properties := NewProvisioningProperties(machineTypesDisplay, machineTypes, AzureRegions(euAccessRestricted), update)
 	properties.AutoScalerMax.Minimum = 3
 	properties.AutoScalerMin.Minimum = 3
+	if update {
+		properties.IncludeHibernation()
+	}
 	return createSchemaWithProperties(properties, additionalParams, update)
 }
 
@@ -423,6 +432,16 @@ func IsFreemiumPlan(planID string) bool {
 	}
 }
 
+// IsHibernationSupportedPlan returns true for plans which runtimes can be hibernated and woken up on update
+func IsHibernationSupportedPlan(planID string) bool {
+	switch planID {
+	case AWSPlanID, AzurePlanID, GCPPlanID:
+		return true
+	default:
+		return false
+	}
+}
+
 func IsOwnClusterPlan(planID string) bool {
 	return planID == OwnClusterPlanID
 }
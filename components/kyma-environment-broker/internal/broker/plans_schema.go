@@ -30,6 +30,9 @@ type UpdateProperties struct {
 	OIDC           *OIDCType `json:"oidc,omitempty"`
 	Administrators *Type     `json:"administrators,omitempty"`
 	MachineType    *Type     `json:"machineType,omitempty"`
+
+	Hibernated           *Type                     `json:"hibernated,omitempty"`
+	HibernationSchedules *HibernationSchedulesType `json:"hibernationSchedules,omitempty"`
 }
 
 func (up *UpdateProperties) IncludeAdditional() {
@@ -37,6 +40,27 @@ func (up *UpdateProperties) IncludeAdditional() {
 	up.Administrators = AdministratorsProperty()
 }
 
+func (up *UpdateProperties) IncludeHibernation() {
+	up.Hibernated = HibernatedProperty()
+	up.HibernationSchedules = NewHibernationSchedulesSchema()
+}
+
+type HibernationScheduleProperties struct {
+	Start    Type `json:"start"`
+	End      Type `json:"end"`
+	Location Type `json:"location"`
+}
+
+type HibernationScheduleType struct {
+	Type
+	Properties HibernationScheduleProperties `json:"properties"`
+}
+
+type HibernationSchedulesType struct {
+	Type
+	Items HibernationScheduleType `json:"items"`
+}
+
 type OIDCProperties struct {
 	ClientID       Type `json:"clientID"`
 	GroupsClaim    Type `json:"groupsClaim"`
@@ -218,7 +242,7 @@ func unmarshalOrPanic(from, to interface{}) interface{} {
 }
 
 func DefaultControlsOrder() []string {
-	return []string{"name", "kubeconfig", "shootName", "shootDomain", "region", "machineType", "autoScalerMin", "autoScalerMax", "zonesCount", "oidc", "administrators"}
+	return []string{"name", "kubeconfig", "shootName", "shootDomain", "region", "machineType", "autoScalerMin", "autoScalerMax", "zonesCount", "oidc", "administrators", "hibernated", "hibernationSchedules"}
 }
 
 func ToInterfaceSlice(input []string) []interface{} {
@@ -239,3 +263,29 @@ func AdministratorsProperty() *Type {
 		},
 	}
 }
+
+func HibernatedProperty() *Type {
+	return &Type{
+		Type:        "boolean",
+		Title:       "Hibernated",
+		Description: "Hibernates the cluster keeping its state or wakes it up",
+	}
+}
+
+func NewHibernationSchedulesSchema() *HibernationSchedulesType {
+	return &HibernationSchedulesType{
+		Type: Type{
+			Type:        "array",
+			Title:       "Hibernation schedules",
+			Description: "Specifies when the cluster is hibernated and woken up, for example, for nights and weekends",
+		},
+		Items: HibernationScheduleType{
+			Type: Type{Type: "object"},
+			Properties: HibernationScheduleProperties{
+				Start:    Type{Type: "string", Description: "Cron expression defining when the cluster is hibernated, for example, 00 20 * * 1-5"},
+				End:      Type{Type: "string", Description: "Cron expression defining when the cluster is woken up, for example, 00 08 * * 1-5"},
+				Location: Type{Type: "string", Description: "Time zone in which the cron expressions are evaluated, for example, Europe/Berlin. UTC is used if not provided."},
+			},
+		},
+	}
+}
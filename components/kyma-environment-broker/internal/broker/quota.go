@@ -0,0 +1,30 @@
+package broker
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/quota"
+	"github.com/pivotal-cf/brokerapi/v8/domain/apiresponses"
+	"github.com/sirupsen/logrus"
+)
+
+// checkQuota rejects the request with an OSB failure response if the global account reached its quota
+// for instances of the plan in the platform region. No quotas are enforced if the checker is nil.
+func checkQuota(quotas QuotaChecker, globalAccountID, planID, platformRegion, action string, logger logrus.FieldLogger) error {
+	if quotas == nil {
+		return nil
+	}
+	err := quotas.Check(globalAccountID, PlanNamesMapping[planID], platformRegion)
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, quota.ErrQuotaExceeded):
+		logger.Infof("Request rejected: %s", err)
+		return apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, action)
+	default:
+		logger.Errorf("unable to check quota: %s", err)
+		return fmt.Errorf("unable to check quota")
+	}
+}
@@ -69,8 +69,9 @@ func (b *ServicesEndpoint) Services(ctx context.Context) ([]domain.Service, erro
 			ID:                   KymaServiceID,
 			Name:                 KymaServiceName,
 			Description:          class.Description,
-			Bindable:             false,
+			Bindable:             b.cfg.Binding.Enabled,
 			InstancesRetrievable: true,
+			BindingsRetrievable:  b.cfg.Binding.Enabled,
 			Tags: []string{
 				"SAP",
 				"Kyma",
@@ -1,10 +1,14 @@
 package internal
 
 import (
+	"errors"
 	"fmt"
 	"net/url"
 	"reflect"
 	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
 )
 
 const (
@@ -156,6 +160,41 @@ func (p AutoScalerParameters) Validate(planMin, planMax int) error {
 	return nil
 }
 
+// HibernationScheduleDTO defines when the runtime is hibernated and woken up.
+// Start and End are standard cron expressions evaluated in the Location time zone, UTC if not set.
+type HibernationScheduleDTO struct {
+	Start    string `json:"start,omitempty" yaml:"start,omitempty"`
+	End      string `json:"end,omitempty" yaml:"end,omitempty"`
+	Location string `json:"location,omitempty" yaml:"location,omitempty"`
+}
+
+func (h HibernationScheduleDTO) Validate() error {
+	errs := make([]string, 0)
+	if h.Start == "" && h.End == "" {
+		errs = append(errs, "start or end must be provided")
+	}
+	if h.Start != "" {
+		if _, err := cron.ParseStandard(h.Start); err != nil {
+			errs = append(errs, fmt.Sprintf("start must be a valid cron expression: %s", err))
+		}
+	}
+	if h.End != "" {
+		if _, err := cron.ParseStandard(h.End); err != nil {
+			errs = append(errs, fmt.Sprintf("end must be a valid cron expression: %s", err))
+		}
+	}
+	if h.Location != "" {
+		if _, err := time.LoadLocation(h.Location); err != nil {
+			errs = append(errs, "location must be a valid time zone")
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, ", "))
+	}
+	return nil
+}
+
 type ProvisioningParametersDTO struct {
 	AutoScalerParameters `json:",inline"`
 
@@ -182,6 +221,10 @@ type ProvisioningParametersDTO struct {
 	ShootDomain string `json:"shootDomain,omitempty"`
 
 	OIDC *OIDCConfigDTO `json:"oidc,omitempty"`
+
+	// Hibernated - the hibernation state of the runtime, stored when its hibernation or wake-up succeeded
+	Hibernated           *bool                    `json:"hibernated,omitempty"`
+	HibernationSchedules []HibernationScheduleDTO `json:"hibernationSchedules,omitempty"`
 }
 
 type UpdatingParametersDTO struct {
@@ -191,10 +234,34 @@ type UpdatingParametersDTO struct {
 	RuntimeAdministrators []string       `json:"administrators,omitempty"`
 	MachineType           *string        `json:"machineType,omitempty"`
 
+	// Hibernated - hibernates (true) or wakes up (false) the runtime, not changed when not set
+	Hibernated *bool `json:"hibernated,omitempty"`
+	// HibernationSchedules - replaces the hibernation schedules of the runtime, an empty list removes them
+	HibernationSchedules []HibernationScheduleDTO `json:"hibernationSchedules"`
+
 	// Expired - means that the trial SKR is marked as expired
 	Expired bool `json:"expired"`
 }
 
+// HibernationOnly returns true if the update changes the hibernation parameters and nothing else
+func (u UpdatingParametersDTO) HibernationOnly() bool {
+	if u.Hibernated == nil && u.HibernationSchedules == nil {
+		return false
+	}
+	u.Hibernated, u.HibernationSchedules = nil, nil
+	return reflect.DeepEqual(u, UpdatingParametersDTO{})
+}
+
+// UpdateHibernationSchedules copies the hibernation schedules to the provisioning parameters and returns true if they were provided.
+// The hibernation state is not copied, it is stored only after the runtime was hibernated or woken up.
+func (u UpdatingParametersDTO) UpdateHibernationSchedules(p *ProvisioningParametersDTO) bool {
+	if u.HibernationSchedules == nil {
+		return false
+	}
+	p.HibernationSchedules = append([]HibernationScheduleDTO{}, u.HibernationSchedules...)
+	return true
+}
+
 func (u UpdatingParametersDTO) UpdateAutoScaler(p *ProvisioningParametersDTO) bool {
 	updated := false
 	if u.AutoScalerMin != nil {
@@ -18,23 +18,40 @@ type Subscriber interface {
 	Subscribe(evType interface{}, evHandler Handler)
 }
 
+// SyncSubscriber calls the handlers in the goroutine of the publisher, so they receive the events in the order
+// in which they were published. Such handlers must not block.
+type SyncSubscriber interface {
+	SubscribeSync(evType interface{}, evHandler Handler)
+}
+
 // PubSub implements a simple event broker which allows to send event across the application.
 type PubSub struct {
 	mu  sync.Mutex
 	log logrus.FieldLogger
 
-	handlers map[reflect.Type][]Handler
+	handlers     map[reflect.Type][]Handler
+	syncHandlers map[reflect.Type][]Handler
 }
 
 func NewPubSub(log logrus.FieldLogger) *PubSub {
 	return &PubSub{
-		log:      log,
-		handlers: make(map[reflect.Type][]Handler),
+		log:          log,
+		handlers:     make(map[reflect.Type][]Handler),
+		syncHandlers: make(map[reflect.Type][]Handler),
 	}
 }
 
 func (b *PubSub) Publish(ctx context.Context, ev interface{}) {
 	tt := reflect.TypeOf(ev)
+	b.mu.Lock()
+	syncHandlers := b.syncHandlers[tt]
+	b.mu.Unlock()
+	for _, handler := range syncHandlers {
+		if err := handler(ctx, ev); err != nil {
+			b.log.Errorf("error while calling pubsub event handler: %s", err.Error())
+		}
+	}
+
 	hList, found := b.handlers[tt]
 	if found {
 		for _, handler := range hList {
@@ -59,3 +76,11 @@ func (b *PubSub) Subscribe(evType interface{}, evHandler Handler) {
 
 	b.handlers[tt] = append(b.handlers[tt], evHandler)
 }
+
+func (b *PubSub) SubscribeSync(evType interface{}, evHandler Handler) {
+	tt := reflect.TypeOf(evType)
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.syncHandlers[tt] = append(b.syncHandlers[tt], evHandler)
+}
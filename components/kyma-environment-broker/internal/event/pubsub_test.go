@@ -61,6 +61,28 @@ func TestPubSub(t *testing.T) {
 	}))
 }
 
+func TestPubSub_SubscribeSync(t *testing.T) {
+	// given
+	var got []string
+	handler := func(ctx context.Context, ev interface{}) error {
+		got = append(got, ev.(eventA).msg)
+		return nil
+	}
+	svc := event.NewPubSub(logrus.New())
+	svc.SubscribeSync(eventA{}, handler)
+
+	// when
+	for i := 0; i < 10; i++ {
+		svc.Publish(context.TODO(), eventA{msg: fmt.Sprintf("event %d", i)})
+	}
+
+	// then
+	require.Len(t, got, 10)
+	for i, msg := range got {
+		assert.Equal(t, fmt.Sprintf("event %d", i), msg)
+	}
+}
+
 func TestPubSub_WhenHandlerReturnsError(t *testing.T) {
 	// given
 	logger, hook := logrusTest.NewNullLogger()
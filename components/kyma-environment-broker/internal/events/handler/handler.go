@@ -2,8 +2,12 @@ package events
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/events"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
@@ -11,12 +15,14 @@ import (
 )
 
 type Handler struct {
-	e storage.Events
-	i storage.Instances
+	e        storage.Events
+	i        storage.Instances
+	maxLimit int
 }
 
-func NewHandler(e storage.Events, i storage.Instances) Handler {
-	return Handler{e, i}
+// NewHandler creates the handler of the /events API, limit requested by clients is capped at maxLimit
+func NewHandler(e storage.Events, i storage.Instances, maxLimit int) Handler {
+	return Handler{e, i, maxLimit}
 }
 
 func split(s string) []string {
@@ -27,27 +33,103 @@ func split(s string) []string {
 }
 
 func (h Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	instanceId := r.URL.Query().Get("instance_ids")
-	instanceIds := split(instanceId)
+	filter, err := h.parseFilter(r.URL.Query())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
 	runtimeId := r.URL.Query().Get("runtime_ids")
-	operationId := r.URL.Query().Get("operation_ids")
-	operationIds := split(operationId)
 	if runtimeId != "" {
 		instances, _, _, err := h.i.List(dbmodel.InstanceFilter{RuntimeIDs: split(runtimeId)})
 		if err != nil {
 			http.Error(w, err.Error(), 503)
 			return
 		}
+		if len(instances) == 0 && len(filter.InstanceIDs) == 0 {
+			writeEvents(w, []events.EventDTO{})
+			return
+		}
 		for _, i := range instances {
-			instanceIds = append(instanceIds, i.InstanceID)
+			filter.InstanceIDs = append(filter.InstanceIDs, i.InstanceID)
 		}
 	}
-	events, err := h.e.ListEvents(events.EventFilter{InstanceIDs: instanceIds, OperationIDs: operationIds})
+
+	// one more event is requested to find out if there is a next page
+	limit := filter.Limit
+	if limit > 0 {
+		filter.Limit = limit + 1
+	}
+	result, err := h.e.ListEvents(filter)
 	if err != nil {
 		http.Error(w, err.Error(), 503)
 		return
 	}
-	bytes, err := json.Marshal(events)
+	if result == nil {
+		result = []events.EventDTO{}
+	}
+	if limit > 0 && len(result) > limit {
+		result = result[:limit]
+		w.Header().Set(events.NextCursorHeader, events.EncodeCursor(result[limit-1]))
+	}
+	writeEvents(w, result)
+}
+
+func (h Handler) parseFilter(query url.Values) (events.EventFilter, error) {
+	filter := events.EventFilter{
+		InstanceIDs:      split(query.Get("instance_ids")),
+		OperationIDs:     split(query.Get("operation_ids")),
+		GlobalAccountIDs: split(query.Get("global_account_ids")),
+		SubAccountIDs:    split(query.Get("subaccount_ids")),
+		PlanIDs:          split(query.Get("plan_ids")),
+		StepNames:        split(query.Get("step_names")),
+		Message:          query.Get("message"),
+		Cursor:           query.Get("cursor"),
+	}
+	for _, l := range split(query.Get("levels")) {
+		level := events.EventLevel(l)
+		if level != events.InfoEventLevel && level != events.ErrorEventLevel {
+			return filter, fmt.Errorf("invalid level %q, allowed values: %s, %s", l, events.InfoEventLevel, events.ErrorEventLevel)
+		}
+		filter.Levels = append(filter.Levels, level)
+	}
+
+	var err error
+	if from := query.Get("from"); from != "" {
+		if filter.From, err = time.Parse(time.RFC3339, from); err != nil {
+			return filter, fmt.Errorf("invalid from parameter: %v", err)
+		}
+	}
+	if to := query.Get("to"); to != "" {
+		if filter.To, err = time.Parse(time.RFC3339, to); err != nil {
+			return filter, fmt.Errorf("invalid to parameter: %v", err)
+		}
+	}
+	if filter.Cursor != "" {
+		if _, _, err = events.DecodeCursor(filter.Cursor); err != nil {
+			return filter, err
+		}
+	}
+	if limit := query.Get("limit"); limit != "" {
+		filter.Limit, err = strconv.Atoi(limit)
+		if err != nil || filter.Limit < 1 {
+			return filter, fmt.Errorf("invalid limit %q, must be a positive number", limit)
+		}
+		if h.maxLimit > 0 && filter.Limit > h.maxLimit {
+			filter.Limit = h.maxLimit
+		}
+	}
+	switch sort := events.SortOrder(query.Get("sort")); sort {
+	case "", events.AscendingSortOrder, events.DescendingSortOrder:
+		filter.Sort = sort
+	default:
+		return filter, fmt.Errorf("invalid sort %q, allowed values: %s, %s", sort, events.AscendingSortOrder, events.DescendingSortOrder)
+	}
+
+	return filter, nil
+}
+
+func writeEvents(w http.ResponseWriter, result []events.EventDTO) {
+	bytes, err := json.Marshal(result)
 	if err != nil {
 		http.Error(w, err.Error(), 503)
 		return
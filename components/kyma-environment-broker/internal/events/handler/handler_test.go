@@ -0,0 +1,129 @@
+package events
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/events"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/driver/memory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_SearchEvents(t *testing.T) {
+	// given
+	db := storage.NewMemoryStorage()
+	stored := memory.NewEvents()
+	insertEvent(stored, events.InfoEventLevel, "processing step: Create_Runtime", events.EventSource{InstanceID: "i-1", OperationID: "op-1", GlobalAccountID: "ga-1", PlanID: "azure", StepName: "Create_Runtime"})
+	insertEvent(stored, events.ErrorEventLevel, "step Create_Runtime processing returned error: timeout", events.EventSource{InstanceID: "i-1", OperationID: "op-1", GlobalAccountID: "ga-1", PlanID: "azure", StepName: "Create_Runtime"})
+	insertEvent(stored, events.InfoEventLevel, "operation processing succeeded", events.EventSource{InstanceID: "i-2", OperationID: "op-2", GlobalAccountID: "ga-2", SubAccountID: "sa-2", PlanID: "gcp"})
+
+	server := httptest.NewServer(NewHandler(stored, db.Instances(), 100))
+	defer server.Close()
+	client := events.NewClient(server.URL, server.Client())
+
+	for name, tc := range map[string]struct {
+		filter   events.EventFilter
+		expected []string
+	}{
+		"all events": {
+			filter:   events.EventFilter{},
+			expected: []string{"processing step: Create_Runtime", "step Create_Runtime processing returned error: timeout", "operation processing succeeded"},
+		},
+		"by level": {
+			filter:   events.EventFilter{Levels: []events.EventLevel{events.ErrorEventLevel}},
+			expected: []string{"step Create_Runtime processing returned error: timeout"},
+		},
+		"by global account and step": {
+			filter:   events.EventFilter{GlobalAccountIDs: []string{"ga-1"}, StepNames: []string{"Create_Runtime"}},
+			expected: []string{"processing step: Create_Runtime", "step Create_Runtime processing returned error: timeout"},
+		},
+		"by subaccount and plan": {
+			filter:   events.EventFilter{SubAccountIDs: []string{"sa-2"}, PlanIDs: []string{"gcp"}},
+			expected: []string{"operation processing succeeded"},
+		},
+		"by message ignoring case": {
+			filter:   events.EventFilter{Message: "TIMEOUT"},
+			expected: []string{"step Create_Runtime processing returned error: timeout"},
+		},
+		"by time range": {
+			filter:   events.EventFilter{From: time.Now().Add(time.Hour)},
+			expected: []string{},
+		},
+		"sorted descending": {
+			filter:   events.EventFilter{InstanceIDs: []string{"i-1"}, Sort: events.DescendingSortOrder},
+			expected: []string{"step Create_Runtime processing returned error: timeout", "processing step: Create_Runtime"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// when
+			page, err := client.SearchEvents(tc.filter)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, messages(page.Data))
+			assert.Empty(t, page.NextCursor)
+		})
+	}
+}
+
+func TestHandler_CursorPagination(t *testing.T) {
+	// given
+	db := storage.NewMemoryStorage()
+	stored := memory.NewEvents()
+	for _, msg := range []string{"first", "second", "third"} {
+		insertEvent(stored, events.InfoEventLevel, msg, events.EventSource{InstanceID: "i-1", OperationID: "op-1"})
+	}
+
+	server := httptest.NewServer(NewHandler(stored, db.Instances(), 100))
+	defer server.Close()
+	client := events.NewClient(server.URL, server.Client())
+
+	// when
+	page, err := client.SearchEvents(events.EventFilter{Limit: 2})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []string{"first", "second"}, messages(page.Data))
+	require.NotEmpty(t, page.NextCursor)
+
+	// when
+	page, err = client.SearchEvents(events.EventFilter{Limit: 2, Cursor: page.NextCursor})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []string{"third"}, messages(page.Data))
+	assert.Empty(t, page.NextCursor)
+}
+
+func TestHandler_InvalidParameters(t *testing.T) {
+	// given
+	db := storage.NewMemoryStorage()
+	handler := NewHandler(memory.NewEvents(), db.Instances(), 100)
+
+	for _, query := range []string{"levels=warning", "from=yesterday", "limit=0", "sort=random", "cursor=invalid"} {
+		// when
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/events?"+query, nil))
+
+		// then
+		assert.Equal(t, http.StatusBadRequest, rec.Code, query)
+	}
+}
+
+func messages(evs []events.EventDTO) []string {
+	result := make([]string, 0, len(evs))
+	for _, ev := range evs {
+		result = append(result, ev.Message)
+	}
+	return result
+}
+
+// insertEvent stores the event and waits, so the creation times of events are distinct
+func insertEvent(store storage.Events, level events.EventLevel, message string, source events.EventSource) {
+	store.InsertEvent(level, message, source)
+	time.Sleep(time.Millisecond)
+}
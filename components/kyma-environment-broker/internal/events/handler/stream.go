@@ -0,0 +1,265 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/events"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/event"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	subscriptionBufferSize = 100
+	defaultKeepAlive       = 15 * time.Second
+)
+
+// Broadcaster passes the progress of operations, reported with step processed events, to the event stream subscribers.
+// The events are handled synchronously, so the progress of an operation is streamed in the order in which it was published.
+type Broadcaster struct {
+	mu            sync.RWMutex
+	subscriptions map[*subscription]struct{}
+	log           logrus.FieldLogger
+}
+
+type subscription struct {
+	filter events.StreamFilter
+	ch     chan events.OperationProgress
+}
+
+func NewBroadcaster(sub event.SyncSubscriber, log logrus.FieldLogger) *Broadcaster {
+	b := &Broadcaster{
+		subscriptions: make(map[*subscription]struct{}),
+		log:           log,
+	}
+	sub.SubscribeSync(process.ProvisioningStepProcessed{}, b.onStepProcessed)
+	sub.SubscribeSync(process.UpdatingStepProcessed{}, b.onStepProcessed)
+	sub.SubscribeSync(process.DeprovisioningStepProcessed{}, b.onStepProcessed)
+	sub.SubscribeSync(process.UpgradeKymaStepProcessed{}, b.onStepProcessed)
+	sub.SubscribeSync(process.UpgradeClusterStepProcessed{}, b.onStepProcessed)
+	sub.SubscribeSync(process.RuntimeTaskStepProcessed{}, b.onStepProcessed)
+	sub.SubscribeSync(process.OperationStepProcessed{}, b.onStepProcessed)
+	sub.SubscribeSync(process.OperationSucceeded{}, b.onStepProcessed)
+
+	return b
+}
+
+func (b *Broadcaster) onStepProcessed(_ context.Context, ev interface{}) error {
+	var step process.StepProcessed
+	var op internal.Operation
+	switch e := ev.(type) {
+	case process.ProvisioningStepProcessed:
+		step, op = e.StepProcessed, e.Operation.Operation
+	case process.UpdatingStepProcessed:
+		step, op = e.StepProcessed, e.Operation.Operation
+	case process.DeprovisioningStepProcessed:
+		step, op = e.StepProcessed, e.Operation.Operation
+	case process.UpgradeKymaStepProcessed:
+		step, op = e.StepProcessed, e.Operation.Operation
+	case process.UpgradeClusterStepProcessed:
+		step, op = e.StepProcessed, e.Operation.Operation
+	case process.RuntimeTaskStepProcessed:
+		step, op = e.StepProcessed, e.Operation
+	case process.OperationStepProcessed:
+		step, op = e.StepProcessed, e.Operation
+	case process.OperationSucceeded:
+		op = e.Operation
+	default:
+		return fmt.Errorf("unexpected event type %T", ev)
+	}
+
+	progress := events.OperationProgress{
+		OperationID:     op.ID,
+		InstanceID:      op.InstanceID,
+		RuntimeID:       op.RuntimeID,
+		OrchestrationID: op.OrchestrationID,
+		Type:            string(op.Type),
+		State:           string(op.State),
+		Description:     op.Description,
+		StepName:        step.StepName,
+		CreatedAt:       time.Now(),
+	}
+	if step.Error != nil {
+		progress.Error = step.Error.Error()
+	}
+	b.Broadcast(progress)
+
+	return nil
+}
+
+// Broadcast sends the progress to all subscribers which filter matches it. Progress is dropped for subscribers
+// which do not keep up with reading, so a slow client cannot block processing of operations.
+func (b *Broadcaster) Broadcast(progress events.OperationProgress) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for s := range b.subscriptions {
+		if !matches(s.filter, progress) {
+			continue
+		}
+		select {
+		case s.ch <- progress:
+		default:
+			b.log.Warnf("event stream subscriber is too slow, dropping progress of operation %s", progress.OperationID)
+		}
+	}
+}
+
+func (b *Broadcaster) subscribe(filter events.StreamFilter) *subscription {
+	s := &subscription{
+		filter: filter,
+		ch:     make(chan events.OperationProgress, subscriptionBufferSize),
+	}
+	b.mu.Lock()
+	b.subscriptions[s] = struct{}{}
+	b.mu.Unlock()
+
+	return s
+}
+
+func (b *Broadcaster) unsubscribe(s *subscription) {
+	b.mu.Lock()
+	delete(b.subscriptions, s)
+	b.mu.Unlock()
+}
+
+func matches(filter events.StreamFilter, progress events.OperationProgress) bool {
+	return matchesAny(filter.InstanceIDs, progress.InstanceID) &&
+		matchesAny(filter.RuntimeIDs, progress.RuntimeID) &&
+		matchesAny(filter.OperationIDs, progress.OperationID) &&
+		matchesAny(filter.OrchestrationIDs, progress.OrchestrationID)
+}
+
+func matchesAny(values []string, value string) bool {
+	if len(values) == 0 {
+		return true
+	}
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// StreamHandler streams the progress of operations as server-sent events. Events stored for the selected
+// instances and operations are sent first, followed by the progress of the operations processed from now on.
+type StreamHandler struct {
+	broadcaster *Broadcaster
+	events      storage.Events
+	instances   storage.Instances
+	keepAlive   time.Duration
+	log         logrus.FieldLogger
+}
+
+func NewStreamHandler(broadcaster *Broadcaster, events storage.Events, instances storage.Instances, log logrus.FieldLogger) *StreamHandler {
+	return &StreamHandler{
+		broadcaster: broadcaster,
+		events:      events,
+		instances:   instances,
+		keepAlive:   defaultKeepAlive,
+		log:         log,
+	}
+}
+
+func (h *StreamHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+
+	query := r.URL.Query()
+	filter := events.StreamFilter{
+		InstanceIDs:      split(query.Get("instance_ids")),
+		RuntimeIDs:       split(query.Get("runtime_ids")),
+		OperationIDs:     split(query.Get("operation_ids")),
+		OrchestrationIDs: split(query.Get("orchestration_ids")),
+	}
+
+	// subscribe before reading stored events, so no progress is lost in between
+	sub := h.broadcaster.subscribe(filter)
+	defer h.broadcaster.unsubscribe(sub)
+
+	stored, err := h.storedEvents(filter)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+
+	for _, ev := range stored {
+		if err := writeStreamEvent(w, events.StoredStreamEvent, ev); err != nil {
+			h.log.Errorf("while writing stored event to stream: %v", err)
+			return
+		}
+	}
+	flusher.Flush()
+
+	keepAlive := time.NewTicker(h.keepAlive)
+	defer keepAlive.Stop()
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case progress := <-sub.ch:
+			if err := writeStreamEvent(w, events.ProgressStreamEvent, progress); err != nil {
+				h.log.Errorf("while writing progress to stream: %v", err)
+				return
+			}
+		case <-keepAlive.C:
+			if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+				return
+			}
+		}
+		flusher.Flush()
+	}
+}
+
+// storedEvents returns events from the events store for the instances and operations selected by the filter.
+// Stored events are not related to orchestrations, so they are not returned when only orchestrations are selected.
+func (h *StreamHandler) storedEvents(filter events.StreamFilter) ([]events.EventDTO, error) {
+	if h.events == nil || (len(filter.InstanceIDs) == 0 && len(filter.RuntimeIDs) == 0 && len(filter.OperationIDs) == 0) {
+		return nil, nil
+	}
+
+	instanceIDs := filter.InstanceIDs
+	if len(filter.RuntimeIDs) > 0 {
+		instances, _, _, err := h.instances.List(dbmodel.InstanceFilter{RuntimeIDs: filter.RuntimeIDs})
+		if err != nil {
+			return nil, fmt.Errorf("while listing instances: %w", err)
+		}
+		if len(instances) == 0 && len(instanceIDs) == 0 {
+			return nil, nil
+		}
+		for _, i := range instances {
+			instanceIDs = append(instanceIDs, i.InstanceID)
+		}
+	}
+
+	stored, err := h.events.ListEvents(events.EventFilter{InstanceIDs: instanceIDs, OperationIDs: filter.OperationIDs})
+	if err != nil {
+		return nil, fmt.Errorf("while listing events: %w", err)
+	}
+	return stored, nil
+}
+
+func writeStreamEvent(w http.ResponseWriter, name string, data interface{}) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("while marshalling %s event: %w", name, err)
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", name, payload)
+	return err
+}
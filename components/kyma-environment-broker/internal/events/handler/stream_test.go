@@ -0,0 +1,118 @@
+package events
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/events"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/event"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/driver/memory"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamHandler(t *testing.T) {
+	// given
+	log := logrus.New()
+	pubSub := event.NewPubSub(log)
+	broadcaster := NewBroadcaster(pubSub, log)
+	db := storage.NewMemoryStorage()
+	stored := memory.NewEvents()
+	stored.InsertEvent(events.InfoEventLevel, "provisioning started", events.EventSource{InstanceID: "instance-1", OperationID: "op-1"})
+	stored.InsertEvent(events.InfoEventLevel, "other instance", events.EventSource{InstanceID: "instance-2", OperationID: "op-2"})
+
+	server := httptest.NewServer(NewStreamHandler(broadcaster, stored, db.Instances(), log))
+	defer server.Close()
+	client := events.NewClient(server.URL, server.Client())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	received := make(chan events.StreamEvent, 10)
+
+	// when
+	go func() {
+		err := client.StreamEvents(ctx, events.StreamFilter{InstanceIDs: []string{"instance-1"}}, func(ev events.StreamEvent) error {
+			received <- ev
+			return nil
+		})
+		assert.NoError(t, err)
+	}()
+
+	// then
+	ev := <-received
+	require.NotNil(t, ev.Event)
+	assert.Equal(t, "provisioning started", ev.Event.Message)
+
+	// when
+	for _, instanceID := range []string{"instance-2", "instance-1"} {
+		op := internal.Operation{ID: "op-" + instanceID, InstanceID: instanceID, State: domain.InProgress, Description: "step done"}
+		pubSub.Publish(ctx, process.OperationStepProcessed{
+			StepProcessed: process.StepProcessed{StepName: "Create_Runtime"},
+			Operation:     op,
+		})
+	}
+
+	// then
+	ev = <-received
+	require.NotNil(t, ev.Progress)
+	assert.Equal(t, "op-instance-1", ev.Progress.OperationID)
+	assert.Equal(t, "Create_Runtime", ev.Progress.StepName)
+	assert.Equal(t, string(domain.InProgress), ev.Progress.State)
+	assert.Empty(t, received)
+}
+
+func TestStreamHandler_StoredEventsOfUnknownRuntime(t *testing.T) {
+	// given
+	log := logrus.New()
+	stored := memory.NewEvents()
+	stored.InsertEvent(events.InfoEventLevel, "provisioning started", events.EventSource{InstanceID: "instance-1", OperationID: "op-1"})
+	handler := NewStreamHandler(NewBroadcaster(event.NewPubSub(log), log), stored, storage.NewMemoryStorage().Instances(), log)
+
+	// when
+	evs, err := handler.storedEvents(events.StreamFilter{RuntimeIDs: []string{"unknown-runtime"}})
+
+	// then
+	require.NoError(t, err)
+	assert.Empty(t, evs)
+}
+
+func TestBroadcaster_Order(t *testing.T) {
+	// given
+	log := logrus.New()
+	pubSub := event.NewPubSub(log)
+	broadcaster := NewBroadcaster(pubSub, log)
+	sub := broadcaster.subscribe(events.StreamFilter{OperationIDs: []string{"op-1"}})
+	defer broadcaster.unsubscribe(sub)
+	steps := []string{"Init", "Create_Runtime", "Check_Runtime", "Create_Kyma", "Check_Kyma"}
+
+	// when
+	for _, step := range steps {
+		pubSub.Publish(context.TODO(), process.OperationStepProcessed{
+			StepProcessed: process.StepProcessed{StepName: step},
+			Operation:     internal.Operation{ID: "op-1", InstanceID: "instance-1", State: domain.InProgress},
+		})
+	}
+
+	// then
+	for _, step := range steps {
+		progress := <-sub.ch
+		assert.Equal(t, step, progress.StepName)
+	}
+}
+
+func TestMatches(t *testing.T) {
+	progress := events.OperationProgress{InstanceID: "i-1", OperationID: "op-1", OrchestrationID: "o-1"}
+
+	assert.True(t, matches(events.StreamFilter{}, progress))
+	assert.True(t, matches(events.StreamFilter{OrchestrationIDs: []string{"o-2", "o-1"}}, progress))
+	assert.True(t, matches(events.StreamFilter{InstanceIDs: []string{"i-1"}, OperationIDs: []string{"op-1"}}, progress))
+	assert.False(t, matches(events.StreamFilter{InstanceIDs: []string{"i-1"}, OperationIDs: []string{"op-2"}}, progress))
+	assert.False(t, matches(events.StreamFilter{RuntimeIDs: []string{"r-1"}}, progress))
+}
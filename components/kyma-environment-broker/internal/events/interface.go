@@ -21,7 +21,7 @@ var (
 
 type Interface interface {
 	ListEvents(filter events.EventFilter) ([]events.EventDTO, error)
-	InsertEvent(eventLevel events.EventLevel, message, instanceID, operationID string)
+	InsertEvent(eventLevel events.EventLevel, message string, source events.EventSource)
 	RunGarbageCollection(pollingPeriod, retention time.Duration)
 }
 
@@ -39,15 +39,25 @@ func New(cfg Config, events Interface) Interface {
 }
 
 func Infof(instanceID, operationID, format string, args ...any) {
-	insertEvent(events.InfoEventLevel, fmt.Sprintf(format, args...), instanceID, operationID)
+	SourceInfof(events.EventSource{InstanceID: instanceID, OperationID: operationID}, format, args...)
 }
 
 func Errorf(instanceID, operationID string, err error, format string, args ...any) {
-	insertEvent(events.ErrorEventLevel, fmt.Sprintf("%v: %v", fmt.Sprintf(format, args...), err), instanceID, operationID)
+	SourceErrorf(events.EventSource{InstanceID: instanceID, OperationID: operationID}, err, format, args...)
 }
 
-func insertEvent(eventLevel events.EventLevel, msg, instanceID, operationID string) {
+// SourceInfof records an info event with the account, plan and step details of the source
+func SourceInfof(source events.EventSource, format string, args ...any) {
+	insertEvent(events.InfoEventLevel, fmt.Sprintf(format, args...), source)
+}
+
+// SourceErrorf records an error event with the account, plan and step details of the source
+func SourceErrorf(source events.EventSource, err error, format string, args ...any) {
+	insertEvent(events.ErrorEventLevel, fmt.Sprintf("%v: %v", fmt.Sprintf(format, args...), err), source)
+}
+
+func insertEvent(eventLevel events.EventLevel, msg string, source events.EventSource) {
 	if ev != nil {
-		ev.InsertEvent(eventLevel, msg, instanceID, operationID)
+		ev.InsertEvent(eventLevel, msg, source)
 	}
 }
@@ -0,0 +1,174 @@
+package kubeconfig
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/event"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/sirupsen/logrus"
+)
+
+type AuditConfig struct {
+	// Retention is the time after which the audit records are deleted, zero value keeps them forever
+	Retention     time.Duration `envconfig:"default=8760h"`
+	PollingPeriod time.Duration `envconfig:"default=24h"`
+}
+
+// KubeconfigIssued is published for every stored audit record
+type KubeconfigIssued struct {
+	Record internal.KubeconfigAuditRecord
+}
+
+// Auditor stores the audit records of the issued kubeconfigs and publishes them as KubeconfigIssued events
+type Auditor struct {
+	records   storage.KubeconfigAudits
+	instances storage.Instances
+	publisher event.Publisher
+	log       logrus.FieldLogger
+}
+
+func NewAuditor(records storage.KubeconfigAudits, instances storage.Instances, publisher event.Publisher, log logrus.FieldLogger) *Auditor {
+	return &Auditor{
+		records:   records,
+		instances: instances,
+		publisher: publisher,
+		log:       log,
+	}
+}
+
+// Record completes the record with the ID, the creation time and the accounts of the runtime, stores it and publishes it
+func (a *Auditor) Record(ctx context.Context, record internal.KubeconfigAuditRecord) (internal.KubeconfigAuditRecord, error) {
+	if record.ID == "" {
+		record.ID = uuid.New().String()
+	}
+	if record.CreatedAt.IsZero() {
+		record.CreatedAt = time.Now().UTC()
+	}
+	// the records of kubeconfig-service contain the address determined by the service
+	record.SourceIP = validIP(record.SourceIP)
+	if record.InstanceID == "" && record.RuntimeID != "" {
+		instances, err := a.instances.FindAllInstancesForRuntimes([]string{record.RuntimeID})
+		switch {
+		case err != nil:
+			a.log.Warnf("unable to find instance for runtime %s: %s", record.RuntimeID, err)
+		case len(instances) > 0:
+			record.InstanceID = instances[0].InstanceID
+			record.GlobalAccountID = instances[0].GlobalAccountID
+			record.SubAccountID = instances[0].SubAccountID
+		}
+	}
+
+	if err := a.records.Insert(record); err != nil {
+		return record, fmt.Errorf("while inserting kubeconfig audit record: %w", err)
+	}
+	a.log.WithFields(logrus.Fields{
+		"audit":      "kubeconfig-issued",
+		"source":     record.Source,
+		"user":       record.UserID,
+		"instanceID": record.InstanceID,
+		"runtimeID":  record.RuntimeID,
+		"role":       record.Role,
+		"sourceIP":   record.SourceIP,
+	}).Info("kubeconfig issued")
+	a.publisher.Publish(ctx, KubeconfigIssued{Record: record})
+
+	return record, nil
+}
+
+// RunRetention deletes the records older than the retention period until the context is done
+func (a *Auditor) RunRetention(ctx context.Context, cfg AuditConfig) {
+	if cfg.Retention == 0 {
+		return
+	}
+	ticker := time.NewTicker(cfg.PollingPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := a.records.DeleteCreatedBefore(time.Now().Add(-cfg.Retention)); err != nil {
+				a.log.Errorf("failed to delete old kubeconfig audit records: %s", err)
+			}
+		}
+	}
+}
+
+type callerClaims struct {
+	Email     string      `json:"email"`
+	LoginName string      `json:"login_name"`
+	Subject   string      `json:"sub"`
+	Issuer    string      `json:"iss"`
+	Groups    interface{} `json:"groups"`
+}
+
+// callerFromRequest returns the caller identity from the claims of the bearer token. The token is validated by
+// the request authentication of the ingress, requests without the token are recorded with empty identity.
+func callerFromRequest(r *http.Request) (userID, issuer string, groups []string) {
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return "", "", nil
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return "", "", nil
+	}
+	claims := callerClaims{}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return "", "", nil
+	}
+
+	switch {
+	case claims.Email != "":
+		userID = claims.Email
+	case claims.LoginName != "":
+		userID = claims.LoginName
+	default:
+		userID = claims.Subject
+	}
+	switch g := claims.Groups.(type) {
+	case string:
+		groups = []string{g}
+	case []interface{}:
+		for _, v := range g {
+			if s, ok := v.(string); ok {
+				groups = append(groups, s)
+			}
+		}
+	}
+	return userID, claims.Issuer, groups
+}
+
+// sourceIP returns the address of the client. Only the last X-Forwarded-For hop, appended by the Istio ingress gateway,
+// can be trusted, the previous ones are sent by the client.
+func sourceIP(r *http.Request) string {
+	if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
+		hops := strings.Split(forwarded, ",")
+		return validIP(hops[len(hops)-1])
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return validIP(r.RemoteAddr)
+	}
+	return validIP(host)
+}
+
+// validIP returns the normalized IP address or an empty string if the value is not an IP address,
+// so the value always fits the source_ip column
+func validIP(value string) string {
+	ip := net.ParseIP(strings.TrimSpace(value))
+	if ip == nil {
+		return ""
+	}
+	return ip.String()
+}
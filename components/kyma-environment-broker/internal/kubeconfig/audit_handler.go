@@ -0,0 +1,138 @@
+package kubeconfig
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/audit"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/pagination"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/httputil"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
+)
+
+// AuditHandler exposes the audit records of the issued kubeconfigs and stores the records of kubeconfig-service
+type AuditHandler struct {
+	records storage.KubeconfigAudits
+	auditor *Auditor
+
+	defaultMaxPage int
+}
+
+func NewAuditHandler(records storage.KubeconfigAudits, auditor *Auditor, defaultMaxPage int) *AuditHandler {
+	return &AuditHandler{
+		records:        records,
+		auditor:        auditor,
+		defaultMaxPage: defaultMaxPage,
+	}
+}
+
+func (h *AuditHandler) AttachRoutes(router *mux.Router) {
+	router.HandleFunc("/audit/kubeconfigs", h.listRecords).Methods(http.MethodGet)
+	router.HandleFunc("/audit/kubeconfigs", h.createRecord).Methods(http.MethodPost)
+}
+
+func (h *AuditHandler) listRecords(w http.ResponseWriter, req *http.Request) {
+	pageSize, page, err := pagination.ExtractPaginationConfigFromRequest(req, h.defaultMaxPage)
+	if err != nil {
+		httputil.WriteErrorResponse(w, http.StatusBadRequest, fmt.Errorf("while getting query parameters: %w", err))
+		return
+	}
+	query := req.URL.Query()
+	filter := dbmodel.KubeconfigAuditFilter{
+		Page:        page,
+		PageSize:    pageSize,
+		InstanceIDs: query[audit.InstanceIDParam],
+		RuntimeIDs:  query[audit.RuntimeIDParam],
+		UserIDs:     query[audit.UserIDParam],
+		Sources:     query[audit.SourceParam],
+	}
+	if filter.From, err = parseTime(query.Get(audit.FromParam)); err != nil {
+		httputil.WriteErrorResponse(w, http.StatusBadRequest, fmt.Errorf("while parsing %s parameter: %w", audit.FromParam, err))
+		return
+	}
+	if filter.To, err = parseTime(query.Get(audit.ToParam)); err != nil {
+		httputil.WriteErrorResponse(w, http.StatusBadRequest, fmt.Errorf("while parsing %s parameter: %w", audit.ToParam, err))
+		return
+	}
+
+	records, count, totalCount, err := h.records.List(filter)
+	if err != nil {
+		httputil.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Errorf("while fetching kubeconfig audit records: %w", err))
+		return
+	}
+
+	response := audit.KubeconfigRecordsPage{
+		Data:       make([]audit.KubeconfigRecordDTO, 0, len(records)),
+		Count:      count,
+		TotalCount: totalCount,
+	}
+	for _, record := range records {
+		response.Data = append(response.Data, toRecordDTO(record))
+	}
+	httputil.WriteResponse(w, http.StatusOK, response)
+}
+
+func (h *AuditHandler) createRecord(w http.ResponseWriter, req *http.Request) {
+	dto := audit.KubeconfigRecordDTO{}
+	if err := json.NewDecoder(req.Body).Decode(&dto); err != nil {
+		httputil.WriteErrorResponse(w, http.StatusBadRequest, fmt.Errorf("while decoding request body: %w", err))
+		return
+	}
+	if dto.RuntimeID == "" {
+		httputil.WriteErrorResponse(w, http.StatusBadRequest, fmt.Errorf("runtimeID is required"))
+		return
+	}
+
+	// the istio-audit authorization policy allows only kubeconfig-service to store records,
+	// the source sent in the body is ignored so the records of other services cannot be forged
+	record, err := h.auditor.Record(req.Context(), internal.KubeconfigAuditRecord{
+		Source:          internal.KubeconfigAuditSourceKubeconfigService,
+		UserID:          dto.UserID,
+		Issuer:          dto.Issuer,
+		Groups:          dto.Groups,
+		InstanceID:      dto.InstanceID,
+		RuntimeID:       dto.RuntimeID,
+		GlobalAccountID: dto.GlobalAccountID,
+		SubAccountID:    dto.SubAccountID,
+		Role:            dto.Role,
+		SourceIP:        dto.SourceIP,
+		UserAgent:       dto.UserAgent,
+		ExpiresAt:       dto.ExpiresAt,
+	})
+	if err != nil {
+		httputil.WriteErrorResponse(w, http.StatusInternalServerError, err)
+		return
+	}
+	httputil.WriteResponse(w, http.StatusCreated, toRecordDTO(record))
+}
+
+func parseTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
+
+func toRecordDTO(record internal.KubeconfigAuditRecord) audit.KubeconfigRecordDTO {
+	return audit.KubeconfigRecordDTO{
+		ID:              record.ID,
+		Source:          record.Source,
+		UserID:          record.UserID,
+		Issuer:          record.Issuer,
+		Groups:          record.Groups,
+		InstanceID:      record.InstanceID,
+		RuntimeID:       record.RuntimeID,
+		GlobalAccountID: record.GlobalAccountID,
+		SubAccountID:    record.SubAccountID,
+		Role:            record.Role,
+		SourceIP:        record.SourceIP,
+		UserAgent:       record.UserAgent,
+		ExpiresAt:       record.ExpiresAt,
+		CreatedAt:       record.CreatedAt,
+	}
+}
@@ -0,0 +1,134 @@
+package kubeconfig
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/audit"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/event"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/logger"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditHandler(t *testing.T) {
+	// given
+	db := storage.NewMemoryStorage()
+	err := db.Instances().Insert(internal.Instance{
+		InstanceID:      instanceID,
+		RuntimeID:       instanceRuntimeID,
+		GlobalAccountID: "ga-id",
+		SubAccountID:    "sa-id",
+	})
+	require.NoError(t, err)
+
+	log := logger.NewLogDummy()
+	pubSub := event.NewPubSub(log)
+	issued := make(chan KubeconfigIssued, 1)
+	pubSub.Subscribe(KubeconfigIssued{}, func(ctx context.Context, ev interface{}) error {
+		issued <- ev.(KubeconfigIssued)
+		return nil
+	})
+	auditor := NewAuditor(db.KubeconfigAudits(), db.Instances(), pubSub, log)
+	_, err = auditor.Record(context.Background(), internal.KubeconfigAuditRecord{
+		Source:    internal.KubeconfigAuditSourceBroker,
+		UserID:    "jane.doe@email.com",
+		RuntimeID: "other-runtime",
+		CreatedAt: time.Now().Add(-time.Hour),
+	})
+	require.NoError(t, err)
+	<-issued
+
+	router := mux.NewRouter()
+	NewAuditHandler(db.KubeconfigAudits(), auditor, 100).AttachRoutes(router)
+	server := httptest.NewServer(router)
+	defer server.Close()
+	client := audit.NewClient(server.URL, http.DefaultClient)
+
+	t.Run("should record kubeconfig issued by kubeconfig-service", func(t *testing.T) {
+		// when
+		err := client.RecordKubeconfig(audit.KubeconfigRecordDTO{
+			UserID:    "john.smith@email.com",
+			RuntimeID: instanceRuntimeID,
+			Role:      "runtimeAdmin",
+		})
+
+		// then
+		require.NoError(t, err)
+		ev := <-issued
+		assert.Equal(t, internal.KubeconfigAuditSourceKubeconfigService, ev.Record.Source)
+		assert.Equal(t, instanceID, ev.Record.InstanceID)
+		assert.Equal(t, "ga-id", ev.Record.GlobalAccountID)
+		assert.Equal(t, "sa-id", ev.Record.SubAccountID)
+	})
+
+	t.Run("should not accept the source from the caller", func(t *testing.T) {
+		// when
+		err := client.RecordKubeconfig(audit.KubeconfigRecordDTO{
+			Source:    internal.KubeconfigAuditSourceBroker,
+			UserID:    "john.smith@email.com",
+			RuntimeID: instanceRuntimeID,
+		})
+
+		// then
+		require.NoError(t, err)
+		ev := <-issued
+		assert.Equal(t, internal.KubeconfigAuditSourceKubeconfigService, ev.Record.Source)
+	})
+
+	t.Run("should reject record without runtime", func(t *testing.T) {
+		err := client.RecordKubeconfig(audit.KubeconfigRecordDTO{UserID: "john.smith@email.com"})
+
+		assert.Error(t, err)
+	})
+
+	t.Run("should list records from the newest", func(t *testing.T) {
+		// when
+		page, err := client.ListKubeconfigRecords(audit.ListParameters{})
+
+		// then
+		require.NoError(t, err)
+		require.Equal(t, 3, page.TotalCount)
+		assert.Equal(t, "john.smith@email.com", page.Data[0].UserID)
+		assert.Equal(t, "jane.doe@email.com", page.Data[2].UserID)
+	})
+
+	t.Run("should filter records", func(t *testing.T) {
+		// when
+		page, err := client.ListKubeconfigRecords(audit.ListParameters{
+			Sources: []string{internal.KubeconfigAuditSourceBroker},
+			From:    time.Now().Add(-2 * time.Hour),
+		})
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, page.Data, 1)
+		assert.Equal(t, "other-runtime", page.Data[0].RuntimeID)
+	})
+
+	t.Run("should reject invalid time", func(t *testing.T) {
+		response, err := http.Get(server.URL + "/audit/kubeconfigs?from=yesterday")
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+	})
+}
+
+func TestSourceIP(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/kubeconfig/instance-id", nil)
+	req.RemoteAddr = "10.0.0.3:4321"
+	assert.Equal(t, "10.0.0.3", sourceIP(req))
+
+	req.Header.Set("X-Forwarded-For", "10.0.0.1, 10.1.1.1")
+	assert.Equal(t, "10.1.1.1", sourceIP(req))
+
+	req.Header.Set("X-Forwarded-For", "10.0.0.1, "+strings.Repeat("a", 100))
+	assert.Empty(t, sourceIP(req))
+}
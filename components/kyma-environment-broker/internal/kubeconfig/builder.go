@@ -13,6 +13,7 @@ import (
 
 type Config struct {
 	AllowOrigins string
+	Audit        AuditConfig
 }
 
 type Builder struct {
@@ -31,6 +32,7 @@ type kubeconfigData struct {
 	ServerURL     string
 	OIDCIssuerURL string
 	OIDCClientID  string
+	Token         string
 }
 
 func (b *Builder) BuildFromAdminKubeconfig(instance *internal.Instance, adminKubeconfig string) (string, error) {
@@ -55,7 +57,7 @@ func (b *Builder) BuildFromAdminKubeconfig(instance *internal.Instance, adminKub
 		return "", fmt.Errorf("while validation kubeconfig fetched by provisioner: %w", err)
 	}
 
-	return b.parseTemplate(kubeconfigData{
+	return b.parseTemplate(kubeconfigTemplate, kubeconfigData{
 		ContextName:   kubeCfg.CurrentContext,
 		CAData:        kubeCfg.Clusters[0].Cluster.CertificateAuthorityData,
 		ServerURL:     kubeCfg.Clusters[0].Cluster.Server,
@@ -68,7 +70,39 @@ func (b *Builder) Build(instance *internal.Instance) (string, error) {
 	return b.BuildFromAdminKubeconfig(instance, "")
 }
 
-func (b *Builder) parseTemplate(payload kubeconfigData) (string, error) {
+// AdminKubeconfig fetches the admin kubeconfig of the runtime from the Provisioner
+func (b *Builder) AdminKubeconfig(instance *internal.Instance) (string, error) {
+	status, err := b.provisionerClient.RuntimeStatus(instance.GlobalAccountID, instance.RuntimeID)
+	if err != nil {
+		return "", fmt.Errorf("while fetching runtime status from provisioner: %w", err)
+	}
+	if status.RuntimeConfiguration.Kubeconfig == nil {
+		return "", fmt.Errorf("kubeconfig is nil (nil response from Provisioner)")
+	}
+	return *status.RuntimeConfiguration.Kubeconfig, nil
+}
+
+// BuildForToken builds a kubeconfig which authenticates with the given bearer token, e.g. the token of a service account,
+// the cluster is taken from the admin kubeconfig
+func (b *Builder) BuildForToken(adminKubeconfig, token string) (string, error) {
+	var kubeCfg kubeconfig
+	err := yaml.Unmarshal([]byte(adminKubeconfig), &kubeCfg)
+	if err != nil {
+		return "", fmt.Errorf("while unmarshaling kubeconfig: %w", err)
+	}
+	if err := b.validKubeconfig(kubeCfg); err != nil {
+		return "", fmt.Errorf("while validation admin kubeconfig: %w", err)
+	}
+
+	return b.parseTemplate(tokenKubeconfigTemplate, kubeconfigData{
+		ContextName: kubeCfg.CurrentContext,
+		CAData:      kubeCfg.Clusters[0].Cluster.CertificateAuthorityData,
+		ServerURL:   kubeCfg.Clusters[0].Cluster.Server,
+		Token:       token,
+	})
+}
+
+func (b *Builder) parseTemplate(kubeconfigTemplate string, payload kubeconfigData) (string, error) {
 	var result bytes.Buffer
 	t := template.New("kubeconfigParser")
 	t, err := t.Parse(kubeconfigTemplate)
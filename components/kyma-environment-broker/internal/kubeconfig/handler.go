@@ -34,15 +34,17 @@ type Handler struct {
 	allowOrigins      string
 	instanceStorage   storage.Instances
 	operationStorage  storage.Operations
+	auditor           *Auditor
 	log               logrus.FieldLogger
 }
 
-func NewHandler(storage storage.BrokerStorage, b KcBuilder, origins string, log logrus.FieldLogger) *Handler {
+func NewHandler(storage storage.BrokerStorage, b KcBuilder, origins string, auditor *Auditor, log logrus.FieldLogger) *Handler {
 	return &Handler{
 		instanceStorage:   storage.Instances(),
 		operationStorage:  storage.Operations(),
 		kubeconfigBuilder: b,
 		allowOrigins:      origins,
+		auditor:           auditor,
 		log:               log,
 	}
 }
@@ -121,6 +123,24 @@ func (h *Handler) GetKubeconfig(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	userID, issuer, groups := callerFromRequest(r)
+	_, err = h.auditor.Record(r.Context(), internal.KubeconfigAuditRecord{
+		Source:          internal.KubeconfigAuditSourceBroker,
+		UserID:          userID,
+		Issuer:          issuer,
+		Groups:          groups,
+		InstanceID:      instance.InstanceID,
+		RuntimeID:       instance.RuntimeID,
+		GlobalAccountID: instance.GlobalAccountID,
+		SubAccountID:    instance.SubAccountID,
+		SourceIP:        sourceIP(r),
+		UserAgent:       r.UserAgent(),
+	})
+	if err != nil {
+		h.handleResponse(w, http.StatusInternalServerError, fmt.Errorf("cannot record kubeconfig audit: %s", err))
+		return
+	}
+
 	writeToResponse(w, newKubeconfig, h.log)
 }
 
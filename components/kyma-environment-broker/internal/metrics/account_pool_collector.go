@@ -0,0 +1,74 @@
+package metrics
+
+import (
+	"strconv"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/hyperscaler"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+)
+
+// AccountPoolStatsGetter provides the number of secret bindings in the hyperscaler account pools:
+//
+// - compass_keb_account_pool_secret_bindings - number of secret bindings per hyperscaler type, EU access pool and state
+// - compass_keb_account_pool_exhausted - 1 if no dedicated secret binding of the pool can be assigned to a new tenant
+type AccountPoolStatsGetter interface {
+	Capacity() ([]hyperscaler.PoolCapacityDTO, error)
+}
+
+type AccountPoolCollector struct {
+	statsGetter AccountPoolStatsGetter
+
+	secretBindingsDesc *prometheus.Desc
+	exhaustedDesc      *prometheus.Desc
+}
+
+func NewAccountPoolCollector(statsGetter AccountPoolStatsGetter) *AccountPoolCollector {
+	return &AccountPoolCollector{
+		statsGetter: statsGetter,
+
+		secretBindingsDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(prometheusNamespace, prometheusSubsystem, "account_pool_secret_bindings"),
+			"The number of secret bindings in the hyperscaler account pool by state",
+			[]string{"hyperscaler_type", "eu_access", "state"},
+			nil),
+		exhaustedDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(prometheusNamespace, prometheusSubsystem, "account_pool_exhausted"),
+			"1 if the hyperscaler account pool has no free secret binding",
+			[]string{"hyperscaler_type", "eu_access"},
+			nil),
+	}
+}
+
+func (c *AccountPoolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.secretBindingsDesc
+	ch <- c.exhaustedDesc
+}
+
+// Collect implements the prometheus.Collector interface.
+func (c *AccountPoolCollector) Collect(ch chan<- prometheus.Metric) {
+	pools, err := c.statsGetter.Capacity()
+	if err != nil {
+		logrus.Error(err)
+		return
+	}
+
+	for _, pool := range pools {
+		euAccess := strconv.FormatBool(pool.EuAccess)
+		for state, count := range map[hyperscaler.AccountState]int{
+			hyperscaler.AccountStateFree:        pool.Free,
+			hyperscaler.AccountStateAssigned:    pool.Assigned,
+			hyperscaler.AccountStateDirty:       pool.Dirty,
+			hyperscaler.AccountStateShared:      pool.Shared,
+			hyperscaler.AccountStateQuarantined: pool.Quarantined,
+		} {
+			collect(ch, c.secretBindingsDesc, count, pool.HyperscalerType, euAccess, string(state))
+		}
+
+		exhausted := 0
+		if pool.Exhausted() {
+			exhausted = 1
+		}
+		collect(ch, c.exhaustedDesc, exhausted, pool.HyperscalerType, euAccess)
+	}
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/google/uuid"
 	reconcilerApi "github.com/kyma-incubator/reconciler/pkg/keb"
+	eventsapi "github.com/kyma-project/control-plane/components/kyma-environment-broker/common/events"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/gardener"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
 	kebError "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/error"
@@ -152,6 +153,11 @@ func (i *Instance) IsExpired() bool {
 	return i.ExpiredAt != nil
 }
 
+// IsHibernated returns true if the runtime of the instance was hibernated and not woken up since
+func (i *Instance) IsHibernated() bool {
+	return i.Parameters.Parameters.Hibernated != nil && *i.Parameters.Parameters.Hibernated
+}
+
 func (i *Instance) GetSubscriptionGlobalAccoundID() string {
 	if i.SubscriptionGlobalAccountID != "" {
 		return i.SubscriptionGlobalAccountID
@@ -184,6 +190,8 @@ const (
 	OperationTypeUpdate OperationType = "update"
 	// OperationTypeUpgradeCluster means upgrade cluster (shoot) OperationType
 	OperationTypeUpgradeCluster OperationType = "upgradeCluster"
+	// OperationTypeRuntimeTask means runtime task OperationType, which applies changes to the runtime
+	OperationTypeRuntimeTask OperationType = "runtimeTask"
 )
 
 type Operation struct {
@@ -208,6 +216,8 @@ type Operation struct {
 	OrchestrationID string             `json:"-"`
 	FinishedStages  []string           `json:"-"`
 	LastError       kebError.LastError `json:"-"`
+	// NextRetryAt is set when a step requested a retry, the operation must not be processed before that time
+	NextRetryAt *time.Time `json:"-"`
 
 	// PROVISIONING
 	RuntimeVersion RuntimeVersionData `json:"runtime_version"`
@@ -225,7 +235,9 @@ type Operation struct {
 	// UPDATING
 	UpdatingParameters    UpdatingParametersDTO `json:"updating_parameters"`
 	CheckReconcilerStatus bool                  `json:"check_reconciler_status"`
-	K8sClient             client.Client         `json:"-"`
+	// HibernationOperationID is the ID of the Provisioner operation hibernating or waking up the runtime
+	HibernationOperationID string        `json:"hibernation_operation_id,omitempty"`
+	K8sClient              client.Client `json:"-"`
 
 	// following fields are not stored in the storage
 
@@ -242,6 +254,9 @@ type Operation struct {
 
 	// KymaTemplate is read from the configuration then used in the apply_kyma step
 	KymaTemplate string `json:"KymaTemplate"`
+
+	// RUNTIME TASK
+	RuntimeTaskResults []orchestration.RuntimeTaskResult `json:"runtime_task_results,omitempty"`
 }
 
 func (o *Operation) IsFinished() bool {
@@ -249,15 +264,36 @@ func (o *Operation) IsFinished() bool {
 }
 
 func (o *Operation) EventInfof(fmt string, args ...any) {
-	events.Infof(o.InstanceID, o.ID, fmt, args...)
+	events.SourceInfof(o.eventSource(""), fmt, args...)
 }
 
 func (o *Operation) EventErrorf(err error, fmt string, args ...any) {
-	events.Errorf(o.InstanceID, o.ID, err, fmt, args...)
+	events.SourceErrorf(o.eventSource(""), err, fmt, args...)
+}
+
+// StepEventInfof records an info event related to the given step of the operation
+func (o *Operation) StepEventInfof(stepName, fmt string, args ...any) {
+	events.SourceInfof(o.eventSource(stepName), fmt, args...)
+}
+
+// StepEventErrorf records an error event related to the given step of the operation
+func (o *Operation) StepEventErrorf(stepName string, err error, fmt string, args ...any) {
+	events.SourceErrorf(o.eventSource(stepName), err, fmt, args...)
+}
+
+func (o *Operation) eventSource(stepName string) eventsapi.EventSource {
+	return eventsapi.EventSource{
+		InstanceID:      o.InstanceID,
+		OperationID:     o.ID,
+		GlobalAccountID: o.ProvisioningParameters.ErsContext.GlobalAccountID,
+		SubAccountID:    o.ProvisioningParameters.ErsContext.SubAccountID,
+		PlanID:          o.ProvisioningParameters.PlanID,
+		StepName:        stepName,
+	}
 }
 
 // Orchestration holds all information about an orchestration.
-// Orchestration performs operations of a specific type (UpgradeKymaOperation, UpgradeClusterOperation)
+// Orchestration performs operations of a specific type (UpgradeKymaOperation, UpgradeClusterOperation, runtime task Operation)
 // on specific targets of SKRs.
 type Orchestration struct {
 	OrchestrationID string
@@ -456,6 +492,13 @@ type InstanceStats struct {
 	PerGlobalAccountID     map[string]int
 }
 
+// InstanceCount provides number of instances of a plan in a platform region
+type InstanceCount struct {
+	PlanName       string
+	PlatformRegion string
+	Count          int
+}
+
 // ERSContextStats provides aggregated information regarding ERSContext
 type ERSContextStats struct {
 	LicenseType map[string]int
@@ -547,6 +590,7 @@ func NewUpdateOperation(operationID string, instance *Instance, updatingParams U
 	}
 
 	updatingParams.UpdateAutoScaler(&op.ProvisioningParameters.Parameters)
+	updatingParams.UpdateHibernationSchedules(&op.ProvisioningParameters.Parameters)
 	if updatingParams.MachineType != nil && *updatingParams.MachineType != "" {
 		op.ProvisioningParameters.Parameters.MachineType = updatingParams.MachineType
 	}
@@ -650,3 +694,103 @@ func (c *ConfigForPlan) ContainsAdditionalComponent(componentName string) bool {
 	}
 	return false
 }
+
+const (
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliveryDelivered = "delivered"
+	// WebhookDeliveryDead means the delivery failed and all retries were exhausted
+	WebhookDeliveryDead = "dead"
+)
+
+// WebhookDelivery is a single lifecycle event sent to a webhook subscription
+type WebhookDelivery struct {
+	ID           string
+	Subscription string
+	EventType    string
+	Payload      string
+	State        string
+	Attempts     int
+	LastError    string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+const (
+	OperationStepSucceeded = "succeeded"
+	OperationStepRetrying  = "retrying"
+	OperationStepFailed    = "failed"
+)
+
+// OperationStep is the execution record of a single step of an operation. When it is recorded, it describes one attempt,
+// the storage accumulates attempts of the same step, so the stored record covers all of them.
+type OperationStep struct {
+	OperationID string
+	StepName    string
+	State       string
+	Attempts    int
+	LastError   string
+	// NextDelay is the delay requested by the last attempt before the step is retried
+	NextDelay time.Duration
+	// ProcessingTime is the time spent in the step, without the delays between attempts
+	ProcessingTime time.Duration
+	StartedAt      time.Time
+	FinishedAt     time.Time
+}
+
+const (
+	BindingOperationBind   = "bind"
+	BindingOperationUnbind = "unbind"
+)
+
+// Binding is a service binding of an instance. Its credentials are a kubeconfig with the token of a service account
+// created on the runtime for the binding, the token expires after ExpirationSeconds.
+type Binding struct {
+	ID                string
+	InstanceID        string
+	ExpirationSeconds int64
+	Kubeconfig        string
+	ExpiresAt         time.Time
+
+	// LastOperation is the type of the last operation on the binding, one of bind or unbind
+	LastOperation string
+	State         domain.LastOperationState
+	Description   string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// ServiceAccountName returns the name of the service account created on the runtime for the binding
+func (b Binding) ServiceAccountName() string {
+	return fmt.Sprintf("kyma-binding-%s", b.ID)
+}
+
+const (
+	KubeconfigAuditSourceBroker            = "kyma-environment-broker"
+	KubeconfigAuditSourceKubeconfigService = "kubeconfig-service"
+)
+
+// KubeconfigAuditRecord records a kubeconfig of a runtime issued to a caller. The records are append-only,
+// they are never updated and are deleted only after the retention period.
+type KubeconfigAuditRecord struct {
+	ID     string
+	Source string
+
+	// UserID, Issuer and Groups identify the caller by the claims of the OIDC token, UserID is empty for anonymous callers
+	UserID string
+	Issuer string
+	Groups []string
+
+	InstanceID      string
+	RuntimeID       string
+	GlobalAccountID string
+	SubAccountID    string
+	// Role is the role granted with the kubeconfig, empty for kubeconfigs authenticating the user with OIDC
+	Role string
+
+	SourceIP  string
+	UserAgent string
+	// ExpiresAt is the expiration of the access granted with the kubeconfig, nil if the access does not expire
+	ExpiresAt *time.Time
+	CreatedAt time.Time
+}
@@ -0,0 +1,96 @@
+package operationsteps
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	pkg "github.com/kyma-project/control-plane/components/kyma-environment-broker/common/runtime"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/httputil"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+)
+
+type Handler struct {
+	operations storage.Operations
+	steps      storage.OperationSteps
+}
+
+func NewHandler(operations storage.Operations, steps storage.OperationSteps) *Handler {
+	return &Handler{
+		operations: operations,
+		steps:      steps,
+	}
+}
+
+func (h *Handler) AttachRoutes(router *mux.Router) {
+	router.HandleFunc("/operations/{operation_id}/steps", h.listSteps).Methods(http.MethodGet)
+}
+
+func (h *Handler) listSteps(w http.ResponseWriter, r *http.Request) {
+	operationID := mux.Vars(r)["operation_id"]
+
+	operation, err := h.operations.GetOperationByID(operationID)
+	switch {
+	case dberr.IsNotFound(err):
+		httputil.WriteErrorResponse(w, http.StatusNotFound, fmt.Errorf("operation %s does not exist", operationID))
+		return
+	case err != nil:
+		httputil.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Errorf("while getting operation %s: %w", operationID, err))
+		return
+	}
+
+	steps, err := h.steps.ListByOperationID(operationID)
+	if err != nil {
+		httputil.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Errorf("while listing steps of operation %s: %w", operationID, err))
+		return
+	}
+
+	response := pkg.OperationSteps{
+		OperationID: operation.ID,
+		Type:        operationType(*operation),
+		State:       string(operation.State),
+		CreatedAt:   operation.CreatedAt,
+		UpdatedAt:   operation.UpdatedAt,
+		Data:        make([]pkg.OperationStep, 0, len(steps)),
+		Count:       len(steps),
+	}
+	for _, step := range steps {
+		response.Data = append(response.Data, toStepDTO(step))
+	}
+	httputil.WriteResponse(w, http.StatusOK, response)
+}
+
+func toStepDTO(step internal.OperationStep) pkg.OperationStep {
+	return pkg.OperationStep{
+		StepName:         step.StepName,
+		State:            step.State,
+		Attempts:         step.Attempts,
+		LastError:        step.LastError,
+		StartedAt:        step.StartedAt,
+		FinishedAt:       step.FinishedAt,
+		DurationMs:       step.FinishedAt.Sub(step.StartedAt).Milliseconds(),
+		ProcessingTimeMs: step.ProcessingTime.Milliseconds(),
+		NextDelayMs:      step.NextDelay.Milliseconds(),
+	}
+}
+
+func operationType(operation internal.Operation) pkg.OperationType {
+	switch operation.Type {
+	case internal.OperationTypeProvision:
+		return pkg.Provision
+	case internal.OperationTypeDeprovision:
+		if operation.Temporary {
+			return pkg.Suspension
+		}
+		return pkg.Deprovision
+	case internal.OperationTypeUpgradeKyma:
+		return pkg.UpgradeKyma
+	case internal.OperationTypeUpgradeCluster:
+		return pkg.UpgradeCluster
+	case internal.OperationTypeUpdate:
+		return pkg.Update
+	}
+	return pkg.OperationType(operation.Type)
+}
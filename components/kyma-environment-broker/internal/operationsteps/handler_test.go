@@ -0,0 +1,67 @@
+package operationsteps
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	pkg "github.com/kyma-project/control-plane/components/kyma-environment-broker/common/runtime"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/fixture"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_ListSteps(t *testing.T) {
+	// given
+	db := storage.NewMemoryStorage()
+	operation := fixture.FixProvisioningOperation("op-1", "inst-1")
+	require.NoError(t, db.Operations().InsertOperation(operation))
+
+	start := time.Now()
+	require.NoError(t, db.OperationSteps().RecordAttempt(internal.OperationStep{OperationID: "op-1", StepName: "Create_Runtime",
+		State: internal.OperationStepSucceeded, ProcessingTime: time.Second, StartedAt: start, FinishedAt: start.Add(time.Second)}))
+	require.NoError(t, db.OperationSteps().RecordAttempt(internal.OperationStep{OperationID: "op-1", StepName: "Check_Runtime",
+		State: internal.OperationStepRetrying, NextDelay: time.Minute, ProcessingTime: time.Second, StartedAt: start.Add(time.Second), FinishedAt: start.Add(2 * time.Second)}))
+	require.NoError(t, db.OperationSteps().RecordAttempt(internal.OperationStep{OperationID: "op-1", StepName: "Check_Runtime",
+		State: internal.OperationStepRetrying, NextDelay: time.Minute, ProcessingTime: time.Second, StartedAt: start.Add(time.Minute), FinishedAt: start.Add(time.Minute + time.Second)}))
+
+	router := mux.NewRouter()
+	NewHandler(db.Operations(), db.OperationSteps()).AttachRoutes(router)
+
+	// when
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/operations/op-1/steps", nil))
+
+	// then
+	require.Equal(t, http.StatusOK, rec.Code)
+	var response pkg.OperationSteps
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, "op-1", response.OperationID)
+	assert.Equal(t, pkg.Provision, response.Type)
+	require.Equal(t, 2, response.Count)
+	assert.Equal(t, "Create_Runtime", response.Data[0].StepName)
+	assert.Equal(t, "Check_Runtime", response.Data[1].StepName)
+	assert.Equal(t, 2, response.Data[1].Attempts)
+	assert.Equal(t, int64(60000), response.Data[1].DurationMs)
+	assert.Equal(t, int64(2000), response.Data[1].ProcessingTimeMs)
+	assert.Equal(t, int64(60000), response.Data[1].NextDelayMs)
+}
+
+func TestHandler_ListStepsOfNotExistingOperation(t *testing.T) {
+	// given
+	db := storage.NewMemoryStorage()
+	router := mux.NewRouter()
+	NewHandler(db.Operations(), db.OperationSteps()).AttachRoutes(router)
+
+	// when
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/operations/op-1/steps", nil))
+
+	// then
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
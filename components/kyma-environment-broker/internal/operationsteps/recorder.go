@@ -0,0 +1,90 @@
+package operationsteps
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/event"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/sirupsen/logrus"
+)
+
+// Recorder stores an attempt of a step every time a step processed event is published by one of the operation managers.
+// The attempts are recorded in the goroutine of the operation manager, so a later attempt of a step is never overwritten by an earlier one.
+type Recorder struct {
+	steps storage.OperationSteps
+	log   logrus.FieldLogger
+}
+
+func NewRecorder(sub event.SyncSubscriber, steps storage.OperationSteps, log logrus.FieldLogger) *Recorder {
+	r := &Recorder{
+		steps: steps,
+		log:   log,
+	}
+	sub.SubscribeSync(process.ProvisioningStepProcessed{}, r.onStepProcessed)
+	sub.SubscribeSync(process.UpdatingStepProcessed{}, r.onStepProcessed)
+	sub.SubscribeSync(process.DeprovisioningStepProcessed{}, r.onStepProcessed)
+	sub.SubscribeSync(process.UpgradeKymaStepProcessed{}, r.onStepProcessed)
+	sub.SubscribeSync(process.UpgradeClusterStepProcessed{}, r.onStepProcessed)
+	sub.SubscribeSync(process.RuntimeTaskStepProcessed{}, r.onStepProcessed)
+	sub.SubscribeSync(process.OperationStepProcessed{}, r.onStepProcessed)
+
+	return r
+}
+
+func (r *Recorder) onStepProcessed(_ context.Context, ev interface{}) error {
+	var step process.StepProcessed
+	var op internal.Operation
+	switch e := ev.(type) {
+	case process.ProvisioningStepProcessed:
+		step, op = e.StepProcessed, e.Operation.Operation
+	case process.UpdatingStepProcessed:
+		step, op = e.StepProcessed, e.Operation.Operation
+	case process.DeprovisioningStepProcessed:
+		step, op = e.StepProcessed, e.Operation.Operation
+	case process.UpgradeKymaStepProcessed:
+		step, op = e.StepProcessed, e.Operation.Operation
+	case process.UpgradeClusterStepProcessed:
+		step, op = e.StepProcessed, e.Operation.Operation
+	case process.RuntimeTaskStepProcessed:
+		step, op = e.StepProcessed, e.Operation
+	case process.OperationStepProcessed:
+		step, op = e.StepProcessed, e.Operation
+	default:
+		return fmt.Errorf("unexpected event type %T", ev)
+	}
+	// events published outside of steps, e.g. when the operation timed out, are not attempts of any step
+	if step.StepName == "" || op.ID == "" {
+		return nil
+	}
+
+	finishedAt := time.Now()
+	attempt := internal.OperationStep{
+		OperationID:    op.ID,
+		StepName:       step.StepName,
+		State:          internal.OperationStepSucceeded,
+		NextDelay:      step.When,
+		ProcessingTime: step.Duration,
+		StartedAt:      finishedAt.Add(-step.Duration),
+		FinishedAt:     finishedAt,
+	}
+	switch {
+	case step.Error != nil:
+		attempt.State = internal.OperationStepFailed
+		attempt.LastError = step.Error.Error()
+	case op.State == domain.Failed:
+		attempt.State = internal.OperationStepFailed
+		attempt.LastError = op.Description
+	case step.When > 0:
+		attempt.State = internal.OperationStepRetrying
+	}
+
+	if err := r.steps.RecordAttempt(attempt); err != nil {
+		return fmt.Errorf("while recording attempt of step %s of operation %s: %w", attempt.StepName, attempt.OperationID, err)
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package operationsteps
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/event"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/logger"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/driver/memory"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecorder(t *testing.T) {
+	// given
+	steps := memory.NewOperationSteps()
+	recorder := NewRecorder(event.NewPubSub(logger.NewLogDummy()), steps, logger.NewLogDummy())
+	operation := internal.Operation{ID: "op-1", State: domain.InProgress}
+
+	// when
+	for _, ev := range []interface{}{
+		process.OperationStepProcessed{StepProcessed: process.StepProcessed{StepName: "Create_Runtime", Duration: 3 * time.Second}, Operation: operation},
+		process.OperationStepProcessed{StepProcessed: process.StepProcessed{StepName: "Check_Runtime", Duration: time.Second, When: time.Minute}, Operation: operation},
+		process.OperationStepProcessed{StepProcessed: process.StepProcessed{StepName: "Check_Runtime", Duration: 2 * time.Second, Error: fmt.Errorf("timeout")}, Operation: operation},
+		process.OperationStepProcessed{StepProcessed: process.StepProcessed{Duration: time.Hour, Error: fmt.Errorf("operation timed out")}, Operation: operation},
+	} {
+		require.NoError(t, recorder.onStepProcessed(context.Background(), ev))
+	}
+
+	// then
+	recorded, err := steps.ListByOperationID("op-1")
+	require.NoError(t, err)
+	require.Len(t, recorded, 2)
+
+	assert.Equal(t, "Create_Runtime", recorded[0].StepName)
+	assert.Equal(t, internal.OperationStepSucceeded, recorded[0].State)
+	assert.Equal(t, 1, recorded[0].Attempts)
+
+	assert.Equal(t, "Check_Runtime", recorded[1].StepName)
+	assert.Equal(t, internal.OperationStepFailed, recorded[1].State)
+	assert.Equal(t, 2, recorded[1].Attempts)
+	assert.Equal(t, "timeout", recorded[1].LastError)
+	assert.Equal(t, 3*time.Second, recorded[1].ProcessingTime)
+}
+
+func TestRecorder_OperationFailedByStep(t *testing.T) {
+	// given
+	steps := memory.NewOperationSteps()
+	recorder := NewRecorder(event.NewPubSub(logger.NewLogDummy()), steps, logger.NewLogDummy())
+
+	// when
+	err := recorder.onStepProcessed(context.Background(), process.DeprovisioningStepProcessed{
+		StepProcessed: process.StepProcessed{StepName: "Remove_Runtime"},
+		Operation: internal.DeprovisioningOperation{
+			Operation: internal.Operation{ID: "op-1", State: domain.Failed, Description: "runtime cannot be removed"},
+		},
+	})
+
+	// then
+	require.NoError(t, err)
+	recorded, err := steps.ListByOperationID("op-1")
+	require.NoError(t, err)
+	require.Len(t, recorded, 1)
+	assert.Equal(t, internal.OperationStepFailed, recorded[0].State)
+	assert.Equal(t, "runtime cannot be removed", recorded[0].LastError)
+}
+
+func TestRecorder_AttemptsInPublishedOrder(t *testing.T) {
+	// given
+	steps := memory.NewOperationSteps()
+	pubSub := event.NewPubSub(logger.NewLogDummy())
+	NewRecorder(pubSub, steps, logger.NewLogDummy())
+	operation := internal.Operation{ID: "op-1", State: domain.InProgress}
+
+	// when
+	for i := 0; i < 10; i++ {
+		pubSub.Publish(context.Background(), process.OperationStepProcessed{StepProcessed: process.StepProcessed{StepName: "Check_Runtime", When: time.Minute}, Operation: operation})
+	}
+	pubSub.Publish(context.Background(), process.OperationStepProcessed{StepProcessed: process.StepProcessed{StepName: "Check_Runtime"}, Operation: operation})
+
+	// then
+	recorded, err := steps.ListByOperationID("op-1")
+	require.NoError(t, err)
+	require.Len(t, recorded, 1)
+	assert.Equal(t, internal.OperationStepSucceeded, recorded[0].State)
+	assert.Equal(t, 11, recorded[0].Attempts)
+}
@@ -0,0 +1,107 @@
+package orchestration
+
+import (
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"time"
+
+	orchestrationExt "github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"gopkg.in/yaml.v2"
+)
+
+// FreezeCalendar combines the freezes defined in the configuration file with the freezes created with the API
+type FreezeCalendar struct {
+	configured []orchestrationExt.Freeze
+	freezes    storage.Freezes
+}
+
+func NewFreezeCalendar(configured []orchestrationExt.Freeze, freezes storage.Freezes) *FreezeCalendar {
+	return &FreezeCalendar{
+		configured: configured,
+		freezes:    freezes,
+	}
+}
+
+// List returns all freezes sorted by the start time
+func (c *FreezeCalendar) List() ([]orchestrationExt.Freeze, error) {
+	stored, err := c.freezes.List()
+	if err != nil {
+		return nil, fmt.Errorf("while listing freezes: %w", err)
+	}
+
+	result := make([]orchestrationExt.Freeze, 0, len(c.configured)+len(stored))
+	result = append(result, c.configured...)
+	result = append(result, stored...)
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Start.Before(result[j].Start)
+	})
+
+	return result, nil
+}
+
+// Get returns the freeze with the given ID, or nil if there is none
+func (c *FreezeCalendar) Get(id string) (*orchestrationExt.Freeze, error) {
+	freezes, err := c.List()
+	if err != nil {
+		return nil, err
+	}
+	for i := range freezes {
+		if freezes[i].ID == id {
+			return &freezes[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// Freeze returns the freeze which applies to the runtime at the given time. If more freezes apply,
+// the one which ends last is returned, so the operation is not postponed into another freeze.
+func (c *FreezeCalendar) Freeze(runtime orchestrationExt.Runtime, at time.Time) (*orchestrationExt.Freeze, error) {
+	freezes, err := c.List()
+	if err != nil {
+		return nil, err
+	}
+
+	var found *orchestrationExt.Freeze
+	for i := range freezes {
+		if !freezes[i].Covers(runtime, at) {
+			continue
+		}
+		if found == nil || freezes[i].End.After(found.End) {
+			found = &freezes[i]
+		}
+	}
+
+	return found, nil
+}
+
+type freezesConfig struct {
+	Freezes []orchestrationExt.Freeze `yaml:"freezes"`
+}
+
+// ReadFreezesFromFile reads freezes from the YAML configuration file
+func ReadFreezesFromFile(filename string) ([]orchestrationExt.Freeze, error) {
+	content, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("while reading %s file with orchestration freezes: %w", filename, err)
+	}
+
+	var data freezesConfig
+	err = yaml.Unmarshal(content, &data)
+	if err != nil {
+		return nil, fmt.Errorf("while unmarshalling a file with orchestration freezes: %w", err)
+	}
+
+	for i, freeze := range data.Freezes {
+		if freeze.ID == "" {
+			return nil, fmt.Errorf("orchestration freeze number %d has no ID", i+1)
+		}
+		if !freeze.Start.Before(freeze.End) {
+			return nil, fmt.Errorf("orchestration freeze %s must start before it ends", freeze.ID)
+		}
+		data.Freezes[i].Configured = true
+	}
+
+	return data.Freezes, nil
+}
@@ -0,0 +1,79 @@
+package orchestration
+
+import (
+	"testing"
+	"time"
+
+	orchestrationExt "github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadFreezesFromFile(t *testing.T) {
+	// given/when
+	freezes, err := ReadFreezesFromFile("test/freezes.yaml")
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, freezes, 2)
+	assert.Equal(t, "end-of-year", freezes[0].ID)
+	assert.Equal(t, "End of year change freeze", freezes[0].Reason)
+	assert.True(t, freezes[0].Configured)
+	assert.Equal(t, "ga-1", freezes[1].GlobalAccountID)
+	assert.Equal(t, time.Date(2026, 11, 3, 8, 0, 0, 0, time.UTC), freezes[1].End.UTC())
+}
+
+func TestFreezeCalendar_Freeze(t *testing.T) {
+	// given
+	db := storage.NewMemoryStorage()
+	configured, err := ReadFreezesFromFile("test/freezes.yaml")
+	require.NoError(t, err)
+	err = db.Freezes().Insert(orchestrationExt.Freeze{
+		ID:              "longer",
+		GlobalAccountID: "ga-1",
+		Start:           time.Date(2026, 11, 2, 0, 0, 0, 0, time.UTC),
+		End:             time.Date(2026, 11, 10, 0, 0, 0, 0, time.UTC),
+	})
+	require.NoError(t, err)
+	calendar := NewFreezeCalendar(configured, db.Freezes())
+
+	for tn, tc := range map[string]struct {
+		runtime  orchestrationExt.Runtime
+		at       time.Time
+		expected string
+	}{
+		"global freeze": {
+			runtime:  orchestrationExt.Runtime{GlobalAccountID: "ga-2"},
+			at:       time.Date(2026, 12, 24, 0, 0, 0, 0, time.UTC),
+			expected: "end-of-year",
+		},
+		"freeze of other global account": {
+			runtime: orchestrationExt.Runtime{GlobalAccountID: "ga-2"},
+			at:      time.Date(2026, 11, 2, 12, 0, 0, 0, time.UTC),
+		},
+		"overlapping freezes, the one which ends last is returned": {
+			runtime:  orchestrationExt.Runtime{GlobalAccountID: "ga-1"},
+			at:       time.Date(2026, 11, 2, 12, 0, 0, 0, time.UTC),
+			expected: "longer",
+		},
+		"end of the freeze": {
+			runtime: orchestrationExt.Runtime{GlobalAccountID: "ga-2"},
+			at:      time.Date(2027, 1, 5, 0, 0, 0, 0, time.UTC),
+		},
+	} {
+		t.Run(tn, func(t *testing.T) {
+			// when
+			freeze, err := calendar.Freeze(tc.runtime, tc.at)
+
+			// then
+			require.NoError(t, err)
+			if tc.expected == "" {
+				assert.Nil(t, freeze)
+				return
+			}
+			require.NotNil(t, freeze)
+			assert.Equal(t, tc.expected, freeze.ID)
+		})
+	}
+}
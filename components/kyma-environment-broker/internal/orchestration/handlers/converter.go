@@ -135,3 +135,50 @@ func (c *Converter) UpgradeClusterOperationToDetailDTO(op internal.UpgradeCluste
 		ClusterConfig:     clusterConfig,
 	}, nil
 }
+
+func (c *Converter) RuntimeTaskOperationToDTO(op internal.Operation) (orchestration.OperationResponse, error) {
+	return orchestration.OperationResponse{
+		OperationID:            op.ID,
+		RuntimeID:              op.RuntimeOperation.RuntimeID,
+		GlobalAccountID:        op.GlobalAccountID,
+		SubAccountID:           op.RuntimeOperation.SubAccountID,
+		OrchestrationID:        op.OrchestrationID,
+		ServicePlanID:          op.ProvisioningParameters.PlanID,
+		ServicePlanName:        broker.PlanNamesMapping[op.ProvisioningParameters.PlanID],
+		DryRun:                 op.DryRun,
+		ShootName:              op.RuntimeOperation.ShootName,
+		MaintenanceWindowBegin: op.MaintenanceWindowBegin,
+		MaintenanceWindowEnd:   op.MaintenanceWindowEnd,
+		State:                  string(op.State),
+		Description:            op.Description,
+	}, nil
+}
+
+func (c *Converter) RuntimeTaskOperationListToDTO(ops []internal.Operation, count, totalCount int) (orchestration.OperationResponseList, error) {
+	data := make([]orchestration.OperationResponse, 0, len(ops))
+
+	for _, op := range ops {
+		o, err := c.RuntimeTaskOperationToDTO(op)
+		if err != nil {
+			return orchestration.OperationResponseList{}, fmt.Errorf("while converting operation to DTO: %w", err)
+		}
+		data = append(data, o)
+	}
+
+	return orchestration.OperationResponseList{
+		Data:       data,
+		Count:      count,
+		TotalCount: totalCount,
+	}, nil
+}
+
+func (c *Converter) RuntimeTaskOperationToDetailDTO(op internal.Operation) (orchestration.OperationDetailResponse, error) {
+	resp, err := c.RuntimeTaskOperationToDTO(op)
+	if err != nil {
+		return orchestration.OperationDetailResponse{}, fmt.Errorf("while converting operation to DTO: %w", err)
+	}
+	return orchestration.OperationDetailResponse{
+		OperationResponse: resp,
+		TaskResults:       op.RuntimeTaskResults,
+	}, nil
+}
@@ -0,0 +1,138 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/httputil"
+	internalOrchestration "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/orchestration"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+	"github.com/sirupsen/logrus"
+)
+
+type freezeHandler struct {
+	calendar *internalOrchestration.FreezeCalendar
+	freezes  storage.Freezes
+	log      logrus.FieldLogger
+}
+
+// NewFreezeHandler exposes the orchestration freeze calendar and allows to manage freezes which are not configured
+func NewFreezeHandler(calendar *internalOrchestration.FreezeCalendar, freezes storage.Freezes, log logrus.FieldLogger) *freezeHandler {
+	return &freezeHandler{
+		calendar: calendar,
+		freezes:  freezes,
+		log:      log,
+	}
+}
+
+func (h *freezeHandler) AttachRoutes(router *mux.Router) {
+	router.HandleFunc("/orchestrations/freezes", h.listFreezes).Methods(http.MethodGet)
+	router.HandleFunc("/orchestrations/freezes", h.createFreeze).Methods(http.MethodPost)
+	router.HandleFunc("/orchestrations/freezes/{freeze_id}", h.deleteFreeze).Methods(http.MethodDelete)
+}
+
+func (h *freezeHandler) listFreezes(w http.ResponseWriter, r *http.Request) {
+	freezes, err := h.calendar.List()
+	if err != nil {
+		h.log.Errorf("while listing freezes: %v", err)
+		httputil.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Errorf("while listing freezes: %w", err))
+		return
+	}
+
+	httputil.WriteResponse(w, http.StatusOK, orchestration.FreezeResponseList{
+		Data:  freezes,
+		Count: len(freezes),
+	})
+}
+
+func (h *freezeHandler) createFreeze(w http.ResponseWriter, r *http.Request) {
+	freeze := orchestration.Freeze{}
+	if r.Body != nil {
+		err := json.NewDecoder(r.Body).Decode(&freeze)
+		if err != nil {
+			h.log.Errorf("while decoding request body: %v", err)
+			httputil.WriteErrorResponse(w, http.StatusBadRequest, fmt.Errorf("while decoding request body: %v", err))
+			return
+		}
+	}
+
+	err := validateFreeze(freeze)
+	if err != nil {
+		h.log.Errorf("while validating freeze: %v", err)
+		httputil.WriteErrorResponse(w, http.StatusBadRequest, fmt.Errorf("while validating freeze: %w", err))
+		return
+	}
+
+	if freeze.ID == "" {
+		freeze.ID = uuid.New().String()
+	}
+	existing, err := h.calendar.Get(freeze.ID)
+	if err != nil {
+		h.log.Errorf("while getting freeze %s: %v", freeze.ID, err)
+		httputil.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Errorf("while getting freeze %s: %w", freeze.ID, err))
+		return
+	}
+	if existing != nil {
+		httputil.WriteErrorResponse(w, http.StatusConflict, fmt.Errorf("freeze %s already exists", freeze.ID))
+		return
+	}
+
+	err = h.freezes.Insert(freeze)
+	if err != nil {
+		h.log.Errorf("while inserting freeze to storage: %v", err)
+		httputil.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Errorf("while inserting freeze to storage: %w", err))
+		return
+	}
+
+	httputil.WriteResponse(w, http.StatusCreated, freeze)
+}
+
+func (h *freezeHandler) deleteFreeze(w http.ResponseWriter, r *http.Request) {
+	freezeID := mux.Vars(r)["freeze_id"]
+
+	freeze, err := h.calendar.Get(freezeID)
+	if err != nil {
+		h.log.Errorf("while getting freeze %s: %v", freezeID, err)
+		httputil.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Errorf("while getting freeze %s: %w", freezeID, err))
+		return
+	}
+	if freeze == nil {
+		httputil.WriteErrorResponse(w, http.StatusNotFound, fmt.Errorf("freeze %s does not exist", freezeID))
+		return
+	}
+	if freeze.Configured {
+		httputil.WriteErrorResponse(w, http.StatusBadRequest, fmt.Errorf("freeze %s is defined in the configuration and cannot be deleted", freezeID))
+		return
+	}
+
+	err = h.freezes.Delete(freezeID)
+	switch {
+	case dberr.IsNotFound(err):
+		httputil.WriteErrorResponse(w, http.StatusNotFound, fmt.Errorf("freeze %s does not exist", freezeID))
+		return
+	case err != nil:
+		h.log.Errorf("while deleting freeze %s: %v", freezeID, err)
+		httputil.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Errorf("while deleting freeze %s: %w", freezeID, err))
+		return
+	}
+
+	httputil.WriteResponse(w, http.StatusOK, freeze)
+}
+
+func validateFreeze(freeze orchestration.Freeze) error {
+	if freeze.Configured {
+		return fmt.Errorf("configured freezes can be defined only in the configuration file")
+	}
+	if freeze.Start.IsZero() || freeze.End.IsZero() {
+		return fmt.Errorf("start and end of the freeze must be set")
+	}
+	if !freeze.Start.Before(freeze.End) {
+		return fmt.Errorf("freeze must start before it ends")
+	}
+	return nil
+}
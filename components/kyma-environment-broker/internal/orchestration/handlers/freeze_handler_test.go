@@ -0,0 +1,100 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	internalOrchestration "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/orchestration"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFreezeHandler(t *testing.T) {
+	// given
+	db := storage.NewMemoryStorage()
+	start := time.Date(2026, 12, 20, 0, 0, 0, 0, time.UTC)
+	calendar := internalOrchestration.NewFreezeCalendar([]orchestration.Freeze{
+		{ID: "configured", Start: start, End: start.Add(24 * time.Hour), Configured: true},
+	}, db.Freezes())
+	router := mux.NewRouter()
+	NewFreezeHandler(calendar, db.Freezes(), logrus.New()).AttachRoutes(router)
+
+	t.Run("should create, list and delete freeze", func(t *testing.T) {
+		// when
+		body, err := json.Marshal(orchestration.Freeze{
+			GlobalAccountID: "ga-1",
+			Start:           start.Add(-time.Hour),
+			End:             start,
+			Reason:          "go-live",
+		})
+		require.NoError(t, err)
+		rr := serveFreezeRequest(router, http.MethodPost, "/orchestrations/freezes", body)
+
+		// then
+		require.Equal(t, http.StatusCreated, rr.Code)
+		var created orchestration.Freeze
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &created))
+		assert.NotEmpty(t, created.ID)
+
+		// when
+		rr = serveFreezeRequest(router, http.MethodGet, "/orchestrations/freezes", nil)
+
+		// then
+		require.Equal(t, http.StatusOK, rr.Code)
+		var list orchestration.FreezeResponseList
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &list))
+		require.Equal(t, 2, list.Count)
+		assert.Equal(t, created.ID, list.Data[0].ID)
+		assert.Equal(t, "configured", list.Data[1].ID)
+
+		// when
+		rr = serveFreezeRequest(router, http.MethodDelete, "/orchestrations/freezes/"+created.ID, nil)
+
+		// then
+		require.Equal(t, http.StatusOK, rr.Code)
+		freezes, err := db.Freezes().List()
+		require.NoError(t, err)
+		assert.Empty(t, freezes)
+	})
+
+	t.Run("should not create freeze which ends before it starts", func(t *testing.T) {
+		// when
+		body, err := json.Marshal(orchestration.Freeze{Start: start, End: start.Add(-time.Hour)})
+		require.NoError(t, err)
+		rr := serveFreezeRequest(router, http.MethodPost, "/orchestrations/freezes", body)
+
+		// then
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("should not delete configured freeze", func(t *testing.T) {
+		// when
+		rr := serveFreezeRequest(router, http.MethodDelete, "/orchestrations/freezes/configured", nil)
+
+		// then
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("should return not found for unknown freeze", func(t *testing.T) {
+		// when
+		rr := serveFreezeRequest(router, http.MethodDelete, "/orchestrations/freezes/unknown", nil)
+
+		// then
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
+
+func serveFreezeRequest(router *mux.Router, method, path string, body []byte) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, bytes.NewReader(body))
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+	return rr
+}
@@ -6,6 +6,8 @@ import (
 
 	"github.com/gorilla/mux"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration/strategies"
+	internalOrchestration "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/orchestration"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
 	"github.com/pkg/errors"
@@ -20,12 +22,16 @@ type handler struct {
 	handlers []Handler
 }
 
-func NewOrchestrationHandler(db storage.BrokerStorage, kymaQueue *process.Queue, clusterQueue *process.Queue, defaultMaxPage int, log logrus.FieldLogger) Handler {
+func NewOrchestrationHandler(db storage.BrokerStorage, kymaQueue *process.Queue, clusterQueue *process.Queue, taskQueue *process.Queue,
+	taskCfg internalOrchestration.RuntimeTaskConfig, freezes *internalOrchestration.FreezeCalendar, defaultMaxPage int, log logrus.FieldLogger) Handler {
 	return &handler{
 		handlers: []Handler{
 			NewKymaHandler(db.Orchestrations(), kymaQueue, log),
 			NewClusterHandler(db.Orchestrations(), clusterQueue, log),
-			NewOrchestrationStatusHandler(db.Operations(), db.Orchestrations(), db.RuntimeStates(), kymaQueue, clusterQueue, defaultMaxPage, log),
+			NewRuntimeTaskHandler(db.Orchestrations(), taskQueue, taskCfg, log),
+			// freezes must be attached before the status handler, otherwise the path matches an orchestration ID
+			NewFreezeHandler(freezes, db.Freezes(), log),
+			NewOrchestrationStatusHandler(db.Operations(), db.Orchestrations(), db.RuntimeStates(), kymaQueue, clusterQueue, taskQueue, defaultMaxPage, log),
 		},
 	}
 }
@@ -67,3 +73,12 @@ func ValidateScheduleParameter(params *orchestration.Parameters) error {
 	}
 	return nil
 }
+
+// ValidateStrategyParameter cheks if the parameters of the selected strategy are valid.
+func ValidateStrategyParameter(params orchestration.Parameters) error {
+	switch params.Strategy.Type {
+	case orchestration.CanaryStrategy:
+		return strategies.ValidateCanaryStrategySpec(params.Strategy.Canary)
+	}
+	return nil
+}
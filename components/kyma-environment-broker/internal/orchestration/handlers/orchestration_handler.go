@@ -31,8 +31,10 @@ type orchestrationHandler struct {
 	log       logrus.FieldLogger
 
 	canceler       *Canceler
+	pauser         *Pauser
 	kymaRetryer    *kymaRetryer
 	clusterRetryer *clusterRetryer
+	taskRetryer    *runtimeTaskRetryer
 
 	defaultMaxPage int
 }
@@ -43,6 +45,7 @@ func NewOrchestrationStatusHandler(operations storage.Operations,
 	runtimeStates storage.RuntimeStates,
 	kymaQueue *process.Queue,
 	clusterQueue *process.Queue,
+	taskQueue *process.Queue,
 	defaultMaxPage int,
 	log logrus.FieldLogger) *orchestrationHandler {
 	return &orchestrationHandler{
@@ -53,8 +56,10 @@ func NewOrchestrationStatusHandler(operations storage.Operations,
 		defaultMaxPage: defaultMaxPage,
 		converter:      Converter{},
 		canceler:       NewCanceler(orchestrations, log),
+		pauser:         NewPauser(orchestrations, log),
 		kymaRetryer:    NewKymaRetryer(orchestrations, operations, kymaQueue, log),
 		clusterRetryer: NewClusterRetryer(orchestrations, operations, clusterQueue, log),
+		taskRetryer:    NewRuntimeTaskRetryer(orchestrations, operations, taskQueue, log),
 	}
 }
 
@@ -62,6 +67,8 @@ func (h *orchestrationHandler) AttachRoutes(router *mux.Router) {
 	router.HandleFunc("/orchestrations", h.listOrchestration).Methods(http.MethodGet)
 	router.HandleFunc("/orchestrations/{orchestration_id}", h.getOrchestration).Methods(http.MethodGet)
 	router.HandleFunc("/orchestrations/{orchestration_id}/cancel", h.cancelOrchestrationByID).Methods(http.MethodPut)
+	router.HandleFunc("/orchestrations/{orchestration_id}/pause", h.pauseOrchestrationByID).Methods(http.MethodPut)
+	router.HandleFunc("/orchestrations/{orchestration_id}/resume", h.resumeOrchestrationByID).Methods(http.MethodPut)
 	router.HandleFunc("/orchestrations/{orchestration_id}/operations", h.listOperations).Methods(http.MethodGet)
 	router.HandleFunc("/orchestrations/{orchestration_id}/operations/{operation_id}", h.getOperation).Methods(http.MethodGet)
 	router.HandleFunc("/orchestrations/{orchestration_id}/retry", h.retryOrchestrationByID).Methods(http.MethodPost)
@@ -109,6 +116,36 @@ func (h *orchestrationHandler) cancelOrchestrationByID(w http.ResponseWriter, r
 	httputil.WriteResponse(w, http.StatusOK, response)
 }
 
+func (h *orchestrationHandler) pauseOrchestrationByID(w http.ResponseWriter, r *http.Request) {
+	orchestrationID := mux.Vars(r)["orchestration_id"]
+
+	err := h.pauser.PauseForID(orchestrationID)
+	if err != nil {
+		h.log.Errorf("while pausing orchestration %s: %v", orchestrationID, err)
+		httputil.WriteErrorResponse(w, h.resolveErrorStatus(err), fmt.Errorf("while pausing orchestration %s: %w", orchestrationID, err))
+		return
+	}
+
+	response := commonOrchestration.UpgradeResponse{OrchestrationID: orchestrationID}
+
+	httputil.WriteResponse(w, http.StatusOK, response)
+}
+
+func (h *orchestrationHandler) resumeOrchestrationByID(w http.ResponseWriter, r *http.Request) {
+	orchestrationID := mux.Vars(r)["orchestration_id"]
+
+	err := h.pauser.ResumeForID(orchestrationID)
+	if err != nil {
+		h.log.Errorf("while resuming orchestration %s: %v", orchestrationID, err)
+		httputil.WriteErrorResponse(w, h.resolveErrorStatus(err), fmt.Errorf("while resuming orchestration %s: %w", orchestrationID, err))
+		return
+	}
+
+	response := commonOrchestration.UpgradeResponse{OrchestrationID: orchestrationID}
+
+	httputil.WriteResponse(w, http.StatusOK, response)
+}
+
 func (h *orchestrationHandler) retryOrchestrationByID(w http.ResponseWriter, r *http.Request) {
 	contentType := r.Header.Get("Content-type")
 	if contentType != "application/x-www-form-urlencoded" {
@@ -173,6 +210,20 @@ func (h *orchestrationHandler) retryOrchestrationByID(w http.ResponseWriter, r *
 			return
 		}
 
+	case commonOrchestration.RuntimeTaskOrchestration:
+		allOps, _, _, err := h.operations.ListOperationsByOrchestrationID(o.OrchestrationID, filter)
+		if err != nil {
+			h.log.Errorf("while getting operations: %v", err)
+			httputil.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Errorf("while getting operations: %w", err))
+			return
+		}
+
+		response, err = h.taskRetryer.orchestrationRetry(o, allOps, operationIDs)
+		if err != nil {
+			httputil.WriteErrorResponse(w, http.StatusInternalServerError, err)
+			return
+		}
+
 	default:
 		httputil.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Errorf("unsupported orchestration type: %s", o.Type))
 		return
@@ -264,6 +315,20 @@ func (h *orchestrationHandler) listOperations(w http.ResponseWriter, r *http.Req
 			return
 		}
 
+	case commonOrchestration.RuntimeTaskOrchestration:
+		operations, count, totalCount, err := h.operations.ListOperationsByOrchestrationID(orchestrationID, filter)
+		if err != nil {
+			h.log.Errorf("while getting operations: %v", err)
+			httputil.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Errorf("while getting operations: %w", err))
+			return
+		}
+		response, err = h.converter.RuntimeTaskOperationListToDTO(operations, count, totalCount)
+		if err != nil {
+			h.log.Errorf("while converting operations: %v", err)
+			httputil.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Errorf("while converting operations: %w", err))
+			return
+		}
+
 	default:
 		httputil.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Errorf("unsupported orchestration type: %s", o.Type))
 		return
@@ -322,6 +387,21 @@ func (h *orchestrationHandler) getOperation(w http.ResponseWriter, r *http.Reque
 			return
 		}
 
+	case commonOrchestration.RuntimeTaskOrchestration:
+		operation, err := h.operations.GetOperationByID(operationID)
+		if err != nil {
+			h.log.Errorf("while getting runtime task operation %s: %v", operationID, err)
+			httputil.WriteErrorResponse(w, h.resolveErrorStatus(err), fmt.Errorf("while getting runtime task operation %s: %w", operationID, err))
+			return
+		}
+
+		response, err = h.converter.RuntimeTaskOperationToDetailDTO(*operation)
+		if err != nil {
+			h.log.Errorf("while converting operation: %v", err)
+			httputil.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Errorf("while converting operation: %w", err))
+			return
+		}
+
 	default:
 		httputil.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Errorf("unsupported orchestration type: %s", o.Type))
 		return
@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+func TestPauser_PauseForID(t *testing.T) {
+	t.Run("should pause orchestration in progress", func(t *testing.T) {
+		s := storage.NewMemoryStorage()
+		err := s.Orchestrations().Insert(fixOrchestration())
+		require.NoError(t, err)
+
+		p := NewPauser(s.Orchestrations(), logrus.New())
+
+		err = p.PauseForID(fixOrchestrationID)
+		require.NoError(t, err)
+
+		assertOrchestrationState(t, s.Orchestrations(), orchestration.Paused)
+	})
+	t.Run("already paused", func(t *testing.T) {
+		s := storage.NewMemoryStorage()
+		o := fixOrchestration()
+		o.State = orchestration.Paused
+		err := s.Orchestrations().Insert(o)
+		require.NoError(t, err)
+
+		p := NewPauser(s.Orchestrations(), logrus.New())
+
+		err = p.PauseForID(fixOrchestrationID)
+		require.NoError(t, err)
+
+		assertOrchestrationState(t, s.Orchestrations(), orchestration.Paused)
+	})
+	t.Run("should not pause finished orchestration", func(t *testing.T) {
+		s := storage.NewMemoryStorage()
+		o := fixOrchestration()
+		o.State = orchestration.Succeeded
+		err := s.Orchestrations().Insert(o)
+		require.NoError(t, err)
+
+		p := NewPauser(s.Orchestrations(), logrus.New())
+
+		err = p.PauseForID(fixOrchestrationID)
+		assert.True(t, apiErrors.IsBadRequest(err))
+
+		assertOrchestrationState(t, s.Orchestrations(), orchestration.Succeeded)
+	})
+	t.Run("should return error when orchestration not found", func(t *testing.T) {
+		s := storage.NewMemoryStorage()
+		p := NewPauser(s.Orchestrations(), logrus.New())
+
+		err := p.PauseForID(fixOrchestrationID)
+		assert.Error(t, err)
+	})
+}
+
+func TestPauser_ResumeForID(t *testing.T) {
+	t.Run("should resume paused orchestration", func(t *testing.T) {
+		s := storage.NewMemoryStorage()
+		o := fixOrchestration()
+		o.State = orchestration.Paused
+		err := s.Orchestrations().Insert(o)
+		require.NoError(t, err)
+
+		p := NewPauser(s.Orchestrations(), logrus.New())
+
+		err = p.ResumeForID(fixOrchestrationID)
+		require.NoError(t, err)
+
+		assertOrchestrationState(t, s.Orchestrations(), orchestration.InProgress)
+	})
+	t.Run("should not resume canceling orchestration", func(t *testing.T) {
+		s := storage.NewMemoryStorage()
+		o := fixOrchestration()
+		o.State = orchestration.Canceling
+		err := s.Orchestrations().Insert(o)
+		require.NoError(t, err)
+
+		p := NewPauser(s.Orchestrations(), logrus.New())
+
+		err = p.ResumeForID(fixOrchestrationID)
+		assert.True(t, apiErrors.IsBadRequest(err))
+
+		assertOrchestrationState(t, s.Orchestrations(), orchestration.Canceling)
+	})
+}
+
+func assertOrchestrationState(t *testing.T, s storage.Orchestrations, state string) {
+	o, err := s.GetByID(fixOrchestrationID)
+	require.NoError(t, err)
+	assert.Equal(t, state, o.State)
+}
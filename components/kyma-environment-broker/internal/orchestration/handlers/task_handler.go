@@ -0,0 +1,125 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/httputil"
+	internalOrchestration "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/orchestration"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/sirupsen/logrus"
+)
+
+type runtimeTaskHandler struct {
+	orchestrations storage.Orchestrations
+	queue          *process.Queue
+	cfg            internalOrchestration.RuntimeTaskConfig
+	log            logrus.FieldLogger
+}
+
+// NewRuntimeTaskHandler creates orchestrations which apply a manifest or patches to the targeted runtimes
+func NewRuntimeTaskHandler(orchestrations storage.Orchestrations, q *process.Queue, cfg internalOrchestration.RuntimeTaskConfig, log logrus.FieldLogger) *runtimeTaskHandler {
+	return &runtimeTaskHandler{
+		orchestrations: orchestrations,
+		queue:          q,
+		cfg:            cfg,
+		log:            log,
+	}
+}
+
+func (h *runtimeTaskHandler) AttachRoutes(router *mux.Router) {
+	router.HandleFunc("/orchestrations/runtime-task", h.createOrchestration).Methods(http.MethodPost)
+}
+
+func (h *runtimeTaskHandler) createOrchestration(w http.ResponseWriter, r *http.Request) {
+	// validate request body
+	params := orchestration.Parameters{}
+	if r.Body != nil {
+		err := json.NewDecoder(r.Body).Decode(&params)
+		if err != nil {
+			h.log.Errorf("while decoding request body: %v", err)
+			httputil.WriteErrorResponse(w, http.StatusBadRequest, fmt.Errorf("while decoding request body: %w", err))
+			return
+		}
+	}
+
+	// validate target
+	err := validateTarget(params.Targets)
+	if err != nil {
+		h.log.Errorf("while validating target: %v", err)
+		httputil.WriteErrorResponse(w, http.StatusBadRequest, fmt.Errorf("while validating target: %w", err))
+		return
+	}
+
+	// validate deprecated parameteter `maintenanceWindow`
+	err = ValidateDeprecatedParameters(params)
+	if err != nil {
+		h.log.Errorf("found deprecated value: %v", err)
+		httputil.WriteErrorResponse(w, http.StatusBadRequest, fmt.Errorf("found deprecated value: %w", err))
+		return
+	}
+
+	// validate `schedule` field
+	err = ValidateScheduleParameter(&params)
+	if err != nil {
+		h.log.Errorf("found deprecated value: %v", err)
+		httputil.WriteErrorResponse(w, http.StatusBadRequest, fmt.Errorf("found deprecated value: %w", err))
+		return
+	}
+
+	// validate `strategy` field
+	err = ValidateStrategyParameter(params)
+	if err != nil {
+		h.log.Errorf("while validating strategy: %v", err)
+		httputil.WriteErrorResponse(w, http.StatusBadRequest, fmt.Errorf("while validating strategy: %w", err))
+		return
+	}
+
+	// customers are notified only about the maintenance of Kyma and Kubernetes
+	if params.Notification {
+		err = errors.New("notification is not supported for runtime tasks")
+		h.log.Errorf("while validating notification: %v", err)
+		httputil.WriteErrorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// validate `task` field
+	err = h.cfg.Validate(params.Task)
+	if err != nil {
+		h.log.Errorf("while validating task: %v", err)
+		httputil.WriteErrorResponse(w, http.StatusBadRequest, fmt.Errorf("while validating task: %w", err))
+		return
+	}
+
+	now := time.Now()
+	o := internal.Orchestration{
+		OrchestrationID: uuid.New().String(),
+		Type:            orchestration.RuntimeTaskOrchestration,
+		State:           orchestration.Pending,
+		Description:     "queued for processing",
+		Parameters:      params,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+
+	err = h.orchestrations.Insert(o)
+	if err != nil {
+		h.log.Errorf("while inserting orchestration to storage: %v", err)
+		httputil.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Errorf("while inserting orchestration to storage: %w", err))
+		return
+	}
+
+	h.queue.Add(o.OrchestrationID)
+
+	response := orchestration.UpgradeResponse{OrchestrationID: o.OrchestrationID}
+
+	httputil.WriteResponse(w, http.StatusAccepted, response)
+}
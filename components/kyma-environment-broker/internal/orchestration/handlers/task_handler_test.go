@@ -0,0 +1,125 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	internalOrchestration "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/orchestration"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const taskManifest = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: settings
+  namespace: kyma-system
+data:
+  key: value
+`
+
+func TestRuntimeTaskHandler_AttachRoutes(t *testing.T) {
+	t.Run("create runtime task", func(t *testing.T) {
+		// given
+		db := storage.NewMemoryStorage()
+		router := fixRuntimeTaskRouter(db)
+		params := fixRuntimeTaskParameters(&orchestration.RuntimeTaskParameters{Manifest: taskManifest})
+
+		// when
+		rr := postRuntimeTask(t, router, params)
+
+		// then
+		require.Equal(t, http.StatusAccepted, rr.Code)
+
+		var out orchestration.UpgradeResponse
+		err := json.Unmarshal(rr.Body.Bytes(), &out)
+		require.NoError(t, err)
+
+		o, err := db.Orchestrations().GetByID(out.OrchestrationID)
+		require.NoError(t, err)
+		assert.Equal(t, orchestration.RuntimeTaskOrchestration, o.Type)
+		assert.Equal(t, orchestration.Pending, o.State)
+		assert.Equal(t, taskManifest, o.Parameters.Task.Manifest)
+	})
+
+	t.Run("reject invalid requests", func(t *testing.T) {
+		for name, params := range map[string]orchestration.Parameters{
+			"missing task": fixRuntimeTaskParameters(nil),
+			"not allowed kind": fixRuntimeTaskParameters(&orchestration.RuntimeTaskParameters{
+				Manifest: "apiVersion: v1\nkind: Namespace\nmetadata:\n  name: test\n",
+			}),
+			"protected namespace": fixRuntimeTaskParameters(&orchestration.RuntimeTaskParameters{
+				Patches: []orchestration.RuntimeTaskPatch{{APIVersion: "v1", Kind: "ConfigMap", Namespace: "kube-system", Name: "test", Type: orchestration.MergePatch, Patch: json.RawMessage(`{"data":{}}`)}},
+			}),
+			"notification": func() orchestration.Parameters {
+				params := fixRuntimeTaskParameters(&orchestration.RuntimeTaskParameters{Manifest: taskManifest})
+				params.Notification = true
+				return params
+			}(),
+			"missing targets": {
+				Strategy: orchestration.StrategySpec{Schedule: "now"},
+				Task:     &orchestration.RuntimeTaskParameters{Manifest: taskManifest},
+			},
+		} {
+			t.Run(name, func(t *testing.T) {
+				// given
+				db := storage.NewMemoryStorage()
+				router := fixRuntimeTaskRouter(db)
+
+				// when
+				rr := postRuntimeTask(t, router, params)
+
+				// then
+				assert.Equal(t, http.StatusBadRequest, rr.Code)
+				_, count, _, err := db.Orchestrations().List(dbmodel.OrchestrationFilter{})
+				require.NoError(t, err)
+				assert.Zero(t, count)
+			})
+		}
+	})
+}
+
+func fixRuntimeTaskRouter(db storage.BrokerStorage) *mux.Router {
+	logs := logrus.New()
+	q := process.NewQueue(&testExecutor{}, logs)
+	cfg := internalOrchestration.RuntimeTaskConfig{
+		AllowedKinds:        []string{"ConfigMap"},
+		ProtectedNamespaces: []string{"kube-system"},
+	}
+	router := mux.NewRouter()
+	NewRuntimeTaskHandler(db.Orchestrations(), q, cfg, logs).AttachRoutes(router)
+	return router
+}
+
+func fixRuntimeTaskParameters(task *orchestration.RuntimeTaskParameters) orchestration.Parameters {
+	return orchestration.Parameters{
+		Targets: orchestration.TargetSpec{
+			Include: []orchestration.RuntimeTarget{{RuntimeID: "test"}},
+		},
+		Strategy: orchestration.StrategySpec{
+			Schedule: "now",
+		},
+		Task: task,
+	}
+}
+
+func postRuntimeTask(t *testing.T, router *mux.Router, params orchestration.Parameters) *httptest.ResponseRecorder {
+	p, err := json.Marshal(&params)
+	require.NoError(t, err)
+
+	req, err := http.NewRequest("POST", "/orchestrations/runtime-task", bytes.NewBuffer(p))
+	require.NoError(t, err)
+
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+	return rr
+}
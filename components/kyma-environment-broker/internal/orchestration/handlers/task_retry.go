@@ -0,0 +1,76 @@
+package handlers
+
+import (
+	commonOrchestration "github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/sirupsen/logrus"
+)
+
+type runtimeTaskRetryer Retryer
+
+func NewRuntimeTaskRetryer(orchestrations storage.Orchestrations, operations storage.Operations, q *process.Queue, logger logrus.FieldLogger) *runtimeTaskRetryer {
+	return &runtimeTaskRetryer{
+		orchestrations: orchestrations,
+		operations:     operations,
+		queue:          q,
+		log:            logger,
+	}
+}
+
+// orchestrationRetry retries the failed operations of the runtime task. Unlike upgrades, newer operations
+// of the same instance do not replace the failed ones, because they belong to other tasks.
+func (r *runtimeTaskRetryer) orchestrationRetry(o *internal.Orchestration, opsByOrch []internal.Operation, operationIDs []string) (commonOrchestration.RetryResponse, error) {
+	resp := commonOrchestration.RetryResponse{OrchestrationID: o.OrchestrationID}
+
+	ops, invalidIDs := r.orchestrationOperationsFilter(opsByOrch, operationIDs)
+	resp.InvalidOperations = invalidIDs
+	if len(ops) == 0 {
+		zeroValidOperationInfo(&resp, r.log)
+		return resp, nil
+	}
+
+	for _, op := range ops {
+		resp.RetryShoots = append(resp.RetryShoots, op.InstanceDetails.ShootName)
+		o.Parameters.RetryOperation.RetryOperations = append(o.Parameters.RetryOperation.RetryOperations, op.ID)
+	}
+	resp.Msg = "retry operations are queued for processing"
+
+	// get orchestration state again in case in progress changed to failed, need to put in queue
+	lastState, err := orchestrationStateUpdate(o, r.orchestrations, o.OrchestrationID, r.log)
+	if err != nil {
+		return resp, err
+	}
+
+	if lastState == commonOrchestration.Failed {
+		r.queue.Add(o.OrchestrationID)
+	}
+
+	return resp, nil
+}
+
+func (r *runtimeTaskRetryer) orchestrationOperationsFilter(opsByOrch []internal.Operation, opsIDs []string) ([]internal.Operation, []string) {
+	if len(opsIDs) <= 0 {
+		return opsByOrch, nil
+	}
+
+	var retOps []internal.Operation
+	var invalidIDs []string
+
+	for _, opID := range opsIDs {
+		found := false
+		for _, op := range opsByOrch {
+			if opID == op.ID {
+				retOps = append(retOps, op)
+				found = true
+				break
+			}
+		}
+		if !found {
+			invalidIDs = append(invalidIDs, opID)
+		}
+	}
+
+	return retOps, invalidIDs
+}
@@ -16,7 +16,9 @@ import (
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration/strategies"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
 	kebError "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/error"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/event"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/notification"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
 	"github.com/pivotal-cf/brokerapi/v8/domain"
@@ -49,6 +51,8 @@ type orchestrationManager struct {
 	kymaVersion          string
 	kubernetesVersion    string
 	bundleBuilder        notification.BundleBuilder
+	publisher            event.Publisher
+	freezes              orchestration.FreezeCalendar
 	speedFactor          int
 }
 
@@ -70,6 +74,15 @@ func (m *orchestrationManager) Execute(orchestrationID string) (time.Duration, e
 		}
 		return m.failOrchestration(o, fmt.Errorf("failed to get orchestration: %w", err))
 	}
+	loadedState := o.State
+
+	// an orchestration paused before restart does not schedule operations until it is resumed
+	if o.State == orchestration.Paused {
+		o, err = m.waitForResume(o, logger)
+		if err != nil {
+			return 0, fmt.Errorf("while waiting for orchestration to be resumed: %w", err)
+		}
+	}
 
 	operations, runtimeNums, err := m.waitForStart(o)
 	if err != nil {
@@ -96,6 +109,9 @@ func (m *orchestrationManager) Execute(orchestrationID string) (time.Duration, e
 		logger.Errorf("while updating orchestration: %v", err)
 		return m.pollingInterval, nil
 	}
+	if o.State != loadedState {
+		m.publishStateChanged(o)
+	}
 	// do not perform any action if the orchestration is finished
 	if o.IsFinished() {
 		m.log.Infof("Orchestration was already finished, state: %s", o.State)
@@ -122,6 +138,7 @@ func (m *orchestrationManager) Execute(orchestrationID string) (time.Duration, e
 		logger.Errorf("while updating orchestration: %v", err)
 		return m.pollingInterval, nil
 	}
+	m.publishStateChanged(o)
 
 	logger.Infof("Finished processing orchestration, state: %s", o.State)
 	return 0, nil
@@ -177,6 +194,7 @@ func (m *orchestrationManager) NewOperationForPendingRetrying(o *internal.Orches
 
 	for _, r := range fileterRuntimes {
 		var op orchestration.RuntimeOperation
+		var freeze *orchestration.Freeze
 		if o.State == orchestration.Pending {
 			exist, op, err := m.factory.QueryOperation(o.OrchestrationID, r)
 			if err != nil {
@@ -202,6 +220,10 @@ func (m *orchestrationManager) NewOperationForPendingRetrying(o *internal.Orches
 			r.MaintenanceWindowBegin = windowBegin
 			r.MaintenanceWindowEnd = windowEnd
 			r.MaintenanceDays = days
+			freeze, err = m.windowAfterFreeze(&r)
+			if err != nil {
+				return nil, o, runtimes, fmt.Errorf("while checking freezes for runtime id %q: %w", r.RuntimeID, err)
+			}
 		} else {
 			if o.Parameters.RetryOperation.Immediate {
 				r.MaintenanceWindowBegin = time.Time{}
@@ -219,6 +241,9 @@ func (m *orchestrationManager) NewOperationForPendingRetrying(o *internal.Orches
 		if err != nil {
 			return nil, o, runtimes, fmt.Errorf("while creating new operation for runtime id %q: %w", r.RuntimeID, err)
 		}
+		if freeze != nil {
+			m.postpone(op, freeze.PostponedDescription(op.MaintenanceWindowBegin))
+		}
 
 		result = append(result, op)
 
@@ -227,6 +252,31 @@ func (m *orchestrationManager) NewOperationForPendingRetrying(o *internal.Orches
 	return result, o, fileterRuntimes, nil
 }
 
+// windowAfterFreeze moves the maintenance window of the runtime after the freeze which covers it, if there is any
+func (m *orchestrationManager) windowAfterFreeze(r *orchestration.Runtime) (*orchestration.Freeze, error) {
+	if m.freezes == nil || r.MaintenanceWindowBegin.IsZero() {
+		return nil, nil
+	}
+	freeze, err := m.freezes.Freeze(*r, r.MaintenanceWindowBegin)
+	if err != nil || freeze == nil {
+		return nil, err
+	}
+	r.MaintenanceWindowBegin, r.MaintenanceWindowEnd = orchestration.WindowAfterFreeze(r.MaintenanceWindowBegin, r.MaintenanceWindowEnd, r.MaintenanceDays, *freeze)
+	return freeze, nil
+}
+
+// postpone stores the reason why the operation was postponed, if the executor supports it
+func (m *orchestrationManager) postpone(op orchestration.RuntimeOperation, description string) {
+	postponer, ok := m.executor.(orchestration.OperationPostponer)
+	if !ok {
+		return
+	}
+	err := postponer.Postpone(op.ID, op.MaintenanceWindowBegin, op.MaintenanceWindowEnd, description)
+	if err != nil {
+		m.log.Errorf("while postponing operation %s: %v", op.ID, err)
+	}
+}
+
 func (m *orchestrationManager) cancelOperationForNonExistent(o *internal.Orchestration, resolvedOperations []orchestration.RuntimeOperation) error {
 	storageOperations, err := m.factory.QueryOperations(o.OrchestrationID)
 	if err != nil {
@@ -318,7 +368,13 @@ func (m *orchestrationManager) resolveOperations(o *internal.Orchestration, poli
 func (m *orchestrationManager) resolveStrategy(sType orchestration.StrategyType, executor orchestration.OperationExecutor, log logrus.FieldLogger) orchestration.Strategy {
 	switch sType {
 	case orchestration.ParallelStrategy:
-		s := strategies.NewParallelOrchestrationStrategy(executor, log, 0)
+		s := strategies.NewParallelOrchestrationStrategy(executor, m.freezes, log, 0)
+		if m.speedFactor != 0 {
+			s.SpeedUp(m.speedFactor)
+		}
+		return s
+	case orchestration.CanaryStrategy:
+		s := strategies.NewCanaryOrchestrationStrategy(executor, &operationResults{operations: m.operationStorage}, m.freezes, log, 0)
 		if m.speedFactor != 0 {
 			s.SpeedUp(m.speedFactor)
 		}
@@ -327,10 +383,64 @@ func (m *orchestrationManager) resolveStrategy(sType orchestration.StrategyType,
 	return nil
 }
 
+// operationResults reads the results of the orchestrated operations for strategies which depend on them
+type operationResults struct {
+	operations storage.Operations
+}
+
+func (r *operationResults) IsFailed(operationID string) (bool, error) {
+	op, err := r.operations.GetOperationByID(operationID)
+	if err != nil {
+		return false, fmt.Errorf("while getting operation %s: %w", operationID, err)
+	}
+	return op.State == domain.Failed, nil
+}
+
+// haltReason returns the reason why one of the executions was halted by the strategy, if it supports halting
+func haltReason(strategy orchestration.Strategy, execIDs []string) string {
+	halting, ok := strategy.(orchestration.HaltingStrategy)
+	if !ok {
+		return ""
+	}
+	for _, execID := range execIDs {
+		if reason := halting.Halted(execID); reason != "" {
+			return reason
+		}
+	}
+	return ""
+}
+
+// waitForResume waits until the paused orchestration is resumed or canceled
+func (m *orchestrationManager) waitForResume(o *internal.Orchestration, log logrus.FieldLogger) (*internal.Orchestration, error) {
+	log.Info("Orchestration is paused, waiting for resume")
+	err := wait.PollImmediateInfinite(m.pollingInterval, func() (bool, error) {
+		current, err := m.orchestrationStorage.GetByID(o.OrchestrationID)
+		switch {
+		case err == nil:
+			o = current
+			return o.State != orchestration.Paused, nil
+		case dberr.IsNotFound(err):
+			return false, err
+		default:
+			log.Errorf("while getting orchestration: %v", err)
+			return false, nil
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.Infof("Orchestration left paused state, state: %s", o.State)
+	return o, nil
+}
+
 // waitForCompletion waits until processing of given orchestration ends or if it's canceled
 func (m *orchestrationManager) waitForCompletion(o *internal.Orchestration, strategy orchestration.Strategy, execID string, log logrus.FieldLogger) (*internal.Orchestration, error) {
 	orchestrationID := o.OrchestrationID
+	observedState := o.State
 	canceled := false
+	paused := false
+	halted := ""
+	haltCanceled := false
 	var err error
 	var stats map[string]int
 	execIDs := []string{execID}
@@ -344,6 +454,11 @@ func (m *orchestrationManager) waitForCompletion(o *internal.Orchestration, stra
 				log.Info("Orchestration was canceled")
 				canceled = true
 			}
+			// the orchestration can be paused, resumed or canceled with the API
+			if o.State != observedState {
+				observedState = o.State
+				m.publishStateChanged(o)
+			}
 		case dberr.IsNotFound(err):
 			log.Errorf("while getting orchestration: %v", err)
 			return false, err
@@ -351,6 +466,19 @@ func (m *orchestrationManager) waitForCompletion(o *internal.Orchestration, stra
 			log.Errorf("while getting orchestration: %v", err)
 			return false, nil
 		}
+		if o.State == orchestration.Paused && !paused {
+			log.Info("Orchestration was paused")
+			for _, id := range execIDs {
+				strategy.Pause(id)
+			}
+			paused = true
+		} else if o.State != orchestration.Paused && paused {
+			log.Info("Orchestration was resumed")
+			for _, id := range execIDs {
+				strategy.Resume(id)
+			}
+			paused = false
+		}
 		s, err := m.operationStorage.GetOperationStatsForOrchestration(o.OrchestrationID)
 		if err != nil {
 			log.Errorf("while getting operations: %v", err)
@@ -358,6 +486,24 @@ func (m *orchestrationManager) waitForCompletion(o *internal.Orchestration, stra
 		}
 		stats = s
 
+		// cancel the operations which were not started if the strategy halted the execution, the reason is kept
+		// before the operations are canceled as the strategy may report it only once
+		if !canceled && halted == "" {
+			if reason := haltReason(strategy, execIDs); reason != "" {
+				log.Infof("Orchestration was halted by the strategy: %s", reason)
+				halted = reason
+			}
+		}
+		if halted != "" && !haltCanceled {
+			err := m.factory.CancelOperations(orchestrationID)
+			if err != nil {
+				log.Errorf("while canceling operations of halted orchestration: %v", err)
+				return false, nil
+			}
+			haltCanceled = true
+			return false, nil
+		}
+
 		numberOfNotFinished := 0
 		numberOfInProgress, found := stats[orchestration.InProgress]
 		if found {
@@ -392,6 +538,9 @@ func (m *orchestrationManager) waitForCompletion(o *internal.Orchestration, stra
 				}
 				execIDs = append(execIDs, retryExecID)
 				execID = retryExecID
+				if paused {
+					strategy.Pause(retryExecID)
+				}
 			}
 			o.Description = updateRetryingDescription(o.Description, fmt.Sprintf("retried %d operations", len(o.Parameters.RetryOperation.RetryOperations)))
 			o.Parameters.RetryOperation.RetryOperations = nil
@@ -416,7 +565,15 @@ func (m *orchestrationManager) waitForCompletion(o *internal.Orchestration, stra
 		return nil, fmt.Errorf("while waiting for scheduled operations to finish: %w", err)
 	}
 
-	return m.resolveOrchestration(o, strategy, execIDs, stats)
+	o, err = m.resolveOrchestration(o, strategy, execIDs, stats)
+	if err != nil {
+		return nil, err
+	}
+	if halted != "" {
+		o.State = orchestration.Failed
+		o.Description = halted
+	}
+	return o, nil
 }
 
 func (m *orchestrationManager) resolveOrchestration(o *internal.Orchestration, strategy orchestration.Strategy, execIDs []string, stats map[string]int) (*internal.Orchestration, error) {
@@ -554,6 +711,7 @@ func (m *orchestrationManager) failOrchestration(o *internal.Orchestration, err
 }
 
 func (m *orchestrationManager) updateOrchestration(o *internal.Orchestration, state, description string) time.Duration {
+	previousState := o.State
 	o.UpdatedAt = time.Now()
 	o.State = state
 	o.Description = description
@@ -563,10 +721,20 @@ func (m *orchestrationManager) updateOrchestration(o *internal.Orchestration, st
 			m.log.Errorf("while updating orchestration: %v", err)
 			return time.Minute
 		}
+		return 0
+	}
+	if previousState != state {
+		m.publishStateChanged(o)
 	}
 	return 0
 }
 
+func (m *orchestrationManager) publishStateChanged(o *internal.Orchestration) {
+	m.publisher.Publish(context.TODO(), process.OrchestrationStateChanged{
+		Orchestration: *o,
+	})
+}
+
 func (m *orchestrationManager) sendNotificationCreate(o *internal.Orchestration, operations []orchestration.RuntimeOperation) error {
 	eventType := ""
 	tenants := []notification.NotificationTenant{}
@@ -8,6 +8,7 @@ import (
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration/automock"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/event"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/notification"
 	notificationAutomock "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/notification/mocks"
 	internalOrchestration "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/orchestration"
@@ -70,7 +71,7 @@ func TestUpgradeClusterManager_Execute(t *testing.T) {
 		bundle.On("CreateNotificationEvent").Return(nil).Once()
 
 		svc := manager.NewUpgradeClusterManager(store.Orchestrations(), store.Operations(), store.Instances(), nil,
-			resolver, 20*time.Millisecond, logrus.New(), k8sClient, orchestrationConfig, notificationBuilder, 1000)
+			resolver, 20*time.Millisecond, logrus.New(), k8sClient, orchestrationConfig, notificationBuilder, event.NewPubSub(logrus.New()), nil, 1000)
 
 		// when
 		_, err = svc.Execute(id)
@@ -116,7 +117,7 @@ func TestUpgradeClusterManager_Execute(t *testing.T) {
 		bundle.On("CreateNotificationEvent").Return(nil).Once()
 
 		svc := manager.NewUpgradeClusterManager(store.Orchestrations(), store.Operations(), store.Instances(), &testExecutor{},
-			resolver, poolingInterval, logrus.New(), k8sClient, orchestrationConfig, notificationBuilder, 1000)
+			resolver, poolingInterval, logrus.New(), k8sClient, orchestrationConfig, notificationBuilder, event.NewPubSub(logrus.New()), nil, 1000)
 
 		// when
 		_, err = svc.Execute(id)
@@ -165,7 +166,7 @@ func TestUpgradeClusterManager_Execute(t *testing.T) {
 		bundle.On("CreateNotificationEvent").Return(nil).Once()
 
 		svc := manager.NewUpgradeClusterManager(store.Orchestrations(), store.Operations(), store.Instances(), nil,
-			resolver, poolingInterval, logrus.New(), k8sClient, orchestrationConfig, notificationBuilder, 1000)
+			resolver, poolingInterval, logrus.New(), k8sClient, orchestrationConfig, notificationBuilder, event.NewPubSub(logrus.New()), nil, 1000)
 
 		// when
 		_, err = svc.Execute(id)
@@ -240,7 +241,7 @@ func TestUpgradeClusterManager_Execute(t *testing.T) {
 		bundle.On("CreateNotificationEvent").Return(nil).Once()
 
 		svc := manager.NewUpgradeClusterManager(store.Orchestrations(), store.Operations(), store.Instances(), &testExecutor{},
-			resolver, poolingInterval, logrus.New(), k8sClient, orchestrationConfig, notificationBuilder, 1000)
+			resolver, poolingInterval, logrus.New(), k8sClient, orchestrationConfig, notificationBuilder, event.NewPubSub(logrus.New()), nil, 1000)
 
 		// when
 		_, err = svc.Execute(id)
@@ -293,7 +294,7 @@ func TestUpgradeClusterManager_Execute(t *testing.T) {
 		bundle.On("CancelNotificationEvent").Return(nil).Once()
 
 		svc := manager.NewUpgradeClusterManager(store.Orchestrations(), store.Operations(), store.Instances(), &testExecutor{}, resolver,
-			poolingInterval, logrus.New(), k8sClient, orchestrationConfig, notificationBuilder, 1000)
+			poolingInterval, logrus.New(), k8sClient, orchestrationConfig, notificationBuilder, event.NewPubSub(logrus.New()), nil, 1000)
 
 		// when
 		_, err = svc.Execute(id)
@@ -369,7 +370,7 @@ func TestUpgradeClusterManager_Execute(t *testing.T) {
 			upgradeType: orchestration.UpgradeClusterOrchestration,
 		}
 		svc := manager.NewUpgradeClusterManager(store.Orchestrations(), store.Operations(), store.Instances(), &executor, resolver,
-			poolingInterval, logrus.New(), k8sClient, orchestrationConfig, notificationBuilder, 1000)
+			poolingInterval, logrus.New(), k8sClient, orchestrationConfig, notificationBuilder, event.NewPubSub(logrus.New()), nil, 1000)
 
 		_, err = store.Orchestrations().GetByID(id)
 		require.NoError(t, err)
@@ -473,7 +474,7 @@ func TestUpgradeClusterManager_Execute(t *testing.T) {
 			upgradeType: orchestration.UpgradeClusterOrchestration,
 		}
 		svc := manager.NewUpgradeClusterManager(store.Orchestrations(), store.Operations(), store.Instances(), &executor, resolver,
-			poolingInterval, logrus.New(), k8sClient, orchestrationConfig, notificationBuilder, 1000)
+			poolingInterval, logrus.New(), k8sClient, orchestrationConfig, notificationBuilder, event.NewPubSub(logrus.New()), nil, 1000)
 
 		_, err = store.Operations().GetUpgradeClusterOperationByID(opId)
 		require.NoError(t, err)
@@ -565,7 +566,7 @@ func TestUpgradeClusterManager_Execute(t *testing.T) {
 			upgradeType: orchestration.UpgradeClusterOrchestration,
 		}
 		svc := manager.NewUpgradeClusterManager(store.Orchestrations(), store.Operations(), store.Instances(), &executor, resolver,
-			poolingInterval, logrus.New(), k8sClient, orchestrationConfig, notificationBuilder, 1000)
+			poolingInterval, logrus.New(), k8sClient, orchestrationConfig, notificationBuilder, event.NewPubSub(logrus.New()), nil, 1000)
 
 		// when
 		_, err = svc.Execute(id)
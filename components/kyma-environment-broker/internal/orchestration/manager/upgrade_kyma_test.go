@@ -17,6 +17,7 @@ import (
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration/automock"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/event"
 	notificationAutomock "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/notification/mocks"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/orchestration/manager"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
@@ -79,7 +80,7 @@ func TestUpgradeKymaManager_Execute(t *testing.T) {
 		bundle.On("CreateNotificationEvent").Return(nil).Once()
 
 		svc := manager.NewUpgradeKymaManager(store.Orchestrations(), store.Operations(), store.Instances(), nil,
-			resolver, 20*time.Millisecond, logrus.New(), k8sClient, &orchestrationConfig, notificationBuilder, 1000)
+			resolver, 20*time.Millisecond, logrus.New(), k8sClient, &orchestrationConfig, notificationBuilder, event.NewPubSub(logrus.New()), nil, 1000)
 
 		// when
 		_, err = svc.Execute(id)
@@ -125,7 +126,7 @@ func TestUpgradeKymaManager_Execute(t *testing.T) {
 		bundle.On("CreateNotificationEvent").Return(nil).Once()
 
 		svc := manager.NewUpgradeKymaManager(store.Orchestrations(), store.Operations(), store.Instances(), &testExecutor{},
-			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, notificationBuilder, 1000)
+			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, notificationBuilder, event.NewPubSub(logrus.New()), nil, 1000)
 
 		// when
 		_, err = svc.Execute(id)
@@ -174,7 +175,7 @@ func TestUpgradeKymaManager_Execute(t *testing.T) {
 		bundle.On("CreateNotificationEvent").Return(nil).Once()
 
 		svc := manager.NewUpgradeKymaManager(store.Orchestrations(), store.Operations(), store.Instances(), nil,
-			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, notificationBuilder, 1000)
+			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, notificationBuilder, event.NewPubSub(logrus.New()), nil, 1000)
 
 		// when
 		_, err = svc.Execute(id)
@@ -249,7 +250,7 @@ func TestUpgradeKymaManager_Execute(t *testing.T) {
 		bundle.On("CreateNotificationEvent").Return(nil).Once()
 
 		svc := manager.NewUpgradeKymaManager(store.Orchestrations(), store.Operations(), store.Instances(), &testExecutor{},
-			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, notificationBuilder, 1000)
+			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, notificationBuilder, event.NewPubSub(logrus.New()), nil, 1000)
 
 		// when
 		_, err = svc.Execute(id)
@@ -307,7 +308,7 @@ func TestUpgradeKymaManager_Execute(t *testing.T) {
 		bundle.On("CancelNotificationEvent").Return(nil).Once()
 
 		svc := manager.NewUpgradeKymaManager(store.Orchestrations(), store.Operations(), store.Instances(), &testExecutor{},
-			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, notificationBuilder, 1000)
+			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, notificationBuilder, event.NewPubSub(logrus.New()), nil, 1000)
 
 		// when
 		_, err = svc.Execute(id)
@@ -324,6 +325,60 @@ func TestUpgradeKymaManager_Execute(t *testing.T) {
 		assert.Equal(t, orchestration.Canceled, string(op.State))
 	})
 
+	t.Run("Paused", func(t *testing.T) {
+		// given
+		store := storage.NewMemoryStorage()
+
+		resolver := &automock.RuntimeResolver{}
+		defer resolver.AssertExpectations(t)
+
+		id := "id"
+		err := store.Orchestrations().Insert(internal.Orchestration{
+			OrchestrationID: id,
+			State:           orchestration.Paused,
+			Type:            orchestration.UpgradeKymaOrchestration,
+			Parameters: orchestration.Parameters{
+				Strategy: orchestration.StrategySpec{
+					Type:         orchestration.ParallelStrategy,
+					Schedule:     time.Now().Format(time.RFC3339),
+					ScheduleTime: time.Time{},
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		svc := manager.NewUpgradeKymaManager(store.Orchestrations(), store.Operations(), store.Instances(), &testExecutor{},
+			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, &notificationAutomock.BundleBuilder{}, event.NewPubSub(logrus.New()), nil, 1000)
+
+		resumed := make(chan struct{})
+		go func() {
+			time.Sleep(10 * poolingInterval)
+			o, err := store.Orchestrations().GetByID(id)
+			require.NoError(t, err)
+			assert.Equal(t, orchestration.Paused, o.State)
+
+			o.State = orchestration.InProgress
+			err = store.Orchestrations().Update(*o)
+			require.NoError(t, err)
+			close(resumed)
+		}()
+
+		// when
+		_, err = svc.Execute(id)
+		require.NoError(t, err)
+
+		// then
+		select {
+		case <-resumed:
+		default:
+			t.Fatal("paused orchestration was processed before it was resumed")
+		}
+		o, err := store.Orchestrations().GetByID(id)
+		require.NoError(t, err)
+
+		assert.Equal(t, orchestration.Succeeded, o.State)
+	})
+
 	t.Run("Retrying failed orchestration", func(t *testing.T) {
 		// given
 		store := storage.NewMemoryStorage()
@@ -392,7 +447,7 @@ func TestUpgradeKymaManager_Execute(t *testing.T) {
 			upgradeType: orchestration.UpgradeKymaOrchestration,
 		}
 		svc := manager.NewUpgradeKymaManager(store.Orchestrations(), store.Operations(), store.Instances(), &executor,
-			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, notificationBuilder, 1000)
+			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, notificationBuilder, event.NewPubSub(logrus.New()), nil, 1000)
 
 		// when
 		_, err = svc.Execute(id)
@@ -469,7 +524,7 @@ func TestUpgradeKymaManager_Execute(t *testing.T) {
 			upgradeType: orchestration.UpgradeKymaOrchestration,
 		}
 		svc := manager.NewUpgradeKymaManager(store.Orchestrations(), store.Operations(), store.Instances(), &executor,
-			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, notificationBuilder, 1000)
+			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, notificationBuilder, event.NewPubSub(logrus.New()), nil, 1000)
 
 		// when
 		_, err = svc.Execute(id)
@@ -566,7 +621,7 @@ func TestUpgradeKymaManager_Execute(t *testing.T) {
 			upgradeType: orchestration.UpgradeKymaOrchestration,
 		}
 		svc := manager.NewUpgradeKymaManager(store.Orchestrations(), store.Operations(), store.Instances(), &executor,
-			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, notificationBuilder, 1000)
+			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, notificationBuilder, event.NewPubSub(logrus.New()), nil, 1000)
 
 		// when
 		_, err = svc.Execute(id)
@@ -679,7 +734,7 @@ func TestUpgradeKymaManager_Execute(t *testing.T) {
 			upgradeType: orchestration.UpgradeKymaOrchestration,
 		}
 		svc := manager.NewUpgradeKymaManager(store.Orchestrations(), store.Operations(), store.Instances(), &executor,
-			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, notificationBuilder, 1000)
+			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, notificationBuilder, event.NewPubSub(logrus.New()), nil, 1000)
 
 		// when
 		_, err = svc.Execute(id)
@@ -791,7 +846,7 @@ func TestUpgradeKymaManager_Execute(t *testing.T) {
 			upgradeType: orchestration.UpgradeKymaOrchestration,
 		}
 		svc := manager.NewUpgradeKymaManager(store.Orchestrations(), store.Operations(), store.Instances(), &executor,
-			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, notificationBuilder, 1000)
+			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, notificationBuilder, event.NewPubSub(logrus.New()), nil, 1000)
 
 		// when
 		_, err = svc.Execute(id)
@@ -822,6 +877,79 @@ func TestUpgradeKymaManager_Execute(t *testing.T) {
 			}
 		}
 	})
+
+	t.Run("Halted by canary strategy when canceling operations fails", func(t *testing.T) {
+		// given
+		store := storage.NewMemoryStorage()
+		operations := &failingCancelOperations{Operations: store.Operations(), failures: 1}
+
+		resolver := &automock.RuntimeResolver{}
+		defer resolver.AssertExpectations(t)
+
+		id := "id"
+		for _, opID := range []string{"op-1", "op-2"} {
+			err := store.Operations().InsertUpgradeKymaOperation(internal.UpgradeKymaOperation{
+				Operation: internal.Operation{
+					ID:              opID,
+					Type:            internal.OperationTypeUpgradeKyma,
+					InstanceID:      "instance-" + opID,
+					OrchestrationID: id,
+					State:           orchestration.Pending,
+					RuntimeOperation: orchestration.RuntimeOperation{
+						ID:      opID,
+						Runtime: orchestration.Runtime{RuntimeID: opID},
+					},
+				},
+			})
+			require.NoError(t, err)
+		}
+		err := store.Orchestrations().Insert(internal.Orchestration{
+			OrchestrationID: id,
+			State:           orchestration.InProgress,
+			Type:            orchestration.UpgradeKymaOrchestration,
+			Parameters: orchestration.Parameters{
+				Strategy: orchestration.StrategySpec{
+					Type:     orchestration.CanaryStrategy,
+					Schedule: time.Now().Format(time.RFC3339),
+					Parallel: orchestration.ParallelStrategySpec{Workers: 1},
+					Canary:   orchestration.CanaryStrategySpec{Waves: []string{"1"}},
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		svc := manager.NewUpgradeKymaManager(store.Orchestrations(), operations, store.Instances(), &failingTestExecutor{store: store},
+			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, &notificationAutomock.BundleBuilder{}, event.NewPubSub(logrus.New()), nil, 1000)
+
+		// when
+		done := make(chan error)
+		go func() {
+			_, err := svc.Execute(id)
+			done <- err
+		}()
+
+		// then
+		select {
+		case err = <-done:
+			require.NoError(t, err)
+		case <-time.After(5 * time.Second):
+			t.Fatal("orchestration was not finished after the strategy halted")
+		}
+
+		o, err := store.Orchestrations().GetByID(id)
+		require.NoError(t, err)
+		assert.Equal(t, orchestration.Failed, o.State)
+		assert.Contains(t, o.Description, "halted after wave 1")
+
+		// the operation of the second wave was canceled
+		var states []string
+		for _, opID := range []string{"op-1", "op-2"} {
+			op, err := store.Operations().GetUpgradeKymaOperationByID(opID)
+			require.NoError(t, err)
+			states = append(states, string(op.State))
+		}
+		assert.ElementsMatch(t, []string{orchestration.Failed, orchestration.Canceled}, states)
+	})
 }
 
 type testExecutor struct{}
@@ -867,3 +995,36 @@ func (t *retryTestExecutor) Execute(opID string) (time.Duration, error) {
 func (t *retryTestExecutor) Reschedule(operationID string, maintenanceWindowBegin, maintenanceWindowEnd time.Time) error {
 	return nil
 }
+
+// failingTestExecutor fails every executed operation
+type failingTestExecutor struct {
+	store storage.BrokerStorage
+}
+
+func (t *failingTestExecutor) Execute(opID string) (time.Duration, error) {
+	op, err := t.store.Operations().GetUpgradeKymaOperationByID(opID)
+	if err != nil {
+		return 0, err
+	}
+	op.State = orchestration.Failed
+	_, err = t.store.Operations().UpdateUpgradeKymaOperation(*op)
+	return 0, err
+}
+
+func (t *failingTestExecutor) Reschedule(operationID string, maintenanceWindowBegin, maintenanceWindowEnd time.Time) error {
+	return nil
+}
+
+// failingCancelOperations fails listing the pending operations to cancel the given number of times
+type failingCancelOperations struct {
+	storage.Operations
+	failures int
+}
+
+func (o *failingCancelOperations) ListUpgradeKymaOperationsByOrchestrationID(orchestrationID string, filter dbmodel.OperationFilter) ([]internal.UpgradeKymaOperation, int, int, error) {
+	if o.failures > 0 && len(filter.States) == 1 && filter.States[0] == orchestration.Pending {
+		o.failures--
+		return nil, 0, 0, fmt.Errorf("listing operations failed")
+	}
+	return o.Operations.ListUpgradeKymaOperationsByOrchestrationID(orchestrationID, filter)
+}
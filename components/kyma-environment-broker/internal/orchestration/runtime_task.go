@@ -0,0 +1,129 @@
+package orchestration
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// RuntimeTaskConfig restricts the changes which runtime task orchestrations can apply to the runtimes
+type RuntimeTaskConfig struct {
+	// AllowedKinds lists the kinds of objects which can be applied or patched, kinds of API groups other than
+	// the core group are prefixed with the group, e.g. apps/Deployment
+	AllowedKinds []string `envconfig:"default=ConfigMap"`
+	// ProtectedNamespaces lists the namespaces in which no objects can be applied or patched
+	ProtectedNamespaces []string `envconfig:"default=kube-system,kyma-system,istio-system"`
+	// Timeout limits the time of applying the task to one runtime, including retries
+	Timeout time.Duration `envconfig:"default=10m"`
+}
+
+// RuntimeTaskObjects decodes the objects of the task manifest, empty documents are skipped
+func RuntimeTaskObjects(task orchestration.RuntimeTaskParameters) ([]unstructured.Unstructured, error) {
+	objects := make([]unstructured.Unstructured, 0)
+	decoder := yaml.NewYAMLOrJSONDecoder(strings.NewReader(task.Manifest), 4096)
+	for {
+		obj := map[string]interface{}{}
+		err := decoder.Decode(&obj)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("while decoding manifest: %w", err)
+		}
+		if len(obj) == 0 {
+			continue
+		}
+		objects = append(objects, unstructured.Unstructured{Object: obj})
+	}
+	return objects, nil
+}
+
+// Validate checks that the task declares at least one change and all its changes are allowed
+func (c RuntimeTaskConfig) Validate(task *orchestration.RuntimeTaskParameters) error {
+	if task == nil {
+		return errors.New("task must be specified")
+	}
+	objects, err := RuntimeTaskObjects(*task)
+	if err != nil {
+		return err
+	}
+	if len(objects) == 0 && len(task.Patches) == 0 {
+		return errors.New("task must contain a manifest or patches")
+	}
+
+	for i, obj := range objects {
+		if obj.GetAPIVersion() == "" || obj.GetKind() == "" || obj.GetName() == "" || obj.GetNamespace() == "" {
+			return fmt.Errorf("manifest object %d must have apiVersion, kind, metadata.name and metadata.namespace", i)
+		}
+		if err := c.validateTarget(obj.GetAPIVersion(), obj.GetKind(), obj.GetNamespace()); err != nil {
+			return fmt.Errorf("manifest object %s %s: %w", obj.GetKind(), obj.GetName(), err)
+		}
+	}
+	for i, p := range task.Patches {
+		if p.APIVersion == "" || p.Kind == "" || p.Name == "" || p.Namespace == "" {
+			return fmt.Errorf("patch %d must have apiVersion, kind, name and namespace", i)
+		}
+		if p.Type != orchestration.MergePatch && p.Type != orchestration.StrategicMergePatch {
+			return fmt.Errorf("patch %d has unsupported type %q, must be %s or %s", i, p.Type, orchestration.MergePatch, orchestration.StrategicMergePatch)
+		}
+		if !isJSONObject(p.Patch) {
+			return fmt.Errorf("patch %d must be a JSON object", i)
+		}
+		if err := c.validateTarget(p.APIVersion, p.Kind, p.Namespace); err != nil {
+			return fmt.Errorf("patch of %s %s: %w", p.Kind, p.Name, err)
+		}
+	}
+	return nil
+}
+
+func (c RuntimeTaskConfig) validateTarget(apiVersion, kind, namespace string) error {
+	gv, err := schema.ParseGroupVersion(apiVersion)
+	if err != nil {
+		return fmt.Errorf("invalid apiVersion %s: %w", apiVersion, err)
+	}
+	gk := gv.WithKind(kind).GroupKind()
+	if !c.allowedKind(gk) {
+		return fmt.Errorf("kind %s is not allowed", gk)
+	}
+	if contains(c.ProtectedNamespaces, namespace) {
+		return fmt.Errorf("namespace %s is protected", namespace)
+	}
+	return nil
+}
+
+// allowedKind checks the kind together with its API group, so kinds of custom resources named like the allowed ones are rejected
+func (c RuntimeTaskConfig) allowedKind(gk schema.GroupKind) bool {
+	for _, entry := range c.AllowedKinds {
+		allowed := schema.GroupKind{Kind: entry}
+		if i := strings.LastIndex(entry, "/"); i >= 0 {
+			allowed = schema.GroupKind{Group: entry[:i], Kind: entry[i+1:]}
+		}
+		if allowed == gk {
+			return true
+		}
+	}
+	return false
+}
+
+func isJSONObject(data json.RawMessage) bool {
+	trimmed := bytes.TrimSpace(data)
+	return len(trimmed) > 0 && trimmed[0] == '{' && json.Valid(trimmed)
+}
+
+func contains(items []string, item string) bool {
+	for _, i := range items {
+		if i == item {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,131 @@
+package orchestration
+
+import (
+	"encoding/json"
+	"testing"
+
+	orchestrationExt "github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const fixTaskManifest = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: settings
+  namespace: kyma-system
+data:
+  key: value
+---
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: credentials
+  namespace: kyma-system
+stringData:
+  password: secret
+`
+
+func TestRuntimeTaskObjects(t *testing.T) {
+	// when
+	objects, err := RuntimeTaskObjects(orchestrationExt.RuntimeTaskParameters{Manifest: fixTaskManifest})
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, objects, 2)
+	assert.Equal(t, "ConfigMap", objects[0].GetKind())
+	assert.Equal(t, "settings", objects[0].GetName())
+	assert.Equal(t, "Secret", objects[1].GetKind())
+	assert.Equal(t, "kyma-system", objects[1].GetNamespace())
+}
+
+func TestRuntimeTaskConfig_Validate(t *testing.T) {
+	cfg := RuntimeTaskConfig{
+		AllowedKinds:        []string{"ConfigMap", "Secret", "apps/Deployment"},
+		ProtectedNamespaces: []string{"kube-system"},
+	}
+	fixPatch := func(modify func(p *orchestrationExt.RuntimeTaskPatch)) []orchestrationExt.RuntimeTaskPatch {
+		p := orchestrationExt.RuntimeTaskPatch{
+			APIVersion: "apps/v1",
+			Kind:       "Deployment",
+			Namespace:  "kyma-system",
+			Name:       "app",
+			Type:       orchestrationExt.StrategicMergePatch,
+			Patch:      json.RawMessage(`{"spec":{"replicas":2}}`),
+		}
+		modify(&p)
+		return []orchestrationExt.RuntimeTaskPatch{p}
+	}
+
+	for name, tc := range map[string]struct {
+		task  *orchestrationExt.RuntimeTaskParameters
+		valid bool
+	}{
+		"manifest": {
+			task:  &orchestrationExt.RuntimeTaskParameters{Manifest: fixTaskManifest},
+			valid: true,
+		},
+		"patch": {
+			task:  &orchestrationExt.RuntimeTaskParameters{Patches: fixPatch(func(p *orchestrationExt.RuntimeTaskPatch) {})},
+			valid: true,
+		},
+		"missing task": {
+			task: nil,
+		},
+		"empty task": {
+			task: &orchestrationExt.RuntimeTaskParameters{Manifest: "---\n"},
+		},
+		"invalid manifest": {
+			task: &orchestrationExt.RuntimeTaskParameters{Manifest: "kind: [ConfigMap"},
+		},
+		"object without name": {
+			task: &orchestrationExt.RuntimeTaskParameters{Manifest: "apiVersion: v1\nkind: ConfigMap\n"},
+		},
+		"object without namespace": {
+			task: &orchestrationExt.RuntimeTaskParameters{Manifest: "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: cm\n"},
+		},
+		"kind not allowed": {
+			task: &orchestrationExt.RuntimeTaskParameters{Manifest: "apiVersion: rbac.authorization.k8s.io/v1\nkind: Role\nmetadata:\n  name: admin\n  namespace: default\n"},
+		},
+		"allowed kind of foreign group": {
+			task: &orchestrationExt.RuntimeTaskParameters{Manifest: "apiVersion: example.com/v1\nkind: ConfigMap\nmetadata:\n  name: cm\n  namespace: default\n"},
+		},
+		"patch of allowed kind of foreign group": {
+			task: &orchestrationExt.RuntimeTaskParameters{Patches: fixPatch(func(p *orchestrationExt.RuntimeTaskPatch) { p.APIVersion = "example.com/v1" })},
+		},
+		"patch with invalid apiVersion": {
+			task: &orchestrationExt.RuntimeTaskParameters{Patches: fixPatch(func(p *orchestrationExt.RuntimeTaskPatch) { p.APIVersion = "apps/v1/beta" })},
+		},
+		"object in protected namespace": {
+			task: &orchestrationExt.RuntimeTaskParameters{Manifest: "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: cm\n  namespace: kube-system\n"},
+		},
+		"patch in protected namespace": {
+			task: &orchestrationExt.RuntimeTaskParameters{Patches: fixPatch(func(p *orchestrationExt.RuntimeTaskPatch) { p.Namespace = "kube-system" })},
+		},
+		"patch without namespace": {
+			task: &orchestrationExt.RuntimeTaskParameters{Patches: fixPatch(func(p *orchestrationExt.RuntimeTaskPatch) { p.Namespace = "" })},
+		},
+		"patch with unsupported type": {
+			task: &orchestrationExt.RuntimeTaskParameters{Patches: fixPatch(func(p *orchestrationExt.RuntimeTaskPatch) { p.Type = "json" })},
+		},
+		"patch which is not an object": {
+			task: &orchestrationExt.RuntimeTaskParameters{Patches: fixPatch(func(p *orchestrationExt.RuntimeTaskPatch) { p.Patch = json.RawMessage(`[{"op":"remove"}]`) })},
+		},
+		"patch without name": {
+			task: &orchestrationExt.RuntimeTaskParameters{Patches: fixPatch(func(p *orchestrationExt.RuntimeTaskPatch) { p.Name = "" })},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// when
+			err := cfg.Validate(tc.task)
+
+			// then
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,200 @@
+package preview
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/httputil"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process/input"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process/provisioning"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process/update"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/pivotal-cf/brokerapi/v8/domain/apiresponses"
+	"github.com/sirupsen/logrus"
+)
+
+type (
+	ProvisioningPreviewer interface {
+		PreviewOperation(ctx context.Context, instanceID string, details domain.ProvisionDetails) (internal.ProvisioningOperation, error)
+	}
+
+	UpdatePreviewer interface {
+		PreviewOperation(instanceID string, details domain.UpdateDetails) (internal.Operation, error)
+	}
+
+	RuntimeVersionConfigurator interface {
+		ForProvisioning(op internal.Operation) (*internal.RuntimeVersionData, error)
+		ForUpdating(op internal.Operation) (*internal.RuntimeVersionData, error)
+	}
+)
+
+// ProvisioningPreview is the provisioner request which would be sent for the provisioning request
+type ProvisioningPreview struct {
+	Provider              internal.CloudProvider          `json:"provider"`
+	RuntimeVersion        internal.RuntimeVersionData     `json:"runtimeVersion"`
+	ProvisionRuntimeInput gqlschema.ProvisionRuntimeInput `json:"provisionRuntimeInput"`
+}
+
+// UpdatePreview is the provisioner request which would be sent for the update request
+type UpdatePreview struct {
+	RuntimeID         string                      `json:"runtimeID"`
+	RuntimeVersion    internal.RuntimeVersionData `json:"runtimeVersion"`
+	UpgradeShootInput gqlschema.UpgradeShootInput `json:"upgradeShootInput"`
+}
+
+// Handler exposes the dry-run of provisioning and update requests. The requests are validated and the provisioner
+// input is built exactly as in the provisioning and update processes, but nothing is persisted and no operation is started.
+type Handler struct {
+	provisioning           ProvisioningPreviewer
+	update                 UpdatePreviewer
+	inputBuilder           input.CreatorForPlan
+	runtimeVerConfigurator RuntimeVersionConfigurator
+	runtimeStates          storage.RuntimeStates
+
+	log logrus.FieldLogger
+}
+
+func NewHandler(provisioning ProvisioningPreviewer, update UpdatePreviewer, inputBuilder input.CreatorForPlan, rvc RuntimeVersionConfigurator, runtimeStates storage.RuntimeStates, log logrus.FieldLogger) *Handler {
+	return &Handler{
+		provisioning:           provisioning,
+		update:                 update,
+		inputBuilder:           inputBuilder,
+		runtimeVerConfigurator: rvc,
+		runtimeStates:          runtimeStates,
+		log:                    log,
+	}
+}
+
+func (h *Handler) AttachRoutes(router *mux.Router) {
+	for _, prefix := range []string{"/preview", "/preview/{region}"} {
+		router.HandleFunc(prefix+"/service_instances/{instance_id}", h.previewProvisioning).Methods(http.MethodPut)
+		router.HandleFunc(prefix+"/service_instances/{instance_id}", h.previewUpdate).Methods(http.MethodPatch)
+	}
+}
+
+func (h *Handler) previewProvisioning(w http.ResponseWriter, r *http.Request) {
+	instanceID := mux.Vars(r)["instance_id"]
+	logger := h.log.WithField("instanceID", instanceID)
+
+	var details domain.ProvisionDetails
+	if err := json.NewDecoder(r.Body).Decode(&details); err != nil {
+		httputil.WriteErrorResponse(w, http.StatusBadRequest, fmt.Errorf("while decoding request body: %w", err))
+		return
+	}
+	if broker.IsOwnClusterPlan(details.PlanID) {
+		httputil.WriteErrorResponse(w, http.StatusBadRequest, fmt.Errorf("preview is not available for the own_cluster plan"))
+		return
+	}
+
+	operation, err := h.provisioning.PreviewOperation(r.Context(), instanceID, details)
+	if err != nil {
+		h.writeError(w, fmt.Errorf("while validating provisioning request: %w", err))
+		return
+	}
+
+	version, err := h.runtimeVerConfigurator.ForProvisioning(operation.Operation)
+	if err != nil {
+		h.writeError(w, fmt.Errorf("while getting the runtime version: %w", err))
+		return
+	}
+	operation.RuntimeVersion = *version
+
+	creator, err := h.inputBuilder.CreateProvisionInput(operation.ProvisioningParameters, operation.RuntimeVersion)
+	if err != nil {
+		h.writeError(w, fmt.Errorf("while creating provisioning input creator: %w", err))
+		return
+	}
+	creator.DisableOptionalComponent(internal.BTPOperatorComponentName)
+	operation.InputCreator = creator
+
+	runtimeInput, err := provisioning.CreateProvisionRuntimeInput(operation.Operation)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+
+	logger.Infof("provisioning preview created for plan %s", details.PlanID)
+	httputil.WriteResponse(w, http.StatusOK, ProvisioningPreview{
+		Provider:              creator.Provider(),
+		RuntimeVersion:        operation.RuntimeVersion,
+		ProvisionRuntimeInput: runtimeInput,
+	})
+}
+
+func (h *Handler) previewUpdate(w http.ResponseWriter, r *http.Request) {
+	instanceID := mux.Vars(r)["instance_id"]
+	logger := h.log.WithField("instanceID", instanceID)
+
+	var details domain.UpdateDetails
+	if err := json.NewDecoder(r.Body).Decode(&details); err != nil {
+		httputil.WriteErrorResponse(w, http.StatusBadRequest, fmt.Errorf("while decoding request body: %w", err))
+		return
+	}
+
+	operation, err := h.update.PreviewOperation(instanceID, details)
+	if err != nil {
+		h.writeError(w, fmt.Errorf("while validating update request: %w", err))
+		return
+	}
+	if broker.IsOwnClusterPlan(operation.ProvisioningParameters.PlanID) {
+		httputil.WriteErrorResponse(w, http.StatusBadRequest, fmt.Errorf("preview is not available for the own_cluster plan"))
+		return
+	}
+
+	version, err := h.runtimeVerConfigurator.ForUpdating(operation)
+	if err != nil {
+		h.writeError(w, fmt.Errorf("while getting the runtime version: %w", err))
+		return
+	}
+	if version != nil {
+		operation.RuntimeVersion = *version
+	}
+
+	creator, err := h.inputBuilder.CreateUpgradeShootInput(operation.ProvisioningParameters, operation.RuntimeVersion)
+	if err != nil {
+		h.writeError(w, fmt.Errorf("while creating upgrade shoot input creator: %w", err))
+		return
+	}
+	operation.InputCreator = creator
+
+	lastRuntimeState, err := h.runtimeStates.GetLatestWithOIDCConfigByRuntimeID(operation.RuntimeID)
+	switch {
+	case err == nil:
+		operation.LastRuntimeState = lastRuntimeState
+	case !dberr.IsNotFound(err):
+		h.writeError(w, fmt.Errorf("while getting the latest runtime state: %w", err))
+		return
+	}
+
+	upgradeInput, err := update.CreateUpgradeShootInput(operation)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+
+	logger.Infof("update preview created for runtime %s", operation.RuntimeID)
+	httputil.WriteResponse(w, http.StatusOK, UpdatePreview{
+		RuntimeID:         operation.RuntimeID,
+		RuntimeVersion:    operation.RuntimeVersion,
+		UpgradeShootInput: upgradeInput,
+	})
+}
+
+func (h *Handler) writeError(w http.ResponseWriter, err error) {
+	var failure *apiresponses.FailureResponse
+	if errors.As(err, &failure) {
+		httputil.WriteErrorResponse(w, failure.ValidatedStatusCode(nil), err)
+		return
+	}
+	h.log.Errorf("preview failed: %s", err)
+	httputil.WriteErrorResponse(w, http.StatusInternalServerError, err)
+}
@@ -0,0 +1,82 @@
+package deprovisioning
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/sirupsen/logrus"
+)
+
+// BindingRevoker revokes the credentials of service bindings on the runtime
+type BindingRevoker interface {
+	Revoke(binding internal.Binding) error
+}
+
+// RemoveBindingsStep revokes the credentials of all bindings of the instance. The bindings are removed when the instance
+// is deprovisioned, on suspension they are kept, so the platform can still unbind them.
+type RemoveBindingsStep struct {
+	operationManager *process.OperationManager
+	bindings         storage.Bindings
+	revoker          BindingRevoker
+}
+
+var _ process.Step = &RemoveBindingsStep{}
+
+func NewRemoveBindingsStep(os storage.Operations, bindings storage.Bindings, revoker BindingRevoker) *RemoveBindingsStep {
+	return &RemoveBindingsStep{
+		operationManager: process.NewOperationManager(os),
+		bindings:         bindings,
+		revoker:          revoker,
+	}
+}
+
+func (s *RemoveBindingsStep) Name() string {
+	return "Remove_Bindings"
+}
+
+func (s *RemoveBindingsStep) Run(operation internal.Operation, log logrus.FieldLogger) (internal.Operation, time.Duration, error) {
+	bindings, err := s.bindings.ListByInstanceID(operation.InstanceID)
+	if err != nil {
+		log.Errorf("unable to list bindings: %s", err)
+		return operation, 10 * time.Second, nil
+	}
+	if len(bindings) == 0 {
+		return operation, 0, nil
+	}
+
+	// every binding is revoked even if revoking another one failed, the failed ones are retried
+	var errs []string
+	for _, binding := range bindings {
+		if err := s.revoker.Revoke(binding); err != nil {
+			errs = append(errs, fmt.Sprintf("binding %s: %s", binding.ID, err))
+		}
+	}
+	if len(errs) > 0 {
+		msg := fmt.Sprintf("unable to revoke credentials of %d of %d bindings: %s", len(errs), len(bindings), strings.Join(errs, ", "))
+		log.Warn(msg)
+		// the runtime is removed in the next steps anyway, so the deprovisioning is not blocked by failing revocation
+		op, repeat, err := s.operationManager.RetryOperationWithoutFail(operation, s.Name(), msg, 10*time.Second, 5*time.Minute, log)
+		if repeat != 0 || err != nil {
+			return op, repeat, err
+		}
+		operation = op
+	}
+	if operation.Temporary {
+		log.Infof("credentials of %d bindings revoked, bindings are kept for the suspended instance", len(bindings))
+		return operation, 0, nil
+	}
+
+	for _, binding := range bindings {
+		if err := s.bindings.Delete(binding.ID); err != nil {
+			log.Errorf("unable to delete binding %s: %s", binding.ID, err)
+			return operation, 10 * time.Second, nil
+		}
+	}
+	log.Infof("%d bindings removed", len(bindings))
+
+	return operation, 0, nil
+}
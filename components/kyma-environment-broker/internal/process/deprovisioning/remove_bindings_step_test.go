@@ -0,0 +1,113 @@
+package deprovisioning
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/fixture"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoveBindingsStep(t *testing.T) {
+	for name, tc := range map[string]struct {
+		operation         internal.Operation
+		expectedRemaining int
+	}{
+		"deprovisioning": {
+			operation:         fixture.FixDeprovisioningOperationAsOperation(operationID, instanceID),
+			expectedRemaining: 0,
+		},
+		"suspension": {
+			operation:         fixture.FixSuspensionOperationAsOperation(operationID, instanceID),
+			expectedRemaining: 2,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// given
+			memoryStorage := storage.NewMemoryStorage()
+			require.NoError(t, memoryStorage.Operations().InsertOperation(tc.operation))
+			fixBindings(t, memoryStorage.Bindings(), instanceID, "binding-1", "binding-2")
+			fixBindings(t, memoryStorage.Bindings(), "other-instance", "binding-3")
+			revoker := &fakeRevoker{}
+
+			step := NewRemoveBindingsStep(memoryStorage.Operations(), memoryStorage.Bindings(), revoker)
+
+			// when
+			_, backoff, err := step.Run(tc.operation, logrus.New())
+
+			// then
+			require.NoError(t, err)
+			assert.Zero(t, backoff)
+			assert.ElementsMatch(t, []string{"binding-1", "binding-2"}, revoker.revoked)
+			remaining, err := memoryStorage.Bindings().ListByInstanceID(instanceID)
+			require.NoError(t, err)
+			assert.Len(t, remaining, tc.expectedRemaining)
+			other, err := memoryStorage.Bindings().ListByInstanceID("other-instance")
+			require.NoError(t, err)
+			assert.Len(t, other, 1)
+		})
+	}
+}
+
+func TestRemoveBindingsStep_RevocationFails(t *testing.T) {
+	// given
+	memoryStorage := storage.NewMemoryStorage()
+	operation := fixture.FixDeprovisioningOperationAsOperation(operationID, instanceID)
+	require.NoError(t, memoryStorage.Operations().InsertOperation(operation))
+	fixBindings(t, memoryStorage.Bindings(), instanceID, "binding-1", "binding-2")
+
+	revoker := &fakeRevoker{failing: map[string]error{"binding-1": fmt.Errorf("runtime not reachable")}}
+	step := NewRemoveBindingsStep(memoryStorage.Operations(), memoryStorage.Bindings(), revoker)
+
+	// when
+	operation.UpdatedAt = time.Now()
+	_, backoff, err := step.Run(operation, logrus.New())
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, 10*time.Second, backoff)
+	assert.Equal(t, []string{"binding-2"}, revoker.revoked)
+
+	// when
+	operation.UpdatedAt = time.Now().Add(-time.Hour)
+	operation, backoff, err = step.Run(operation, logrus.New())
+
+	// then
+	require.NoError(t, err)
+	assert.Zero(t, backoff)
+	assert.Contains(t, operation.ExcutedButNotCompleted, "Remove_Bindings")
+	remaining, err := memoryStorage.Bindings().ListByInstanceID(instanceID)
+	require.NoError(t, err)
+	assert.Empty(t, remaining)
+}
+
+type fakeRevoker struct {
+	revoked []string
+	failing map[string]error
+}
+
+func (r *fakeRevoker) Revoke(binding internal.Binding) error {
+	if err, ok := r.failing[binding.ID]; ok {
+		return err
+	}
+	r.revoked = append(r.revoked, binding.ID)
+	return nil
+}
+
+func fixBindings(t *testing.T, bindings storage.Bindings, instanceID string, ids ...string) {
+	for _, id := range ids {
+		require.NoError(t, bindings.Insert(internal.Binding{
+			ID:            id,
+			InstanceID:    instanceID,
+			LastOperation: internal.BindingOperationBind,
+			State:         domain.Succeeded,
+			CreatedAt:     time.Now(),
+		}))
+	}
+}
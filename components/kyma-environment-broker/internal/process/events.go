@@ -42,6 +42,12 @@ type UpgradeClusterStepProcessed struct {
 	Operation    internal.UpgradeClusterOperation
 }
 
+type RuntimeTaskStepProcessed struct {
+	StepProcessed
+	OldOperation internal.Operation
+	Operation    internal.Operation
+}
+
 type ProvisioningSucceeded struct {
 	Operation internal.ProvisioningOperation
 }
@@ -55,3 +61,8 @@ type OperationStepProcessed struct {
 type OperationSucceeded struct {
 	Operation internal.Operation
 }
+
+// OrchestrationStateChanged is published after the new state of the orchestration was saved
+type OrchestrationStateChanged struct {
+	Orchestration internal.Orchestration
+}
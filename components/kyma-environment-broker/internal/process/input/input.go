@@ -36,6 +36,8 @@ type Config struct {
 	AutoUpdateMachineImageVersion bool                   `envconfig:"default=false"`
 	MultiZoneCluster              bool                   `envconfig:"default=false"`
 	ControlPlaneFailureTolerance  string                 `envconfig:"optional"`
+	// OperationStatusSubscriptions enables receiving Runtime operation statuses over GraphQL subscriptions instead of polling
+	OperationStatusSubscriptions bool `envconfig:"default=false"`
 }
 
 type RuntimeInput struct {
@@ -84,7 +84,7 @@ func (om *OperationManager) RetryOperationWithoutFail(operation internal.Operati
 		return op, repeat, err
 	}
 
-	op.EventErrorf(fmt.Errorf(description), "step %s failed retries: operation continues", stepName)
+	op.StepEventErrorf(stepName, fmt.Errorf(description), "step %s failed retries: operation continues", stepName)
 	log.Errorf("Omitting after %s of failing retries", maxTime.String())
 	return op, 0, nil
 }
@@ -129,7 +129,7 @@ func (om *OperationManager) MarkStepAsExcutedButNotCompleted(operation internal.
 		return op, repeat, err
 	}
 
-	op.EventErrorf(fmt.Errorf(msg), "step %s failed: operation continues", stepName)
+	op.StepEventErrorf(stepName, fmt.Errorf(msg), "step %s failed: operation continues", stepName)
 	log.Errorf(msg)
 	return op, 0, nil
 }
@@ -52,7 +52,7 @@ func (s *CreateRuntimeWithoutKymaStep) Run(operation internal.Operation, log log
 		return s.operationManager.OperationFailed(operation, fmt.Sprintf("operation has reached the time limit: %s", CreateRuntimeTimeout), nil, log)
 	}
 
-	requestInput, err := s.createProvisionInput(operation)
+	requestInput, err := CreateProvisionRuntimeInput(operation)
 	if err != nil {
 		log.Errorf("Unable to create provisioning input: %s", err.Error())
 		return s.operationManager.OperationFailed(operation, "invalid operation data - cannot create provisioning input", err, log)
@@ -129,7 +129,9 @@ func (s *CreateRuntimeWithoutKymaStep) updateInstance(id, runtimeID, region stri
 	return nil
 }
 
-func (s *CreateRuntimeWithoutKymaStep) createProvisionInput(operation internal.Operation) (gqlschema.ProvisionRuntimeInput, error) {
+// CreateProvisionRuntimeInput builds the provisioner request for the operation using its InputCreator.
+// It does not call the provisioner, so it can be used to preview the request as well.
+func CreateProvisionRuntimeInput(operation internal.Operation) (gqlschema.ProvisionRuntimeInput, error) {
 	operation.InputCreator.SetProvisioningParameters(operation.ProvisioningParameters)
 	operation.InputCreator.SetShootName(operation.ShootName)
 	operation.InputCreator.SetShootDomain(operation.ShootDomain)
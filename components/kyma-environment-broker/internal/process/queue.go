@@ -5,11 +5,25 @@ import (
 	"sync"
 	"time"
 
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
 	"github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/util/workqueue"
 )
 
+const leaseRetryInterval = 10 * time.Second
+
+// LeaseConfig configures sharing of the operations processing between several KEB replicas
+type LeaseConfig struct {
+	Enabled bool `envconfig:"default=false"`
+	// Owner identifies the replica, the hostname is used if not set
+	Owner string `envconfig:"optional"`
+	// TTL defines after which time the work of a crashed replica can be taken over by another replica
+	TTL time.Duration `envconfig:"default=2m"`
+	// AdoptionInterval defines how often operations in progress without a valid lease are looked for
+	AdoptionInterval time.Duration `envconfig:"default=1m"`
+}
+
 type Executor interface {
 	Execute(operationID string) (time.Duration, error)
 }
@@ -21,6 +35,13 @@ type Queue struct {
 	log       logrus.FieldLogger
 
 	speedFactor int64
+
+	leases   storage.Leases
+	owner    string
+	leaseTTL time.Duration
+	// owned contains IDs which are processed or scheduled by this queue and whose leases must be renewed
+	owned   map[string]struct{}
+	ownedMu sync.Mutex
 }
 
 func NewQueue(executor Executor, log logrus.FieldLogger) *Queue {
@@ -34,14 +55,69 @@ func NewQueue(executor Executor, log logrus.FieldLogger) *Queue {
 	}
 }
 
+// WithLeases enables the lease-based ownership. An item is processed only if the lease for it
+// could be acquired, so several KEB replicas can safely share the same operations.
+func (q *Queue) WithLeases(leases storage.Leases, owner string, ttl time.Duration) *Queue {
+	q.leases = leases
+	q.owner = owner
+	q.leaseTTL = ttl
+	q.owned = make(map[string]struct{})
+	return q
+}
+
 func (q *Queue) Add(processId string) {
+	if q.leases != nil && !q.track(processId) {
+		// the item is already processed or scheduled by this queue,
+		// adding it again would skip the delay requested by the executor
+		return
+	}
 	q.queue.Add(processId)
 }
 
 func (q *Queue) AddAfter(processId string, duration time.Duration) {
+	if q.leases != nil && !q.track(processId) {
+		// the item is already processed or scheduled by this queue
+		return
+	}
 	q.queue.AddAfter(processId, duration)
 }
 
+// UnleasedQueue adds only the items without a valid lease, so the adoption of the items of crashed replicas
+// does not resume the items which are processed by the running replicas
+type UnleasedQueue struct {
+	*Queue
+	leased map[string]struct{}
+}
+
+// Unleased returns the queue which skips the given leased items
+func (q *Queue) Unleased(leased []string) UnleasedQueue {
+	ids := make(map[string]struct{}, len(leased))
+	for _, id := range leased {
+		ids[id] = struct{}{}
+	}
+	return UnleasedQueue{Queue: q, leased: ids}
+}
+
+// Leased returns true if the item has a valid lease
+func (q UnleasedQueue) Leased(processId string) bool {
+	_, found := q.leased[processId]
+	return found
+}
+
+func (q UnleasedQueue) Add(processId string) {
+	if q.Leased(processId) {
+		return
+	}
+	q.Queue.Add(processId)
+}
+
+func (q UnleasedQueue) AddAfter(processId string, duration time.Duration) {
+	if q.Leased(processId) {
+		return
+	}
+	q.Queue.AddAfter(processId, duration)
+}
+
 func (q *Queue) ShutDown() {
 	q.queue.ShutDown()
 }
@@ -51,6 +127,9 @@ func (q *Queue) Run(stop <-chan struct{}, workersAmount int) {
 		q.waitGroup.Add(1)
 		q.createWorker(q.queue, q.executor.Execute, stop, &q.waitGroup, q.log)
 	}
+	if q.leases != nil {
+		go wait.Until(q.renewLeases, q.leaseTTL/3, stop)
+	}
 }
 
 // SpeedUp changes speedFactor parameter to reduce time between processing operations.
@@ -80,10 +159,24 @@ func (q *Queue) worker(queue workqueue.RateLimitingInterface, process func(key s
 				defer func() {
 					if err := recover(); err != nil {
 						log.Errorf("panic error from process: %v. Stacktrace: %s", err, debug.Stack())
+						q.release(id)
 					}
 					queue.Done(key)
 				}()
 
+				acquired, err := q.acquire(id)
+				if err != nil {
+					log.Errorf("Unable to acquire lease, retrying in %s: %v", leaseRetryInterval, err)
+					queue.AddAfter(key, leaseRetryInterval)
+					return false
+				}
+				if !acquired {
+					log.Infof("Operation is owned by another replica, skipping")
+					q.untrack(id)
+					queue.Forget(key)
+					return false
+				}
+
 				when, err := process(id)
 				if err == nil && when != 0 {
 					log.Infof("Adding %q item after %s", id, when)
@@ -95,9 +188,56 @@ func (q *Queue) worker(queue workqueue.RateLimitingInterface, process func(key s
 					log.Errorf("Error from process: %v", err)
 				}
 
+				q.release(id)
 				queue.Forget(key)
 				return false
 			}()
 		}
 	}
 }
+
+func (q *Queue) acquire(id string) (bool, error) {
+	if q.leases == nil {
+		return true, nil
+	}
+	return q.leases.Acquire(id, q.owner, q.leaseTTL)
+}
+
+func (q *Queue) release(id string) {
+	if q.leases == nil {
+		return
+	}
+	q.untrack(id)
+	if err := q.leases.Release(id, q.owner); err != nil {
+		q.log.Errorf("Unable to release lease for %s, it will expire after %s: %v", id, q.leaseTTL, err)
+	}
+}
+
+func (q *Queue) track(id string) bool {
+	q.ownedMu.Lock()
+	defer q.ownedMu.Unlock()
+	if _, found := q.owned[id]; found {
+		return false
+	}
+	q.owned[id] = struct{}{}
+	return true
+}
+
+func (q *Queue) untrack(id string) {
+	q.ownedMu.Lock()
+	defer q.ownedMu.Unlock()
+	delete(q.owned, id)
+}
+
+func (q *Queue) renewLeases() {
+	q.ownedMu.Lock()
+	ids := make([]string, 0, len(q.owned))
+	for id := range q.owned {
+		ids = append(ids, id)
+	}
+	q.ownedMu.Unlock()
+
+	if err := q.leases.Renew(q.owner, ids, q.leaseTTL); err != nil {
+		q.log.Errorf("Unable to renew %d leases: %v", len(ids), err)
+	}
+}
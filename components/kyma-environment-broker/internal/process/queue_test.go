@@ -0,0 +1,107 @@
+package process
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+func TestQueue_WithLeases(t *testing.T) {
+	t.Run("should process operation only by one replica", func(t *testing.T) {
+		// given
+		leases := storage.NewMemoryStorage().Leases()
+		executor := &countingExecutor{executions: map[string]int{}}
+		stop := make(chan struct{})
+		defer close(stop)
+
+		first := NewQueue(executor, logrus.New()).WithLeases(leases, "keb-1", time.Minute)
+		second := NewQueue(executor, logrus.New()).WithLeases(leases, "keb-2", time.Minute)
+		_, err := leases.Acquire("op-1", "keb-1", time.Minute)
+		assert.NoError(t, err)
+
+		// when
+		second.Run(stop, 1)
+		second.Add("op-1")
+		second.Add("op-2")
+
+		// then
+		err = wait.PollImmediate(10*time.Millisecond, time.Second, func() (bool, error) {
+			return executor.count("op-2") == 1, nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 0, executor.count("op-1"))
+
+		// when
+		first.Run(stop, 1)
+		first.Add("op-1")
+
+		// then
+		err = wait.PollImmediate(10*time.Millisecond, time.Second, func() (bool, error) {
+			return executor.count("op-1") == 1, nil
+		})
+		assert.NoError(t, err)
+
+		acquired, err := leases.Acquire("op-1", "keb-2", time.Minute)
+		assert.NoError(t, err)
+		assert.True(t, acquired, "lease should be released after the operation is processed")
+	})
+
+	t.Run("should not schedule operation which is already processed", func(t *testing.T) {
+		// given
+		leases := storage.NewMemoryStorage().Leases()
+		queue := NewQueue(&countingExecutor{executions: map[string]int{}}, logrus.New()).WithLeases(leases, "keb-1", time.Minute)
+		queue.Add("op-1")
+		item, _ := queue.queue.Get()
+
+		// when
+		queue.AddAfter("op-1", 0)
+		queue.queue.Done(item)
+
+		// then
+		assert.Equal(t, 0, queue.queue.Len())
+	})
+
+	t.Run("should adopt only operations without valid lease", func(t *testing.T) {
+		// given
+		leases := storage.NewMemoryStorage().Leases()
+		queue := NewQueue(&countingExecutor{executions: map[string]int{}}, logrus.New()).WithLeases(leases, "keb-1", time.Minute)
+		_, err := leases.Acquire("op-1", "keb-2", time.Minute)
+		assert.NoError(t, err)
+		leased, err := leases.ListValid()
+		assert.NoError(t, err)
+
+		// when
+		unleased := queue.Unleased(leased)
+		unleased.Add("op-1")
+		unleased.AddAfter("op-1", 0)
+		unleased.Add("op-2")
+
+		// then
+		assert.Equal(t, 1, queue.queue.Len())
+		item, _ := queue.queue.Get()
+		assert.Equal(t, "op-2", item)
+	})
+}
+
+type countingExecutor struct {
+	mu         sync.Mutex
+	executions map[string]int
+}
+
+func (e *countingExecutor) Execute(operationID string) (time.Duration, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.executions[operationID]++
+	return 0, nil
+}
+
+func (e *countingExecutor) count(operationID string) int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.executions[operationID]
+}
@@ -0,0 +1,55 @@
+package process
+
+import (
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// RetryScheduler periodically looks for operations in progress whose persisted retry time has passed
+// and adds them to the queue responsible for their type. It makes sure a requested retry is not lost
+// when the in-memory queue is gone, for example after a restart.
+type RetryScheduler struct {
+	operations storage.Operations
+	queues     map[internal.OperationType]*Queue
+	interval   time.Duration
+	log        logrus.FieldLogger
+}
+
+func NewRetryScheduler(operations storage.Operations, interval time.Duration, log logrus.FieldLogger) *RetryScheduler {
+	return &RetryScheduler{
+		operations: operations,
+		queues:     map[internal.OperationType]*Queue{},
+		interval:   interval,
+		log:        log,
+	}
+}
+
+func (s *RetryScheduler) Register(opType internal.OperationType, queue *Queue) {
+	s.queues[opType] = queue
+}
+
+func (s *RetryScheduler) Run(stop <-chan struct{}) {
+	go wait.Until(s.scheduleDueOperations, s.interval, stop)
+}
+
+func (s *RetryScheduler) scheduleDueOperations() {
+	now := time.Now()
+	for opType, queue := range s.queues {
+		operations, err := s.operations.GetNotFinishedOperationsByType(opType)
+		if err != nil {
+			s.log.Errorf("while getting in progress %s operations: %s", opType, err)
+			continue
+		}
+		for _, op := range operations {
+			if op.NextRetryAt == nil || op.NextRetryAt.After(now) {
+				continue
+			}
+			s.log.Debugf("Scheduling %s operation %s, retry was due at %s", opType, op.ID, op.NextRetryAt.Format(time.RFC3339))
+			queue.Add(op.ID)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package process
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/fixture"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+func TestRetryScheduler(t *testing.T) {
+	// given
+	operations := storage.NewMemoryStorage().Operations()
+	past := time.Now().Add(-time.Minute)
+	future := time.Now().Add(time.Hour)
+	for id, nextRetryAt := range map[string]*time.Time{
+		"due-op":         &past,
+		"not-due-op":     &future,
+		"not-retried-op": nil,
+	} {
+		op := fixture.FixProvisioningOperation(id, "instance-"+id)
+		op.State = domain.InProgress
+		op.NextRetryAt = nextRetryAt
+		require.NoError(t, operations.InsertOperation(op))
+	}
+
+	executor := &countingExecutor{executions: map[string]int{}}
+	stop := make(chan struct{})
+	defer close(stop)
+	queue := NewQueue(executor, logrus.New())
+	queue.Run(stop, 1)
+
+	scheduler := NewRetryScheduler(operations, time.Minute, logrus.New())
+	scheduler.Register(internal.OperationTypeProvision, queue)
+
+	// when
+	scheduler.scheduleDueOperations()
+
+	// then
+	err := wait.PollImmediate(10*time.Millisecond, time.Second, func() (bool, error) {
+		return executor.count("due-op") == 1, nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, executor.count("not-due-op"))
+	assert.Equal(t, 0, executor.count("not-retried-op"))
+}
@@ -0,0 +1,168 @@
+package runtime_task
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	internalOrchestration "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/orchestration"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// FieldManager is the field manager of the fields set in the runtimes by runtime tasks
+const FieldManager = "kyma-environment-broker"
+
+var patchTypes = map[orchestration.PatchType]types.PatchType{
+	orchestration.MergePatch:          types.MergePatchType,
+	orchestration.StrategicMergePatch: types.StrategicMergePatchType,
+}
+
+// KubeconfigProvider provides the admin kubeconfig of the runtime of the instance
+type KubeconfigProvider interface {
+	AdminKubeconfig(instance *internal.Instance) (string, error)
+}
+
+// ApplyStep applies the objects of the task manifest and the task patches to the runtime.
+// All changes are attempted, the result of every change is stored in the operation.
+type ApplyStep struct {
+	operationManager     *process.OperationManager
+	orchestrationStorage storage.Orchestrations
+	instanceStorage      storage.Instances
+	kubeconfigs          KubeconfigProvider
+	k8sClientProvider    func(kcfg string) (client.Client, error)
+	cfg                  internalOrchestration.RuntimeTaskConfig
+	timeSchedule         TimeSchedule
+}
+
+func NewApplyStep(os storage.Operations, ors storage.Orchestrations, is storage.Instances, kubeconfigs KubeconfigProvider,
+	k8sClientProvider func(kcfg string) (client.Client, error), cfg internalOrchestration.RuntimeTaskConfig, timeSchedule *TimeSchedule) *ApplyStep {
+	ts := timeSchedule
+	if ts == nil {
+		ts = &TimeSchedule{
+			Retry:       10 * time.Second,
+			StatusCheck: time.Minute,
+		}
+	}
+	return &ApplyStep{
+		operationManager:     process.NewOperationManager(os),
+		orchestrationStorage: ors,
+		instanceStorage:      is,
+		kubeconfigs:          kubeconfigs,
+		k8sClientProvider:    k8sClientProvider,
+		cfg:                  cfg,
+		timeSchedule:         *ts,
+	}
+}
+
+func (s *ApplyStep) Name() string {
+	return "Apply_Runtime_Task"
+}
+
+func (s *ApplyStep) Run(operation internal.Operation, log logrus.FieldLogger) (internal.Operation, time.Duration, error) {
+	o, err := s.orchestrationStorage.GetByID(operation.OrchestrationID)
+	if err != nil {
+		return s.operationManager.RetryOperation(operation, "unable to get orchestration", err, s.timeSchedule.Retry, s.cfg.Timeout, log)
+	}
+	if o.Parameters.Task == nil {
+		return s.operationManager.OperationFailed(operation, fmt.Sprintf("orchestration %s has no task", o.OrchestrationID), nil, log)
+	}
+	// the configuration could have changed since the orchestration was created
+	if err := s.cfg.Validate(o.Parameters.Task); err != nil {
+		return s.operationManager.OperationFailed(operation, "invalid runtime task", err, log)
+	}
+
+	instance, err := s.instanceStorage.GetByID(operation.InstanceID)
+	if err != nil {
+		return s.operationManager.RetryOperation(operation, "unable to get instance", err, s.timeSchedule.Retry, s.cfg.Timeout, log)
+	}
+	kubeconfig, err := s.kubeconfigs.AdminKubeconfig(instance)
+	if err != nil {
+		return s.operationManager.RetryOperation(operation, "unable to get runtime kubeconfig", err, s.timeSchedule.Retry, s.cfg.Timeout, log)
+	}
+	cli, err := s.k8sClientProvider(kubeconfig)
+	if err != nil {
+		return s.operationManager.RetryOperation(operation, "unable to create runtime client", err, s.timeSchedule.Retry, s.cfg.Timeout, log)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.cfg.Timeout)
+	defer cancel()
+	results := apply(ctx, cli, *o.Parameters.Task, operation.DryRun)
+
+	failed := 0
+	for _, r := range results {
+		if r.Error != "" {
+			failed++
+		}
+	}
+	operation, delay, _ := s.operationManager.UpdateOperation(operation, func(op *internal.Operation) {
+		op.RuntimeTaskResults = results
+	}, log)
+	if delay != 0 {
+		return operation, delay, nil
+	}
+
+	if failed > 0 {
+		return s.operationManager.OperationFailed(operation, fmt.Sprintf("%d of %d changes failed", failed, len(results)), nil, log)
+	}
+	description := fmt.Sprintf("%d changes applied", len(results))
+	if operation.DryRun {
+		description = fmt.Sprintf("%d changes validated in dry run", len(results))
+	}
+	return s.operationManager.OperationSucceeded(operation, description, log)
+}
+
+func apply(ctx context.Context, cli client.Client, task orchestration.RuntimeTaskParameters, dryRun bool) []orchestration.RuntimeTaskResult {
+	var dryRunOpts []client.PatchOption
+	if dryRun {
+		dryRunOpts = append(dryRunOpts, client.DryRunAll)
+	}
+
+	// the manifest was validated before, so it can be decoded
+	objects, _ := internalOrchestration.RuntimeTaskObjects(task)
+	results := make([]orchestration.RuntimeTaskResult, 0, len(objects)+len(task.Patches))
+	for i := range objects {
+		obj := &objects[i]
+		result := orchestration.RuntimeTaskResult{
+			APIVersion: obj.GetAPIVersion(),
+			Kind:       obj.GetKind(),
+			Namespace:  obj.GetNamespace(),
+			Name:       obj.GetName(),
+			Action:     orchestration.RuntimeTaskApplied,
+		}
+		// fields owned by other managers are not taken over, the conflict is reported as the error of the object
+		opts := append([]client.PatchOption{client.FieldOwner(FieldManager)}, dryRunOpts...)
+		if err := cli.Patch(ctx, obj, client.Apply, opts...); err != nil {
+			result.Error = err.Error()
+		}
+		results = append(results, result)
+	}
+
+	for _, p := range task.Patches {
+		result := orchestration.RuntimeTaskResult{
+			APIVersion: p.APIVersion,
+			Kind:       p.Kind,
+			Namespace:  p.Namespace,
+			Name:       p.Name,
+			Action:     orchestration.RuntimeTaskPatched,
+		}
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion(p.APIVersion)
+		obj.SetKind(p.Kind)
+		obj.SetNamespace(p.Namespace)
+		obj.SetName(p.Name)
+		opts := append([]client.PatchOption{client.FieldOwner(FieldManager)}, dryRunOpts...)
+		if err := cli.Patch(ctx, obj, client.RawPatch(patchTypes[p.Type], p.Patch), opts...); err != nil {
+			result.Error = err.Error()
+		}
+		results = append(results, result)
+	}
+
+	return results
+}
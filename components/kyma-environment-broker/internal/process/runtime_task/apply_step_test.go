@@ -0,0 +1,187 @@
+package runtime_task
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/fixture"
+	internalOrchestration "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/orchestration"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	coreV1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const (
+	fixOrchestrationID = "orchestration-id"
+	fixOperationID     = "operation-id"
+	fixInstanceID      = "instance-id"
+)
+
+const fixManifest = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: settings
+  namespace: kyma-system
+data:
+  mode: strict
+`
+
+type fakeKubeconfigProvider struct{}
+
+func (fakeKubeconfigProvider) AdminKubeconfig(instance *internal.Instance) (string, error) {
+	return fmt.Sprintf("kubeconfig-for-%s", instance.RuntimeID), nil
+}
+
+func TestApplyStep_Run(t *testing.T) {
+	t.Run("should apply manifest and patches", func(t *testing.T) {
+		// given
+		db, cli := fixApplyStepEnvironment(t, fixTask("other"), false)
+		step := fixApplyStep(db, cli)
+		operation, err := db.Operations().GetOperationByID(fixOperationID)
+		require.NoError(t, err)
+
+		// when
+		op, when, err := step.Run(*operation, logrus.New())
+
+		// then
+		require.NoError(t, err)
+		assert.Zero(t, when)
+		assert.Equal(t, domain.Succeeded, op.State)
+		assert.Equal(t, "2 changes applied", op.Description)
+		require.Len(t, op.RuntimeTaskResults, 2)
+		assert.Equal(t, orchestration.RuntimeTaskApplied, op.RuntimeTaskResults[0].Action)
+		assert.Equal(t, orchestration.RuntimeTaskPatched, op.RuntimeTaskResults[1].Action)
+		assert.Empty(t, op.RuntimeTaskResults[0].Error)
+		assert.Empty(t, op.RuntimeTaskResults[1].Error)
+
+		settings := coreV1.ConfigMap{}
+		require.NoError(t, cli.Get(context.Background(), client.ObjectKey{Namespace: "kyma-system", Name: "settings"}, &settings))
+		assert.Equal(t, "strict", settings.Data["mode"])
+		other := coreV1.ConfigMap{}
+		require.NoError(t, cli.Get(context.Background(), client.ObjectKey{Namespace: "kyma-system", Name: "other"}, &other))
+		assert.Equal(t, "true", other.Data["patched"])
+	})
+
+	t.Run("should record failed changes and fail the operation", func(t *testing.T) {
+		// given
+		db, cli := fixApplyStepEnvironment(t, fixTask("missing"), false)
+		step := fixApplyStep(db, cli)
+		operation, err := db.Operations().GetOperationByID(fixOperationID)
+		require.NoError(t, err)
+
+		// when
+		op, _, err := step.Run(*operation, logrus.New())
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, domain.Failed, op.State)
+		require.Len(t, op.RuntimeTaskResults, 2)
+		assert.Empty(t, op.RuntimeTaskResults[0].Error)
+		assert.NotEmpty(t, op.RuntimeTaskResults[1].Error)
+
+		stored, err := db.Operations().GetOperationByID(fixOperationID)
+		require.NoError(t, err)
+		assert.Equal(t, op.RuntimeTaskResults, stored.RuntimeTaskResults)
+	})
+
+	t.Run("should not change the runtime in dry run", func(t *testing.T) {
+		// given
+		db, cli := fixApplyStepEnvironment(t, fixTask("other"), true)
+		step := fixApplyStep(db, cli)
+		operation, err := db.Operations().GetOperationByID(fixOperationID)
+		require.NoError(t, err)
+
+		// when
+		op, _, err := step.Run(*operation, logrus.New())
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, domain.Succeeded, op.State)
+		assert.Equal(t, "2 changes validated in dry run", op.Description)
+
+		settings := coreV1.ConfigMap{}
+		require.NoError(t, cli.Get(context.Background(), client.ObjectKey{Namespace: "kyma-system", Name: "settings"}, &settings))
+		assert.Equal(t, "lenient", settings.Data["mode"])
+	})
+
+	t.Run("should fail the operation when the task is no longer allowed", func(t *testing.T) {
+		// given
+		task := fixTask("other")
+		task.Patches[0].Namespace = "kube-system"
+		db, cli := fixApplyStepEnvironment(t, task, false)
+		step := fixApplyStep(db, cli)
+		operation, err := db.Operations().GetOperationByID(fixOperationID)
+		require.NoError(t, err)
+
+		// when
+		op, _, err := step.Run(*operation, logrus.New())
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, domain.Failed, op.State)
+		assert.Empty(t, op.RuntimeTaskResults)
+	})
+}
+
+func fixTask(patchedConfigMap string) orchestration.RuntimeTaskParameters {
+	return orchestration.RuntimeTaskParameters{
+		Manifest: fixManifest,
+		Patches: []orchestration.RuntimeTaskPatch{
+			{
+				APIVersion: "v1",
+				Kind:       "ConfigMap",
+				Namespace:  "kyma-system",
+				Name:       patchedConfigMap,
+				Type:       orchestration.MergePatch,
+				Patch:      json.RawMessage(`{"data":{"patched":"true"}}`),
+			},
+		},
+	}
+}
+
+func fixApplyStepEnvironment(t *testing.T, task orchestration.RuntimeTaskParameters, dryRun bool) (storage.BrokerStorage, client.Client) {
+	db := storage.NewMemoryStorage()
+
+	err := db.Orchestrations().Insert(internal.Orchestration{
+		OrchestrationID: fixOrchestrationID,
+		Type:            orchestration.RuntimeTaskOrchestration,
+		State:           orchestration.InProgress,
+		Parameters:      orchestration.Parameters{DryRun: dryRun, Task: &task},
+	})
+	require.NoError(t, err)
+	err = db.Instances().Insert(fixture.FixInstance(fixInstanceID))
+	require.NoError(t, err)
+
+	operation := fixture.FixOperation(fixOperationID, fixInstanceID, internal.OperationTypeRuntimeTask)
+	operation.OrchestrationID = fixOrchestrationID
+	operation.State = domain.InProgress
+	operation.DryRun = dryRun
+	err = db.Operations().InsertOperation(operation)
+	require.NoError(t, err)
+
+	cli := fake.NewClientBuilder().WithObjects(
+		&coreV1.ConfigMap{ObjectMeta: metaV1.ObjectMeta{Namespace: "kyma-system", Name: "settings"}, Data: map[string]string{"mode": "lenient"}},
+		&coreV1.ConfigMap{ObjectMeta: metaV1.ObjectMeta{Namespace: "kyma-system", Name: "other"}},
+	).Build()
+
+	return db, cli
+}
+
+func fixApplyStep(db storage.BrokerStorage, cli client.Client) *ApplyStep {
+	cfg := internalOrchestration.RuntimeTaskConfig{
+		AllowedKinds:        []string{"ConfigMap"},
+		ProtectedNamespaces: []string{"kube-system"},
+	}
+	return NewApplyStep(db.Operations(), db.Orchestrations(), db.Instances(), fakeKubeconfigProvider{},
+		func(kcfg string) (client.Client, error) { return cli, nil }, cfg, nil)
+}
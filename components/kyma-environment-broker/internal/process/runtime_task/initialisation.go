@@ -0,0 +1,90 @@
+package runtime_task
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/sirupsen/logrus"
+)
+
+type TimeSchedule struct {
+	Retry       time.Duration
+	StatusCheck time.Duration
+}
+
+type InitialisationStep struct {
+	operationManager     *process.OperationManager
+	operationStorage     storage.Operations
+	orchestrationStorage storage.Orchestrations
+	timeSchedule         TimeSchedule
+}
+
+func NewInitialisationStep(os storage.Operations, ors storage.Orchestrations, timeSchedule *TimeSchedule) *InitialisationStep {
+	ts := timeSchedule
+	if ts == nil {
+		ts = &TimeSchedule{
+			Retry:       5 * time.Second,
+			StatusCheck: time.Minute,
+		}
+	}
+	return &InitialisationStep{
+		operationManager:     process.NewOperationManager(os),
+		operationStorage:     os,
+		orchestrationStorage: ors,
+		timeSchedule:         *ts,
+	}
+}
+
+func (s *InitialisationStep) Name() string {
+	return "Runtime_Task_Initialisation"
+}
+
+func (s *InitialisationStep) Run(operation internal.Operation, log logrus.FieldLogger) (internal.Operation, time.Duration, error) {
+	if operation.State != orchestration.Pending {
+		return operation, 0, nil
+	}
+
+	// Check concurrent deprovisioning (or suspension) operation (launched after target resolution)
+	lastOp, err := s.operationStorage.GetLastOperation(operation.InstanceID)
+	if err != nil {
+		return operation, s.timeSchedule.Retry, nil
+	}
+	if lastOp.Type == internal.OperationTypeDeprovision {
+		return s.operationManager.OperationCanceled(operation, fmt.Sprintf("operation preempted by deprovisioning %s", lastOp.ID), log)
+	}
+
+	// Check if the orchestration got cancelled, don't start new pending operation
+	o, err := s.orchestrationStorage.GetByID(operation.OrchestrationID)
+	if err != nil {
+		return operation, s.timeSchedule.Retry, nil
+	}
+	if o.IsCanceled() {
+		log.Infof("Skipping processing because orchestration %s was canceled", operation.OrchestrationID)
+		return s.operationManager.OperationCanceled(operation, fmt.Sprintf("orchestration %s was canceled", operation.OrchestrationID), log)
+	}
+
+	// Check concurrent operations and wait to finish before proceeding
+	// - unsuspension provisioning launched after suspension
+	// - kyma upgrade or cluster upgrade
+	switch lastOp.Type {
+	case internal.OperationTypeProvision, internal.OperationTypeUpgradeKyma, internal.OperationTypeUpgradeCluster, internal.OperationTypeUpdate:
+		if !lastOp.IsFinished() {
+			return operation, s.timeSchedule.StatusCheck, nil
+		}
+	}
+
+	op, delay, _ := s.operationManager.UpdateOperation(operation, func(op *internal.Operation) {
+		op.ProvisioningParameters.ErsContext = internal.InheritMissingERSContext(op.ProvisioningParameters.ErsContext, lastOp.ProvisioningParameters.ErsContext)
+		op.State = domain.InProgress
+		op.Description = "Applying the runtime task"
+	}, log)
+	if delay != 0 {
+		return operation, delay, nil
+	}
+	return op, 0, nil
+}
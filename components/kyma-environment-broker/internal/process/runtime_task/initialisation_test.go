@@ -0,0 +1,87 @@
+package runtime_task
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/fixture"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInitialisationStep_Run(t *testing.T) {
+	for name, tc := range map[string]struct {
+		lastOperationType  internal.OperationType
+		lastOperationState domain.LastOperationState
+		orchestrationState string
+		expectedState      domain.LastOperationState
+		expectedWait       bool
+	}{
+		"should start the task": {
+			lastOperationType:  internal.OperationTypeProvision,
+			lastOperationState: domain.Succeeded,
+			orchestrationState: orchestration.InProgress,
+			expectedState:      domain.InProgress,
+		},
+		"should wait for the upgrade in progress": {
+			lastOperationType:  internal.OperationTypeUpgradeCluster,
+			lastOperationState: domain.InProgress,
+			orchestrationState: orchestration.InProgress,
+			expectedState:      orchestration.Pending,
+			expectedWait:       true,
+		},
+		"should cancel the task of the deprovisioned instance": {
+			lastOperationType:  internal.OperationTypeDeprovision,
+			lastOperationState: domain.Succeeded,
+			orchestrationState: orchestration.InProgress,
+			expectedState:      orchestration.Canceled,
+		},
+		"should cancel the task of the canceled orchestration": {
+			lastOperationType:  internal.OperationTypeProvision,
+			lastOperationState: domain.Succeeded,
+			orchestrationState: orchestration.Canceling,
+			expectedState:      orchestration.Canceled,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// given
+			db := storage.NewMemoryStorage()
+			err := db.Orchestrations().Insert(internal.Orchestration{
+				OrchestrationID: fixOrchestrationID,
+				Type:            orchestration.RuntimeTaskOrchestration,
+				State:           tc.orchestrationState,
+			})
+			require.NoError(t, err)
+
+			lastOp := fixture.FixOperation("last-operation-id", fixInstanceID, tc.lastOperationType)
+			lastOp.State = tc.lastOperationState
+			err = db.Operations().InsertOperation(lastOp)
+			require.NoError(t, err)
+
+			operation := fixture.FixOperation(fixOperationID, fixInstanceID, internal.OperationTypeRuntimeTask)
+			operation.OrchestrationID = fixOrchestrationID
+			operation.State = orchestration.Pending
+			err = db.Operations().InsertOperation(operation)
+			require.NoError(t, err)
+
+			step := NewInitialisationStep(db.Operations(), db.Orchestrations(), &TimeSchedule{Retry: time.Second, StatusCheck: time.Minute})
+
+			// when
+			op, when, err := step.Run(operation, logrus.New())
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedState, op.State)
+			if tc.expectedWait {
+				assert.Equal(t, time.Minute, when)
+			} else {
+				assert.Zero(t, when)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package runtime_task
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/event"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/sirupsen/logrus"
+)
+
+// Manager executes the steps of runtime task operations in the order in which they were added.
+// Unlike the staged manager it does not limit the time since the operation was created,
+// because the operations wait for the maintenance windows of their runtimes.
+type Manager struct {
+	log              logrus.FieldLogger
+	steps            []process.Step
+	operationStorage storage.Operations
+
+	publisher event.Publisher
+}
+
+func NewManager(storage storage.Operations, pub event.Publisher, logger logrus.FieldLogger) *Manager {
+	return &Manager{
+		log:              logger,
+		operationStorage: storage,
+		publisher:        pub,
+	}
+}
+
+func (m *Manager) AddStep(step process.Step) {
+	m.steps = append(m.steps, step)
+}
+
+func (m *Manager) runStep(step process.Step, operation internal.Operation, logger logrus.FieldLogger) (processedOperation internal.Operation, when time.Duration, err error) {
+	defer func() {
+		if pErr := recover(); pErr != nil {
+			logger.Println("panic in RunStep during runtime task: ", pErr)
+			err = errors.New(fmt.Sprintf("%v", pErr))
+			om := process.NewOperationManager(m.operationStorage)
+			processedOperation, _, _ = om.OperationFailed(operation, "recovered from panic", err, m.log)
+		}
+	}()
+
+	start := time.Now()
+	processedOperation, when, err = step.Run(operation, logger)
+	m.publisher.Publish(context.TODO(), process.RuntimeTaskStepProcessed{
+		OldOperation: operation,
+		Operation:    processedOperation,
+		StepProcessed: process.StepProcessed{
+			StepName: step.Name(),
+			Duration: time.Since(start),
+			When:     when,
+			Error:    err,
+		},
+	})
+	return processedOperation, when, err
+}
+
+func (m *Manager) Execute(operationID string) (time.Duration, error) {
+	op, err := m.operationStorage.GetOperationByID(operationID)
+	if err != nil {
+		m.log.Errorf("Cannot fetch operation from storage: %s", err)
+		return 3 * time.Second, nil
+	}
+	operation := *op
+	if operation.IsFinished() {
+		return 0, nil
+	}
+
+	var when time.Duration
+	logOperation := m.log.WithFields(logrus.Fields{"operation": operationID, "instanceID": operation.InstanceID})
+
+	logOperation.Info("Start process operation steps")
+	for _, step := range m.steps {
+		logStep := logOperation.WithField("step", step.Name())
+		logStep.Infof("Start step")
+
+		operation, when, err = m.runStep(step, operation, logStep)
+		if err != nil {
+			logStep.Errorf("Process operation failed: %s", err)
+			return 0, err
+		}
+		if operation.IsFinished() {
+			logStep.Infof("Operation %q got status %s. Process finished.", operation.ID, operation.State)
+			return 0, nil
+		}
+		if when == 0 {
+			logStep.Info("Process operation successful")
+			continue
+		}
+
+		logStep.Infof("Process operation will be repeated in %s ...", when)
+		return when, nil
+	}
+
+	logOperation.Infof("Operation %q got status %s. All steps finished.", operation.ID, operation.State)
+	return 0, nil
+}
+
+func (m *Manager) Reschedule(operationID string, maintenanceWindowBegin, maintenanceWindowEnd time.Time) error {
+	op, err := m.operationStorage.GetOperationByID(operationID)
+	if err != nil {
+		m.log.Errorf("Cannot fetch operation %s from storage: %s", operationID, err)
+		return err
+	}
+	op.MaintenanceWindowBegin = maintenanceWindowBegin
+	op.MaintenanceWindowEnd = maintenanceWindowEnd
+	_, err = m.operationStorage.UpdateOperation(*op)
+	if err != nil {
+		m.log.Errorf("Cannot update (reschedule) operation %s in storage: %s", operationID, err)
+	}
+
+	return err
+}
+
+// Postpone reschedules the operation and sets its description, e.g. with the reason why the operation was postponed
+func (m *Manager) Postpone(operationID string, maintenanceWindowBegin, maintenanceWindowEnd time.Time, description string) error {
+	op, err := m.operationStorage.GetOperationByID(operationID)
+	if err != nil {
+		m.log.Errorf("Cannot fetch operation %s from storage: %s", operationID, err)
+		return err
+	}
+	op.MaintenanceWindowBegin = maintenanceWindowBegin
+	op.MaintenanceWindowEnd = maintenanceWindowEnd
+	op.Description = description
+	_, err = m.operationStorage.UpdateOperation(*op)
+	if err != nil {
+		m.log.Errorf("Cannot update (postpone) operation %s in storage: %s", operationID, err)
+	}
+
+	return err
+}
@@ -124,6 +124,20 @@ func (m *StagedManager) Execute(operationID string) (time.Duration, error) {
 		return 0, timeoutErr
 	}
 
+	if operation.NextRetryAt != nil {
+		if remaining := time.Until(*operation.NextRetryAt); remaining > 0 {
+			logOperation.Infof("Operation is scheduled for retry at %s", operation.NextRetryAt.Format(time.RFC3339))
+			return remaining, nil
+		}
+		operation.NextRetryAt = nil
+		updated, err := m.operationStorage.UpdateOperation(*operation)
+		if err != nil {
+			logOperation.Errorf("Unable to clear the next retry time of the operation: %s", err)
+			return time.Second, nil
+		}
+		operation = updated
+	}
+
 	var when time.Duration
 	processedOperation := *operation
 
@@ -139,12 +153,12 @@ func (m *StagedManager) Execute(operationID string) (time.Duration, error) {
 				logStep.Debugf("Skipping")
 				continue
 			}
-			operation.EventInfof("processing step: %v", step.Name())
+			operation.StepEventInfof(step.Name(), "processing step: %v", step.Name())
 
 			processedOperation, when, err = m.runStep(step, processedOperation, logStep)
 			if err != nil {
 				logStep.Errorf("Process operation failed: %s", err)
-				operation.EventErrorf(err, "step %v processing returned error", step.Name())
+				operation.StepEventErrorf(step.Name(), err, "step %v processing returned error", step.Name())
 				return 0, err
 			}
 			if processedOperation.State == domain.Failed || processedOperation.State == domain.Succeeded {
@@ -156,6 +170,7 @@ func (m *StagedManager) Execute(operationID string) (time.Duration, error) {
 			// the step needs a retry
 			if when > 0 {
 				logStep.Warnf("retrying step by restarting the operation in %d s", int64(when.Seconds()))
+				m.saveNextRetry(processedOperation, when, logStep)
 				return when, nil
 			}
 		}
@@ -194,6 +209,15 @@ func (m *StagedManager) saveFinishedStage(operation internal.Operation, s *stage
 	return *op, nil
 }
 
+// saveNextRetry persists the retry time, so the delay is respected when the operation is resumed after a restart
+func (m *StagedManager) saveNextRetry(operation internal.Operation, when time.Duration, log logrus.FieldLogger) {
+	nextRetryAt := time.Now().Add(time.Duration(int64(when) / m.speedFactor))
+	operation.NextRetryAt = &nextRetryAt
+	if _, err := m.operationStorage.UpdateOperation(operation); err != nil {
+		log.Warnf("Unable to save the next retry time of the operation: %s", err)
+	}
+}
+
 func (m *StagedManager) runStep(step Step, operation internal.Operation, logger logrus.FieldLogger) (processedOperation internal.Operation, backoff time.Duration, err error) {
 	var start time.Time
 	defer func() {
@@ -239,7 +263,7 @@ func (m *StagedManager) runStep(step Step, operation internal.Operation, logger
 		if backoff == 0 || err != nil || time.Since(begin) > 10*time.Minute {
 			return processedOperation, backoff, err
 		}
-		operation.EventInfof("step %v sleeping for %v", step.Name(), backoff)
+		operation.StepEventInfof(step.Name(), "step %v sleeping for %v", step.Name(), backoff)
 		time.Sleep(backoff / time.Duration(m.speedFactor))
 	}
 }
@@ -138,6 +138,47 @@ func TestSkipFinishedStage(t *testing.T) {
 	assert.True(t, op.IsStageFinished("stage-2"))
 }
 
+func TestPersistedRetry(t *testing.T) {
+	t.Run("should not process operation before the next retry time", func(t *testing.T) {
+		// given
+		operation := FixOperation("op-0001234")
+		nextRetryAt := time.Now().Add(time.Hour)
+		operation.NextRetryAt = &nextRetryAt
+		mgr, operationStorage, eventCollector := SetupStagedManager(operation)
+		mgr.AddStep("stage-1", &testingStep{name: "first", eventPublisher: eventCollector}, nil)
+
+		// when
+		retry, err := mgr.Execute(operation.ID)
+
+		// then
+		assert.NoError(t, err)
+		assert.InDelta(t, time.Hour.Seconds(), retry.Seconds(), 5)
+		eventCollector.AssertProcessedSteps(t, []string{})
+		op, _ := operationStorage.GetOperationByID(operation.ID)
+		assert.False(t, op.IsStageFinished("stage-1"))
+	})
+
+	t.Run("should process operation and clear the retry time when it is due", func(t *testing.T) {
+		// given
+		operation := FixOperation("op-0001234")
+		nextRetryAt := time.Now().Add(-time.Minute)
+		operation.NextRetryAt = &nextRetryAt
+		mgr, operationStorage, eventCollector := SetupStagedManager(operation)
+		mgr.AddStep("stage-1", &testingStep{name: "first", eventPublisher: eventCollector}, nil)
+
+		// when
+		retry, err := mgr.Execute(operation.ID)
+
+		// then
+		assert.NoError(t, err)
+		assert.Zero(t, retry)
+		eventCollector.AssertProcessedSteps(t, []string{"first"})
+		op, _ := operationStorage.GetOperationByID(operation.ID)
+		assert.True(t, op.IsStageFinished("stage-1"))
+		assert.Nil(t, op.NextRetryAt)
+	})
+}
+
 func SetupStagedManager(op internal.Operation) (*process.StagedManager, storage.Operations, *CollectingEventHandler) {
 	memoryStorage := storage.NewMemoryStorage()
 	memoryStorage.Operations().InsertOperation(op)
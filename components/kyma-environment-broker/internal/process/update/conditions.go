@@ -3,6 +3,7 @@ package update
 import (
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
 )
 
 func RequiresReconcilerUpdate(op internal.Operation) bool {
@@ -25,3 +26,22 @@ func SkipForOwnClusterPlan(op internal.Operation) bool {
 func RequiresBTPOperatorCredentials(op internal.Operation) bool {
 	return ForBTPOperatorCredentialsProvided(op) && !broker.IsPreviewPlan(op.ProvisioningParameters.PlanID)
 }
+
+// SkipForHibernationOnly wraps the condition of a step changing the cluster, the step is skipped if the update
+// changes only the hibernation parameters as the cluster may be hibernated
+func SkipForHibernationOnly(condition process.StepCondition) process.StepCondition {
+	return func(op internal.Operation) bool {
+		if op.UpdatingParameters.HibernationOnly() {
+			return false
+		}
+		return condition == nil || condition(op)
+	}
+}
+
+func RequiresWakeUp(op internal.Operation) bool {
+	return op.UpdatingParameters.Hibernated != nil && !*op.UpdatingParameters.Hibernated
+}
+
+func RequiresHibernation(op internal.Operation) bool {
+	return op.UpdatingParameters.Hibernated != nil && *op.UpdatingParameters.Hibernated
+}
@@ -0,0 +1,134 @@
+package update
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/provisioner"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+)
+
+// HibernationStep hibernates or wakes up the runtime using the Provisioner and waits until the operation finishes.
+// The hibernation state is stored in the instance only when the Provisioner operation succeeded.
+type HibernationStep struct {
+	operationManager  *process.OperationManager
+	instanceStorage   storage.Instances
+	provisionerClient provisioner.Client
+	hibernate         bool
+	timeout           time.Duration
+}
+
+// NewHibernateRuntimeStep creates a step which hibernates the runtime
+func NewHibernateRuntimeStep(os storage.Operations, is storage.Instances, cli provisioner.Client, timeout time.Duration) *HibernationStep {
+	return &HibernationStep{
+		operationManager:  process.NewOperationManager(os),
+		instanceStorage:   is,
+		provisionerClient: cli,
+		hibernate:         true,
+		timeout:           timeout,
+	}
+}
+
+// NewWakeUpRuntimeStep creates a step which wakes up the runtime
+func NewWakeUpRuntimeStep(os storage.Operations, is storage.Instances, cli provisioner.Client, timeout time.Duration) *HibernationStep {
+	return &HibernationStep{
+		operationManager:  process.NewOperationManager(os),
+		instanceStorage:   is,
+		provisionerClient: cli,
+		hibernate:         false,
+		timeout:           timeout,
+	}
+}
+
+var _ process.Step = (*HibernationStep)(nil)
+
+func (s *HibernationStep) Name() string {
+	if s.hibernate {
+		return "Hibernate_Runtime"
+	}
+	return "Wake_Up_Runtime"
+}
+
+func (s *HibernationStep) Run(operation internal.Operation, log logrus.FieldLogger) (internal.Operation, time.Duration, error) {
+	if operation.RuntimeID == "" {
+		log.Infof("Runtime does not exists, skipping a call to Provisioner")
+		return operation, 0, nil
+	}
+	log = log.WithField("runtimeID", operation.RuntimeID)
+	globalAccountID := operation.ProvisioningParameters.ErsContext.GlobalAccountID
+
+	if operation.HibernationOperationID == "" {
+		var (
+			provisionerResponse gqlschema.OperationStatus
+			err                 error
+		)
+		if s.hibernate {
+			provisionerResponse, err = s.provisionerClient.HibernateRuntime(globalAccountID, operation.RuntimeID)
+		} else {
+			provisionerResponse, err = s.provisionerClient.WakeUpRuntime(globalAccountID, operation.RuntimeID)
+		}
+		if err != nil {
+			return s.operationManager.RetryOperation(operation, "call to provisioner failed", err, retryDuration, 5*time.Minute, log)
+		}
+
+		repeat := time.Duration(0)
+		operation, repeat, _ = s.operationManager.UpdateOperation(operation, func(op *internal.Operation) {
+			op.HibernationOperationID = *provisionerResponse.ID
+		}, log)
+		if repeat != 0 {
+			log.Errorf("cannot save hibernation operation ID from provisioner")
+			return operation, retryDuration, nil
+		}
+		log.Infof("call to provisioner succeeded, got operation ID %q", operation.HibernationOperationID)
+	}
+
+	return s.checkOperationStatus(operation, log.WithField("provisionerOperationID", operation.HibernationOperationID))
+}
+
+func (s *HibernationStep) checkOperationStatus(operation internal.Operation, log logrus.FieldLogger) (internal.Operation, time.Duration, error) {
+	if time.Since(operation.UpdatedAt) > s.timeout {
+		log.Infof("operation has reached the time limit: updated operation time: %s", operation.UpdatedAt)
+		return s.operationManager.OperationFailed(operation, fmt.Sprintf("operation has reached the time limit: %s", s.timeout), nil, log)
+	}
+
+	status, err := s.provisionerClient.RuntimeOperationStatus(operation.ProvisioningParameters.ErsContext.GlobalAccountID, operation.HibernationOperationID)
+	if err != nil {
+		log.Errorf("call to provisioner RuntimeOperationStatus failed: %s", err.Error())
+		return operation, 1 * time.Minute, nil
+	}
+	log.Infof("call to provisioner returned %s status", status.State.String())
+
+	var msg string
+	if status.Message != nil {
+		msg = *status.Message
+	}
+
+	switch status.State {
+	case gqlschema.OperationStateSucceeded:
+		return s.storeHibernationState(operation, log)
+	case gqlschema.OperationStateInProgress, gqlschema.OperationStatePending:
+		return operation, time.Minute, nil
+	case gqlschema.OperationStateFailed:
+		return s.operationManager.OperationFailed(operation, fmt.Sprintf("provisioner client returns failed status: %s", msg), nil, log)
+	}
+
+	return s.operationManager.OperationFailed(operation, fmt.Sprintf("unsupported provisioner client status: %s", status.State.String()), nil, log)
+}
+
+func (s *HibernationStep) storeHibernationState(operation internal.Operation, log logrus.FieldLogger) (internal.Operation, time.Duration, error) {
+	instance, err := s.instanceStorage.GetByID(operation.InstanceID)
+	if err != nil {
+		return s.operationManager.RetryOperation(operation, "unable to get instance", err, retryDuration, 5*time.Minute, log)
+	}
+	hibernated := s.hibernate
+	instance.Parameters.Parameters.Hibernated = &hibernated
+	if _, err := s.instanceStorage.Update(*instance); err != nil {
+		return s.operationManager.RetryOperation(operation, "unable to store hibernation state in the instance", err, retryDuration, 5*time.Minute, log)
+	}
+	return operation, 0, nil
+}
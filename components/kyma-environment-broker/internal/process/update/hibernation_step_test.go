@@ -0,0 +1,118 @@
+package update
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/provisioner"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/ptr"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHibernationStep_Run(t *testing.T) {
+	for _, tc := range []struct {
+		name              string
+		hibernate         bool
+		expectedStepName  string
+		expectedOperation gqlschema.OperationType
+	}{
+		{
+			name:              "hibernate",
+			hibernate:         true,
+			expectedStepName:  "Hibernate_Runtime",
+			expectedOperation: gqlschema.OperationTypeHibernate,
+		},
+		{
+			name:              "wake up",
+			hibernate:         false,
+			expectedStepName:  "Wake_Up_Runtime",
+			expectedOperation: provisioner.OperationTypeWakeUp,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			provisionerClient := provisioner.NewFakeClient()
+			st := storage.NewMemoryStorage()
+			operation := fixHibernationOperation(tc.hibernate)
+			require.NoError(t, st.Operations().InsertOperation(operation))
+			require.NoError(t, st.Instances().Insert(internal.Instance{InstanceID: operation.InstanceID}))
+
+			step := NewWakeUpRuntimeStep(st.Operations(), st.Instances(), provisionerClient, time.Minute)
+			if tc.hibernate {
+				step = NewHibernateRuntimeStep(st.Operations(), st.Instances(), provisionerClient, time.Minute)
+			}
+			assert.Equal(t, tc.expectedStepName, step.Name())
+
+			// when
+			operation, repeat, err := step.Run(operation, logrus.New())
+
+			// then
+			require.NoError(t, err)
+			assert.NotZero(t, repeat)
+			assert.NotEmpty(t, operation.HibernationOperationID)
+			assert.Equal(t, tc.hibernate, provisionerClient.IsHibernated(statusRuntimeID))
+			assert.Equal(t, tc.expectedOperation, provisionerClient.FindOperationByRuntimeIDAndType(statusRuntimeID, tc.expectedOperation).Operation)
+			instance, err := st.Instances().GetByID(operation.InstanceID)
+			require.NoError(t, err)
+			assert.Nil(t, instance.Parameters.Parameters.Hibernated)
+
+			// when
+			provisionerClient.FinishProvisionerOperation(operation.HibernationOperationID, gqlschema.OperationStateSucceeded)
+			operation, repeat, err = step.Run(operation, logrus.New())
+
+			// then
+			require.NoError(t, err)
+			assert.Zero(t, repeat)
+			assert.Equal(t, domain.InProgress, operation.State)
+			instance, err = st.Instances().GetByID(operation.InstanceID)
+			require.NoError(t, err)
+			require.NotNil(t, instance.Parameters.Parameters.Hibernated)
+			assert.Equal(t, tc.hibernate, *instance.Parameters.Parameters.Hibernated)
+		})
+	}
+
+	t.Run("should fail when the provisioner operation failed", func(t *testing.T) {
+		// given
+		provisionerClient := provisioner.NewFakeClient()
+		st := storage.NewMemoryStorage()
+		operation := fixHibernationOperation(true)
+		operation.HibernationOperationID = statusProvisionerOperationID
+		provisionerClient.SetOperation(statusProvisionerOperationID, gqlschema.OperationStatus{
+			ID:        ptr.String(statusProvisionerOperationID),
+			Operation: gqlschema.OperationTypeHibernate,
+			State:     gqlschema.OperationStateFailed,
+			RuntimeID: ptr.String(statusRuntimeID),
+		})
+		require.NoError(t, st.Operations().InsertOperation(operation))
+		require.NoError(t, st.Instances().Insert(internal.Instance{InstanceID: operation.InstanceID}))
+
+		step := NewHibernateRuntimeStep(st.Operations(), st.Instances(), provisionerClient, time.Minute)
+
+		// when
+		operation, repeat, err := step.Run(operation, logrus.New())
+
+		// then
+		require.Error(t, err)
+		assert.Zero(t, repeat)
+		assert.Equal(t, domain.Failed, operation.State)
+		instance, err := st.Instances().GetByID(operation.InstanceID)
+		require.NoError(t, err)
+		assert.Nil(t, instance.Parameters.Parameters.Hibernated)
+	})
+}
+
+func fixHibernationOperation(hibernated bool) internal.Operation {
+	operation := fixOperationRuntimeStatus(broker.AWSPlanID)
+	operation.RuntimeID = statusRuntimeID
+	operation.InstanceID = "instance-id"
+	operation.ProvisionerOperationID = ""
+	operation.UpdatingParameters = internal.UpdatingParametersDTO{Hibernated: &hibernated}
+	return operation
+}
@@ -50,7 +50,7 @@ func (s *UpgradeShootStep) Run(operation internal.Operation, log logrus.FieldLog
 	}
 	operation.LastRuntimeState = latestRuntimeStateWithOIDC
 
-	input, err := s.createUpgradeShootInput(operation)
+	input, err := CreateUpgradeShootInput(operation)
 	if err != nil {
 		return s.operationManager.OperationFailed(operation, "invalid operation data - cannot create upgradeShoot input", err, log)
 	}
@@ -91,7 +91,9 @@ func (s *UpgradeShootStep) Run(operation internal.Operation, log logrus.FieldLog
 
 }
 
-func (s *UpgradeShootStep) createUpgradeShootInput(operation internal.Operation) (gqlschema.UpgradeShootInput, error) {
+// CreateUpgradeShootInput builds the provisioner upgrade shoot request for the operation using its InputCreator.
+// It does not call the provisioner, so it can be used to preview the request as well.
+func CreateUpgradeShootInput(operation internal.Operation) (gqlschema.UpgradeShootInput, error) {
 	operation.InputCreator.SetProvisioningParameters(operation.ProvisioningParameters)
 	if operation.LastRuntimeState.ClusterConfig.OidcConfig != nil {
 		operation.InputCreator.SetOIDCLastValues(*operation.LastRuntimeState.ClusterConfig.OidcConfig)
@@ -148,6 +148,24 @@ func (m Manager) Reschedule(operationID string, maintenanceWindowBegin, maintena
 	return err
 }
 
+// Postpone reschedules the operation and sets its description, e.g. with the reason why the operation was postponed
+func (m Manager) Postpone(operationID string, maintenanceWindowBegin, maintenanceWindowEnd time.Time, description string) error {
+	op, err := m.operationStorage.GetUpgradeKymaOperationByID(operationID)
+	if err != nil {
+		m.log.Errorf("Cannot fetch operation %s from storage: %s", operationID, err)
+		return err
+	}
+	op.MaintenanceWindowBegin = maintenanceWindowBegin
+	op.MaintenanceWindowEnd = maintenanceWindowEnd
+	op.Description = description
+	op, err = m.operationStorage.UpdateUpgradeKymaOperation(*op)
+	if err != nil {
+		m.log.Errorf("Cannot update (postpone) operation %s in storage: %s", operationID, err)
+	}
+
+	return err
+}
+
 func (m *Manager) sortWeight() []int {
 	var weight []int
 	for w := range m.steps {
@@ -33,6 +33,27 @@ func (_m *Client) DeprovisionRuntime(accountID string, runtimeID string) (string
 	return r0, r1
 }
 
+// HibernateRuntime provides a mock function with given fields: accountID, runtimeID
+func (_m *Client) HibernateRuntime(accountID string, runtimeID string) (gqlschema.OperationStatus, error) {
+	ret := _m.Called(accountID, runtimeID)
+
+	var r0 gqlschema.OperationStatus
+	if rf, ok := ret.Get(0).(func(string, string) gqlschema.OperationStatus); ok {
+		r0 = rf(accountID, runtimeID)
+	} else {
+		r0 = ret.Get(0).(gqlschema.OperationStatus)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(accountID, runtimeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ProvisionRuntime provides a mock function with given fields: accountID, subAccountID, config
 func (_m *Client) ProvisionRuntime(accountID string, subAccountID string, config gqlschema.ProvisionRuntimeInput) (gqlschema.OperationStatus, error) {
 	ret := _m.Called(accountID, subAccountID, config)
@@ -159,6 +180,27 @@ func (_m *Client) UpgradeShoot(accountID string, runtimeID string, config gqlsch
 	return r0, r1
 }
 
+// WakeUpRuntime provides a mock function with given fields: accountID, runtimeID
+func (_m *Client) WakeUpRuntime(accountID string, runtimeID string) (gqlschema.OperationStatus, error) {
+	ret := _m.Called(accountID, runtimeID)
+
+	var r0 gqlschema.OperationStatus
+	if rf, ok := ret.Get(0).(func(string, string) gqlschema.OperationStatus); ok {
+		r0 = rf(accountID, runtimeID)
+	} else {
+		r0 = ret.Get(0).(gqlschema.OperationStatus)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(accountID, runtimeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewClient interface {
 	mock.TestingT
 	Cleanup(func())
@@ -18,6 +18,9 @@ const (
 	subAccountIDKey = "sub-account"
 )
 
+// OperationTypeWakeUp is the type of the Provisioner operation waking up a hibernated Runtime
+const OperationTypeWakeUp schema.OperationType = "WakeUp"
+
 //go:generate mockery --name=Client --output=automock --outpkg=automock --case=underscore
 
 type Client interface {
@@ -25,6 +28,8 @@ type Client interface {
 	DeprovisionRuntime(accountID, runtimeID string) (string, error)
 	UpgradeRuntime(accountID, runtimeID string, config schema.UpgradeRuntimeInput) (schema.OperationStatus, error)
 	UpgradeShoot(accountID, runtimeID string, config schema.UpgradeShootInput) (schema.OperationStatus, error)
+	HibernateRuntime(accountID, runtimeID string) (schema.OperationStatus, error)
+	WakeUpRuntime(accountID, runtimeID string) (schema.OperationStatus, error)
 	ReconnectRuntimeAgent(accountID, runtimeID string) (string, error)
 	RuntimeOperationStatus(accountID, operationID string) (schema.OperationStatus, error)
 	RuntimeStatus(accountID, runtimeID string) (schema.RuntimeStatus, error)
@@ -120,6 +125,32 @@ func (c *client) UpgradeShoot(accountID, runtimeID string, config schema.Upgrade
 	return res, nil
 }
 
+func (c *client) HibernateRuntime(accountID, runtimeID string) (schema.OperationStatus, error) {
+	query := c.queryProvider.hibernateRuntime(runtimeID)
+	req := gcli.NewRequest(query)
+	req.Header.Add(accountIDKey, accountID)
+
+	var res schema.OperationStatus
+	err := c.executeRequest(req, &res)
+	if err != nil {
+		return schema.OperationStatus{}, fmt.Errorf("failed to hibernate Runtime: %w", err)
+	}
+	return res, nil
+}
+
+func (c *client) WakeUpRuntime(accountID, runtimeID string) (schema.OperationStatus, error) {
+	query := c.queryProvider.wakeUpRuntime(runtimeID)
+	req := gcli.NewRequest(query)
+	req.Header.Add(accountIDKey, accountID)
+
+	var res schema.OperationStatus
+	err := c.executeRequest(req, &res)
+	if err != nil {
+		return schema.OperationStatus{}, fmt.Errorf("failed to wake up Runtime: %w", err)
+	}
+	return res, nil
+}
+
 func (c *client) ReconnectRuntimeAgent(accountID, runtimeID string) (string, error) {
 	query := c.queryProvider.reconnectRuntimeAgent(runtimeID)
 	req := gcli.NewRequest(query)
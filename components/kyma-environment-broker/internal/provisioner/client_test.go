@@ -23,6 +23,8 @@ const (
 	provisionRuntimeOperationID   = "c89f7862-0ef9-4d4e-bc82-afbc5ac98b8d"
 	upgradeRuntimeOperationID     = "74f47e0a-9a76-4336-9974-70705500a981"
 	deprovisionRuntimeOperationID = "f9f7b734-7538-419c-8ac1-37060c60531a"
+	hibernateRuntimeOperationID   = "0b3b6a4e-6c84-4bd8-9fb3-0a1b2e06e7bc"
+	wakeUpRuntimeOperationID      = "5d6b3d0e-2f36-4f0c-a1a2-3b0f2b9f4f8e"
 )
 
 var (
@@ -229,6 +231,68 @@ func TestClient_UpgradeShoot(t *testing.T) {
 	})
 }
 
+func TestClient_HibernateRuntime(t *testing.T) {
+	t.Run("should trigger hibernation", func(t *testing.T) {
+		// given
+		tr := &testResolver{t: t, runtime: &testRuntime{}}
+		testServer := fixHTTPServer(tr)
+		defer testServer.Close()
+
+		client := NewProvisionerClient(testServer.URL, false)
+
+		// when
+		status, err := client.HibernateRuntime(testAccountID, provisionRuntimeID)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, ptr.String(hibernateRuntimeOperationID), status.ID)
+		assert.Equal(t, schema.OperationTypeHibernate, status.Operation)
+		assert.Equal(t, ptr.String(provisionRuntimeID), status.RuntimeID)
+	})
+
+	t.Run("provisioner should return error", func(t *testing.T) {
+		// given
+		tr := &testResolver{t: t, runtime: &testRuntime{}, failed: true}
+		testServer := fixHTTPServer(tr)
+		defer testServer.Close()
+
+		client := NewProvisionerClient(testServer.URL, false)
+
+		// when
+		status, err := client.HibernateRuntime(testAccountID, provisionRuntimeID)
+
+		// then
+		assert.Error(t, err)
+		assert.Empty(t, status)
+	})
+}
+
+func TestClient_WakeUpRuntime(t *testing.T) {
+	// given
+	server := fixHTTPMockServer(fmt.Sprintf(`{
+		"data": {
+			"result": {
+				"id": "%s",
+				"operation": "WakeUp",
+				"state": "InProgress",
+				"runtimeID": "%s"
+			}
+		}
+	}`, wakeUpRuntimeOperationID, provisionRuntimeID))
+	defer server.Close()
+
+	client := NewProvisionerClient(server.URL, false)
+
+	// when
+	status, err := client.WakeUpRuntime(testAccountID, provisionRuntimeID)
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, ptr.String(wakeUpRuntimeOperationID), status.ID)
+	assert.Equal(t, OperationTypeWakeUp, status.Operation)
+	assert.Equal(t, schema.OperationStateInProgress, status.State)
+}
+
 func TestClient_ReconnectRuntimeAgent(t *testing.T) {
 	t.Run("should reconnect runtime agent", func(t *testing.T) {
 		// Given
@@ -569,8 +633,19 @@ func (tmr testMutationResolver) DeprovisionRuntime(_ context.Context, id string)
 	return tmr.runtime.deprovisionOperationID, nil
 }
 
-func (tmr testMutationResolver) HibernateRuntime(ctx context.Context, id string) (*schema.OperationStatus, error) {
-	return nil, fmt.Errorf("not implemented")
+func (tmr testMutationResolver) HibernateRuntime(_ context.Context, id string) (*schema.OperationStatus, error) {
+	tmr.t.Log("HibernateRuntime testMutationResolver")
+
+	if tmr.failed {
+		return nil, fmt.Errorf("hibernation failed for %s", id)
+	}
+
+	return &schema.OperationStatus{
+		ID:        ptr.String(hibernateRuntimeOperationID),
+		State:     schema.OperationStateInProgress,
+		Operation: schema.OperationTypeHibernate,
+		RuntimeID: ptr.String(id),
+	}, nil
 }
 
 func (tmr testMutationResolver) RollBackUpgradeOperation(_ context.Context, id string) (*schema.RuntimeStatus, error) {
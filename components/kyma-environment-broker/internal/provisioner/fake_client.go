@@ -27,6 +27,7 @@ type FakeClient struct {
 	runtimes      []runtime
 	upgrades      map[string]schema.UpgradeRuntimeInput
 	shootUpgrades map[string]schema.UpgradeShootInput
+	hibernated    map[string]bool
 	operations    map[string]schema.OperationStatus
 	dumpRequest   bool
 
@@ -45,6 +46,7 @@ func NewFakeClientWithGardener(gc dynamic.Interface, ns string) *FakeClient {
 		operations:     make(map[string]schema.OperationStatus),
 		upgrades:       make(map[string]schema.UpgradeRuntimeInput),
 		shootUpgrades:  make(map[string]schema.UpgradeShootInput),
+		hibernated:     make(map[string]bool),
 		gardenerClient: gc,
 	}
 }
@@ -268,6 +270,40 @@ func (c *FakeClient) UpgradeShoot(accountID, runtimeID string, config schema.Upg
 	}, nil
 }
 
+func (c *FakeClient) HibernateRuntime(accountID, runtimeID string) (schema.OperationStatus, error) {
+	return c.changeHibernation(runtimeID, schema.OperationTypeHibernate, true)
+}
+
+func (c *FakeClient) WakeUpRuntime(accountID, runtimeID string) (schema.OperationStatus, error) {
+	return c.changeHibernation(runtimeID, OperationTypeWakeUp, false)
+}
+
+func (c *FakeClient) changeHibernation(runtimeID string, operationType schema.OperationType, hibernated bool) (schema.OperationStatus, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	opId := uuid.New().String()
+	c.operations[opId] = schema.OperationStatus{
+		ID:        &opId,
+		RuntimeID: &runtimeID,
+		Operation: operationType,
+		State:     schema.OperationStateInProgress,
+	}
+	c.hibernated[runtimeID] = hibernated
+	return schema.OperationStatus{
+		RuntimeID: &runtimeID,
+		ID:        &opId,
+	}, nil
+}
+
+// IsHibernated returns true if the last hibernation change of the Runtime was a hibernation
+func (c *FakeClient) IsHibernated(runtimeID string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.hibernated[runtimeID]
+}
+
 func (c *FakeClient) IsRuntimeUpgraded(runtimeID string, version string) bool {
 	input, found := c.upgrades[runtimeID]
 	if found && version != "" && input.KymaConfig != nil {
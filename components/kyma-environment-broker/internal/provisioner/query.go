@@ -28,6 +28,22 @@ func (qp queryProvider) upgradeShoot(runtimeID string, config string) string {
 }`, runtimeID, config, operationStatusData())
 }
 
+func (qp queryProvider) hibernateRuntime(runtimeID string) string {
+	return fmt.Sprintf(`mutation {
+	result: hibernateRuntime(id: "%s") {
+		%s
+}
+}`, runtimeID, operationStatusData())
+}
+
+func (qp queryProvider) wakeUpRuntime(runtimeID string) string {
+	return fmt.Sprintf(`mutation {
+	result: wakeUpRuntime(id: "%s") {
+		%s
+}
+}`, runtimeID, operationStatusData())
+}
+
 func (qp queryProvider) deprovisionRuntime(runtimeID string) string {
 	return fmt.Sprintf(`mutation {
 	result: deprovisionRuntime(id: "%s")
@@ -56,6 +72,14 @@ func (qp queryProvider) runtimeOperationStatus(operationID string) string {
 }`, operationID, operationStatusData())
 }
 
+func (qp queryProvider) operationStatusChanged(operationID string) string {
+	return fmt.Sprintf(`subscription {
+	result: operationStatusChanged(id: "%s") {
+	%s
+	}
+}`, operationID, operationStatusData())
+}
+
 func runtimeStatusData() string {
 	return fmt.Sprintf(`lastOperationStatus {
 				operation
@@ -64,6 +88,7 @@ func runtimeStatusData() string {
 				lastError { errMessage reason component }
 			}
 			runtimeConnectionStatus { status }
+			hibernationStatus { hibernated hibernationPossible }
 			runtimeConfiguration {
 				kubeconfig
 				clusterConfig {
@@ -0,0 +1,226 @@
+package provisioner
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	schema "github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// graphqlWSProtocol is the websocket subprotocol used by the Provisioner for GraphQL subscriptions
+	graphqlWSProtocol = "graphql-ws"
+
+	// subscriptionIdleTimeout defines how long a subscription is kept open after the last status read
+	subscriptionIdleTimeout = 10 * time.Minute
+	// subscriptionRetryInterval defines how long statuses are queried after a subscription failed before subscribing again
+	subscriptionRetryInterval = time.Minute
+	// subscriptionReadTimeout defines how long the subscription waits for a message, the Provisioner sends keep-alive
+	// messages every 10s by default, so the connection is considered broken after three of them are missed
+	subscriptionReadTimeout = 30 * time.Second
+)
+
+type subscriptionMessage struct {
+	ID      string          `json:"id,omitempty"`
+	Type    string          `json:"type"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+type subscriptionData struct {
+	Data struct {
+		Result *schema.OperationStatus `json:"result"`
+	} `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// SubscribingClient serves Runtime operation statuses from GraphQL subscriptions to the Provisioner.
+// The first call for an operation is forwarded to the wrapped client and opens a subscription,
+// following calls return the last status pushed by the Provisioner as long as the subscription is alive.
+// All other calls are forwarded to the wrapped client.
+type SubscribingClient struct {
+	Client
+
+	endpoint      string
+	dialer        *websocket.Dialer
+	queryProvider queryProvider
+	idleTimeout   time.Duration
+	readTimeout   time.Duration
+	log           logrus.FieldLogger
+
+	mu         sync.Mutex
+	operations map[string]*operationSubscription
+}
+
+type operationSubscription struct {
+	status   *schema.OperationStatus
+	lastRead time.Time
+	active   bool
+	retryAt  time.Time
+}
+
+func NewSubscribingClient(client Client, endpoint string, log logrus.FieldLogger) *SubscribingClient {
+	endpoint = strings.Replace(endpoint, "http://", "ws://", 1)
+	endpoint = strings.Replace(endpoint, "https://", "wss://", 1)
+
+	return &SubscribingClient{
+		Client:        client,
+		endpoint:      endpoint,
+		dialer:        &websocket.Dialer{HandshakeTimeout: 30 * time.Second, Subprotocols: []string{graphqlWSProtocol}},
+		queryProvider: queryProvider{},
+		idleTimeout:   subscriptionIdleTimeout,
+		readTimeout:   subscriptionReadTimeout,
+		log:           log,
+		operations:    map[string]*operationSubscription{},
+	}
+}
+
+func (c *SubscribingClient) RuntimeOperationStatus(accountID, operationID string) (schema.OperationStatus, error) {
+	c.mu.Lock()
+	c.sweep()
+	subscription, found := c.operations[operationID]
+	if !found {
+		subscription = &operationSubscription{}
+		c.operations[operationID] = subscription
+	}
+	subscription.lastRead = time.Now()
+	switch {
+	case subscription.status != nil:
+		status := *subscription.status
+		c.mu.Unlock()
+		return status, nil
+	case !subscription.active && time.Now().After(subscription.retryAt):
+		subscription.active = true
+		go c.subscribe(accountID, operationID)
+	}
+	c.mu.Unlock()
+
+	return c.Client.RuntimeOperationStatus(accountID, operationID)
+}
+
+// subscribe keeps the latest status of the operation up to date until the operation finishes,
+// the subscription fails or the status is not read for the idle timeout
+func (c *SubscribingClient) subscribe(accountID, operationID string) {
+	log := c.log.WithField("operationID", operationID)
+
+	finished, err := c.receive(accountID, operationID)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	subscription, found := c.operations[operationID]
+	if !found {
+		return
+	}
+	subscription.active = false
+
+	switch {
+	case finished:
+		// the final status does not change, it is served until the operation is no longer read
+	case err != nil:
+		log.Warnf("subscription to operation status closed, querying statuses for %s: %s", subscriptionRetryInterval, err)
+		subscription.status = nil
+		subscription.retryAt = time.Now().Add(subscriptionRetryInterval)
+	default:
+		delete(c.operations, operationID)
+	}
+}
+
+// sweep removes operations without an open subscription which were not read for the idle timeout
+func (c *SubscribingClient) sweep() {
+	for id, subscription := range c.operations {
+		if !subscription.active && time.Since(subscription.lastRead) > c.idleTimeout {
+			delete(c.operations, id)
+		}
+	}
+}
+
+func (c *SubscribingClient) receive(accountID, operationID string) (bool, error) {
+	header := http.Header{}
+	header.Add(accountIDKey, accountID)
+
+	conn, _, err := c.dialer.Dial(c.endpoint, header)
+	if err != nil {
+		return false, fmt.Errorf("while connecting to %s: %w", c.endpoint, err)
+	}
+	defer conn.Close()
+
+	payload, err := json.Marshal(map[string]string{"query": c.queryProvider.operationStatusChanged(operationID)})
+	if err != nil {
+		return false, fmt.Errorf("while marshalling subscription query: %w", err)
+	}
+	for _, msg := range []subscriptionMessage{
+		{Type: "connection_init"},
+		{ID: operationID, Type: "start", Payload: payload},
+	} {
+		if err := conn.WriteJSON(msg); err != nil {
+			return false, fmt.Errorf("while sending %s message: %w", msg.Type, err)
+		}
+	}
+
+	for {
+		var msg subscriptionMessage
+		// every message, including keep-alives, extends the deadline, so a half-open connection is not read forever
+		if err := conn.SetReadDeadline(time.Now().Add(c.readTimeout)); err != nil {
+			return false, fmt.Errorf("while setting read deadline: %w", err)
+		}
+		if err := conn.ReadJSON(&msg); err != nil {
+			return false, fmt.Errorf("while reading message: %w", err)
+		}
+
+		switch msg.Type {
+		case "data":
+			var data subscriptionData
+			if err := json.Unmarshal(msg.Payload, &data); err != nil {
+				return false, fmt.Errorf("while unmarshalling operation status: %w", err)
+			}
+			if len(data.Errors) > 0 {
+				return false, fmt.Errorf("subscription error: %s", data.Errors[0].Message)
+			}
+			if data.Data.Result == nil {
+				continue
+			}
+			if c.update(operationID, data.Data.Result) {
+				return true, nil
+			}
+		case "complete":
+			return false, fmt.Errorf("subscription completed before operation finished")
+		case "error", "connection_error":
+			return false, fmt.Errorf("subscription error: %s", string(msg.Payload))
+		}
+
+		if c.idle(operationID) {
+			_ = conn.WriteJSON(subscriptionMessage{ID: operationID, Type: "stop"})
+			return false, nil
+		}
+	}
+}
+
+// update stores the status and returns true if the operation finished
+func (c *SubscribingClient) update(operationID string, status *schema.OperationStatus) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	subscription, found := c.operations[operationID]
+	if !found {
+		return false
+	}
+	subscription.status = status
+
+	return status.State == schema.OperationStateSucceeded || status.State == schema.OperationStateFailed
+}
+
+func (c *SubscribingClient) idle(operationID string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	subscription, found := c.operations[operationID]
+	return !found || time.Since(subscription.lastRead) > c.idleTimeout
+}
@@ -0,0 +1,157 @@
+package provisioner
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/ptr"
+	schema "github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubscribingClient_RuntimeOperationStatus(t *testing.T) {
+	t.Run("should serve statuses pushed by the Provisioner", func(t *testing.T) {
+		// Given
+		statuses := make(chan schema.OperationStatus)
+		testServer := fixSubscriptionServer(t, statuses)
+		defer testServer.Close()
+
+		fakeClient := NewFakeClient()
+		fakeClient.SetOperation(provisionRuntimeOperationID, fixOperationStatus(schema.OperationStateInProgress, "queried"))
+
+		client := NewSubscribingClient(fakeClient, testServer.URL, logrus.New())
+
+		// When
+		status, err := client.RuntimeOperationStatus(testAccountID, provisionRuntimeOperationID)
+
+		// Then
+		require.NoError(t, err)
+		assert.Equal(t, "queried", *status.Message)
+
+		// When
+		statuses <- fixOperationStatus(schema.OperationStateInProgress, "pushed")
+
+		// Then
+		assert.Eventually(t, func() bool {
+			status, err := client.RuntimeOperationStatus(testAccountID, provisionRuntimeOperationID)
+			return err == nil && *status.Message == "pushed"
+		}, time.Second, 10*time.Millisecond)
+
+		// When
+		statuses <- fixOperationStatus(schema.OperationStateSucceeded, "succeeded")
+
+		// Then
+		assert.Eventually(t, func() bool {
+			status, err := client.RuntimeOperationStatus(testAccountID, provisionRuntimeOperationID)
+			return err == nil && status.State == schema.OperationStateSucceeded
+		}, time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("should query statuses when subscription stops receiving messages", func(t *testing.T) {
+		// Given
+		statuses := make(chan schema.OperationStatus)
+		testServer := fixSubscriptionServer(t, statuses)
+		defer testServer.Close()
+		defer close(statuses)
+
+		fakeClient := NewFakeClient()
+		fakeClient.SetOperation(provisionRuntimeOperationID, fixOperationStatus(schema.OperationStateInProgress, "queried"))
+
+		client := NewSubscribingClient(fakeClient, testServer.URL, logrus.New())
+		client.readTimeout = 50 * time.Millisecond
+
+		// When
+		_, err := client.RuntimeOperationStatus(testAccountID, provisionRuntimeOperationID)
+		require.NoError(t, err)
+
+		// Then
+		assert.Eventually(t, func() bool {
+			client.mu.Lock()
+			defer client.mu.Unlock()
+			subscription := client.operations[provisionRuntimeOperationID]
+			return !subscription.active && !subscription.retryAt.IsZero()
+		}, time.Second, 10*time.Millisecond)
+
+		fakeClient.SetOperation(provisionRuntimeOperationID, fixOperationStatus(schema.OperationStateSucceeded, "succeeded"))
+		status, err := client.RuntimeOperationStatus(testAccountID, provisionRuntimeOperationID)
+		require.NoError(t, err)
+		assert.Equal(t, schema.OperationStateSucceeded, status.State)
+	})
+
+	t.Run("should query statuses when subscription is not available", func(t *testing.T) {
+		// Given
+		testServer := httptest.NewServer(http.NotFoundHandler())
+		defer testServer.Close()
+
+		fakeClient := NewFakeClient()
+		fakeClient.SetOperation(provisionRuntimeOperationID, fixOperationStatus(schema.OperationStateInProgress, "queried"))
+
+		client := NewSubscribingClient(fakeClient, testServer.URL, logrus.New())
+
+		// When
+		_, err := client.RuntimeOperationStatus(testAccountID, provisionRuntimeOperationID)
+		require.NoError(t, err)
+
+		// Then
+		assert.Eventually(t, func() bool {
+			client.mu.Lock()
+			defer client.mu.Unlock()
+			return !client.operations[provisionRuntimeOperationID].retryAt.IsZero()
+		}, time.Second, 10*time.Millisecond)
+
+		fakeClient.SetOperation(provisionRuntimeOperationID, fixOperationStatus(schema.OperationStateSucceeded, "succeeded"))
+		status, err := client.RuntimeOperationStatus(testAccountID, provisionRuntimeOperationID)
+		require.NoError(t, err)
+		assert.Equal(t, schema.OperationStateSucceeded, status.State)
+	})
+}
+
+func fixOperationStatus(state schema.OperationState, message string) schema.OperationStatus {
+	return schema.OperationStatus{
+		ID:        ptr.String(provisionRuntimeOperationID),
+		Operation: schema.OperationTypeProvision,
+		State:     state,
+		Message:   ptr.String(message),
+		RuntimeID: ptr.String(provisionRuntimeID),
+	}
+}
+
+// fixSubscriptionServer serves the graphql-ws protocol sending every status from the channel as subscription data
+func fixSubscriptionServer(t *testing.T, statuses <-chan schema.OperationStatus) *httptest.Server {
+	upgrader := websocket.Upgrader{Subprotocols: []string{graphqlWSProtocol}}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(accountIDKey) != testAccountID {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		require.NoError(t, err)
+		defer conn.Close()
+
+		var msg subscriptionMessage
+		require.NoError(t, conn.ReadJSON(&msg))
+		assert.Equal(t, "connection_init", msg.Type)
+		require.NoError(t, conn.WriteJSON(subscriptionMessage{Type: "connection_ack"}))
+
+		require.NoError(t, conn.ReadJSON(&msg))
+		assert.Equal(t, "start", msg.Type)
+		assert.Contains(t, string(msg.Payload), "operationStatusChanged")
+
+		for status := range statuses {
+			data := subscriptionData{}
+			data.Data.Result = &status
+			payload, err := json.Marshal(data)
+			require.NoError(t, err)
+			if err := conn.WriteJSON(subscriptionMessage{ID: msg.ID, Type: "data", Payload: payload}); err != nil {
+				return
+			}
+		}
+	}))
+}
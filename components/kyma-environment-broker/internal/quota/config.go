@@ -0,0 +1,91 @@
+package quota
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Rule limits the number of instances of a plan a global account can have in the given platform region,
+// or in all platform regions if the region is empty
+type Rule struct {
+	Plan   string `yaml:"plan" json:"plan"`
+	Region string `yaml:"region,omitempty" json:"region,omitempty"`
+	Limit  int    `yaml:"limit" json:"limit"`
+}
+
+// Config holds default rules for all global accounts and overrides for single global accounts.
+// An override replaces the default rule with the same plan and region.
+type Config struct {
+	Defaults       []Rule            `yaml:"defaults"`
+	GlobalAccounts map[string][]Rule `yaml:"globalAccounts"`
+}
+
+func ReadConfigFromFile(filename string) (Config, error) {
+	var cfg Config
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		return cfg, fmt.Errorf("while reading %s file with quotas config: %w", filename, err)
+	}
+	err = yaml.Unmarshal(content, &cfg)
+	if err != nil {
+		return cfg, fmt.Errorf("while unmarshalling a file with quotas config: %w", err)
+	}
+
+	return cfg, cfg.Validate()
+}
+
+func (c Config) Validate() error {
+	if err := validateRules(c.Defaults); err != nil {
+		return fmt.Errorf("invalid default quotas: %w", err)
+	}
+	for globalAccountID, rules := range c.GlobalAccounts {
+		if err := validateRules(rules); err != nil {
+			return fmt.Errorf("invalid quotas of global account %s: %w", globalAccountID, err)
+		}
+	}
+	return nil
+}
+
+// RulesFor returns rules which apply to the global account
+func (c Config) RulesFor(globalAccountID string) []Rule {
+	overrides := c.GlobalAccounts[globalAccountID]
+	rules := make([]Rule, 0, len(c.Defaults)+len(overrides))
+	for _, rule := range c.Defaults {
+		if !containsRuleFor(overrides, rule.Plan, rule.Region) {
+			rules = append(rules, rule)
+		}
+	}
+
+	return append(rules, overrides...)
+}
+
+// appliesTo returns true if an instance of the plan in the platform region counts against the rule
+func (r Rule) appliesTo(planName, platformRegion string) bool {
+	return r.Plan == planName && (r.Region == "" || r.Region == platformRegion)
+}
+
+func validateRules(rules []Rule) error {
+	for i, rule := range rules {
+		if rule.Plan == "" {
+			return fmt.Errorf("rule %d has no plan", i)
+		}
+		if rule.Limit < 0 {
+			return fmt.Errorf("rule for plan %s has a negative limit", rule.Plan)
+		}
+		if containsRuleFor(rules[:i], rule.Plan, rule.Region) {
+			return fmt.Errorf("duplicated rule for plan %s and region %q", rule.Plan, rule.Region)
+		}
+	}
+	return nil
+}
+
+func containsRuleFor(rules []Rule, plan, region string) bool {
+	for _, rule := range rules {
+		if rule.Plan == plan && rule.Region == region {
+			return true
+		}
+	}
+	return false
+}
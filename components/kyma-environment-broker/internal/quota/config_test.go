@@ -0,0 +1,66 @@
+package quota
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadConfigFromFile(t *testing.T) {
+	// when
+	cfg, err := ReadConfigFromFile("testdata/quotas.yaml")
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []Rule{{Plan: "aws", Limit: 2}, {Plan: "azure", Region: "cf-eu20", Limit: 1}}, cfg.Defaults)
+	assert.Equal(t, []Rule{{Plan: "aws", Limit: 10}}, cfg.GlobalAccounts["big-customer"])
+}
+
+func TestConfig_RulesFor(t *testing.T) {
+	// given
+	cfg := Config{
+		Defaults: []Rule{{Plan: "aws", Limit: 2}, {Plan: "azure", Region: "cf-eu20", Limit: 1}},
+		GlobalAccounts: map[string][]Rule{
+			"big-customer": {{Plan: "aws", Limit: 10}, {Plan: "azure", Limit: 5}},
+		},
+	}
+
+	// then
+	assert.Equal(t, cfg.Defaults, cfg.RulesFor("other"))
+	assert.Equal(t, []Rule{
+		{Plan: "azure", Region: "cf-eu20", Limit: 1},
+		{Plan: "aws", Limit: 10},
+		{Plan: "azure", Limit: 5},
+	}, cfg.RulesFor("big-customer"))
+}
+
+func TestConfig_Validate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		config Config
+		valid  bool
+	}{
+		"valid": {
+			config: Config{Defaults: []Rule{{Plan: "aws", Limit: 0}, {Plan: "aws", Region: "cf-eu10", Limit: 1}}},
+			valid:  true,
+		},
+		"missing plan": {
+			config: Config{Defaults: []Rule{{Limit: 1}}},
+		},
+		"negative limit": {
+			config: Config{GlobalAccounts: map[string][]Rule{"ga": {{Plan: "aws", Limit: -1}}}},
+		},
+		"duplicated rule": {
+			config: Config{Defaults: []Rule{{Plan: "aws", Limit: 1}, {Plan: "aws", Limit: 2}}},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			err := tc.config.Validate()
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
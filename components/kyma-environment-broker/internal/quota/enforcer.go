@@ -0,0 +1,122 @@
+package quota
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+)
+
+var ErrQuotaExceeded = errors.New("quota exceeded")
+
+type InstanceCounter interface {
+	GetInstanceCountsForGlobalAccountID(globalAccountID string) ([]internal.InstanceCount, error)
+}
+
+// UsageDTO compares the number of instances counted against a rule with its limit
+type UsageDTO struct {
+	Rule
+	Used int `json:"used"`
+}
+
+// InstanceCountDTO is the number of existing instances of a plan in a platform region
+type InstanceCountDTO struct {
+	Plan   string `json:"plan"`
+	Region string `json:"region"`
+	Count  int    `json:"count"`
+}
+
+type GlobalAccountUsageDTO struct {
+	GlobalAccountID string             `json:"globalAccountID"`
+	Quotas          []UsageDTO         `json:"quotas"`
+	Instances       []InstanceCountDTO `json:"instances"`
+}
+
+// Enforcer checks if global accounts can create more instances
+type Enforcer struct {
+	config    Config
+	instances InstanceCounter
+}
+
+func NewEnforcer(config Config, instances InstanceCounter) *Enforcer {
+	return &Enforcer{
+		config:    config,
+		instances: instances,
+	}
+}
+
+// Check returns an error wrapping ErrQuotaExceeded if the global account reached any limit
+// for instances of the plan in the platform region
+func (e *Enforcer) Check(globalAccountID, planName, platformRegion string) error {
+	var rules []Rule
+	for _, rule := range e.config.RulesFor(globalAccountID) {
+		if rule.appliesTo(planName, platformRegion) {
+			rules = append(rules, rule)
+		}
+	}
+	if len(rules) == 0 {
+		return nil
+	}
+
+	counts, err := e.instances.GetInstanceCountsForGlobalAccountID(globalAccountID)
+	if err != nil {
+		return fmt.Errorf("while counting instances of global account %s: %w", globalAccountID, err)
+	}
+	for _, rule := range rules {
+		if used := countFor(rule, counts); used >= rule.Limit {
+			return fmt.Errorf("%w: the global account %s has %d of %d allowed %s instances%s",
+				ErrQuotaExceeded, globalAccountID, used, rule.Limit, rule.Plan, inRegion(rule.Region))
+		}
+	}
+
+	return nil
+}
+
+// Usage returns the number of instances counted against every rule which applies to the global account,
+// together with all instances of the global account
+func (e *Enforcer) Usage(globalAccountID string) (GlobalAccountUsageDTO, error) {
+	counts, err := e.instances.GetInstanceCountsForGlobalAccountID(globalAccountID)
+	if err != nil {
+		return GlobalAccountUsageDTO{}, fmt.Errorf("while counting instances of global account %s: %w", globalAccountID, err)
+	}
+
+	usage := GlobalAccountUsageDTO{
+		GlobalAccountID: globalAccountID,
+		Quotas:          make([]UsageDTO, 0),
+		Instances:       make([]InstanceCountDTO, 0, len(counts)),
+	}
+	for _, rule := range e.config.RulesFor(globalAccountID) {
+		usage.Quotas = append(usage.Quotas, UsageDTO{Rule: rule, Used: countFor(rule, counts)})
+	}
+	for _, c := range counts {
+		usage.Instances = append(usage.Instances, InstanceCountDTO{Plan: c.PlanName, Region: c.PlatformRegion, Count: c.Count})
+	}
+	sort.Slice(usage.Quotas, func(i, j int) bool {
+		a, b := usage.Quotas[i], usage.Quotas[j]
+		return a.Plan < b.Plan || (a.Plan == b.Plan && a.Region < b.Region)
+	})
+	sort.Slice(usage.Instances, func(i, j int) bool {
+		a, b := usage.Instances[i], usage.Instances[j]
+		return a.Plan < b.Plan || (a.Plan == b.Plan && a.Region < b.Region)
+	})
+
+	return usage, nil
+}
+
+func countFor(rule Rule, counts []internal.InstanceCount) int {
+	used := 0
+	for _, c := range counts {
+		if rule.appliesTo(c.PlanName, c.PlatformRegion) {
+			used += c.Count
+		}
+	}
+	return used
+}
+
+func inRegion(region string) string {
+	if region == "" {
+		return ""
+	}
+	return fmt.Sprintf(" in the region %s", region)
+}
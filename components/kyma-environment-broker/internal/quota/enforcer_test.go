@@ -0,0 +1,64 @@
+package quota
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeCounter map[string][]internal.InstanceCount
+
+func (f fakeCounter) GetInstanceCountsForGlobalAccountID(globalAccountID string) ([]internal.InstanceCount, error) {
+	return f[globalAccountID], nil
+}
+
+func fixEnforcer() *Enforcer {
+	return NewEnforcer(Config{
+		Defaults: []Rule{{Plan: "aws", Limit: 2}, {Plan: "azure", Region: "cf-eu20", Limit: 1}},
+		GlobalAccounts: map[string][]Rule{
+			"big-customer": {{Plan: "aws", Limit: 10}},
+		},
+	}, fakeCounter{
+		"ga": {
+			{PlanName: "aws", PlatformRegion: "cf-eu10", Count: 1},
+			{PlanName: "aws", PlatformRegion: "cf-us10", Count: 1},
+			{PlanName: "azure", PlatformRegion: "cf-eu20", Count: 1},
+			{PlanName: "gcp", PlatformRegion: "cf-eu10", Count: 7},
+		},
+		"big-customer": {
+			{PlanName: "aws", PlatformRegion: "cf-eu10", Count: 9},
+		},
+	})
+}
+
+func TestEnforcer_Check(t *testing.T) {
+	enforcer := fixEnforcer()
+
+	for name, tc := range map[string]struct {
+		globalAccountID string
+		plan            string
+		region          string
+		exceeded        bool
+	}{
+		"limit in all regions reached":   {globalAccountID: "ga", plan: "aws", region: "cf-jp10", exceeded: true},
+		"limit in the region reached":    {globalAccountID: "ga", plan: "azure", region: "cf-eu20", exceeded: true},
+		"limit in other region":          {globalAccountID: "ga", plan: "azure", region: "cf-us20"},
+		"plan without quota":             {globalAccountID: "ga", plan: "gcp", region: "cf-eu10"},
+		"override of the default limit":  {globalAccountID: "big-customer", plan: "aws", region: "cf-eu10"},
+		"global account without any use": {globalAccountID: "new", plan: "aws", region: "cf-eu10"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// when
+			err := enforcer.Check(tc.globalAccountID, tc.plan, tc.region)
+
+			// then
+			if tc.exceeded {
+				assert.True(t, errors.Is(err, ErrQuotaExceeded), "expected quota exceeded, got: %v", err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
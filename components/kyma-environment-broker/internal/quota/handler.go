@@ -0,0 +1,33 @@
+package quota
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/httputil"
+)
+
+type Handler struct {
+	enforcer *Enforcer
+}
+
+func NewHandler(enforcer *Enforcer) *Handler {
+	return &Handler{
+		enforcer: enforcer,
+	}
+}
+
+func (h *Handler) AttachRoutes(router *mux.Router) {
+	router.HandleFunc("/quotas/{global_account_id}", h.getUsage).Methods(http.MethodGet)
+}
+
+func (h *Handler) getUsage(w http.ResponseWriter, r *http.Request) {
+	globalAccountID := mux.Vars(r)["global_account_id"]
+	usage, err := h.enforcer.Usage(globalAccountID)
+	if err != nil {
+		httputil.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Errorf("while getting quota usage: %w", err))
+		return
+	}
+	httputil.WriteResponse(w, http.StatusOK, usage)
+}
@@ -0,0 +1,34 @@
+package quota
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_GetUsage(t *testing.T) {
+	// given
+	router := mux.NewRouter()
+	NewHandler(fixEnforcer()).AttachRoutes(router)
+
+	// when
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/quotas/ga", nil))
+
+	// then
+	require.Equal(t, http.StatusOK, rec.Code)
+	var usage GlobalAccountUsageDTO
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &usage))
+	assert.Equal(t, "ga", usage.GlobalAccountID)
+	assert.Equal(t, []UsageDTO{
+		{Rule: Rule{Plan: "aws", Limit: 2}, Used: 2},
+		{Rule: Rule{Plan: "azure", Region: "cf-eu20", Limit: 1}, Used: 1},
+	}, usage.Quotas)
+	assert.Len(t, usage.Instances, 4)
+	assert.Equal(t, InstanceCountDTO{Plan: "aws", Region: "cf-eu10", Count: 1}, usage.Instances[0])
+}
@@ -0,0 +1,82 @@
+package reencryption
+
+import (
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
+	"github.com/sirupsen/logrus"
+)
+
+type Config struct {
+	Enabled   bool `envconfig:"default=false"`
+	BatchSize int  `envconfig:"default=100"`
+	// BatchInterval is the pause between batches, which limits the load of the database
+	BatchInterval time.Duration `envconfig:"default=1s"`
+}
+
+// Job walks all encrypted data in batches and encrypts the data stored with legacy keys with the active key.
+// It lets the encryption key be rotated without downtime: KEB is started with the new active key and the old one
+// configured as a legacy key, which can be removed when the job reports that all data was re-encrypted.
+type Job struct {
+	reencryption storage.Reencryption
+	cfg          Config
+	log          logrus.FieldLogger
+}
+
+func NewJob(reencryption storage.Reencryption, cfg Config, log logrus.FieldLogger) *Job {
+	return &Job{
+		reencryption: reencryption,
+		cfg:          cfg,
+		log:          log,
+	}
+}
+
+func (j *Job) Run(stop <-chan struct{}) {
+	go func() {
+		j.log.Info("starting re-encryption of the stored data")
+		failed := 0
+		for _, kind := range dbmodel.EncryptedDataKinds {
+			summary, ok := j.reencrypt(kind, stop)
+			if !ok {
+				j.log.Info("re-encryption stopped")
+				return
+			}
+			failed += len(summary.Failed)
+			j.log.Infof("%s: %d processed, %d re-encrypted, %d failed", kind, summary.Processed, summary.Reencrypted, len(summary.Failed))
+			if len(summary.Failed) > 0 {
+				j.log.Warnf("%s which could not be decrypted: %v", kind, summary.Failed)
+			}
+		}
+		if failed > 0 {
+			j.log.Warnf("re-encryption finished, %d records could not be re-encrypted, the legacy keys are still needed", failed)
+			return
+		}
+		j.log.Info("re-encryption finished, all data is encrypted with the active key")
+	}()
+}
+
+// reencrypt processes all batches of the given kind, it returns false if the job was stopped
+func (j *Job) reencrypt(kind dbmodel.EncryptedDataKind, stop <-chan struct{}) (dbmodel.ReencryptionBatch, bool) {
+	summary := dbmodel.ReencryptionBatch{}
+	for {
+		batch, err := j.reencryption.ReencryptBatch(kind, summary.LastID, j.cfg.BatchSize)
+		if err != nil {
+			j.log.Errorf("while re-encrypting %s after ID %q, retrying: %s", kind, summary.LastID, err)
+		} else {
+			if batch.LastID == "" {
+				return summary, true
+			}
+			summary.LastID = batch.LastID
+			summary.Processed += batch.Processed
+			summary.Reencrypted += batch.Reencrypted
+			summary.Failed = append(summary.Failed, batch.Failed...)
+		}
+
+		select {
+		case <-stop:
+			return summary, false
+		case <-time.After(j.cfg.BatchInterval):
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package reencryption
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+func TestJob_Run(t *testing.T) {
+	// given
+	store := &fakeReencryption{
+		records: map[dbmodel.EncryptedDataKind][]string{
+			dbmodel.InstanceParametersData:  {"i1", "i2", "i3"},
+			dbmodel.OperationParametersData: {"o1"},
+		},
+		failOnce: true,
+	}
+	job := NewJob(store, Config{Enabled: true, BatchSize: 2, BatchInterval: time.Millisecond}, logrus.New())
+	stop := make(chan struct{})
+	defer close(stop)
+
+	// when
+	job.Run(stop)
+
+	// then
+	err := wait.PollImmediate(5*time.Millisecond, time.Second, func() (bool, error) {
+		return len(store.Reencrypted()) == 4, nil
+	})
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"i1", "i2", "i3", "o1"}, store.Reencrypted())
+}
+
+type fakeReencryption struct {
+	mu          sync.Mutex
+	records     map[dbmodel.EncryptedDataKind][]string
+	reencrypted []string
+	failOnce    bool
+}
+
+func (f *fakeReencryption) ReencryptBatch(kind dbmodel.EncryptedDataKind, afterID string, batchSize int) (dbmodel.ReencryptionBatch, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.failOnce {
+		f.failOnce = false
+		return dbmodel.ReencryptionBatch{}, fmt.Errorf("connection lost")
+	}
+
+	batch := dbmodel.ReencryptionBatch{}
+	for _, id := range f.records[kind] {
+		if id <= afterID || batch.Processed == batchSize {
+			continue
+		}
+		batch.LastID = id
+		batch.Processed++
+		batch.Reencrypted++
+		f.reencrypted = append(f.reencrypted, id)
+	}
+	return batch, nil
+}
+
+func (f *fakeReencryption) Reencrypted() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string{}, f.reencrypted...)
+}
@@ -66,6 +66,7 @@ func (c *converter) applyOperation(source *internal.Operation, target *pkg.Opera
 		target.RuntimeVersion = source.RuntimeVersion.Version
 		target.FinishedStages = source.FinishedStages
 		target.ExecutedButNotCompletedSteps = source.ExcutedButNotCompleted
+		target.NextRetryAt = source.NextRetryAt
 	}
 }
 
@@ -19,6 +19,11 @@ type Config struct {
 	SSLRootCert string `envconfig:"optional"`
 
 	SecretKey string `envconfig:"optional"`
+	// SecretKeyID is stored with values encrypted with the SecretKey, values are encrypted without a key ID when it is empty
+	SecretKeyID string `envconfig:"optional"`
+	// LegacySecretKeys is a JSON object which maps key IDs to keys used before a key rotation,
+	// the key with an empty ID decrypts values stored without a key ID
+	LegacySecretKeys string `envconfig:"optional"`
 
 	MaxOpenConns    int           `envconfig:"default=8"`
 	MaxIdleConns    int           `envconfig:"default=2"`
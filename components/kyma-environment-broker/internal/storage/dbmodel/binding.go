@@ -0,0 +1,19 @@
+package dbmodel
+
+import (
+	"database/sql"
+	"time"
+)
+
+type BindingDTO struct {
+	ID                string
+	InstanceID        string
+	ExpirationSeconds int64
+	Kubeconfig        sql.NullString
+	ExpiresAt         sql.NullTime
+	LastOperation     string
+	State             string
+	Description       string
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
@@ -0,0 +1,36 @@
+package dbmodel
+
+// EncryptedDataKind identifies a table column which stores encrypted data
+type EncryptedDataKind string
+
+const (
+	InstanceParametersData       EncryptedDataKind = "instance parameters"
+	OperationParametersData      EncryptedDataKind = "operation parameters"
+	RuntimeStateKymaConfigData   EncryptedDataKind = "runtime state kyma config"
+	RuntimeStateClusterSetupData EncryptedDataKind = "runtime state cluster setup"
+	BindingKubeconfigData        EncryptedDataKind = "binding kubeconfig"
+)
+
+// EncryptedDataKinds lists all kinds of encrypted data stored by KEB
+var EncryptedDataKinds = []EncryptedDataKind{
+	InstanceParametersData,
+	OperationParametersData,
+	RuntimeStateKymaConfigData,
+	RuntimeStateClusterSetupData,
+	BindingKubeconfigData,
+}
+
+type EncryptedDataDTO struct {
+	ID   string
+	Data string
+}
+
+// ReencryptionBatch is the result of re-encrypting a batch of records
+type ReencryptionBatch struct {
+	// LastID is the ID of the last processed record, it is empty when there were no more records to process
+	LastID      string
+	Processed   int
+	Reencrypted int
+	// Failed contains IDs of records whose data could not be decrypted
+	Failed []string
+}
@@ -0,0 +1,14 @@
+package dbmodel
+
+import (
+	"time"
+)
+
+type FreezeDTO struct {
+	ID              string
+	GlobalAccountID string
+	StartTime       time.Time
+	EndTime         time.Time
+	Reason          string
+	CreatedAt       time.Time
+}
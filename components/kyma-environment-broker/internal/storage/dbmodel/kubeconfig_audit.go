@@ -0,0 +1,35 @@
+package dbmodel
+
+import (
+	"database/sql"
+	"time"
+)
+
+type KubeconfigAuditFilter struct {
+	Page        int
+	PageSize    int
+	InstanceIDs []string
+	RuntimeIDs  []string
+	UserIDs     []string
+	Sources     []string
+	// From and To limit the creation time of records, zero value does not limit it
+	From time.Time
+	To   time.Time
+}
+
+type KubeconfigAuditDTO struct {
+	ID              string
+	Source          string
+	UserID          string
+	Issuer          string
+	Groups          string
+	InstanceID      string
+	RuntimeID       string
+	GlobalAccountID string
+	SubAccountID    string
+	Role            string
+	SourceIP        string
+	UserAgent       string
+	ExpiresAt       sql.NullTime
+	CreatedAt       time.Time
+}
@@ -30,6 +30,7 @@ type OperationDTO struct {
 	Description            string
 	FinishedStages         sql.NullString
 	ProvisioningParameters sql.NullString
+	NextRetryAt            *time.Time
 
 	Type internal.OperationType
 }
@@ -46,6 +47,12 @@ type InstanceByGlobalAccountIDStatEntry struct {
 	Total           int
 }
 
+type InstanceByPlanAndRegionStatEntry struct {
+	ServicePlanName string
+	PlatformRegion  string
+	Total           int
+}
+
 type InstanceERSContextStatsEntry struct {
 	LicenseType sql.NullString
 	Total       int
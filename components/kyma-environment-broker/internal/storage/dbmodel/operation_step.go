@@ -0,0 +1,19 @@
+package dbmodel
+
+import (
+	"database/sql"
+	"time"
+)
+
+type OperationStepDTO struct {
+	OperationID string
+	StepName    string
+	State       string
+	Attempts    int
+	LastError   sql.NullString
+	// NextDelay and ProcessingTime are stored in milliseconds
+	NextDelay      int64
+	ProcessingTime int64
+	StartedAt      time.Time
+	FinishedAt     time.Time
+}
@@ -0,0 +1,26 @@
+package dbmodel
+
+import (
+	"database/sql"
+	"time"
+)
+
+type WebhookDeliveryFilter struct {
+	Page          int
+	PageSize      int
+	States        []string
+	Subscriptions []string
+	EventTypes    []string
+}
+
+type WebhookDeliveryDTO struct {
+	ID           string
+	Subscription string
+	EventType    string
+	Payload      string
+	State        string
+	Attempts     int
+	LastError    sql.NullString
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
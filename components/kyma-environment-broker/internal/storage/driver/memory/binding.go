@@ -0,0 +1,92 @@
+package memory
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+)
+
+type bindings struct {
+	mu sync.Mutex
+
+	bindings map[string]internal.Binding
+}
+
+func NewBindings() *bindings {
+	return &bindings{
+		bindings: make(map[string]internal.Binding),
+	}
+}
+
+func (s *bindings) Insert(binding internal.Binding) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.bindings[binding.ID]; exists {
+		return dberr.AlreadyExists("binding with id %s already exist", binding.ID)
+	}
+	s.bindings[binding.ID] = binding
+
+	return nil
+}
+
+func (s *bindings) Update(binding internal.Binding) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.bindings[binding.ID]; !exists {
+		return dberr.NotFound("binding with id %s does not exist", binding.ID)
+	}
+	s.bindings[binding.ID] = binding
+
+	return nil
+}
+
+func (s *bindings) GetByID(bindingID string) (*internal.Binding, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	binding, exists := s.bindings[bindingID]
+	if !exists {
+		return nil, dberr.NotFound("binding with id %s does not exist", bindingID)
+	}
+
+	return &binding, nil
+}
+
+func (s *bindings) ListByInstanceID(instanceID string) ([]internal.Binding, error) {
+	return s.filter(func(binding internal.Binding) bool { return binding.InstanceID == instanceID }), nil
+}
+
+func (s *bindings) ListInProgress() ([]internal.Binding, error) {
+	return s.filter(func(binding internal.Binding) bool { return binding.State == domain.InProgress }), nil
+}
+
+func (s *bindings) Delete(bindingID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.bindings, bindingID)
+
+	return nil
+}
+
+func (s *bindings) filter(match func(binding internal.Binding) bool) []internal.Binding {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := make([]internal.Binding, 0)
+	for _, binding := range s.bindings {
+		if match(binding) {
+			result = append(result, binding)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].CreatedAt.Before(result[j].CreatedAt)
+	})
+
+	return result
+}
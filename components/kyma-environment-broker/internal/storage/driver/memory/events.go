@@ -0,0 +1,145 @@
+package memory
+
+import (
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/events"
+)
+
+type eventsStore struct {
+	mu sync.RWMutex
+
+	events []events.EventDTO
+}
+
+func NewEvents() *eventsStore {
+	return &eventsStore{
+		events: make([]events.EventDTO, 0),
+	}
+}
+
+func (_ *eventsStore) RunGarbageCollection(pollingPeriod, retention time.Duration) {
+	return
+}
+
+func (e *eventsStore) InsertEvent(eventLevel events.EventLevel, message string, source events.EventSource) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.events = append(e.events, events.EventDTO{
+		ID:              uuid.NewString(),
+		Level:           eventLevel,
+		InstanceID:      &source.InstanceID,
+		OperationID:     &source.OperationID,
+		GlobalAccountID: optionalString(source.GlobalAccountID),
+		SubAccountID:    optionalString(source.SubAccountID),
+		PlanID:          optionalString(source.PlanID),
+		StepName:        optionalString(source.StepName),
+		Message:         message,
+		CreatedAt:       time.Now(),
+	})
+	log.Printf("EVENT [%v/%v] %v: %v\n", source.InstanceID, source.OperationID, eventLevel, message)
+}
+
+func (e *eventsStore) ListEvents(filter events.EventFilter) ([]events.EventDTO, error) {
+	var cursorTime time.Time
+	var cursorID string
+	if filter.Cursor != "" {
+		var err error
+		cursorTime, cursorID, err = events.DecodeCursor(filter.Cursor)
+		if err != nil {
+			return nil, err
+		}
+	}
+	ascending := filter.Sort != events.DescendingSortOrder
+
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	var result []events.EventDTO
+	for _, ev := range e.events {
+		if !matchEvent(ev, filter) {
+			continue
+		}
+		if filter.Cursor != "" && !afterCursor(ev, cursorTime, cursorID, ascending) {
+			continue
+		}
+		result = append(result, ev)
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		if ascending {
+			return eventBefore(result[i], result[j])
+		}
+		return eventBefore(result[j], result[i])
+	})
+	if filter.Limit > 0 && len(result) > filter.Limit {
+		result = result[:filter.Limit]
+	}
+
+	return result, nil
+}
+
+func matchEvent(ev events.EventDTO, filter events.EventFilter) bool {
+	if !requiredContains(ev.InstanceID, filter.InstanceIDs) ||
+		!requiredContains(ev.OperationID, filter.OperationIDs) ||
+		!requiredContains(&ev.Level, filter.Levels) ||
+		!requiredContains(ev.GlobalAccountID, filter.GlobalAccountIDs) ||
+		!requiredContains(ev.SubAccountID, filter.SubAccountIDs) ||
+		!requiredContains(ev.PlanID, filter.PlanIDs) ||
+		!requiredContains(ev.StepName, filter.StepNames) {
+		return false
+	}
+	if filter.Message != "" && !strings.Contains(strings.ToLower(ev.Message), strings.ToLower(filter.Message)) {
+		return false
+	}
+	if !filter.From.IsZero() && ev.CreatedAt.Before(filter.From) {
+		return false
+	}
+	if !filter.To.IsZero() && ev.CreatedAt.After(filter.To) {
+		return false
+	}
+	return true
+}
+
+func afterCursor(ev events.EventDTO, cursorTime time.Time, cursorID string, ascending bool) bool {
+	cursor := events.EventDTO{ID: cursorID, CreatedAt: cursorTime}
+	if ascending {
+		return eventBefore(cursor, ev)
+	}
+	return eventBefore(ev, cursor)
+}
+
+func eventBefore(a, b events.EventDTO) bool {
+	if !a.CreatedAt.Equal(b.CreatedAt) {
+		return a.CreatedAt.Before(b.CreatedAt)
+	}
+	return a.ID < b.ID
+}
+
+func requiredContains[T comparable](el *T, sl []T) bool {
+	if len(sl) == 0 {
+		return true
+	}
+	if el == nil {
+		return false
+	}
+	for _, x := range sl {
+		if *el == x {
+			return true
+		}
+	}
+	return false
+}
+
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
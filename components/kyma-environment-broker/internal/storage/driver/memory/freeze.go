@@ -0,0 +1,60 @@
+package memory
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+)
+
+type freezes struct {
+	mu sync.Mutex
+
+	freezes map[string]orchestration.Freeze
+}
+
+func NewFreezes() *freezes {
+	return &freezes{
+		freezes: make(map[string]orchestration.Freeze),
+	}
+}
+
+func (s *freezes) Insert(freeze orchestration.Freeze) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.freezes[freeze.ID]; exists {
+		return dberr.AlreadyExists("freeze with id %s already exist", freeze.ID)
+	}
+	s.freezes[freeze.ID] = freeze
+
+	return nil
+}
+
+func (s *freezes) List() ([]orchestration.Freeze, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := make([]orchestration.Freeze, 0, len(s.freezes))
+	for _, freeze := range s.freezes {
+		result = append(result, freeze)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Start.Before(result[j].Start)
+	})
+
+	return result, nil
+}
+
+func (s *freezes) Delete(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.freezes[id]; !exists {
+		return dberr.NotFound("freeze with id %s does not exist", id)
+	}
+	delete(s.freezes, id)
+
+	return nil
+}
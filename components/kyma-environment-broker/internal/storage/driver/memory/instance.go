@@ -148,6 +148,25 @@ func (s *instances) GetNumberOfInstancesForGlobalAccountID(globalAccountID strin
 	return numberOfInstances, nil
 }
 
+func (s *instances) GetInstanceCountsForGlobalAccountID(globalAccountID string) ([]internal.InstanceCount, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	type key struct{ plan, region string }
+	totals := map[key]int{}
+	for _, inst := range s.instances {
+		if inst.GlobalAccountID == globalAccountID && inst.DeletedAt.IsZero() {
+			totals[key{plan: inst.ServicePlanName, region: inst.Parameters.PlatformRegion}]++
+		}
+	}
+
+	counts := make([]internal.InstanceCount, 0, len(totals))
+	for k, total := range totals {
+		counts = append(counts, internal.InstanceCount{PlanName: k.plan, PlatformRegion: k.region, Count: total})
+	}
+	return counts, nil
+}
+
 func (s *instances) GetByID(instanceID string) (*internal.Instance, error) {
 	inst, ok := s.instances[instanceID]
 	if !ok {
@@ -0,0 +1,79 @@
+package memory
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/pagination"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
+)
+
+type kubeconfigAudits struct {
+	mu sync.Mutex
+
+	records map[string]internal.KubeconfigAuditRecord
+}
+
+func NewKubeconfigAudits() *kubeconfigAudits {
+	return &kubeconfigAudits{
+		records: make(map[string]internal.KubeconfigAuditRecord),
+	}
+}
+
+func (s *kubeconfigAudits) Insert(record internal.KubeconfigAuditRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.records[record.ID]; exists {
+		return dberr.AlreadyExists("kubeconfig audit record with id %s already exist", record.ID)
+	}
+	s.records[record.ID] = record
+
+	return nil
+}
+
+func (s *kubeconfigAudits) List(filter dbmodel.KubeconfigAuditFilter) ([]internal.KubeconfigAuditRecord, int, int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	equal := func(a, b string) bool { return a == b }
+	filtered := make([]internal.KubeconfigAuditRecord, 0)
+	for _, r := range s.records {
+		if !matchFilter(r.InstanceID, filter.InstanceIDs, equal) ||
+			!matchFilter(r.RuntimeID, filter.RuntimeIDs, equal) ||
+			!matchFilter(r.UserID, filter.UserIDs, equal) ||
+			!matchFilter(r.Source, filter.Sources, equal) {
+			continue
+		}
+		if (!filter.From.IsZero() && r.CreatedAt.Before(filter.From)) || (!filter.To.IsZero() && r.CreatedAt.After(filter.To)) {
+			continue
+		}
+		filtered = append(filtered, r)
+	}
+	sort.Slice(filtered, func(i, j int) bool {
+		return filtered[i].CreatedAt.After(filtered[j].CreatedAt)
+	})
+
+	result := make([]internal.KubeconfigAuditRecord, 0)
+	offset := pagination.ConvertPageAndPageSizeToOffset(filter.PageSize, filter.Page)
+	for i := offset; (filter.PageSize < 1 || i < offset+filter.PageSize) && i < len(filtered); i++ {
+		result = append(result, filtered[i])
+	}
+
+	return result, len(result), len(filtered), nil
+}
+
+func (s *kubeconfigAudits) DeleteCreatedBefore(until time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for id, r := range s.records {
+		if r.CreatedAt.Before(until) {
+			delete(s.records, id)
+		}
+	}
+	return nil
+}
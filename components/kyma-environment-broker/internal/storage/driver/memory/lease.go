@@ -0,0 +1,77 @@
+package memory
+
+import (
+	"sync"
+	"time"
+)
+
+type lease struct {
+	owner     string
+	expiresAt time.Time
+}
+
+type leases struct {
+	mu sync.Mutex
+
+	leases map[string]lease
+}
+
+func NewLeases() *leases {
+	return &leases{
+		leases: make(map[string]lease),
+	}
+}
+
+func (s *leases) Acquire(id, owner string, ttl time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if l, ok := s.leases[id]; ok && l.owner != owner && l.expiresAt.After(now) {
+		return false, nil
+	}
+	s.leases[id] = lease{owner: owner, expiresAt: now.Add(ttl)}
+
+	return true, nil
+}
+
+func (s *leases) Renew(owner string, ids []string, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	expiresAt := time.Now().Add(ttl)
+	for _, id := range ids {
+		if l, ok := s.leases[id]; ok && l.owner == owner {
+			l.expiresAt = expiresAt
+			s.leases[id] = l
+		}
+	}
+
+	return nil
+}
+
+func (s *leases) Release(id, owner string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if l, ok := s.leases[id]; ok && l.owner == owner {
+		delete(s.leases, id)
+	}
+
+	return nil
+}
+
+func (s *leases) ListValid() ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	ids := make([]string, 0)
+	for id, l := range s.leases {
+		if l.expiresAt.After(now) {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids, nil
+}
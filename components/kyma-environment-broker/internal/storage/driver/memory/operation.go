@@ -387,7 +387,7 @@ func (s *operations) GetLastOperation(instanceID string) (*internal.Operation, e
 	var rows []internal.Operation
 
 	for _, op := range s.operations {
-		if op.InstanceID == instanceID && op.State != orchestration.Pending {
+		if op.InstanceID == instanceID && op.State != orchestration.Pending && op.Type != internal.OperationTypeRuntimeTask {
 			rows = append(rows, op)
 		}
 	}
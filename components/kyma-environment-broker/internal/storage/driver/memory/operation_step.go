@@ -0,0 +1,67 @@
+package memory
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+)
+
+type operationSteps struct {
+	mu sync.Mutex
+
+	steps map[string]map[string]internal.OperationStep
+}
+
+func NewOperationSteps() *operationSteps {
+	return &operationSteps{
+		steps: make(map[string]map[string]internal.OperationStep),
+	}
+}
+
+func (s *operationSteps) RecordAttempt(attempt internal.OperationStep) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, found := s.steps[attempt.OperationID]; !found {
+		s.steps[attempt.OperationID] = make(map[string]internal.OperationStep)
+	}
+	step, found := s.steps[attempt.OperationID][attempt.StepName]
+	if !found {
+		attempt.Attempts = 1
+		s.steps[attempt.OperationID][attempt.StepName] = attempt
+		return nil
+	}
+
+	step.State = attempt.State
+	step.Attempts++
+	if attempt.LastError != "" {
+		step.LastError = attempt.LastError
+	}
+	step.NextDelay = attempt.NextDelay
+	step.ProcessingTime += attempt.ProcessingTime
+	if attempt.StartedAt.Before(step.StartedAt) {
+		step.StartedAt = attempt.StartedAt
+	}
+	if attempt.FinishedAt.After(step.FinishedAt) {
+		step.FinishedAt = attempt.FinishedAt
+	}
+	s.steps[attempt.OperationID][attempt.StepName] = step
+
+	return nil
+}
+
+func (s *operationSteps) ListByOperationID(operationID string) ([]internal.OperationStep, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := make([]internal.OperationStep, 0, len(s.steps[operationID]))
+	for _, step := range s.steps[operationID] {
+		result = append(result, step)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].StartedAt.Before(result[j].StartedAt)
+	})
+
+	return result, nil
+}
@@ -0,0 +1,16 @@
+package memory
+
+import (
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
+)
+
+// reencryption does nothing, the in-memory storage does not encrypt data
+type reencryption struct{}
+
+func NewReencryption() *reencryption {
+	return &reencryption{}
+}
+
+func (r *reencryption) ReencryptBatch(kind dbmodel.EncryptedDataKind, afterID string, batchSize int) (dbmodel.ReencryptionBatch, error) {
+	return dbmodel.ReencryptionBatch{}, nil
+}
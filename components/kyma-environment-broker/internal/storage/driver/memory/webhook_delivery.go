@@ -0,0 +1,86 @@
+package memory
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/pagination"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
+)
+
+type webhookDeliveries struct {
+	mu sync.Mutex
+
+	deliveries map[string]internal.WebhookDelivery
+}
+
+func NewWebhookDeliveries() *webhookDeliveries {
+	return &webhookDeliveries{
+		deliveries: make(map[string]internal.WebhookDelivery),
+	}
+}
+
+func (s *webhookDeliveries) Insert(delivery internal.WebhookDelivery) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.deliveries[delivery.ID]; exists {
+		return dberr.AlreadyExists("webhook delivery with id %s already exist", delivery.ID)
+	}
+	s.deliveries[delivery.ID] = delivery
+
+	return nil
+}
+
+func (s *webhookDeliveries) Update(delivery internal.WebhookDelivery) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.deliveries[delivery.ID]; !exists {
+		return dberr.NotFound("webhook delivery with id %s does not exist", delivery.ID)
+	}
+	s.deliveries[delivery.ID] = delivery
+
+	return nil
+}
+
+func (s *webhookDeliveries) GetByID(id string) (*internal.WebhookDelivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delivery, exists := s.deliveries[id]
+	if !exists {
+		return nil, dberr.NotFound("webhook delivery with id %s does not exist", id)
+	}
+
+	return &delivery, nil
+}
+
+func (s *webhookDeliveries) List(filter dbmodel.WebhookDeliveryFilter) ([]internal.WebhookDelivery, int, int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	equal := func(a, b string) bool { return a == b }
+	filtered := make([]internal.WebhookDelivery, 0)
+	for _, d := range s.deliveries {
+		if !matchFilter(d.State, filter.States, equal) ||
+			!matchFilter(d.Subscription, filter.Subscriptions, equal) ||
+			!matchFilter(d.EventType, filter.EventTypes, equal) {
+			continue
+		}
+		filtered = append(filtered, d)
+	}
+	sort.Slice(filtered, func(i, j int) bool {
+		return filtered[i].CreatedAt.Before(filtered[j].CreatedAt)
+	})
+
+	result := make([]internal.WebhookDelivery, 0)
+	offset := pagination.ConvertPageAndPageSizeToOffset(filter.PageSize, filter.Page)
+	for i := offset; (filter.PageSize < 1 || i < offset+filter.PageSize) && i < len(filtered); i++ {
+		result = append(result, filtered[i])
+	}
+
+	return result, len(result), len(filtered), nil
+}
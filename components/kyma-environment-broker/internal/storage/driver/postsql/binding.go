@@ -0,0 +1,133 @@
+package postsql
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/postsql"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+)
+
+type bindings struct {
+	postsql.Factory
+	cipher Cipher
+}
+
+func NewBindings(sess postsql.Factory, cipher Cipher) *bindings {
+	return &bindings{
+		Factory: sess,
+		cipher:  cipher,
+	}
+}
+
+func (b *bindings) Insert(binding internal.Binding) error {
+	dto, err := b.toDTO(binding)
+	if err != nil {
+		return err
+	}
+	sess := b.NewWriteSession()
+	return sess.InsertBinding(dto)
+}
+
+func (b *bindings) Update(binding internal.Binding) error {
+	dto, err := b.toDTO(binding)
+	if err != nil {
+		return err
+	}
+	sess := b.NewWriteSession()
+	return sess.UpdateBinding(dto)
+}
+
+func (b *bindings) GetByID(bindingID string) (*internal.Binding, error) {
+	sess := b.NewReadSession()
+	dto, dbErr := sess.GetBindingByID(bindingID)
+	if dbErr != nil {
+		return nil, dbErr
+	}
+	binding, err := b.toBinding(dto)
+	if err != nil {
+		return nil, err
+	}
+	return &binding, nil
+}
+
+func (b *bindings) ListByInstanceID(instanceID string) ([]internal.Binding, error) {
+	sess := b.NewReadSession()
+	dtos, err := sess.ListBindingsByInstanceID(instanceID)
+	if err != nil {
+		return nil, err
+	}
+	return b.toBindings(dtos)
+}
+
+func (b *bindings) ListInProgress() ([]internal.Binding, error) {
+	sess := b.NewReadSession()
+	dtos, err := sess.ListBindingsByState(string(domain.InProgress))
+	if err != nil {
+		return nil, err
+	}
+	return b.toBindings(dtos)
+}
+
+func (b *bindings) Delete(bindingID string) error {
+	sess := b.NewWriteSession()
+	return sess.DeleteBinding(bindingID)
+}
+
+func (b *bindings) toDTO(binding internal.Binding) (dbmodel.BindingDTO, error) {
+	dto := dbmodel.BindingDTO{
+		ID:                binding.ID,
+		InstanceID:        binding.InstanceID,
+		ExpirationSeconds: binding.ExpirationSeconds,
+		ExpiresAt:         sql.NullTime{Time: binding.ExpiresAt, Valid: !binding.ExpiresAt.IsZero()},
+		LastOperation:     binding.LastOperation,
+		State:             string(binding.State),
+		Description:       binding.Description,
+		CreatedAt:         binding.CreatedAt,
+		UpdatedAt:         binding.UpdatedAt,
+	}
+	if binding.Kubeconfig != "" {
+		encrypted, err := b.cipher.Encrypt([]byte(binding.Kubeconfig))
+		if err != nil {
+			return dbmodel.BindingDTO{}, fmt.Errorf("while encrypting kubeconfig of binding %s: %w", binding.ID, err)
+		}
+		dto.Kubeconfig = sql.NullString{String: string(encrypted), Valid: true}
+	}
+	return dto, nil
+}
+
+func (b *bindings) toBinding(dto dbmodel.BindingDTO) (internal.Binding, error) {
+	binding := internal.Binding{
+		ID:                dto.ID,
+		InstanceID:        dto.InstanceID,
+		ExpirationSeconds: dto.ExpirationSeconds,
+		ExpiresAt:         dto.ExpiresAt.Time,
+		LastOperation:     dto.LastOperation,
+		State:             domain.LastOperationState(dto.State),
+		Description:       dto.Description,
+		CreatedAt:         dto.CreatedAt,
+		UpdatedAt:         dto.UpdatedAt,
+	}
+	if dto.Kubeconfig.Valid {
+		decrypted, err := b.cipher.Decrypt([]byte(dto.Kubeconfig.String))
+		if err != nil {
+			return internal.Binding{}, fmt.Errorf("while decrypting kubeconfig of binding %s: %w", dto.ID, err)
+		}
+		binding.Kubeconfig = string(decrypted)
+	}
+	return binding, nil
+}
+
+func (b *bindings) toBindings(dtos []dbmodel.BindingDTO) ([]internal.Binding, error) {
+	result := make([]internal.Binding, 0, len(dtos))
+	for _, dto := range dtos {
+		binding, err := b.toBinding(dto)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, binding)
+	}
+	return result, nil
+}
@@ -0,0 +1,81 @@
+package postsql_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/events"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBindings(t *testing.T) {
+
+	ctx := context.Background()
+
+	t.Run("should insert, update and delete bindings", func(t *testing.T) {
+		containerCleanupFunc, cfg, err := storage.InitTestDBContainer(t.Logf, ctx, "test_DB_1")
+		require.NoError(t, err)
+		defer containerCleanupFunc()
+
+		tablesCleanupFunc, err := storage.InitTestDBTables(t, cfg.ConnectionURL())
+		require.NoError(t, err)
+		defer tablesCleanupFunc()
+
+		cipher := storage.NewEncrypter(cfg.SecretKey)
+		brokerStorage, _, err := storage.NewFromConfig(cfg, events.Config{}, cipher, logrus.StandardLogger())
+		require.NoError(t, err)
+		require.NotNil(t, brokerStorage)
+
+		svc := brokerStorage.Bindings()
+		now := time.Now().Truncate(time.Millisecond)
+		binding := internal.Binding{
+			ID:                "binding-1",
+			InstanceID:        "instance-1",
+			ExpirationSeconds: 600,
+			LastOperation:     internal.BindingOperationBind,
+			State:             domain.InProgress,
+			CreatedAt:         now,
+			UpdatedAt:         now,
+		}
+
+		// when
+		require.NoError(t, svc.Insert(binding))
+		err = svc.Insert(binding)
+
+		// then
+		assert.True(t, dberr.IsAlreadyExists(err))
+		inProgress, err := svc.ListInProgress()
+		require.NoError(t, err)
+		assert.Len(t, inProgress, 1)
+
+		// when
+		binding.Kubeconfig = "kubeconfig"
+		binding.ExpiresAt = now.Add(10 * time.Minute)
+		binding.State = domain.Succeeded
+		require.NoError(t, svc.Update(binding))
+
+		// then
+		got, err := svc.GetByID("binding-1")
+		require.NoError(t, err)
+		assert.Equal(t, "kubeconfig", got.Kubeconfig)
+		assert.Equal(t, domain.Succeeded, got.State)
+		assert.True(t, binding.ExpiresAt.Equal(got.ExpiresAt))
+		byInstance, err := svc.ListByInstanceID("instance-1")
+		require.NoError(t, err)
+		assert.Len(t, byInstance, 1)
+
+		// when
+		require.NoError(t, svc.Delete("binding-1"))
+
+		// then
+		_, err = svc.GetByID("binding-1")
+		assert.True(t, dberr.IsNotFound(err))
+	})
+}
@@ -27,13 +27,13 @@ func (e *events) ListEvents(filter eventsapi.EventFilter) ([]eventsapi.EventDTO,
 	return sess.ListEvents(filter)
 }
 
-func (e *events) InsertEvent(eventLevel eventsapi.EventLevel, message, instanceID, operationID string) {
+func (e *events) InsertEvent(eventLevel eventsapi.EventLevel, message string, source eventsapi.EventSource) {
 	if e == nil {
 		return
 	}
 	sess := e.NewWriteSession()
-	if err := sess.InsertEvent(eventLevel, message, instanceID, operationID); err != nil {
-		e.log.Errorf("failed to insert event [%v] %v/%v %q: %v", eventLevel, instanceID, operationID, message, err)
+	if err := sess.InsertEvent(eventLevel, message, source); err != nil {
+		e.log.Errorf("failed to insert event [%v] %v/%v %q: %v", eventLevel, source.InstanceID, source.OperationID, message, err)
 	}
 }
 
@@ -13,4 +13,8 @@ type Cipher interface {
 	// methods used to encrypt/decrypt kubeconfig
 	EncryptKubeconfig(pp *internal.ProvisioningParameters) error
 	DecryptKubeconfig(pp *internal.ProvisioningParameters) error
+
+	// methods used to re-encrypt data with the active key after a key rotation
+	Reencrypt(text []byte) ([]byte, bool, error)
+	ReencryptProvisioningParameters(pp *internal.ProvisioningParameters) (bool, error)
 }
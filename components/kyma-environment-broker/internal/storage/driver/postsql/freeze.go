@@ -0,0 +1,59 @@
+package postsql
+
+import (
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/postsql"
+)
+
+type freezes struct {
+	postsql.Factory
+}
+
+func NewFreezes(sess postsql.Factory) *freezes {
+	return &freezes{
+		Factory: sess,
+	}
+}
+
+func (f *freezes) Insert(freeze orchestration.Freeze) error {
+	sess := f.NewWriteSession()
+	return sess.InsertFreeze(dbmodel.FreezeDTO{
+		ID:              freeze.ID,
+		GlobalAccountID: freeze.GlobalAccountID,
+		StartTime:       freeze.Start,
+		EndTime:         freeze.End,
+		Reason:          freeze.Reason,
+		CreatedAt:       time.Now(),
+	})
+}
+
+func (f *freezes) List() ([]orchestration.Freeze, error) {
+	sess := f.NewReadSession()
+	dtos, err := sess.ListFreezes()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]orchestration.Freeze, 0, len(dtos))
+	for _, dto := range dtos {
+		result = append(result, orchestration.Freeze{
+			ID:              dto.ID,
+			GlobalAccountID: dto.GlobalAccountID,
+			Start:           dto.StartTime,
+			End:             dto.EndTime,
+			Reason:          dto.Reason,
+		})
+	}
+	return result, nil
+}
+
+func (f *freezes) Delete(id string) error {
+	sess := f.NewWriteSession()
+	if err := sess.DeleteFreeze(id); err != nil {
+		return err
+	}
+	return nil
+}
@@ -302,6 +302,29 @@ func (s *Instance) GetNumberOfInstancesForGlobalAccountID(globalAccountID string
 	return result, err
 }
 
+func (s *Instance) GetInstanceCountsForGlobalAccountID(globalAccountID string) ([]internal.InstanceCount, error) {
+	sess := s.NewReadSession()
+	var entries []dbmodel.InstanceByPlanAndRegionStatEntry
+	err := wait.PollImmediate(defaultRetryInterval, defaultRetryTimeout, func() (bool, error) {
+		var err error
+		entries, err = sess.GetInstanceCountsForGlobalAccountID(globalAccountID)
+		return err == nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make([]internal.InstanceCount, 0, len(entries))
+	for _, e := range entries {
+		counts = append(counts, internal.InstanceCount{
+			PlanName:       e.ServicePlanName,
+			PlatformRegion: e.PlatformRegion,
+			Count:          e.Total,
+		})
+	}
+	return counts, nil
+}
+
 // TODO: Wrap retries in single method WithRetries
 func (s *Instance) GetByID(instanceID string) (*internal.Instance, error) {
 	sess := s.NewReadSession()
@@ -160,6 +160,8 @@ func TestInstance(t *testing.T) {
 		require.NoError(t, err)
 		numberOfInstancesB, err := brokerStorage.Instances().GetNumberOfInstancesForGlobalAccountID("B")
 		require.NoError(t, err)
+		countsA, err := brokerStorage.Instances().GetInstanceCountsForGlobalAccountID("A")
+		require.NoError(t, err)
 
 		t.Logf("%+v", stats)
 
@@ -171,6 +173,10 @@ func TestInstance(t *testing.T) {
 		assert.Equal(t, 2, numberOfInstancesA)
 		assert.Equal(t, 1, numberOfInstancesC)
 		assert.Equal(t, 0, numberOfInstancesB)
+		assert.ElementsMatch(t, []internal.InstanceCount{
+			{PlanName: "A1", PlatformRegion: fixture.Region, Count: 1},
+			{PlanName: "A2", PlatformRegion: fixture.Region, Count: 1},
+		}, countsA)
 	})
 
 	t.Run("Should fetch instances along with their operations", func(t *testing.T) {
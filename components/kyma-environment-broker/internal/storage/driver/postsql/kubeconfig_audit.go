@@ -0,0 +1,92 @@
+package postsql
+
+import (
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/postsql"
+)
+
+type kubeconfigAudits struct {
+	postsql.Factory
+}
+
+func NewKubeconfigAudits(sess postsql.Factory) *kubeconfigAudits {
+	return &kubeconfigAudits{
+		Factory: sess,
+	}
+}
+
+func (s *kubeconfigAudits) Insert(record internal.KubeconfigAuditRecord) error {
+	sess := s.NewWriteSession()
+	return sess.InsertKubeconfigAuditRecord(toKubeconfigAuditDTO(record))
+}
+
+func (s *kubeconfigAudits) List(filter dbmodel.KubeconfigAuditFilter) ([]internal.KubeconfigAuditRecord, int, int, error) {
+	sess := s.NewReadSession()
+	dtos, count, totalCount, err := sess.ListKubeconfigAuditRecords(filter)
+	if err != nil {
+		return nil, -1, -1, err
+	}
+
+	result := make([]internal.KubeconfigAuditRecord, 0, len(dtos))
+	for _, dto := range dtos {
+		result = append(result, toKubeconfigAuditRecord(dto))
+	}
+	return result, count, totalCount, nil
+}
+
+func (s *kubeconfigAudits) DeleteCreatedBefore(until time.Time) error {
+	sess := s.NewWriteSession()
+	return sess.DeleteKubeconfigAuditRecords(until)
+}
+
+func toKubeconfigAuditDTO(record internal.KubeconfigAuditRecord) dbmodel.KubeconfigAuditDTO {
+	dto := dbmodel.KubeconfigAuditDTO{
+		ID:              record.ID,
+		Source:          record.Source,
+		UserID:          record.UserID,
+		Issuer:          record.Issuer,
+		Groups:          strings.Join(record.Groups, ","),
+		InstanceID:      record.InstanceID,
+		RuntimeID:       record.RuntimeID,
+		GlobalAccountID: record.GlobalAccountID,
+		SubAccountID:    record.SubAccountID,
+		Role:            record.Role,
+		SourceIP:        record.SourceIP,
+		UserAgent:       record.UserAgent,
+		CreatedAt:       record.CreatedAt,
+	}
+	if record.ExpiresAt != nil {
+		dto.ExpiresAt = sql.NullTime{Time: *record.ExpiresAt, Valid: true}
+	}
+	return dto
+}
+
+func toKubeconfigAuditRecord(dto dbmodel.KubeconfigAuditDTO) internal.KubeconfigAuditRecord {
+	record := internal.KubeconfigAuditRecord{
+		ID:              dto.ID,
+		Source:          dto.Source,
+		UserID:          dto.UserID,
+		Issuer:          dto.Issuer,
+		InstanceID:      dto.InstanceID,
+		RuntimeID:       dto.RuntimeID,
+		GlobalAccountID: dto.GlobalAccountID,
+		SubAccountID:    dto.SubAccountID,
+		Role:            dto.Role,
+		SourceIP:        dto.SourceIP,
+		UserAgent:       dto.UserAgent,
+		CreatedAt:       dto.CreatedAt,
+	}
+	if dto.Groups != "" {
+		record.Groups = strings.Split(dto.Groups, ",")
+	}
+	if dto.ExpiresAt.Valid {
+		expiresAt := dto.ExpiresAt.Time
+		record.ExpiresAt = &expiresAt
+	}
+	return record
+}
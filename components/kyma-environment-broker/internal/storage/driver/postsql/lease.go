@@ -0,0 +1,51 @@
+package postsql
+
+import (
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/postsql"
+)
+
+type leases struct {
+	postsql.Factory
+}
+
+func NewLeases(sess postsql.Factory) *leases {
+	return &leases{
+		Factory: sess,
+	}
+}
+
+func (l *leases) Acquire(id, owner string, ttl time.Duration) (bool, error) {
+	sess := l.NewWriteSession()
+	acquired, err := sess.AcquireLease(id, owner, ttl)
+	if err != nil {
+		return false, err
+	}
+	return acquired, nil
+}
+
+func (l *leases) Renew(owner string, ids []string, ttl time.Duration) error {
+	sess := l.NewWriteSession()
+	if err := sess.RenewLeases(owner, ids, ttl); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (l *leases) Release(id, owner string) error {
+	sess := l.NewWriteSession()
+	if err := sess.ReleaseLease(id, owner); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (l *leases) ListValid() ([]string, error) {
+	sess := l.NewReadSession()
+	ids, err := sess.ListValidLeases()
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
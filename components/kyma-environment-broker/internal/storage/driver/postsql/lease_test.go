@@ -0,0 +1,100 @@
+package postsql_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/events"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLeases(t *testing.T) {
+
+	ctx := context.Background()
+
+	t.Run("should acquire, renew and release lease", func(t *testing.T) {
+		containerCleanupFunc, cfg, err := storage.InitTestDBContainer(t.Logf, ctx, "test_DB_1")
+		require.NoError(t, err)
+		defer containerCleanupFunc()
+
+		tablesCleanupFunc, err := storage.InitTestDBTables(t, cfg.ConnectionURL())
+		require.NoError(t, err)
+		defer tablesCleanupFunc()
+
+		cipher := storage.NewEncrypter(cfg.SecretKey)
+		brokerStorage, _, err := storage.NewFromConfig(cfg, events.Config{}, cipher, logrus.StandardLogger())
+		require.NoError(t, err)
+		require.NotNil(t, brokerStorage)
+
+		svc := brokerStorage.Leases()
+
+		// when
+		acquired, err := svc.Acquire("op-1", "keb-1", time.Minute)
+		require.NoError(t, err)
+		assert.True(t, acquired)
+
+		// then
+		acquired, err = svc.Acquire("op-1", "keb-2", time.Minute)
+		require.NoError(t, err)
+		assert.False(t, acquired)
+
+		acquired, err = svc.Acquire("op-1", "keb-1", time.Minute)
+		require.NoError(t, err)
+		assert.True(t, acquired)
+		valid, err := svc.ListValid()
+		require.NoError(t, err)
+		assert.Equal(t, []string{"op-1"}, valid)
+
+		// when
+		err = svc.Renew("keb-1", []string{"op-1"}, time.Minute)
+		require.NoError(t, err)
+		err = svc.Release("op-1", "keb-1")
+		require.NoError(t, err)
+
+		// then
+		acquired, err = svc.Acquire("op-1", "keb-2", time.Minute)
+		require.NoError(t, err)
+		assert.True(t, acquired)
+	})
+
+	t.Run("should take over expired lease", func(t *testing.T) {
+		containerCleanupFunc, cfg, err := storage.InitTestDBContainer(t.Logf, ctx, "test_DB_1")
+		require.NoError(t, err)
+		defer containerCleanupFunc()
+
+		tablesCleanupFunc, err := storage.InitTestDBTables(t, cfg.ConnectionURL())
+		require.NoError(t, err)
+		defer tablesCleanupFunc()
+
+		cipher := storage.NewEncrypter(cfg.SecretKey)
+		brokerStorage, _, err := storage.NewFromConfig(cfg, events.Config{}, cipher, logrus.StandardLogger())
+		require.NoError(t, err)
+		require.NotNil(t, brokerStorage)
+
+		svc := brokerStorage.Leases()
+		acquired, err := svc.Acquire("op-1", "keb-1", time.Second)
+		require.NoError(t, err)
+		require.True(t, acquired)
+
+		// when
+		time.Sleep(2 * time.Second)
+		valid, err := svc.ListValid()
+		require.NoError(t, err)
+		assert.Empty(t, valid)
+		acquired, err = svc.Acquire("op-1", "keb-2", time.Minute)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, acquired)
+
+		err = svc.Release("op-1", "keb-1")
+		require.NoError(t, err)
+		acquired, err = svc.Acquire("op-1", "keb-1", time.Minute)
+		require.NoError(t, err)
+		assert.False(t, acquired)
+	})
+}
@@ -922,6 +922,7 @@ func (s *operations) operationToDB(op internal.Operation) (dbmodel.OperationDTO,
 		OrchestrationID:        storage.StringToSQLNullString(op.OrchestrationID),
 		ProvisioningParameters: storage.StringToSQLNullString(string(pp)),
 		FinishedStages:         storage.StringToSQLNullString(strings.Join(op.FinishedStages, ",")),
+		NextRetryAt:            op.NextRetryAt,
 	}, nil
 }
 
@@ -963,6 +964,7 @@ func (s *operations) toOperation(dto *dbmodel.OperationDTO, existingOp internal.
 	existingOp.OrchestrationID = storage.SQLNullStringToString(dto.OrchestrationID)
 	existingOp.ProvisioningParameters = provisioningParameters
 	existingOp.FinishedStages = stages
+	existingOp.NextRetryAt = dto.NextRetryAt
 
 	return existingOp, nil
 }
@@ -0,0 +1,58 @@
+package postsql
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/postsql"
+)
+
+type operationSteps struct {
+	postsql.Factory
+}
+
+func NewOperationSteps(sess postsql.Factory) *operationSteps {
+	return &operationSteps{
+		Factory: sess,
+	}
+}
+
+func (s *operationSteps) RecordAttempt(attempt internal.OperationStep) error {
+	sess := s.NewWriteSession()
+	return sess.RecordOperationStepAttempt(dbmodel.OperationStepDTO{
+		OperationID:    attempt.OperationID,
+		StepName:       attempt.StepName,
+		State:          attempt.State,
+		LastError:      sql.NullString{String: attempt.LastError, Valid: attempt.LastError != ""},
+		NextDelay:      attempt.NextDelay.Milliseconds(),
+		ProcessingTime: attempt.ProcessingTime.Milliseconds(),
+		StartedAt:      attempt.StartedAt,
+		FinishedAt:     attempt.FinishedAt,
+	})
+}
+
+func (s *operationSteps) ListByOperationID(operationID string) ([]internal.OperationStep, error) {
+	sess := s.NewReadSession()
+	dtos, err := sess.ListOperationSteps(operationID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]internal.OperationStep, 0, len(dtos))
+	for _, dto := range dtos {
+		result = append(result, internal.OperationStep{
+			OperationID:    dto.OperationID,
+			StepName:       dto.StepName,
+			State:          dto.State,
+			Attempts:       dto.Attempts,
+			LastError:      dto.LastError.String,
+			NextDelay:      time.Duration(dto.NextDelay) * time.Millisecond,
+			ProcessingTime: time.Duration(dto.ProcessingTime) * time.Millisecond,
+			StartedAt:      dto.StartedAt,
+			FinishedAt:     dto.FinishedAt,
+		})
+	}
+	return result, nil
+}
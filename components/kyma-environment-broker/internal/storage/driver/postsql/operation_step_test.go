@@ -0,0 +1,60 @@
+package postsql_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/events"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOperationSteps(t *testing.T) {
+
+	ctx := context.Background()
+
+	t.Run("should accumulate attempts of a step", func(t *testing.T) {
+		containerCleanupFunc, cfg, err := storage.InitTestDBContainer(t.Logf, ctx, "test_DB_1")
+		require.NoError(t, err)
+		defer containerCleanupFunc()
+
+		tablesCleanupFunc, err := storage.InitTestDBTables(t, cfg.ConnectionURL())
+		require.NoError(t, err)
+		defer tablesCleanupFunc()
+
+		cipher := storage.NewEncrypter(cfg.SecretKey)
+		brokerStorage, _, err := storage.NewFromConfig(cfg, events.Config{}, cipher, logrus.StandardLogger())
+		require.NoError(t, err)
+		require.NotNil(t, brokerStorage)
+
+		svc := brokerStorage.OperationSteps()
+		start := time.Now().Truncate(time.Millisecond)
+
+		// when
+		err = svc.RecordAttempt(internal.OperationStep{OperationID: "op-1", StepName: "Check_Runtime", State: internal.OperationStepRetrying,
+			LastError: "timeout", NextDelay: time.Minute, ProcessingTime: time.Second, StartedAt: start, FinishedAt: start.Add(time.Second)})
+		require.NoError(t, err)
+		err = svc.RecordAttempt(internal.OperationStep{OperationID: "op-1", StepName: "Check_Runtime", State: internal.OperationStepSucceeded,
+			ProcessingTime: 2 * time.Second, StartedAt: start.Add(time.Minute), FinishedAt: start.Add(time.Minute + 2*time.Second)})
+		require.NoError(t, err)
+		err = svc.RecordAttempt(internal.OperationStep{OperationID: "op-2", StepName: "Check_Runtime", State: internal.OperationStepSucceeded,
+			StartedAt: start, FinishedAt: start})
+		require.NoError(t, err)
+
+		// then
+		steps, err := svc.ListByOperationID("op-1")
+		require.NoError(t, err)
+		require.Len(t, steps, 1)
+		assert.Equal(t, internal.OperationStepSucceeded, steps[0].State)
+		assert.Equal(t, 2, steps[0].Attempts)
+		assert.Equal(t, "timeout", steps[0].LastError)
+		assert.Equal(t, time.Duration(0), steps[0].NextDelay)
+		assert.Equal(t, 3*time.Second, steps[0].ProcessingTime)
+		assert.True(t, start.Equal(steps[0].StartedAt))
+		assert.True(t, start.Add(time.Minute+2*time.Second).Equal(steps[0].FinishedAt))
+	})
+}
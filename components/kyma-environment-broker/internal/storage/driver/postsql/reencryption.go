@@ -0,0 +1,88 @@
+package postsql
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/postsql"
+)
+
+type reencryption struct {
+	postsql.Factory
+	cipher Cipher
+}
+
+func NewReencryption(sess postsql.Factory, cipher Cipher) *reencryption {
+	return &reencryption{
+		Factory: sess,
+		cipher:  cipher,
+	}
+}
+
+// ReencryptBatch encrypts the data of the given kind with the active key. Records are processed in the order of their IDs,
+// starting after the given ID. Records which were modified during the re-encryption are skipped, because every write
+// already uses the active key.
+func (r *reencryption) ReencryptBatch(kind dbmodel.EncryptedDataKind, afterID string, batchSize int) (dbmodel.ReencryptionBatch, error) {
+	batch := dbmodel.ReencryptionBatch{}
+	dtos, err := r.NewReadSession().ListEncryptedData(kind, afterID, batchSize)
+	if err != nil {
+		return batch, fmt.Errorf("while listing %s: %w", kind, err)
+	}
+
+	reencrypt := r.reencryptValue
+	if kind == dbmodel.InstanceParametersData || kind == dbmodel.OperationParametersData {
+		reencrypt = r.reencryptParameters
+	}
+
+	sess := r.NewWriteSession()
+	for _, dto := range dtos {
+		batch.LastID = dto.ID
+		batch.Processed++
+		if dto.Data == "" {
+			continue
+		}
+		data, changed, err := reencrypt(dto.Data)
+		if err != nil {
+			batch.Failed = append(batch.Failed, dto.ID)
+			continue
+		}
+		if !changed {
+			continue
+		}
+		replaced, err := sess.ReplaceEncryptedData(kind, dto.ID, dto.Data, data)
+		if err != nil {
+			return batch, fmt.Errorf("while replacing %s of %s: %w", kind, dto.ID, err)
+		}
+		if replaced {
+			batch.Reencrypted++
+		}
+	}
+
+	return batch, nil
+}
+
+func (r *reencryption) reencryptValue(data string) (string, bool, error) {
+	reencrypted, changed, err := r.cipher.Reencrypt([]byte(data))
+	if err != nil {
+		return "", false, err
+	}
+	return string(reencrypted), changed, nil
+}
+
+func (r *reencryption) reencryptParameters(data string) (string, bool, error) {
+	var pp internal.ProvisioningParameters
+	if err := json.Unmarshal([]byte(data), &pp); err != nil {
+		return "", false, fmt.Errorf("while unmarshaling provisioning parameters: %w", err)
+	}
+	changed, err := r.cipher.ReencryptProvisioningParameters(&pp)
+	if err != nil || !changed {
+		return "", false, err
+	}
+	reencrypted, err := json.Marshal(pp)
+	if err != nil {
+		return "", false, fmt.Errorf("while marshaling provisioning parameters: %w", err)
+	}
+	return string(reencrypted), true, nil
+}
@@ -0,0 +1,76 @@
+package postsql_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/events"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/fixture"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReencryption(t *testing.T) {
+
+	ctx := context.Background()
+
+	t.Run("should re-encrypt data stored with the legacy key", func(t *testing.T) {
+		containerCleanupFunc, cfg, err := storage.InitTestDBContainer(t.Logf, ctx, "test_DB_1")
+		require.NoError(t, err)
+		defer containerCleanupFunc()
+
+		tablesCleanupFunc, err := storage.InitTestDBTables(t, cfg.ConnectionURL())
+		require.NoError(t, err)
+		defer tablesCleanupFunc()
+
+		legacyStorage, _, err := storage.NewFromConfig(cfg, events.Config{}, storage.NewEncrypter(cfg.SecretKey), logrus.StandardLogger())
+		require.NoError(t, err)
+
+		instance := fixture.FixInstance("instance-1")
+		instance.Parameters.ErsContext.SMOperatorCredentials = &internal.ServiceManagerOperatorCredentials{ClientID: "id", ClientSecret: "secret"}
+		require.NoError(t, legacyStorage.Instances().Insert(instance))
+		require.NoError(t, legacyStorage.Bindings().Insert(internal.Binding{ID: "binding-1", InstanceID: "instance-1", Kubeconfig: "kubeconfig",
+			State: domain.Succeeded, CreatedAt: time.Now(), UpdatedAt: time.Now()}))
+
+		rotatedCfg := cfg
+		rotatedCfg.SecretKey = "qbl92bqtl6zshtjb4bvbwwc2qk7vtw2d"
+		rotatedCfg.SecretKeyID = "v2"
+		rotatedCfg.LegacySecretKeys = fmt.Sprintf(`{"": %q}`, cfg.SecretKey)
+		cipher, err := storage.NewEncrypterFromConfig(rotatedCfg)
+		require.NoError(t, err)
+		brokerStorage, _, err := storage.NewFromConfig(rotatedCfg, events.Config{}, cipher, logrus.StandardLogger())
+		require.NoError(t, err)
+
+		// when
+		instances, err := brokerStorage.Reencryption().ReencryptBatch(dbmodel.InstanceParametersData, "", 10)
+		require.NoError(t, err)
+		bindings, err := brokerStorage.Reencryption().ReencryptBatch(dbmodel.BindingKubeconfigData, "", 10)
+		require.NoError(t, err)
+		next, err := brokerStorage.Reencryption().ReencryptBatch(dbmodel.InstanceParametersData, instances.LastID, 10)
+		require.NoError(t, err)
+
+		// then
+		assert.Equal(t, 1, instances.Reencrypted)
+		assert.Equal(t, 1, bindings.Reencrypted)
+		assert.Empty(t, next.LastID)
+
+		rotatedCfg.LegacySecretKeys = ""
+		withoutLegacyKeys, err := storage.NewEncrypterFromConfig(rotatedCfg)
+		require.NoError(t, err)
+		rotatedStorage, _, err := storage.NewFromConfig(rotatedCfg, events.Config{}, withoutLegacyKeys, logrus.StandardLogger())
+		require.NoError(t, err)
+		gotInstance, err := rotatedStorage.Instances().GetByID("instance-1")
+		require.NoError(t, err)
+		assert.Equal(t, "secret", gotInstance.Parameters.ErsContext.SMOperatorCredentials.ClientSecret)
+		gotBinding, err := rotatedStorage.Bindings().GetByID("binding-1")
+		require.NoError(t, err)
+		assert.Equal(t, "kubeconfig", gotBinding.Kubeconfig)
+	})
+}
@@ -0,0 +1,81 @@
+package postsql
+
+import (
+	"database/sql"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/postsql"
+)
+
+type webhookDeliveries struct {
+	postsql.Factory
+}
+
+func NewWebhookDeliveries(sess postsql.Factory) *webhookDeliveries {
+	return &webhookDeliveries{
+		Factory: sess,
+	}
+}
+
+func (s *webhookDeliveries) Insert(delivery internal.WebhookDelivery) error {
+	sess := s.NewWriteSession()
+	return sess.InsertWebhookDelivery(toWebhookDeliveryDTO(delivery))
+}
+
+func (s *webhookDeliveries) Update(delivery internal.WebhookDelivery) error {
+	sess := s.NewWriteSession()
+	return sess.UpdateWebhookDelivery(toWebhookDeliveryDTO(delivery))
+}
+
+func (s *webhookDeliveries) GetByID(id string) (*internal.WebhookDelivery, error) {
+	sess := s.NewReadSession()
+	dto, err := sess.GetWebhookDeliveryByID(id)
+	if err != nil {
+		return nil, err
+	}
+	delivery := toWebhookDelivery(dto)
+	return &delivery, nil
+}
+
+func (s *webhookDeliveries) List(filter dbmodel.WebhookDeliveryFilter) ([]internal.WebhookDelivery, int, int, error) {
+	sess := s.NewReadSession()
+	dtos, count, totalCount, err := sess.ListWebhookDeliveries(filter)
+	if err != nil {
+		return nil, -1, -1, err
+	}
+
+	result := make([]internal.WebhookDelivery, 0, len(dtos))
+	for _, dto := range dtos {
+		result = append(result, toWebhookDelivery(dto))
+	}
+	return result, count, totalCount, nil
+}
+
+func toWebhookDeliveryDTO(delivery internal.WebhookDelivery) dbmodel.WebhookDeliveryDTO {
+	return dbmodel.WebhookDeliveryDTO{
+		ID:           delivery.ID,
+		Subscription: delivery.Subscription,
+		EventType:    delivery.EventType,
+		Payload:      delivery.Payload,
+		State:        delivery.State,
+		Attempts:     delivery.Attempts,
+		LastError:    sql.NullString{String: delivery.LastError, Valid: delivery.LastError != ""},
+		CreatedAt:    delivery.CreatedAt,
+		UpdatedAt:    delivery.UpdatedAt,
+	}
+}
+
+func toWebhookDelivery(dto dbmodel.WebhookDeliveryDTO) internal.WebhookDelivery {
+	return internal.WebhookDelivery{
+		ID:           dto.ID,
+		Subscription: dto.Subscription,
+		EventType:    dto.EventType,
+		Payload:      dto.Payload,
+		State:        dto.State,
+		Attempts:     dto.Attempts,
+		LastError:    dto.LastError.String,
+		CreatedAt:    dto.CreatedAt,
+		UpdatedAt:    dto.UpdatedAt,
+	}
+}
@@ -5,22 +5,110 @@ import (
 	"crypto/cipher"
 	"crypto/rand"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
 )
 
+// keyIDSeparator separates the key ID from the encrypted value, it never occurs in base64 encoded values
+const keyIDSeparator = ":"
+
+// NewEncrypter creates an encrypter with a single key, values are encrypted without a key ID
 func NewEncrypter(secretKey string) *Encrypter {
-	return &Encrypter{key: []byte(secretKey)}
+	return &Encrypter{keys: map[string][]byte{"": []byte(secretKey)}}
+}
+
+// NewEncrypterFromConfig creates an encrypter with a key ring. New values are encrypted with the active key
+// and prefixed with its ID. Legacy keys are used only to decrypt values encrypted before a key rotation,
+// the legacy key with an empty ID decrypts values stored without a key ID.
+func NewEncrypterFromConfig(cfg Config) (*Encrypter, error) {
+	if strings.Contains(cfg.SecretKeyID, keyIDSeparator) {
+		return nil, fmt.Errorf("secret key ID must not contain %q", keyIDSeparator)
+	}
+	keys := map[string][]byte{}
+	if cfg.LegacySecretKeys != "" {
+		legacy := map[string]string{}
+		if err := json.Unmarshal([]byte(cfg.LegacySecretKeys), &legacy); err != nil {
+			return nil, fmt.Errorf("while unmarshaling legacy secret keys: %w", err)
+		}
+		for id, key := range legacy {
+			if strings.Contains(id, keyIDSeparator) {
+				return nil, fmt.Errorf("legacy secret key ID must not contain %q", keyIDSeparator)
+			}
+			keys[id] = []byte(key)
+		}
+	}
+	keys[cfg.SecretKeyID] = []byte(cfg.SecretKey)
+
+	return &Encrypter{activeKeyID: cfg.SecretKeyID, keys: keys}, nil
 }
 
 type Encrypter struct {
-	key []byte
+	activeKeyID string
+	keys        map[string][]byte
+}
+
+// ActiveKeyID returns the ID of the key used to encrypt new values
+func (e *Encrypter) ActiveKeyID() string {
+	return e.activeKeyID
+}
+
+// KeyID returns the ID of the key the value was encrypted with
+func (e *Encrypter) KeyID(obj []byte) string {
+	keyID, _ := splitKeyID(obj)
+	return keyID
 }
 
 func (e *Encrypter) Encrypt(obj []byte) ([]byte, error) {
-	block, err := aes.NewCipher(e.key)
+	encrypted, err := encrypt(e.keys[e.activeKeyID], obj)
+	if err != nil {
+		return nil, err
+	}
+	if e.activeKeyID == "" {
+		return encrypted, nil
+	}
+	return append([]byte(e.activeKeyID+keyIDSeparator), encrypted...), nil
+}
+
+func (e *Encrypter) Decrypt(obj []byte) ([]byte, error) {
+	keyID, encrypted := splitKeyID(obj)
+	key, found := e.keys[keyID]
+	if !found {
+		return nil, fmt.Errorf("unknown encryption key ID %q", keyID)
+	}
+	return decrypt(key, encrypted)
+}
+
+// Reencrypt encrypts the value with the active key if it was encrypted with another one,
+// the returned flag tells if the value was changed
+func (e *Encrypter) Reencrypt(obj []byte) ([]byte, bool, error) {
+	if e.KeyID(obj) == e.activeKeyID {
+		return obj, false, nil
+	}
+	decrypted, err := e.Decrypt(obj)
+	if err != nil {
+		return nil, false, err
+	}
+	encrypted, err := e.Encrypt(decrypted)
+	if err != nil {
+		return nil, false, err
+	}
+	return encrypted, true, nil
+}
+
+func splitKeyID(obj []byte) (string, []byte) {
+	parts := strings.SplitN(string(obj), keyIDSeparator, 2)
+	if len(parts) == 1 {
+		return "", obj
+	}
+	return parts[0], []byte(parts[1])
+}
+
+func encrypt(key, obj []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
 	if err != nil {
 		return nil, err
 	}
@@ -36,12 +124,12 @@ func (e *Encrypter) Encrypt(obj []byte) ([]byte, error) {
 	return []byte(base64.StdEncoding.EncodeToString(bytes)), nil
 }
 
-func (e *Encrypter) Decrypt(obj []byte) ([]byte, error) {
+func decrypt(key, obj []byte) ([]byte, error) {
 	obj, err := base64.StdEncoding.DecodeString(string(obj))
 	if err != nil {
 		return nil, fmt.Errorf("while decoding input object: %w", err)
 	}
-	block, err := aes.NewCipher(e.key)
+	block, err := aes.NewCipher(key)
 	if err != nil {
 		return nil, err
 	}
@@ -146,3 +234,36 @@ func (e *Encrypter) DecryptKubeconfig(provisioningParameters *internal.Provision
 	provisioningParameters.Parameters.Kubeconfig = string(decryptedKubeconfig)
 	return nil
 }
+
+// ReencryptProvisioningParameters encrypts the SM credentials and the kubeconfig with the active key
+// if they were encrypted with another one, the returned flag tells if the parameters were changed
+func (e *Encrypter) ReencryptProvisioningParameters(provisioningParameters *internal.ProvisioningParameters) (bool, error) {
+	changed := false
+	reencrypt := func(value *string, name string) error {
+		if *value == "" {
+			return nil
+		}
+		reencrypted, ok, err := e.Reencrypt([]byte(*value))
+		if err != nil {
+			return fmt.Errorf("while re-encrypting %s: %w", name, err)
+		}
+		if ok {
+			*value = string(reencrypted)
+			changed = true
+		}
+		return nil
+	}
+
+	if creds := provisioningParameters.ErsContext.SMOperatorCredentials; creds != nil {
+		if err := reencrypt(&creds.ClientID, "ClientID"); err != nil {
+			return false, err
+		}
+		if err := reencrypt(&creds.ClientSecret, "ClientSecret"); err != nil {
+			return false, err
+		}
+	}
+	if err := reencrypt(&provisioningParameters.Parameters.Kubeconfig, "kubeconfig"); err != nil {
+		return false, err
+	}
+	return changed, nil
+}
@@ -2,8 +2,12 @@ package storage
 
 import (
 	"encoding/json"
+	"fmt"
+	"strings"
 	"testing"
 
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/util/rand"
@@ -70,3 +74,129 @@ func TestNewEncrypter(t *testing.T) {
 	})
 
 }
+
+func TestEncrypterKeyRing(t *testing.T) {
+	oldKey := rand.String(32)
+	newKey := rand.String(32)
+
+	t.Run("should decrypt values encrypted before key rotation", func(t *testing.T) {
+		// given
+		legacy := NewEncrypter(oldKey)
+		unversioned, err := legacy.Encrypt([]byte("unversioned"))
+		require.NoError(t, err)
+
+		v1, err := NewEncrypterFromConfig(Config{SecretKey: oldKey, SecretKeyID: "v1"})
+		require.NoError(t, err)
+		versioned, err := v1.Encrypt([]byte("versioned"))
+		require.NoError(t, err)
+
+		e, err := NewEncrypterFromConfig(Config{SecretKey: newKey, SecretKeyID: "v2",
+			LegacySecretKeys: fmt.Sprintf(`{"": %q, "v1": %q}`, oldKey, oldKey)})
+		require.NoError(t, err)
+
+		// when
+		decryptedUnversioned, err := e.Decrypt(unversioned)
+		require.NoError(t, err)
+		decryptedVersioned, err := e.Decrypt(versioned)
+		require.NoError(t, err)
+
+		// then
+		assert.Equal(t, "unversioned", string(decryptedUnversioned))
+		assert.Equal(t, "versioned", string(decryptedVersioned))
+		assert.Equal(t, "", e.KeyID(unversioned))
+		assert.Equal(t, "v1", e.KeyID(versioned))
+	})
+
+	t.Run("should encrypt values with the active key", func(t *testing.T) {
+		// given
+		e, err := NewEncrypterFromConfig(Config{SecretKey: newKey, SecretKeyID: "v2"})
+		require.NoError(t, err)
+
+		// when
+		encrypted, err := e.Encrypt([]byte("test"))
+		require.NoError(t, err)
+
+		// then
+		assert.True(t, strings.HasPrefix(string(encrypted), "v2:"))
+		_, err = NewEncrypter(newKey).Decrypt(encrypted)
+		assert.Error(t, err)
+	})
+
+	t.Run("should re-encrypt values encrypted with a legacy key", func(t *testing.T) {
+		// given
+		encrypted, err := NewEncrypter(oldKey).Encrypt([]byte("test"))
+		require.NoError(t, err)
+		e, err := NewEncrypterFromConfig(Config{SecretKey: newKey, SecretKeyID: "v2", LegacySecretKeys: fmt.Sprintf(`{"": %q}`, oldKey)})
+		require.NoError(t, err)
+
+		// when
+		reencrypted, changed, err := e.Reencrypt(encrypted)
+		require.NoError(t, err)
+
+		// then
+		assert.True(t, changed)
+		assert.Equal(t, "v2", e.KeyID(reencrypted))
+		onlyNewKey, err := NewEncrypterFromConfig(Config{SecretKey: newKey, SecretKeyID: "v2"})
+		require.NoError(t, err)
+		decrypted, err := onlyNewKey.Decrypt(reencrypted)
+		require.NoError(t, err)
+		assert.Equal(t, "test", string(decrypted))
+
+		// when
+		_, changed, err = e.Reencrypt(reencrypted)
+
+		// then
+		require.NoError(t, err)
+		assert.False(t, changed)
+	})
+
+	t.Run("should re-encrypt provisioning parameters", func(t *testing.T) {
+		// given
+		legacy := NewEncrypter(oldKey)
+		pp := internal.ProvisioningParameters{
+			ErsContext: internal.ERSContext{SMOperatorCredentials: &internal.ServiceManagerOperatorCredentials{ClientID: "id", ClientSecret: "secret"}},
+		}
+		pp.Parameters.Kubeconfig = "kubeconfig"
+		require.NoError(t, legacy.EncryptSMCreds(&pp))
+		require.NoError(t, legacy.EncryptKubeconfig(&pp))
+		e, err := NewEncrypterFromConfig(Config{SecretKey: newKey, SecretKeyID: "v2", LegacySecretKeys: fmt.Sprintf(`{"": %q}`, oldKey)})
+		require.NoError(t, err)
+
+		// when
+		changed, err := e.ReencryptProvisioningParameters(&pp)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, changed)
+		assert.Equal(t, "v2", e.KeyID([]byte(pp.ErsContext.SMOperatorCredentials.ClientID)))
+		assert.Equal(t, "v2", e.KeyID([]byte(pp.Parameters.Kubeconfig)))
+		require.NoError(t, e.DecryptSMCreds(&pp))
+		require.NoError(t, e.DecryptKubeconfig(&pp))
+		assert.Equal(t, "secret", pp.ErsContext.SMOperatorCredentials.ClientSecret)
+		assert.Equal(t, "kubeconfig", pp.Parameters.Kubeconfig)
+	})
+
+	t.Run("should fail for unknown key ID", func(t *testing.T) {
+		// given
+		v1, err := NewEncrypterFromConfig(Config{SecretKey: oldKey, SecretKeyID: "v1"})
+		require.NoError(t, err)
+		encrypted, err := v1.Encrypt([]byte("test"))
+		require.NoError(t, err)
+		e, err := NewEncrypterFromConfig(Config{SecretKey: newKey, SecretKeyID: "v2"})
+		require.NoError(t, err)
+
+		// when
+		_, err = e.Decrypt(encrypted)
+
+		// then
+		assert.EqualError(t, err, `unknown encryption key ID "v1"`)
+	})
+
+	t.Run("should fail for invalid configuration", func(t *testing.T) {
+		_, err := NewEncrypterFromConfig(Config{SecretKey: newKey, SecretKeyID: "v:2"})
+		assert.Error(t, err)
+
+		_, err = NewEncrypterFromConfig(Config{SecretKey: newKey, LegacySecretKeys: "not-json"})
+		assert.Error(t, err)
+	})
+}
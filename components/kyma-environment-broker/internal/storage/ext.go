@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/events"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/predicate"
@@ -20,6 +21,7 @@ type Instances interface {
 	GetInstanceStats() (internal.InstanceStats, error)
 	GetERSContextStats() (internal.ERSContextStats, error)
 	GetNumberOfInstancesForGlobalAccountID(globalAccountID string) (int, error)
+	GetInstanceCountsForGlobalAccountID(globalAccountID string) ([]internal.InstanceCount, error)
 	List(dbmodel.InstanceFilter) ([]internal.Instance, int, int, error)
 
 	// todo: remove after instances parameters migration is done
@@ -111,7 +113,65 @@ type Updating interface {
 	UpdateUpdatingOperation(operation internal.UpdatingOperation) (*internal.UpdatingOperation, error)
 }
 
+// Leases keeps track of which KEB replica owns the processing of a given operation or orchestration.
+// A lease which is not renewed before it expires can be taken over by another replica.
+type Leases interface {
+	// Acquire returns true if the lease was free, expired or already held by the owner
+	Acquire(id, owner string, ttl time.Duration) (bool, error)
+	Renew(owner string, ids []string, ttl time.Duration) error
+	Release(id, owner string) error
+	// ListValid returns the IDs of the leases which have not expired
+	ListValid() ([]string, error)
+}
+
+// Freezes stores the orchestration freezes created with the API.
+type Freezes interface {
+	Insert(freeze orchestration.Freeze) error
+	List() ([]orchestration.Freeze, error)
+	Delete(id string) error
+}
+
 type Events interface {
-	InsertEvent(level events.EventLevel, message, instanceID, operationID string)
+	InsertEvent(level events.EventLevel, message string, source events.EventSource)
 	ListEvents(filter events.EventFilter) ([]events.EventDTO, error)
 }
+
+// WebhookDeliveries stores lifecycle events sent to webhook subscriptions. Deliveries whose retries were exhausted
+// stay in the dead state and act as a dead-letter queue.
+type WebhookDeliveries interface {
+	Insert(delivery internal.WebhookDelivery) error
+	Update(delivery internal.WebhookDelivery) error
+	GetByID(id string) (*internal.WebhookDelivery, error)
+	List(filter dbmodel.WebhookDeliveryFilter) ([]internal.WebhookDelivery, int, int, error)
+}
+
+// Bindings stores service bindings, the credentials are stored encrypted
+type Bindings interface {
+	Insert(binding internal.Binding) error
+	Update(binding internal.Binding) error
+	GetByID(bindingID string) (*internal.Binding, error)
+	ListByInstanceID(instanceID string) ([]internal.Binding, error)
+	// ListInProgress returns bindings with a bind or unbind operation in progress
+	ListInProgress() ([]internal.Binding, error)
+	Delete(bindingID string) error
+}
+
+// OperationSteps stores execution records of operation steps
+type OperationSteps interface {
+	// RecordAttempt adds a single attempt of a step to its record, the record is created with the first attempt
+	RecordAttempt(attempt internal.OperationStep) error
+	ListByOperationID(operationID string) ([]internal.OperationStep, error)
+}
+
+// KubeconfigAudits stores the append-only audit trail of the issued kubeconfigs, the records are deleted only after the retention period
+type KubeconfigAudits interface {
+	Insert(record internal.KubeconfigAuditRecord) error
+	// List returns the records ordered from the newest one
+	List(filter dbmodel.KubeconfigAuditFilter) ([]internal.KubeconfigAuditRecord, int, int, error)
+	DeleteCreatedBefore(until time.Time) error
+}
+
+// Reencryption re-encrypts sensitive data stored with encryption keys other than the active one
+type Reencryption interface {
+	ReencryptBatch(kind dbmodel.EncryptedDataKind, afterID string, batchSize int) (dbmodel.ReencryptionBatch, error)
+}
@@ -39,6 +39,7 @@ type ReadSession interface {
 	GetInstanceStats() ([]dbmodel.InstanceByGlobalAccountIDStatEntry, error)
 	GetERSContextStats() ([]dbmodel.InstanceERSContextStatsEntry, error)
 	GetNumberOfInstancesForGlobalAccountID(globalAccountID string) (int, error)
+	GetInstanceCountsForGlobalAccountID(globalAccountID string) ([]dbmodel.InstanceByPlanAndRegionStatEntry, error)
 	GetRuntimeStateByOperationID(operationID string) (dbmodel.RuntimeStateDTO, dberr.Error)
 	ListRuntimeStateByRuntimeID(runtimeID string) ([]dbmodel.RuntimeStateDTO, dberr.Error)
 	GetOrchestrationByID(oID string) (dbmodel.OrchestrationDTO, dberr.Error)
@@ -52,6 +53,16 @@ type ReadSession interface {
 	GetLatestRuntimeStateWithKymaVersionByRuntimeID(runtimeID string) (dbmodel.RuntimeStateDTO, dberr.Error)
 	GetLatestRuntimeStateWithOIDCConfigByRuntimeID(runtimeID string) (dbmodel.RuntimeStateDTO, dberr.Error)
 	ListEvents(filter events.EventFilter) ([]events.EventDTO, error)
+	ListFreezes() ([]dbmodel.FreezeDTO, dberr.Error)
+	GetWebhookDeliveryByID(id string) (dbmodel.WebhookDeliveryDTO, dberr.Error)
+	ListWebhookDeliveries(filter dbmodel.WebhookDeliveryFilter) ([]dbmodel.WebhookDeliveryDTO, int, int, error)
+	ListOperationSteps(operationID string) ([]dbmodel.OperationStepDTO, dberr.Error)
+	GetBindingByID(bindingID string) (dbmodel.BindingDTO, dberr.Error)
+	ListBindingsByInstanceID(instanceID string) ([]dbmodel.BindingDTO, dberr.Error)
+	ListBindingsByState(state string) ([]dbmodel.BindingDTO, dberr.Error)
+	ListEncryptedData(kind dbmodel.EncryptedDataKind, afterID string, limit int) ([]dbmodel.EncryptedDataDTO, dberr.Error)
+	ListKubeconfigAuditRecords(filter dbmodel.KubeconfigAuditFilter) ([]dbmodel.KubeconfigAuditDTO, int, int, error)
+	ListValidLeases() ([]string, dberr.Error)
 }
 
 //go:generate mockery --name=WriteSession
@@ -64,8 +75,22 @@ type WriteSession interface {
 	InsertOrchestration(o dbmodel.OrchestrationDTO) dberr.Error
 	UpdateOrchestration(o dbmodel.OrchestrationDTO) dberr.Error
 	InsertRuntimeState(state dbmodel.RuntimeStateDTO) dberr.Error
-	InsertEvent(level events.EventLevel, message, instanceID, operationID string) dberr.Error
+	InsertEvent(level events.EventLevel, message string, source events.EventSource) dberr.Error
 	DeleteEvents(until time.Time) dberr.Error
+	AcquireLease(id, owner string, ttl time.Duration) (bool, dberr.Error)
+	RenewLeases(owner string, ids []string, ttl time.Duration) dberr.Error
+	ReleaseLease(id, owner string) dberr.Error
+	InsertFreeze(freeze dbmodel.FreezeDTO) dberr.Error
+	DeleteFreeze(id string) dberr.Error
+	InsertWebhookDelivery(delivery dbmodel.WebhookDeliveryDTO) dberr.Error
+	UpdateWebhookDelivery(delivery dbmodel.WebhookDeliveryDTO) dberr.Error
+	RecordOperationStepAttempt(attempt dbmodel.OperationStepDTO) dberr.Error
+	InsertBinding(binding dbmodel.BindingDTO) dberr.Error
+	UpdateBinding(binding dbmodel.BindingDTO) dberr.Error
+	DeleteBinding(bindingID string) dberr.Error
+	ReplaceEncryptedData(kind dbmodel.EncryptedDataKind, id, oldData, newData string) (bool, dberr.Error)
+	InsertKubeconfigAuditRecord(record dbmodel.KubeconfigAuditDTO) dberr.Error
+	DeleteKubeconfigAuditRecords(until time.Time) dberr.Error
 }
 
 type Transaction interface {
@@ -12,12 +12,18 @@ import (
 )
 
 const (
-	schemaName             = "public"
-	InstancesTableName     = "instances"
-	OperationTableName     = "operations"
-	OrchestrationTableName = "orchestrations"
-	RuntimeStateTableName  = "runtime_states"
-	CreatedAtField         = "created_at"
+	schemaName               = "public"
+	InstancesTableName       = "instances"
+	OperationTableName       = "operations"
+	OrchestrationTableName   = "orchestrations"
+	RuntimeStateTableName    = "runtime_states"
+	LeaseTableName           = "leases"
+	FreezeTableName          = "orchestration_freezes"
+	WebhookDeliveryTableName = "webhook_deliveries"
+	OperationStepTableName   = "operation_steps"
+	BindingTableName         = "bindings"
+	KubeconfigAuditTableName = "kubeconfig_audit"
+	CreatedAtField           = "created_at"
 )
 
 // InitializeDatabase opens database connection and initializes schema if it does not exist
@@ -106,7 +106,9 @@ func (r readSession) FindAllInstancesForSubAccounts(subAccountslist []string) ([
 func (r readSession) GetLastOperation(instanceID string) (dbmodel.OperationDTO, dberr.Error) {
 	inst := dbr.Eq("instance_id", instanceID)
 	state := dbr.Neq("state", []string{orchestration.Pending, orchestration.Canceled})
-	condition := dbr.And(inst, state)
+	// runtime tasks do not change the instance, so they are never its last operation
+	opType := dbr.Neq("type", string(internal.OperationTypeRuntimeTask))
+	condition := dbr.And(inst, state, opType)
 	operation, err := r.getLastOperation(condition)
 	if err != nil {
 		switch {
@@ -642,6 +644,18 @@ func (r readSession) GetNumberOfInstancesForGlobalAccountID(globalAccountID stri
 	return res.Total, err
 }
 
+func (r readSession) GetInstanceCountsForGlobalAccountID(globalAccountID string) ([]dbmodel.InstanceByPlanAndRegionStatEntry, error) {
+	var rows []dbmodel.InstanceByPlanAndRegionStatEntry
+	_, err := r.session.Select("service_plan_name", "provisioning_parameters::json->>'platform_region' AS platform_region", "count(*) AS total").
+		From(InstancesTableName).
+		Where(dbr.Eq("global_account_id", globalAccountID)).
+		Where(dbr.Eq("deleted_at", "0001-01-01T00:00:00.000Z")).
+		GroupBy("service_plan_name", "platform_region").
+		Load(&rows)
+
+	return rows, err
+}
+
 func (r readSession) ListInstances(filter dbmodel.InstanceFilter) ([]dbmodel.InstanceDTO, int, int, error) {
 	var instances []dbmodel.InstanceDTO
 
@@ -687,7 +701,7 @@ func (r readSession) ListInstances(filter dbmodel.InstanceFilter) ([]dbmodel.Ins
 }
 
 func (r readSession) ListEvents(filter events.EventFilter) ([]events.EventDTO, error) {
-	var events []events.EventDTO
+	var result []events.EventDTO
 	stmt := r.session.Select("*").From("events")
 	if len(filter.InstanceIDs) != 0 {
 		stmt.Where(dbr.Eq("instance_id", filter.InstanceIDs))
@@ -695,9 +709,146 @@ func (r readSession) ListEvents(filter events.EventFilter) ([]events.EventDTO, e
 	if len(filter.OperationIDs) != 0 {
 		stmt.Where(dbr.Eq("operation_id", filter.OperationIDs))
 	}
-	stmt.OrderBy("created_at")
-	_, err := stmt.Load(&events)
-	return events, err
+	if len(filter.Levels) != 0 {
+		levels := make([]string, 0, len(filter.Levels))
+		for _, l := range filter.Levels {
+			levels = append(levels, string(l))
+		}
+		stmt.Where(dbr.Eq("level", levels))
+	}
+	if len(filter.GlobalAccountIDs) != 0 {
+		stmt.Where(dbr.Eq("global_account_id", filter.GlobalAccountIDs))
+	}
+	if len(filter.SubAccountIDs) != 0 {
+		stmt.Where(dbr.Eq("sub_account_id", filter.SubAccountIDs))
+	}
+	if len(filter.PlanIDs) != 0 {
+		stmt.Where(dbr.Eq("plan_id", filter.PlanIDs))
+	}
+	if len(filter.StepNames) != 0 {
+		stmt.Where(dbr.Eq("step_name", filter.StepNames))
+	}
+	if filter.Message != "" {
+		stmt.Where("message ILIKE ?", "%"+escapeLike(filter.Message)+"%")
+	}
+	if !filter.From.IsZero() {
+		stmt.Where(dbr.Gte("created_at", filter.From))
+	}
+	if !filter.To.IsZero() {
+		stmt.Where(dbr.Lte("created_at", filter.To))
+	}
+
+	ascending := filter.Sort != events.DescendingSortOrder
+	if filter.Cursor != "" {
+		createdAt, id, err := events.DecodeCursor(filter.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		if ascending {
+			stmt.Where("(created_at, id) > (?, ?)", createdAt, id)
+		} else {
+			stmt.Where("(created_at, id) < (?, ?)", createdAt, id)
+		}
+	}
+	stmt.OrderDir("created_at", ascending).OrderDir("id", ascending)
+	if filter.Limit > 0 {
+		stmt.Limit(uint64(filter.Limit))
+	}
+
+	_, err := stmt.Load(&result)
+	return result, err
+}
+
+// escapeLike escapes the wildcards of the LIKE pattern, so the value is matched literally
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(value)
+}
+
+func (r readSession) ListFreezes() ([]dbmodel.FreezeDTO, dberr.Error) {
+	var freezes []dbmodel.FreezeDTO
+
+	_, err := r.session.
+		Select("*").
+		From(FreezeTableName).
+		OrderBy("start_time").
+		Load(&freezes)
+	if err != nil {
+		return nil, dberr.Internal("Failed to get freezes: %s", err)
+	}
+	return freezes, nil
+}
+
+func (r readSession) GetWebhookDeliveryByID(id string) (dbmodel.WebhookDeliveryDTO, dberr.Error) {
+	var delivery dbmodel.WebhookDeliveryDTO
+
+	err := r.session.
+		Select("*").
+		From(WebhookDeliveryTableName).
+		Where(dbr.Eq("id", id)).
+		LoadOne(&delivery)
+	if err != nil {
+		if err == dbr.ErrNotFound {
+			return dbmodel.WebhookDeliveryDTO{}, dberr.NotFound("Cannot find webhook delivery with ID:'%s'", id)
+		}
+		return dbmodel.WebhookDeliveryDTO{}, dberr.Internal("Failed to get webhook delivery: %s", err)
+	}
+	return delivery, nil
+}
+
+func (r readSession) ListWebhookDeliveries(filter dbmodel.WebhookDeliveryFilter) ([]dbmodel.WebhookDeliveryDTO, int, int, error) {
+	var deliveries []dbmodel.WebhookDeliveryDTO
+
+	stmt := r.session.Select("*").
+		From(WebhookDeliveryTableName).
+		OrderBy(CreatedAtField)
+
+	if filter.Page > 0 && filter.PageSize > 0 {
+		stmt.Paginate(uint64(filter.Page), uint64(filter.PageSize))
+	}
+	addWebhookDeliveryFilters(stmt, filter)
+
+	_, err := stmt.Load(&deliveries)
+	if err != nil {
+		return nil, -1, -1, dberr.Internal("Failed to get webhook deliveries: %s", err)
+	}
+
+	var res struct {
+		Total int
+	}
+	countStmt := r.session.Select("count(*) as total").From(WebhookDeliveryTableName)
+	addWebhookDeliveryFilters(countStmt, filter)
+	err = countStmt.LoadOne(&res)
+	if err != nil {
+		return nil, -1, -1, dberr.Internal("Failed to count webhook deliveries: %s", err)
+	}
+
+	return deliveries, len(deliveries), res.Total, nil
+}
+
+func addWebhookDeliveryFilters(stmt *dbr.SelectStmt, filter dbmodel.WebhookDeliveryFilter) {
+	if len(filter.States) > 0 {
+		stmt.Where("state IN ?", filter.States)
+	}
+	if len(filter.Subscriptions) > 0 {
+		stmt.Where("subscription IN ?", filter.Subscriptions)
+	}
+	if len(filter.EventTypes) > 0 {
+		stmt.Where("event_type IN ?", filter.EventTypes)
+	}
+}
+
+func (r readSession) ListOperationSteps(operationID string) ([]dbmodel.OperationStepDTO, dberr.Error) {
+	var steps []dbmodel.OperationStepDTO
+
+	_, err := r.session.Select("*").
+		From(OperationStepTableName).
+		Where(dbr.Eq("operation_id", operationID)).
+		OrderBy("started_at").
+		Load(&steps)
+	if err != nil {
+		return nil, dberr.Internal("Failed to get steps of operation %s: %s", operationID, err)
+	}
+	return steps, nil
 }
 
 func (r readSession) getInstanceCount(filter dbmodel.InstanceFilter) (int, error) {
@@ -889,3 +1040,150 @@ func (r readSession) getOrchestrationCount(filter dbmodel.OrchestrationFilter) (
 
 	return res.Total, err
 }
+
+func (r readSession) GetBindingByID(bindingID string) (dbmodel.BindingDTO, dberr.Error) {
+	var binding dbmodel.BindingDTO
+
+	err := r.session.
+		Select("*").
+		From(BindingTableName).
+		Where(dbr.Eq("id", bindingID)).
+		LoadOne(&binding)
+	if err != nil {
+		if err == dbr.ErrNotFound {
+			return dbmodel.BindingDTO{}, dberr.NotFound("Cannot find binding with ID:'%s'", bindingID)
+		}
+		return dbmodel.BindingDTO{}, dberr.Internal("Failed to get binding: %s", err)
+	}
+	return binding, nil
+}
+
+func (r readSession) ListBindingsByInstanceID(instanceID string) ([]dbmodel.BindingDTO, dberr.Error) {
+	var bindings []dbmodel.BindingDTO
+
+	_, err := r.session.
+		Select("*").
+		From(BindingTableName).
+		Where(dbr.Eq("instance_id", instanceID)).
+		OrderBy(CreatedAtField).
+		Load(&bindings)
+	if err != nil {
+		return nil, dberr.Internal("Failed to get bindings of instance %s: %s", instanceID, err)
+	}
+	return bindings, nil
+}
+
+func (r readSession) ListBindingsByState(state string) ([]dbmodel.BindingDTO, dberr.Error) {
+	var bindings []dbmodel.BindingDTO
+
+	_, err := r.session.
+		Select("*").
+		From(BindingTableName).
+		Where(dbr.Eq("state", state)).
+		OrderBy(CreatedAtField).
+		Load(&bindings)
+	if err != nil {
+		return nil, dberr.Internal("Failed to get bindings in state %s: %s", state, err)
+	}
+	return bindings, nil
+}
+
+type encryptedColumn struct {
+	table    string
+	idColumn string
+	column   string
+}
+
+var encryptedColumns = map[dbmodel.EncryptedDataKind]encryptedColumn{
+	dbmodel.InstanceParametersData:       {table: InstancesTableName, idColumn: "instance_id", column: "provisioning_parameters"},
+	dbmodel.OperationParametersData:      {table: OperationTableName, idColumn: "id", column: "provisioning_parameters"},
+	dbmodel.RuntimeStateKymaConfigData:   {table: RuntimeStateTableName, idColumn: "id", column: "kyma_config"},
+	dbmodel.RuntimeStateClusterSetupData: {table: RuntimeStateTableName, idColumn: "id", column: "cluster_setup"},
+	dbmodel.BindingKubeconfigData:        {table: BindingTableName, idColumn: "id", column: "kubeconfig"},
+}
+
+func (r readSession) ListEncryptedData(kind dbmodel.EncryptedDataKind, afterID string, limit int) ([]dbmodel.EncryptedDataDTO, dberr.Error) {
+	c, found := encryptedColumns[kind]
+	if !found {
+		return nil, dberr.Internal("Unknown kind of encrypted data: %s", kind)
+	}
+	var data []dbmodel.EncryptedDataDTO
+
+	_, err := r.session.
+		Select(fmt.Sprintf("%s AS id", c.idColumn), fmt.Sprintf("COALESCE(%s, '') AS data", c.column)).
+		From(c.table).
+		Where(dbr.Gt(c.idColumn, afterID)).
+		OrderBy(c.idColumn).
+		Limit(uint64(limit)).
+		Load(&data)
+	if err != nil {
+		return nil, dberr.Internal("Failed to get %s: %s", kind, err)
+	}
+	return data, nil
+}
+
+func (r readSession) ListKubeconfigAuditRecords(filter dbmodel.KubeconfigAuditFilter) ([]dbmodel.KubeconfigAuditDTO, int, int, error) {
+	var records []dbmodel.KubeconfigAuditDTO
+
+	stmt := r.session.Select("*").
+		From(KubeconfigAuditTableName).
+		OrderDesc(CreatedAtField)
+
+	if filter.Page > 0 && filter.PageSize > 0 {
+		stmt.Paginate(uint64(filter.Page), uint64(filter.PageSize))
+	}
+	addKubeconfigAuditFilters(stmt, filter)
+
+	_, err := stmt.Load(&records)
+	if err != nil {
+		return nil, -1, -1, dberr.Internal("Failed to get kubeconfig audit records: %s", err)
+	}
+
+	var res struct {
+		Total int
+	}
+	countStmt := r.session.Select("count(*) as total").From(KubeconfigAuditTableName)
+	addKubeconfigAuditFilters(countStmt, filter)
+	err = countStmt.LoadOne(&res)
+	if err != nil {
+		return nil, -1, -1, dberr.Internal("Failed to count kubeconfig audit records: %s", err)
+	}
+
+	return records, len(records), res.Total, nil
+}
+
+func addKubeconfigAuditFilters(stmt *dbr.SelectStmt, filter dbmodel.KubeconfigAuditFilter) {
+	if len(filter.InstanceIDs) > 0 {
+		stmt.Where("instance_id IN ?", filter.InstanceIDs)
+	}
+	if len(filter.RuntimeIDs) > 0 {
+		stmt.Where("runtime_id IN ?", filter.RuntimeIDs)
+	}
+	if len(filter.UserIDs) > 0 {
+		stmt.Where("user_id IN ?", filter.UserIDs)
+	}
+	if len(filter.Sources) > 0 {
+		stmt.Where("source IN ?", filter.Sources)
+	}
+	if !filter.From.IsZero() {
+		stmt.Where(dbr.Gte(CreatedAtField, filter.From))
+	}
+	if !filter.To.IsZero() {
+		stmt.Where(dbr.Lte(CreatedAtField, filter.To))
+	}
+}
+
+// ListValidLeases returns the IDs of the leases which have not expired, the database clock is used like when the leases are acquired
+func (r readSession) ListValidLeases() ([]string, dberr.Error) {
+	var ids []string
+
+	_, err := r.session.
+		Select("id").
+		From(LeaseTableName).
+		Where("expires_at >= now()").
+		Load(&ids)
+	if err != nil {
+		return nil, dberr.Internal("Failed to get valid leases: %s", err)
+	}
+	return ids, nil
+}
@@ -1,6 +1,8 @@
 package postsql
 
 import (
+	"database/sql"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
@@ -113,6 +115,7 @@ func (ws writeSession) InsertOperation(op dbmodel.OperationDTO) dberr.Error {
 		Pair("orchestration_id", op.OrchestrationID.String).
 		Pair("provisioning_parameters", op.ProvisioningParameters.String).
 		Pair("finished_stages", op.FinishedStages).
+		Pair("next_retry_at", op.NextRetryAt).
 		Exec()
 
 	if err != nil {
@@ -220,6 +223,7 @@ func (ws writeSession) UpdateOperation(op dbmodel.OperationDTO) dberr.Error {
 		Set("orchestration_id", op.OrchestrationID.String).
 		Set("provisioning_parameters", op.ProvisioningParameters.String).
 		Set("finished_stages", op.FinishedStages).
+		Set("next_retry_at", op.NextRetryAt).
 		Exec()
 
 	if err != nil {
@@ -240,12 +244,16 @@ func (ws writeSession) UpdateOperation(op dbmodel.OperationDTO) dberr.Error {
 	return nil
 }
 
-func (ws writeSession) InsertEvent(level events.EventLevel, message, instanceID, operationID string) dberr.Error {
+func (ws writeSession) InsertEvent(level events.EventLevel, message string, source events.EventSource) dberr.Error {
 	_, err := ws.insertInto("events").
 		Pair("id", uuid.NewString()).
 		Pair("level", level).
-		Pair("instance_id", instanceID).
-		Pair("operation_id", operationID).
+		Pair("instance_id", source.InstanceID).
+		Pair("operation_id", source.OperationID).
+		Pair("global_account_id", nullString(source.GlobalAccountID)).
+		Pair("sub_account_id", nullString(source.SubAccountID)).
+		Pair("plan_id", nullString(source.PlanID)).
+		Pair("step_name", nullString(source.StepName)).
 		Pair("message", message).
 		Pair("created_at", time.Now()).
 		Exec()
@@ -265,6 +273,216 @@ func (ws writeSession) DeleteEvents(until time.Time) dberr.Error {
 	return nil
 }
 
+// AcquireLease inserts the lease or takes it over when it is already owned by the given owner or has expired.
+// The database clock is used to avoid problems with clock skew between replicas.
+func (ws writeSession) AcquireLease(id, owner string, ttl time.Duration) (bool, dberr.Error) {
+	query := fmt.Sprintf(`INSERT INTO %[1]s (id, owner, expires_at, updated_at) VALUES (?, ?, now() + make_interval(secs => ?), now())
+	ON CONFLICT (id) DO UPDATE SET owner = EXCLUDED.owner, expires_at = EXCLUDED.expires_at, updated_at = EXCLUDED.updated_at
+	WHERE %[1]s.owner = EXCLUDED.owner OR %[1]s.expires_at < now()`, LeaseTableName)
+
+	var stmt *dbr.InsertStmt
+	if ws.transaction != nil {
+		stmt = ws.transaction.InsertBySql(query, id, owner, ttl.Seconds())
+	} else {
+		stmt = ws.session.InsertBySql(query, id, owner, ttl.Seconds())
+	}
+	res, err := stmt.Exec()
+	if err != nil {
+		return false, dberr.Internal("Failed to acquire lease %s: %s", id, err)
+	}
+	rAffected, err := res.RowsAffected()
+	if err != nil {
+		return false, dberr.Internal("the DB driver does not support RowsAffected operation")
+	}
+
+	return rAffected == 1, nil
+}
+
+func (ws writeSession) RenewLeases(owner string, ids []string, ttl time.Duration) dberr.Error {
+	if len(ids) == 0 {
+		return nil
+	}
+	_, err := ws.update(LeaseTableName).
+		Set("expires_at", dbr.Expr("now() + make_interval(secs => ?)", ttl.Seconds())).
+		Set("updated_at", dbr.Expr("now()")).
+		Where(dbr.Eq("owner", owner)).
+		Where(dbr.Eq("id", ids)).
+		Exec()
+	if err != nil {
+		return dberr.Internal("Failed to renew leases owned by %s: %s", owner, err)
+	}
+
+	return nil
+}
+
+func (ws writeSession) ReleaseLease(id, owner string) dberr.Error {
+	_, err := ws.deleteFrom(LeaseTableName).
+		Where(dbr.Eq("id", id)).
+		Where(dbr.Eq("owner", owner)).
+		Exec()
+	if err != nil {
+		return dberr.Internal("Failed to release lease %s: %s", id, err)
+	}
+
+	return nil
+}
+
+func (ws writeSession) InsertFreeze(freeze dbmodel.FreezeDTO) dberr.Error {
+	_, err := ws.insertInto(FreezeTableName).
+		Pair("id", freeze.ID).
+		Pair("global_account_id", freeze.GlobalAccountID).
+		Pair("start_time", freeze.StartTime).
+		Pair("end_time", freeze.EndTime).
+		Pair("reason", freeze.Reason).
+		Pair("created_at", freeze.CreatedAt).
+		Exec()
+
+	if err != nil {
+		if err, ok := err.(*pq.Error); ok {
+			if err.Code == UniqueViolationErrorCode {
+				return dberr.AlreadyExists("Freeze with id %s already exist", freeze.ID)
+			}
+		}
+		return dberr.Internal("Failed to insert record to freezes table: %s", err)
+	}
+
+	return nil
+}
+
+func (ws writeSession) DeleteFreeze(id string) dberr.Error {
+	res, err := ws.deleteFrom(FreezeTableName).
+		Where(dbr.Eq("id", id)).
+		Exec()
+	if err != nil {
+		return dberr.Internal("Failed to delete freeze %s: %s", id, err)
+	}
+	rAffected, err := res.RowsAffected()
+	if err != nil {
+		return dberr.Internal("Failed to delete freeze %s: %s", id, err)
+	}
+	if rAffected == 0 {
+		return dberr.NotFound("Freeze with id %s does not exist", id)
+	}
+
+	return nil
+}
+
+func (ws writeSession) InsertWebhookDelivery(delivery dbmodel.WebhookDeliveryDTO) dberr.Error {
+	_, err := ws.insertInto(WebhookDeliveryTableName).
+		Pair("id", delivery.ID).
+		Pair("subscription", delivery.Subscription).
+		Pair("event_type", delivery.EventType).
+		Pair("payload", delivery.Payload).
+		Pair("state", delivery.State).
+		Pair("attempts", delivery.Attempts).
+		Pair("last_error", delivery.LastError).
+		Pair("created_at", delivery.CreatedAt).
+		Pair("updated_at", delivery.UpdatedAt).
+		Exec()
+
+	if err != nil {
+		if err, ok := err.(*pq.Error); ok {
+			if err.Code == UniqueViolationErrorCode {
+				return dberr.AlreadyExists("Webhook delivery with id %s already exist", delivery.ID)
+			}
+		}
+		return dberr.Internal("Failed to insert record to webhook deliveries table: %s", err)
+	}
+
+	return nil
+}
+
+func (ws writeSession) UpdateWebhookDelivery(delivery dbmodel.WebhookDeliveryDTO) dberr.Error {
+	res, err := ws.update(WebhookDeliveryTableName).
+		Where(dbr.Eq("id", delivery.ID)).
+		Set("state", delivery.State).
+		Set("attempts", delivery.Attempts).
+		Set("last_error", delivery.LastError).
+		Set("updated_at", delivery.UpdatedAt).
+		Exec()
+	if err != nil {
+		return dberr.Internal("Failed to update webhook delivery %s: %s", delivery.ID, err)
+	}
+	rAffected, err := res.RowsAffected()
+	if err != nil {
+		return dberr.Internal("Failed to update webhook delivery %s: %s", delivery.ID, err)
+	}
+	if rAffected == 0 {
+		return dberr.NotFound("Webhook delivery with id %s does not exist", delivery.ID)
+	}
+
+	return nil
+}
+
+// RecordOperationStepAttempt inserts the step record or adds the attempt to the existing one in a single statement,
+// so attempts recorded concurrently are not lost
+func (ws writeSession) RecordOperationStepAttempt(attempt dbmodel.OperationStepDTO) dberr.Error {
+	query := fmt.Sprintf(`INSERT INTO %[1]s (operation_id, step_name, state, attempts, last_error, next_delay, processing_time, started_at, finished_at)
+	VALUES (?, ?, ?, 1, ?, ?, ?, ?, ?)
+	ON CONFLICT (operation_id, step_name) DO UPDATE SET
+		state = EXCLUDED.state,
+		attempts = %[1]s.attempts + 1,
+		last_error = COALESCE(EXCLUDED.last_error, %[1]s.last_error),
+		next_delay = EXCLUDED.next_delay,
+		processing_time = %[1]s.processing_time + EXCLUDED.processing_time,
+		started_at = LEAST(%[1]s.started_at, EXCLUDED.started_at),
+		finished_at = GREATEST(%[1]s.finished_at, EXCLUDED.finished_at)`, OperationStepTableName)
+
+	args := []interface{}{attempt.OperationID, attempt.StepName, attempt.State, attempt.LastError,
+		attempt.NextDelay, attempt.ProcessingTime, attempt.StartedAt, attempt.FinishedAt}
+	var stmt *dbr.InsertStmt
+	if ws.transaction != nil {
+		stmt = ws.transaction.InsertBySql(query, args...)
+	} else {
+		stmt = ws.session.InsertBySql(query, args...)
+	}
+	if _, err := stmt.Exec(); err != nil {
+		return dberr.Internal("Failed to record attempt of step %s of operation %s: %s", attempt.StepName, attempt.OperationID, err)
+	}
+
+	return nil
+}
+
+func (ws writeSession) InsertKubeconfigAuditRecord(record dbmodel.KubeconfigAuditDTO) dberr.Error {
+	_, err := ws.insertInto(KubeconfigAuditTableName).
+		Pair("id", record.ID).
+		Pair("source", record.Source).
+		Pair("user_id", record.UserID).
+		Pair("issuer", record.Issuer).
+		Pair("groups", record.Groups).
+		Pair("instance_id", record.InstanceID).
+		Pair("runtime_id", record.RuntimeID).
+		Pair("global_account_id", record.GlobalAccountID).
+		Pair("sub_account_id", record.SubAccountID).
+		Pair("role", record.Role).
+		Pair("source_ip", record.SourceIP).
+		Pair("user_agent", record.UserAgent).
+		Pair("expires_at", record.ExpiresAt).
+		Pair("created_at", record.CreatedAt).
+		Exec()
+
+	if err != nil {
+		if err, ok := err.(*pq.Error); ok {
+			if err.Code == UniqueViolationErrorCode {
+				return dberr.AlreadyExists("Kubeconfig audit record with id %s already exist", record.ID)
+			}
+		}
+		return dberr.Internal("Failed to insert record to kubeconfig audit table: %s", err)
+	}
+
+	return nil
+}
+
+func (ws writeSession) DeleteKubeconfigAuditRecords(until time.Time) dberr.Error {
+	_, err := ws.deleteFrom(KubeconfigAuditTableName).
+		Where(dbr.Lt(CreatedAtField, until)).
+		Exec()
+	if err != nil {
+		return dberr.Internal("failed to delete kubeconfig audit records created before %v: %v", until.Format(time.RFC1123Z), err)
+	}
+	return nil
+}
+
 func (ws writeSession) Commit() dberr.Error {
 	err := ws.transaction.Commit()
 	if err != nil {
@@ -301,3 +519,92 @@ func (ws writeSession) update(table string) *dbr.UpdateStmt {
 
 	return ws.session.Update(table)
 }
+
+// nullString stores empty values as NULL
+func nullString(value string) sql.NullString {
+	return sql.NullString{String: value, Valid: value != ""}
+}
+
+func (ws writeSession) InsertBinding(binding dbmodel.BindingDTO) dberr.Error {
+	_, err := ws.insertInto(BindingTableName).
+		Pair("id", binding.ID).
+		Pair("instance_id", binding.InstanceID).
+		Pair("expiration_seconds", binding.ExpirationSeconds).
+		Pair("kubeconfig", binding.Kubeconfig).
+		Pair("expires_at", binding.ExpiresAt).
+		Pair("last_operation", binding.LastOperation).
+		Pair("state", binding.State).
+		Pair("description", binding.Description).
+		Pair("created_at", binding.CreatedAt).
+		Pair("updated_at", binding.UpdatedAt).
+		Exec()
+
+	if err != nil {
+		if err, ok := err.(*pq.Error); ok {
+			if err.Code == UniqueViolationErrorCode {
+				return dberr.AlreadyExists("Binding with id %s already exist", binding.ID)
+			}
+		}
+		return dberr.Internal("Failed to insert record to bindings table: %s", err)
+	}
+
+	return nil
+}
+
+func (ws writeSession) UpdateBinding(binding dbmodel.BindingDTO) dberr.Error {
+	res, err := ws.update(BindingTableName).
+		Where(dbr.Eq("id", binding.ID)).
+		Set("kubeconfig", binding.Kubeconfig).
+		Set("expires_at", binding.ExpiresAt).
+		Set("last_operation", binding.LastOperation).
+		Set("state", binding.State).
+		Set("description", binding.Description).
+		Set("updated_at", binding.UpdatedAt).
+		Exec()
+	if err != nil {
+		return dberr.Internal("Failed to update binding %s: %s", binding.ID, err)
+	}
+	rAffected, err := res.RowsAffected()
+	if err != nil {
+		return dberr.Internal("Failed to update binding %s: %s", binding.ID, err)
+	}
+	if rAffected == 0 {
+		return dberr.NotFound("Binding with id %s does not exist", binding.ID)
+	}
+
+	return nil
+}
+
+func (ws writeSession) DeleteBinding(bindingID string) dberr.Error {
+	_, err := ws.deleteFrom(BindingTableName).
+		Where(dbr.Eq("id", bindingID)).
+		Exec()
+	if err != nil {
+		return dberr.Internal("Failed to delete binding %s: %s", bindingID, err)
+	}
+
+	return nil
+}
+
+// ReplaceEncryptedData sets the new data only if the stored data was not changed in the meantime,
+// the returned flag tells if the data was replaced
+func (ws writeSession) ReplaceEncryptedData(kind dbmodel.EncryptedDataKind, id, oldData, newData string) (bool, dberr.Error) {
+	c, found := encryptedColumns[kind]
+	if !found {
+		return false, dberr.Internal("Unknown kind of encrypted data: %s", kind)
+	}
+	res, err := ws.update(c.table).
+		Where(dbr.Eq(c.idColumn, id)).
+		Where(dbr.Eq(c.column, oldData)).
+		Set(c.column, newData).
+		Exec()
+	if err != nil {
+		return false, dberr.Internal("Failed to replace %s of %s: %s", kind, id, err)
+	}
+	rAffected, err := res.RowsAffected()
+	if err != nil {
+		return false, dberr.Internal("Failed to replace %s of %s: %s", kind, id, err)
+	}
+
+	return rAffected > 0, nil
+}
@@ -1,13 +1,9 @@
 package storage
 
 import (
-	"log"
-	"time"
-
 	"github.com/gocraft/dbr"
 	"github.com/sirupsen/logrus"
 
-	eventsapi "github.com/kyma-project/control-plane/components/kyma-environment-broker/common/events"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/events"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/driver/memory"
 	postgres "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/driver/postsql"
@@ -23,6 +19,13 @@ type BrokerStorage interface {
 	Orchestrations() Orchestrations
 	RuntimeStates() RuntimeStates
 	Events() Events
+	Leases() Leases
+	Freezes() Freezes
+	WebhookDeliveries() WebhookDeliveries
+	OperationSteps() OperationSteps
+	Bindings() Bindings
+	Reencryption() Reencryption
+	KubeconfigAudits() KubeconfigAudits
 }
 
 const (
@@ -51,6 +54,13 @@ func NewFromConfig(cfg Config, evcfg events.Config, cipher postgres.Cipher, log
 		orchestrations: postgres.NewOrchestrations(fact),
 		runtimeStates:  postgres.NewRuntimeStates(fact, cipher),
 		events:         events.New(evcfg, eventstorage.New(fact, log)),
+		leases:         postgres.NewLeases(fact),
+		freezes:        postgres.NewFreezes(fact),
+		deliveries:     postgres.NewWebhookDeliveries(fact),
+		steps:          postgres.NewOperationSteps(fact),
+		bindings:       postgres.NewBindings(fact, cipher),
+		reencryption:   postgres.NewReencryption(fact, cipher),
+		kcAudits:       postgres.NewKubeconfigAudits(fact),
 	}, connection, nil
 }
 
@@ -61,56 +71,15 @@ func NewMemoryStorage() BrokerStorage {
 		instance:       memory.NewInstance(op),
 		orchestrations: memory.NewOrchestrations(),
 		runtimeStates:  memory.NewRuntimeStates(),
-		events:         events.New(events.Config{}, NewInMemoryEvents()),
-	}
-}
-
-type inMemoryEvents struct {
-	events []eventsapi.EventDTO
-}
-
-func NewInMemoryEvents() *inMemoryEvents {
-	return &inMemoryEvents{
-		events: make([]eventsapi.EventDTO, 0),
-	}
-}
-
-func (_ inMemoryEvents) RunGarbageCollection(pollingPeriod, retention time.Duration) {
-	return
-}
-
-func (e *inMemoryEvents) InsertEvent(eventLevel eventsapi.EventLevel, message, instanceID, operationID string) {
-	e.events = append(e.events, eventsapi.EventDTO{Level: eventLevel, InstanceID: &instanceID, OperationID: &operationID, Message: message})
-	log.Printf("EVENT [%v/%v] %v: %v\n", instanceID, operationID, eventLevel, message)
-}
-
-func (e *inMemoryEvents) ListEvents(filter eventsapi.EventFilter) ([]eventsapi.EventDTO, error) {
-	var events []eventsapi.EventDTO
-	for _, ev := range e.events {
-		if !requiredContains(ev.InstanceID, filter.InstanceIDs) {
-			continue
-		}
-		if !requiredContains(ev.OperationID, filter.OperationIDs) {
-			continue
-		}
-		events = append(events, ev)
-	}
-	return events, nil
-}
-
-func requiredContains[T comparable](el *T, sl []T) bool {
-	if len(sl) == 0 {
-		return true
+		events:         events.New(events.Config{}, memory.NewEvents()),
+		leases:         memory.NewLeases(),
+		freezes:        memory.NewFreezes(),
+		deliveries:     memory.NewWebhookDeliveries(),
+		steps:          memory.NewOperationSteps(),
+		bindings:       memory.NewBindings(),
+		reencryption:   memory.NewReencryption(),
+		kcAudits:       memory.NewKubeconfigAudits(),
 	}
-	if el == nil {
-		return false
-	}
-	for _, x := range sl {
-		if *el == x {
-			return true
-		}
-	}
-	return false
 }
 
 type storage struct {
@@ -119,6 +88,13 @@ type storage struct {
 	orchestrations Orchestrations
 	runtimeStates  RuntimeStates
 	events         Events
+	leases         Leases
+	freezes        Freezes
+	deliveries     WebhookDeliveries
+	steps          OperationSteps
+	bindings       Bindings
+	reencryption   Reencryption
+	kcAudits       KubeconfigAudits
 }
 
 func (s storage) Instances() Instances {
@@ -148,3 +124,31 @@ func (s storage) RuntimeStates() RuntimeStates {
 func (s storage) Events() Events {
 	return s.events
 }
+
+func (s storage) Leases() Leases {
+	return s.leases
+}
+
+func (s storage) Freezes() Freezes {
+	return s.freezes
+}
+
+func (s storage) WebhookDeliveries() WebhookDeliveries {
+	return s.deliveries
+}
+
+func (s storage) OperationSteps() OperationSteps {
+	return s.steps
+}
+
+func (s storage) Bindings() Bindings {
+	return s.bindings
+}
+
+func (s storage) Reencryption() Reencryption {
+	return s.reencryption
+}
+
+func (s storage) KubeconfigAudits() KubeconfigAudits {
+	return s.kcAudits
+}
@@ -0,0 +1,81 @@
+package webhook
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+type Config struct {
+	Enabled bool `envconfig:"default=false"`
+	// SubscriptionsFilePath points to the YAML file with the list of subscriptions
+	SubscriptionsFilePath string `envconfig:"optional"`
+	// MaxAttempts defines after how many failed attempts the delivery is moved to the dead state
+	MaxAttempts    int           `envconfig:"default=10"`
+	InitialBackoff time.Duration `envconfig:"default=10s"`
+	MaxBackoff     time.Duration `envconfig:"default=30m"`
+	Timeout        time.Duration `envconfig:"default=10s"`
+	Workers        int           `envconfig:"default=5"`
+}
+
+// Subscription defines an external endpoint notified about the selected lifecycle events
+type Subscription struct {
+	Name string `yaml:"name"`
+	URL  string `yaml:"url"`
+	// Events lists the event types sent to the endpoint, all events are sent if empty
+	Events []string `yaml:"events"`
+	// Secret is used to sign the payload with HMAC-SHA256
+	Secret string `yaml:"secret"`
+}
+
+func (s Subscription) Accepts(eventType string) bool {
+	if len(s.Events) == 0 {
+		return true
+	}
+	for _, e := range s.Events {
+		if e == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// ReadSubscriptionsFromFile reads and validates the webhook subscriptions
+func ReadSubscriptionsFromFile(path string) ([]Subscription, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("while reading webhook subscriptions file: %w", err)
+	}
+
+	var subscriptions []Subscription
+	if err := yaml.Unmarshal(content, &subscriptions); err != nil {
+		return nil, fmt.Errorf("while unmarshalling webhook subscriptions: %w", err)
+	}
+
+	names := map[string]struct{}{}
+	for _, s := range subscriptions {
+		if s.Name == "" {
+			return nil, fmt.Errorf("webhook subscription name must not be empty")
+		}
+		if _, exists := names[s.Name]; exists {
+			return nil, fmt.Errorf("webhook subscription %s is defined more than once", s.Name)
+		}
+		names[s.Name] = struct{}{}
+		if u, err := url.Parse(s.URL); err != nil || u.Scheme == "" || u.Host == "" {
+			return nil, fmt.Errorf("webhook subscription %s has invalid URL %q", s.Name, s.URL)
+		}
+		if s.Secret == "" {
+			return nil, fmt.Errorf("webhook subscription %s has no secret", s.Name)
+		}
+		for _, e := range s.Events {
+			if !IsEventType(e) {
+				return nil, fmt.Errorf("webhook subscription %s has unknown event type %q", s.Name, e)
+			}
+		}
+	}
+
+	return subscriptions, nil
+}
@@ -0,0 +1,20 @@
+package webhook
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadSubscriptionsFromFile(t *testing.T) {
+	// when
+	subscriptions, err := ReadSubscriptionsFromFile("test/subscriptions.yaml")
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, subscriptions, 2)
+	assert.True(t, subscriptions[0].Accepts(OrchestrationStateChanged))
+	assert.True(t, subscriptions[1].Accepts(SuspensionFailed))
+	assert.False(t, subscriptions[1].Accepts(ProvisioningSucceeded))
+}
@@ -0,0 +1,50 @@
+package webhook
+
+import (
+	"time"
+)
+
+const (
+	ProvisioningSucceeded     = "provisioning.succeeded"
+	ProvisioningFailed        = "provisioning.failed"
+	DeprovisioningSucceeded   = "deprovisioning.succeeded"
+	DeprovisioningFailed      = "deprovisioning.failed"
+	UpdateSucceeded           = "update.succeeded"
+	UpdateFailed              = "update.failed"
+	SuspensionSucceeded       = "suspension.succeeded"
+	SuspensionFailed          = "suspension.failed"
+	OrchestrationStateChanged = "orchestration.stateChanged"
+)
+
+var eventTypes = []string{
+	ProvisioningSucceeded, ProvisioningFailed,
+	DeprovisioningSucceeded, DeprovisioningFailed,
+	UpdateSucceeded, UpdateFailed,
+	SuspensionSucceeded, SuspensionFailed,
+	OrchestrationStateChanged,
+}
+
+func IsEventType(eventType string) bool {
+	for _, t := range eventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// Event is the payload sent to the webhook subscriptions
+type Event struct {
+	ID              string    `json:"id"`
+	Type            string    `json:"type"`
+	OccurredAt      time.Time `json:"occurredAt"`
+	InstanceID      string    `json:"instanceID,omitempty"`
+	RuntimeID       string    `json:"runtimeID,omitempty"`
+	GlobalAccountID string    `json:"globalAccountID,omitempty"`
+	SubAccountID    string    `json:"subAccountID,omitempty"`
+	PlanID          string    `json:"planID,omitempty"`
+	OperationID     string    `json:"operationID,omitempty"`
+	OrchestrationID string    `json:"orchestrationID,omitempty"`
+	State           string    `json:"state"`
+	Description     string    `json:"description,omitempty"`
+}
@@ -0,0 +1,160 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/pagination"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/httputil"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	StateParam        = "state"
+	SubscriptionParam = "subscription"
+	EventTypeParam    = "event_type"
+)
+
+type DeliveryDTO struct {
+	ID           string          `json:"id"`
+	Subscription string          `json:"subscription"`
+	EventType    string          `json:"eventType"`
+	State        string          `json:"state"`
+	Attempts     int             `json:"attempts"`
+	LastError    string          `json:"lastError,omitempty"`
+	Payload      json.RawMessage `json:"payload"`
+	CreatedAt    time.Time       `json:"createdAt"`
+	UpdatedAt    time.Time       `json:"updatedAt"`
+}
+
+type DeliveryListResponse struct {
+	Count      int           `json:"count"`
+	TotalCount int           `json:"totalCount"`
+	Data       []DeliveryDTO `json:"data"`
+}
+
+type Handler struct {
+	deliveries     storage.WebhookDeliveries
+	queue          Queue
+	defaultMaxPage int
+	log            logrus.FieldLogger
+}
+
+// NewHandler exposes the webhook deliveries and allows to redeliver the dead ones
+func NewHandler(deliveries storage.WebhookDeliveries, queue Queue, defaultMaxPage int, log logrus.FieldLogger) *Handler {
+	return &Handler{
+		deliveries:     deliveries,
+		queue:          queue,
+		defaultMaxPage: defaultMaxPage,
+		log:            log,
+	}
+}
+
+func (h *Handler) AttachRoutes(router *mux.Router) {
+	router.HandleFunc("/webhooks/deliveries", h.listDeliveries).Methods(http.MethodGet)
+	router.HandleFunc("/webhooks/deliveries/{delivery_id}", h.getDelivery).Methods(http.MethodGet)
+	router.HandleFunc("/webhooks/deliveries/{delivery_id}/retry", h.retryDelivery).Methods(http.MethodPost)
+}
+
+func (h *Handler) listDeliveries(w http.ResponseWriter, r *http.Request) {
+	pageSize, page, err := pagination.ExtractPaginationConfigFromRequest(r, h.defaultMaxPage)
+	if err != nil {
+		httputil.WriteErrorResponse(w, http.StatusBadRequest, fmt.Errorf("while getting query parameters: %w", err))
+		return
+	}
+	query := r.URL.Query()
+	filter := dbmodel.WebhookDeliveryFilter{
+		Page:          page,
+		PageSize:      pageSize,
+		States:        query[StateParam],
+		Subscriptions: query[SubscriptionParam],
+		EventTypes:    query[EventTypeParam],
+	}
+
+	deliveries, count, totalCount, err := h.deliveries.List(filter)
+	if err != nil {
+		h.log.Errorf("while getting webhook deliveries: %v", err)
+		httputil.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Errorf("while getting webhook deliveries: %w", err))
+		return
+	}
+
+	response := DeliveryListResponse{
+		Count:      count,
+		TotalCount: totalCount,
+		Data:       make([]DeliveryDTO, 0, len(deliveries)),
+	}
+	for _, d := range deliveries {
+		response.Data = append(response.Data, deliveryToDTO(d))
+	}
+	httputil.WriteResponse(w, http.StatusOK, response)
+}
+
+func (h *Handler) getDelivery(w http.ResponseWriter, r *http.Request) {
+	deliveryID := mux.Vars(r)["delivery_id"]
+
+	delivery, err := h.deliveries.GetByID(deliveryID)
+	if err != nil {
+		h.log.Errorf("while getting webhook delivery %s: %v", deliveryID, err)
+		httputil.WriteErrorResponse(w, errorStatus(err), fmt.Errorf("while getting webhook delivery %s: %w", deliveryID, err))
+		return
+	}
+
+	httputil.WriteResponse(w, http.StatusOK, deliveryToDTO(*delivery))
+}
+
+// retryDelivery moves the dead delivery back to the pending state and sends it again
+func (h *Handler) retryDelivery(w http.ResponseWriter, r *http.Request) {
+	deliveryID := mux.Vars(r)["delivery_id"]
+
+	delivery, err := h.deliveries.GetByID(deliveryID)
+	if err != nil {
+		h.log.Errorf("while getting webhook delivery %s: %v", deliveryID, err)
+		httputil.WriteErrorResponse(w, errorStatus(err), fmt.Errorf("while getting webhook delivery %s: %w", deliveryID, err))
+		return
+	}
+	if delivery.State != internal.WebhookDeliveryDead {
+		httputil.WriteErrorResponse(w, http.StatusBadRequest, fmt.Errorf("webhook delivery in state %q cannot be retried, only dead deliveries can be retried", delivery.State))
+		return
+	}
+
+	delivery.State = internal.WebhookDeliveryPending
+	delivery.Attempts = 0
+	delivery.UpdatedAt = time.Now()
+	err = h.deliveries.Update(*delivery)
+	if err != nil {
+		h.log.Errorf("while updating webhook delivery %s: %v", deliveryID, err)
+		httputil.WriteErrorResponse(w, errorStatus(err), fmt.Errorf("while updating webhook delivery %s: %w", deliveryID, err))
+		return
+	}
+	h.queue.Add(delivery.ID)
+
+	httputil.WriteResponse(w, http.StatusAccepted, deliveryToDTO(*delivery))
+}
+
+func deliveryToDTO(d internal.WebhookDelivery) DeliveryDTO {
+	return DeliveryDTO{
+		ID:           d.ID,
+		Subscription: d.Subscription,
+		EventType:    d.EventType,
+		State:        d.State,
+		Attempts:     d.Attempts,
+		LastError:    d.LastError,
+		Payload:      json.RawMessage(d.Payload),
+		CreatedAt:    d.CreatedAt,
+		UpdatedAt:    d.UpdatedAt,
+	}
+}
+
+func errorStatus(err error) int {
+	if dberr.IsNotFound(err) {
+		return http.StatusNotFound
+	}
+	return http.StatusInternalServerError
+}
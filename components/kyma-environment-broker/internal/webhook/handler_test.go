@@ -0,0 +1,78 @@
+package webhook
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	// given
+	db := storage.NewMemoryStorage()
+	dead := fixDelivery("dead", "audit")
+	dead.State = internal.WebhookDeliveryDead
+	dead.Attempts = 10
+	dead.LastError = "calling https://audit.example.com/keb returned 503 status"
+	require.NoError(t, db.WebhookDeliveries().Insert(dead))
+	require.NoError(t, db.WebhookDeliveries().Insert(fixDelivery("pending", "audit")))
+	queue := &recordingQueue{}
+	router := mux.NewRouter()
+	NewHandler(db.WebhookDeliveries(), queue, 100, logrus.New()).AttachRoutes(router)
+
+	t.Run("should list dead deliveries", func(t *testing.T) {
+		// when
+		rr := serveRequest(router, http.MethodGet, "/webhooks/deliveries?state=dead")
+
+		// then
+		require.Equal(t, http.StatusOK, rr.Code)
+		var list DeliveryListResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &list))
+		require.Equal(t, 1, list.TotalCount)
+		assert.Equal(t, "dead", list.Data[0].ID)
+		assert.JSONEq(t, dead.Payload, string(list.Data[0].Payload))
+	})
+
+	t.Run("should not retry pending delivery", func(t *testing.T) {
+		// when
+		rr := serveRequest(router, http.MethodPost, "/webhooks/deliveries/pending/retry")
+
+		// then
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("should retry dead delivery", func(t *testing.T) {
+		// when
+		rr := serveRequest(router, http.MethodPost, "/webhooks/deliveries/dead/retry")
+
+		// then
+		require.Equal(t, http.StatusAccepted, rr.Code)
+		assert.Equal(t, []string{"dead"}, queue.ids)
+		stored, err := db.WebhookDeliveries().GetByID("dead")
+		require.NoError(t, err)
+		assert.Equal(t, internal.WebhookDeliveryPending, stored.State)
+		assert.Zero(t, stored.Attempts)
+	})
+
+	t.Run("should return not found for unknown delivery", func(t *testing.T) {
+		// when
+		rr := serveRequest(router, http.MethodGet, "/webhooks/deliveries/unknown")
+
+		// then
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
+
+func serveRequest(router *mux.Router, method, url string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, url, nil)
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+	return rr
+}
@@ -0,0 +1,170 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/event"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/sirupsen/logrus"
+)
+
+type Queue interface {
+	Add(id string)
+}
+
+// Notifier converts KEB lifecycle events into webhook deliveries. Every delivery is stored before it is queued,
+// so it is not lost when KEB is restarted before the delivery succeeds.
+type Notifier struct {
+	subscriptions []Subscription
+	deliveries    storage.WebhookDeliveries
+	queue         Queue
+	log           logrus.FieldLogger
+}
+
+func NewNotifier(subscriptions []Subscription, deliveries storage.WebhookDeliveries, queue Queue, log logrus.FieldLogger) *Notifier {
+	return &Notifier{
+		subscriptions: subscriptions,
+		deliveries:    deliveries,
+		queue:         queue,
+		log:           log,
+	}
+}
+
+// Subscribe registers the notifier for the lifecycle events, it must be called before any operation is processed
+func (n *Notifier) Subscribe(sub event.Subscriber) {
+	sub.Subscribe(process.OperationStepProcessed{}, n.OnOperationStepProcessed)
+	sub.Subscribe(process.OperationSucceeded{}, n.OnOperationSucceeded)
+	sub.Subscribe(process.OrchestrationStateChanged{}, n.OnOrchestrationStateChanged)
+}
+
+// ResumePending adds deliveries which were not finished before the restart to the given queue
+func (n *Notifier) ResumePending(queue Queue) error {
+	pending, _, _, err := n.deliveries.List(dbmodel.WebhookDeliveryFilter{States: []string{internal.WebhookDeliveryPending}})
+	if err != nil {
+		return fmt.Errorf("while listing pending webhook deliveries: %w", err)
+	}
+	for _, d := range pending {
+		queue.Add(d.ID)
+	}
+	n.log.Infof("Resumed %d pending webhook deliveries", len(pending))
+	return nil
+}
+
+func (n *Notifier) OnOperationStepProcessed(ctx context.Context, ev interface{}) error {
+	stepProcessed, ok := ev.(process.OperationStepProcessed)
+	if !ok {
+		return fmt.Errorf("expected process.OperationStepProcessed but got %+v", ev)
+	}
+
+	op := stepProcessed.Operation
+	// a failure outside of steps, e.g. a timeout, is published with the same old and new operation
+	if op.State != domain.Failed || (stepProcessed.OldOperation.State == domain.Failed && stepProcessed.StepName != "") {
+		return nil
+	}
+	eventType := operationEventType(op, false)
+	if eventType == "" {
+		return nil
+	}
+	return n.notify(operationEvent(eventType, op))
+}
+
+func (n *Notifier) OnOperationSucceeded(ctx context.Context, ev interface{}) error {
+	succeeded, ok := ev.(process.OperationSucceeded)
+	if !ok {
+		return fmt.Errorf("expected process.OperationSucceeded but got %+v", ev)
+	}
+
+	eventType := operationEventType(succeeded.Operation, true)
+	if eventType == "" {
+		return nil
+	}
+	return n.notify(operationEvent(eventType, succeeded.Operation))
+}
+
+func (n *Notifier) OnOrchestrationStateChanged(ctx context.Context, ev interface{}) error {
+	changed, ok := ev.(process.OrchestrationStateChanged)
+	if !ok {
+		return fmt.Errorf("expected process.OrchestrationStateChanged but got %+v", ev)
+	}
+
+	o := changed.Orchestration
+	return n.notify(Event{
+		Type:            OrchestrationStateChanged,
+		OrchestrationID: o.OrchestrationID,
+		State:           o.State,
+		Description:     o.Description,
+	})
+}
+
+func (n *Notifier) notify(ev Event) error {
+	ev.ID = uuid.New().String()
+	ev.OccurredAt = time.Now()
+	payload, err := json.Marshal(ev)
+	if err != nil {
+		return fmt.Errorf("while marshalling webhook event: %w", err)
+	}
+
+	for _, s := range n.subscriptions {
+		if !s.Accepts(ev.Type) {
+			continue
+		}
+		now := time.Now()
+		delivery := internal.WebhookDelivery{
+			ID:           uuid.New().String(),
+			Subscription: s.Name,
+			EventType:    ev.Type,
+			Payload:      string(payload),
+			State:        internal.WebhookDeliveryPending,
+			CreatedAt:    now,
+			UpdatedAt:    now,
+		}
+		if err := n.deliveries.Insert(delivery); err != nil {
+			n.log.Errorf("while storing %s webhook delivery for subscription %s: %s", ev.Type, s.Name, err)
+			continue
+		}
+		n.queue.Add(delivery.ID)
+	}
+	return nil
+}
+
+func operationEventType(op internal.Operation, succeeded bool) string {
+	var prefix string
+	switch {
+	case op.Type == internal.OperationTypeProvision:
+		prefix = "provisioning"
+	case op.Type == internal.OperationTypeDeprovision && op.Temporary:
+		prefix = "suspension"
+	case op.Type == internal.OperationTypeDeprovision:
+		prefix = "deprovisioning"
+	case op.Type == internal.OperationTypeUpdate:
+		prefix = "update"
+	default:
+		return ""
+	}
+	if succeeded {
+		return prefix + ".succeeded"
+	}
+	return prefix + ".failed"
+}
+
+func operationEvent(eventType string, op internal.Operation) Event {
+	return Event{
+		Type:            eventType,
+		InstanceID:      op.InstanceID,
+		RuntimeID:       op.RuntimeID,
+		GlobalAccountID: op.ProvisioningParameters.ErsContext.GlobalAccountID,
+		SubAccountID:    op.ProvisioningParameters.ErsContext.SubAccountID,
+		PlanID:          op.ProvisioningParameters.PlanID,
+		OperationID:     op.ID,
+		State:           string(op.State),
+		Description:     op.Description,
+	}
+}
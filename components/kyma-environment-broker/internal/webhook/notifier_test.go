@@ -0,0 +1,126 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingQueue struct {
+	mu  sync.Mutex
+	ids []string
+}
+
+func (q *recordingQueue) Add(id string) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.ids = append(q.ids, id)
+}
+
+func TestNotifier(t *testing.T) {
+	subscriptions := []Subscription{
+		{Name: "all", URL: "http://all", Secret: "s"},
+		{Name: "suspensions", URL: "http://suspensions", Secret: "s", Events: []string{SuspensionSucceeded, SuspensionFailed}},
+	}
+
+	t.Run("should create deliveries for matching subscriptions", func(t *testing.T) {
+		// given
+		db := storage.NewMemoryStorage()
+		queue := &recordingQueue{}
+		notifier := NewNotifier(subscriptions, db.WebhookDeliveries(), queue, logrus.New())
+		op := internal.Operation{ID: "op-1", InstanceID: "instance-1", Type: internal.OperationTypeDeprovision, Temporary: true, State: domain.Succeeded}
+		op.ProvisioningParameters.ErsContext.GlobalAccountID = "ga-1"
+
+		// when
+		err := notifier.OnOperationSucceeded(context.Background(), process.OperationSucceeded{Operation: op})
+
+		// then
+		require.NoError(t, err)
+		deliveries, _, total, err := db.WebhookDeliveries().List(dbmodel.WebhookDeliveryFilter{})
+		require.NoError(t, err)
+		assert.Equal(t, 2, total)
+		assert.Len(t, queue.ids, 2)
+
+		var ev Event
+		require.NoError(t, json.Unmarshal([]byte(deliveries[0].Payload), &ev))
+		assert.Equal(t, SuspensionSucceeded, ev.Type)
+		assert.Equal(t, "instance-1", ev.InstanceID)
+		assert.Equal(t, "ga-1", ev.GlobalAccountID)
+		assert.Equal(t, "op-1", ev.OperationID)
+	})
+
+	t.Run("should notify about failed operation once", func(t *testing.T) {
+		// given
+		db := storage.NewMemoryStorage()
+		queue := &recordingQueue{}
+		notifier := NewNotifier(subscriptions, db.WebhookDeliveries(), queue, logrus.New())
+		old := internal.Operation{ID: "op-1", Type: internal.OperationTypeProvision, State: domain.InProgress}
+		failed := old
+		failed.State = domain.Failed
+
+		// when
+		require.NoError(t, notifier.OnOperationStepProcessed(context.Background(), process.OperationStepProcessed{
+			StepProcessed: process.StepProcessed{StepName: "Create_Runtime"}, OldOperation: old, Operation: failed}))
+		require.NoError(t, notifier.OnOperationStepProcessed(context.Background(), process.OperationStepProcessed{
+			StepProcessed: process.StepProcessed{StepName: "Create_Runtime"}, OldOperation: failed, Operation: failed}))
+		require.NoError(t, notifier.OnOperationStepProcessed(context.Background(), process.OperationStepProcessed{
+			StepProcessed: process.StepProcessed{StepName: "Start"}, OldOperation: old, Operation: old}))
+
+		// then
+		deliveries, _, _, err := db.WebhookDeliveries().List(dbmodel.WebhookDeliveryFilter{})
+		require.NoError(t, err)
+		require.Len(t, deliveries, 1)
+		assert.Equal(t, ProvisioningFailed, deliveries[0].EventType)
+		assert.Equal(t, "all", deliveries[0].Subscription)
+	})
+
+	t.Run("should notify about orchestration state change", func(t *testing.T) {
+		// given
+		db := storage.NewMemoryStorage()
+		queue := &recordingQueue{}
+		notifier := NewNotifier(subscriptions, db.WebhookDeliveries(), queue, logrus.New())
+
+		// when
+		err := notifier.OnOrchestrationStateChanged(context.Background(), process.OrchestrationStateChanged{
+			Orchestration: internal.Orchestration{OrchestrationID: "o-1", State: orchestration.Paused},
+		})
+
+		// then
+		require.NoError(t, err)
+		deliveries, _, _, err := db.WebhookDeliveries().List(dbmodel.WebhookDeliveryFilter{})
+		require.NoError(t, err)
+		require.Len(t, deliveries, 1)
+		assert.Equal(t, OrchestrationStateChanged, deliveries[0].EventType)
+		assert.Contains(t, deliveries[0].Payload, `"state":"paused"`)
+	})
+
+	t.Run("should resume pending deliveries", func(t *testing.T) {
+		// given
+		db := storage.NewMemoryStorage()
+		queue := &recordingQueue{}
+		pending := fixDelivery("pending", "all")
+		delivered := fixDelivery("delivered", "all")
+		delivered.State = internal.WebhookDeliveryDelivered
+		require.NoError(t, db.WebhookDeliveries().Insert(pending))
+		require.NoError(t, db.WebhookDeliveries().Insert(delivered))
+		notifier := NewNotifier(subscriptions, db.WebhookDeliveries(), queue, logrus.New())
+
+		// when
+		err := notifier.ResumePending(queue)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"pending"}, queue.ids)
+	})
+}
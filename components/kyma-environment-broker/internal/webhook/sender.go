@@ -0,0 +1,148 @@
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	EventHeader     = "X-KEB-Event"
+	DeliveryHeader  = "X-KEB-Delivery"
+	TimestampHeader = "X-KEB-Timestamp"
+	// SignatureHeader contains the hex encoded HMAC-SHA256 of "<timestamp>.<body>" computed with the subscription secret
+	SignatureHeader = "X-KEB-Signature"
+)
+
+// Sender sends stored deliveries to the subscriptions. It is executed by the process.Queue, which retries
+// a failed delivery after the returned backoff.
+type Sender struct {
+	subscriptions map[string]Subscription
+	deliveries    storage.WebhookDeliveries
+	httpClient    *http.Client
+	cfg           Config
+	log           logrus.FieldLogger
+}
+
+func NewSender(cfg Config, subscriptions []Subscription, deliveries storage.WebhookDeliveries, log logrus.FieldLogger) *Sender {
+	subs := make(map[string]Subscription, len(subscriptions))
+	for _, s := range subscriptions {
+		subs[s.Name] = s
+	}
+	return &Sender{
+		subscriptions: subs,
+		deliveries:    deliveries,
+		httpClient:    &http.Client{Timeout: cfg.Timeout},
+		cfg:           cfg,
+		log:           log,
+	}
+}
+
+func (s *Sender) Execute(deliveryID string) (time.Duration, error) {
+	log := s.log.WithField("deliveryID", deliveryID)
+	delivery, err := s.deliveries.GetByID(deliveryID)
+	switch {
+	case dberr.IsNotFound(err):
+		log.Warnf("webhook delivery does not exist")
+		return 0, nil
+	case err != nil:
+		log.Errorf("while getting webhook delivery: %s", err)
+		return s.cfg.InitialBackoff, nil
+	}
+	if delivery.State != internal.WebhookDeliveryPending {
+		return 0, nil
+	}
+
+	log = log.WithFields(logrus.Fields{"subscription": delivery.Subscription, "eventType": delivery.EventType})
+	subscription, found := s.subscriptions[delivery.Subscription]
+	if found {
+		err = s.send(subscription, *delivery)
+	} else {
+		err = fmt.Errorf("subscription %s is not configured", delivery.Subscription)
+	}
+
+	delivery.Attempts++
+	delivery.UpdatedAt = time.Now()
+	var retryAfter time.Duration
+	switch {
+	case err == nil:
+		delivery.State = internal.WebhookDeliveryDelivered
+		delivery.LastError = ""
+	case !found || delivery.Attempts >= s.cfg.MaxAttempts:
+		log.Warnf("webhook delivery failed after %d attempts, moving it to the dead state: %s", delivery.Attempts, err)
+		delivery.State = internal.WebhookDeliveryDead
+		delivery.LastError = err.Error()
+	default:
+		retryAfter = s.backoff(delivery.Attempts)
+		log.Infof("webhook delivery attempt %d failed, retrying in %s: %s", delivery.Attempts, retryAfter, err)
+		delivery.LastError = err.Error()
+	}
+
+	if err := s.deliveries.Update(*delivery); err != nil {
+		log.Errorf("while updating webhook delivery: %s", err)
+		if retryAfter == 0 {
+			retryAfter = s.cfg.InitialBackoff
+		}
+	}
+	return retryAfter, nil
+}
+
+func (s *Sender) send(subscription Subscription, delivery internal.WebhookDelivery) error {
+	body := []byte(delivery.Payload)
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	req, err := http.NewRequest(http.MethodPost, subscription.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("while creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, delivery.EventType)
+	req.Header.Set(DeliveryHeader, delivery.ID)
+	req.Header.Set(TimestampHeader, timestamp)
+	req.Header.Set(SignatureHeader, "sha256="+Sign(subscription.Secret, timestamp, body))
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("while calling %s: %w", subscription.URL, err)
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 1024))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("calling %s returned %d status", subscription.URL, resp.StatusCode)
+	}
+	return nil
+}
+
+// backoff doubles the delay with every attempt up to the configured maximum
+func (s *Sender) backoff(attempts int) time.Duration {
+	delay := s.cfg.InitialBackoff
+	for i := 1; i < attempts && delay < s.cfg.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > s.cfg.MaxBackoff {
+		delay = s.cfg.MaxBackoff
+	}
+	return delay
+}
+
+// Sign returns the hex encoded HMAC-SHA256 signature of the timestamp and the body, receivers should compute
+// the same value and compare it with the SignatureHeader
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,135 @@
+package webhook
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSender_Execute(t *testing.T) {
+	t.Run("should deliver signed payload", func(t *testing.T) {
+		// given
+		var mu sync.Mutex
+		var received *http.Request
+		var body []byte
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+			received = r
+			body, _ = io.ReadAll(r.Body)
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		db := storage.NewMemoryStorage()
+		delivery := fixDelivery("d-1", "consumer")
+		require.NoError(t, db.WebhookDeliveries().Insert(delivery))
+		sender := NewSender(fixConfig(), []Subscription{{Name: "consumer", URL: server.URL, Secret: "s3cr3t"}}, db.WebhookDeliveries(), logrus.New())
+
+		// when
+		retry, err := sender.Execute("d-1")
+
+		// then
+		require.NoError(t, err)
+		assert.Zero(t, retry)
+		mu.Lock()
+		defer mu.Unlock()
+		assert.Equal(t, delivery.Payload, string(body))
+		assert.Equal(t, ProvisioningSucceeded, received.Header.Get(EventHeader))
+		assert.Equal(t, "d-1", received.Header.Get(DeliveryHeader))
+		assert.Equal(t, "sha256="+Sign("s3cr3t", received.Header.Get(TimestampHeader), body), received.Header.Get(SignatureHeader))
+
+		stored, err := db.WebhookDeliveries().GetByID("d-1")
+		require.NoError(t, err)
+		assert.Equal(t, internal.WebhookDeliveryDelivered, stored.State)
+		assert.Equal(t, 1, stored.Attempts)
+	})
+
+	t.Run("should retry with backoff and move to dead state", func(t *testing.T) {
+		// given
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		db := storage.NewMemoryStorage()
+		require.NoError(t, db.WebhookDeliveries().Insert(fixDelivery("d-1", "consumer")))
+		sender := NewSender(fixConfig(), []Subscription{{Name: "consumer", URL: server.URL, Secret: "s3cr3t"}}, db.WebhookDeliveries(), logrus.New())
+
+		// when
+		first, err := sender.Execute("d-1")
+		require.NoError(t, err)
+		second, err := sender.Execute("d-1")
+		require.NoError(t, err)
+		third, err := sender.Execute("d-1")
+		require.NoError(t, err)
+
+		// then
+		assert.Equal(t, time.Second, first)
+		assert.Equal(t, 2*time.Second, second)
+		assert.Zero(t, third)
+
+		stored, err := db.WebhookDeliveries().GetByID("d-1")
+		require.NoError(t, err)
+		assert.Equal(t, internal.WebhookDeliveryDead, stored.State)
+		assert.Equal(t, 3, stored.Attempts)
+		assert.Contains(t, stored.LastError, "503")
+	})
+
+	t.Run("should move delivery of not configured subscription to dead state", func(t *testing.T) {
+		// given
+		db := storage.NewMemoryStorage()
+		require.NoError(t, db.WebhookDeliveries().Insert(fixDelivery("d-1", "removed")))
+		sender := NewSender(fixConfig(), nil, db.WebhookDeliveries(), logrus.New())
+
+		// when
+		retry, err := sender.Execute("d-1")
+
+		// then
+		require.NoError(t, err)
+		assert.Zero(t, retry)
+		stored, err := db.WebhookDeliveries().GetByID("d-1")
+		require.NoError(t, err)
+		assert.Equal(t, internal.WebhookDeliveryDead, stored.State)
+	})
+}
+
+func TestSender_Backoff(t *testing.T) {
+	sender := NewSender(Config{InitialBackoff: 10 * time.Second, MaxBackoff: time.Minute}, nil, nil, logrus.New())
+
+	assert.Equal(t, 10*time.Second, sender.backoff(1))
+	assert.Equal(t, 20*time.Second, sender.backoff(2))
+	assert.Equal(t, 40*time.Second, sender.backoff(3))
+	assert.Equal(t, time.Minute, sender.backoff(4))
+	assert.Equal(t, time.Minute, sender.backoff(20))
+}
+
+func fixConfig() Config {
+	return Config{
+		MaxAttempts:    3,
+		InitialBackoff: time.Second,
+		MaxBackoff:     time.Minute,
+		Timeout:        time.Second,
+	}
+}
+
+func fixDelivery(id, subscription string) internal.WebhookDelivery {
+	return internal.WebhookDelivery{
+		ID:           id,
+		Subscription: subscription,
+		EventType:    ProvisioningSucceeded,
+		Payload:      `{"type":"provisioning.succeeded","instanceID":"instance-1"}`,
+		State:        internal.WebhookDeliveryPending,
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+}
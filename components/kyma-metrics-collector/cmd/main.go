@@ -17,7 +17,9 @@ import (
 
 	"github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/keb"
 
+	"github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/calculator"
 	"github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/edp"
+	"github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/outbox"
 	"k8s.io/client-go/util/workqueue"
 
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -61,6 +63,12 @@ func main() {
 	}
 	logger.Debugf("public cloud spec: %v", publicCloudSpecs)
 
+	// Load calculators of additional pricing dimensions
+	calculators, err := calculator.Load(cfg.MetricCalculators)
+	if err != nil {
+		logger.With(log.KeyResult, log.ValueFail).With(log.KeyError, err.Error()).Fatal("Load metric calculators")
+	}
+
 	secretClient, err := gardenersecret.NewClient(opts)
 	if err != nil {
 		logger.With(log.KeyResult, log.ValueFail).With(log.KeyError, err.Error()).Fatal("Generate client for gardener secrets")
@@ -97,6 +105,19 @@ func main() {
 
 	edpClient := edp.NewClient(edpConfig, logger)
 
+	// Creating outbox for the metrics which could not be sent to EDP
+	outboxConfig := new(outbox.Config)
+	if err := envconfig.Process("", outboxConfig); err != nil {
+		logger.With(log.KeyResult, log.ValueFail).With(log.KeyError, err.Error()).Fatal("Load outbox config")
+	}
+	var metricsOutbox *outbox.Outbox
+	if outboxConfig.Enabled() {
+		metricsOutbox, err = outbox.New(outboxConfig, logger)
+		if err != nil {
+			logger.With(log.KeyResult, log.ValueFail).With(log.KeyError, err.Error()).Fatal("Create outbox")
+		}
+	}
+
 	queue := workqueue.NewDelayingQueue()
 
 	kmcProcess := kmcprocess.Process{
@@ -113,6 +134,8 @@ func main() {
 		NodeConfig:      skrnode.Config{},
 		PVCConfig:       skrpvc.Config{},
 		SvcConfig:       skrsvc.Config{},
+		Outbox:          metricsOutbox,
+		Calculators:     calculators,
 	}
 
 	// Start execution
@@ -127,6 +150,7 @@ func main() {
 		writer.WriteHeader(http.StatusOK)
 	})
 	router.Path(metricsPath).Handler(promhttp.Handler())
+	service.NewConsumptionHandler(cache, logger).AttachRoutes(router)
 
 	kmcSvr := service.Server{
 		Addr:   fmt.Sprintf(":%d", opts.ListenAddr),
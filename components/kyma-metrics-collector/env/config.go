@@ -3,4 +3,6 @@ package env
 // Config contains the configurations which are controlled by the ENV vars
 type Config struct {
 	PublicCloudSpecs string `envconfig:"PUBLIC_CLOUD_SPECS" required:"true"`
+	// MetricCalculators enables the calculators of additional pricing dimensions per provider
+	MetricCalculators string `envconfig:"METRIC_CALCULATORS" default:""`
 }
@@ -6,6 +6,8 @@ type Record struct {
 	SubAccountID string
 	RuntimeID    string
 	ShootName    string
+	Provider     string
+	PlanName     string
 	KubeConfig   string
 	Metric       *edp.ConsumptionMetrics
 }
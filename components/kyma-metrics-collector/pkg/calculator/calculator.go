@@ -0,0 +1,137 @@
+package calculator
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+
+	"github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/edp"
+)
+
+// Input contains the data collected for a runtime which calculators compute the dimensions from.
+// Calculators which need other resources can fetch them from the runtime using the kubeconfig.
+type Input struct {
+	Provider   string
+	Shoot      *gardencorev1beta1.Shoot
+	Nodes      *corev1.NodeList
+	PVCs       *corev1.PersistentVolumeClaimList
+	Services   *corev1.ServiceList
+	KubeConfig string
+}
+
+// Options configure a calculator for a provider
+type Options map[string]string
+
+// MetricCalculator computes an additional pricing dimension of a runtime.
+// The returned value is sent to EDP in the additional dimensions of the consumption metrics under the calculator name,
+// nil values are not sent.
+type MetricCalculator interface {
+	Calculate(input Input, options Options) (interface{}, error)
+}
+
+var (
+	registryMu sync.RWMutex
+	registry   = map[string]MetricCalculator{}
+)
+
+// Register makes the calculator available under the name, so it can be enabled in the configuration.
+// It panics if the name is already registered.
+func Register(name string, calculator MetricCalculator) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	if calculator == nil {
+		panic("calculator: Register calculator is nil")
+	}
+	if _, found := registry[name]; found {
+		panic(fmt.Sprintf("calculator: Register called twice for calculator %s", name))
+	}
+	registry[name] = calculator
+}
+
+func lookup(name string) (MetricCalculator, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	calculator, found := registry[name]
+	return calculator, found
+}
+
+// Config maps the calculator names to the providers they are enabled for and the options for each provider
+type Config map[string]map[string]Options
+
+type enabledCalculator struct {
+	name       string
+	calculator MetricCalculator
+	options    Options
+}
+
+// Calculators runs the calculators enabled for the provider of the runtime
+type Calculators struct {
+	providers map[string][]enabledCalculator
+}
+
+// Load creates calculators from the JSON configuration, for example:
+//
+//	{"gpus": {"aws": {}, "azure": {"resourceName": "nvidia.com/gpu"}}}
+//
+// Empty configuration enables no calculators.
+func Load(config string) (*Calculators, error) {
+	cfg := Config{}
+	if config != "" {
+		if err := json.Unmarshal([]byte(config), &cfg); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal metric calculators config")
+		}
+	}
+	return New(cfg)
+}
+
+func New(config Config) (*Calculators, error) {
+	c := &Calculators{providers: map[string][]enabledCalculator{}}
+	for name, providers := range config {
+		calculator, found := lookup(name)
+		if !found {
+			return nil, fmt.Errorf("metric calculator %s is not registered", name)
+		}
+		for provider, options := range providers {
+			c.providers[provider] = append(c.providers[provider], enabledCalculator{
+				name:       name,
+				calculator: calculator,
+				options:    options,
+			})
+		}
+	}
+	for _, calculators := range c.providers {
+		sort.Slice(calculators, func(i, j int) bool {
+			return calculators[i].name < calculators[j].name
+		})
+	}
+	return c, nil
+}
+
+// Calculate adds the dimensions computed by the calculators enabled for the provider to the metric.
+// A failing calculator does not stop the others, all errors are returned.
+func (c *Calculators) Calculate(input Input, metric *edp.ConsumptionMetrics) error {
+	var errs []error
+	for _, enabled := range c.providers[input.Provider] {
+		value, err := enabled.calculator.Calculate(input, enabled.options)
+		if err != nil {
+			errs = append(errs, errors.Wrapf(err, "failed to calculate %s", enabled.name))
+			continue
+		}
+		if value == nil {
+			continue
+		}
+		if metric.AdditionalDimensions == nil {
+			metric.AdditionalDimensions = map[string]interface{}{}
+		}
+		metric.AdditionalDimensions[enabled.name] = value
+	}
+	return utilerrors.NewAggregate(errs)
+}
@@ -0,0 +1,125 @@
+package calculator
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/edp"
+)
+
+type fixedCalculator struct {
+	value interface{}
+	err   error
+}
+
+func (c fixedCalculator) Calculate(input Input, options Options) (interface{}, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	if value, found := options["value"]; found {
+		return value, nil
+	}
+	return c.value, nil
+}
+
+func init() {
+	Register("fixed", fixedCalculator{value: 1})
+	Register("nothing", fixedCalculator{})
+	Register("failing", fixedCalculator{err: fmt.Errorf("failure")})
+}
+
+func TestLoad(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	t.Run("should enable no calculators without config", func(t *testing.T) {
+		calculators, err := Load("")
+
+		g.Expect(err).Should(gomega.BeNil())
+		metric := &edp.ConsumptionMetrics{}
+		g.Expect(calculators.Calculate(Input{Provider: "aws"}, metric)).Should(gomega.BeNil())
+		g.Expect(metric.AdditionalDimensions).To(gomega.BeNil())
+	})
+
+	t.Run("should fail for not registered calculator", func(t *testing.T) {
+		_, err := Load(`{"unknown": {"aws": {}}}`)
+
+		g.Expect(err).ShouldNot(gomega.BeNil())
+	})
+
+	t.Run("should fail for invalid config", func(t *testing.T) {
+		_, err := Load(`{"fixed": ["aws"]}`)
+
+		g.Expect(err).ShouldNot(gomega.BeNil())
+	})
+}
+
+func TestCalculators_Calculate(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	calculators, err := Load(`{"fixed": {"aws": {}, "azure": {"value": "azure"}}, "nothing": {"aws": {}}, "failing": {"gcp": {}}, "gpus": {"gcp": {}}}`)
+	g.Expect(err).Should(gomega.BeNil())
+
+	for _, tc := range []struct {
+		provider    string
+		expected    map[string]interface{}
+		expectedErr bool
+	}{
+		{
+			provider: "aws",
+			expected: map[string]interface{}{"fixed": 1},
+		},
+		{
+			provider: "azure",
+			expected: map[string]interface{}{"fixed": "azure"},
+		},
+		{
+			provider:    "gcp",
+			expected:    map[string]interface{}{GPUsName: int64(0)},
+			expectedErr: true,
+		},
+		{
+			provider: "openstack",
+		},
+	} {
+		t.Run(tc.provider, func(t *testing.T) {
+			metric := &edp.ConsumptionMetrics{}
+
+			err := calculators.Calculate(Input{Provider: tc.provider}, metric)
+
+			if tc.expectedErr {
+				g.Expect(err).ShouldNot(gomega.BeNil())
+			} else {
+				g.Expect(err).Should(gomega.BeNil())
+			}
+			if tc.expected == nil {
+				g.Expect(metric.AdditionalDimensions).To(gomega.BeNil())
+				return
+			}
+			g.Expect(metric.AdditionalDimensions).To(gomega.Equal(tc.expected))
+		})
+	}
+}
+
+func TestGPUs(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	nodes := &corev1.NodeList{Items: []corev1.Node{
+		newNode(corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("2")}),
+		newNode(corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("1"), "amd.com/gpu": resource.MustParse("4")}),
+		newNode(corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("8")}),
+	}}
+
+	gpus, err := GPUs{}.Calculate(Input{Nodes: nodes}, nil)
+	g.Expect(err).Should(gomega.BeNil())
+	g.Expect(gpus).To(gomega.Equal(int64(3)))
+
+	gpus, err = GPUs{}.Calculate(Input{Nodes: nodes}, Options{ResourceNameOption: "amd.com/gpu"})
+	g.Expect(err).Should(gomega.BeNil())
+	g.Expect(gpus).To(gomega.Equal(int64(4)))
+}
+
+func newNode(capacity corev1.ResourceList) corev1.Node {
+	return corev1.Node{Status: corev1.NodeStatus{Capacity: capacity}}
+}
@@ -0,0 +1,38 @@
+package calculator
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	GPUsName = "gpus"
+
+	// ResourceNameOption is the extended resource name of the GPUs, nvidia.com/gpu by default
+	ResourceNameOption  = "resourceName"
+	defaultGPUsResource = "nvidia.com/gpu"
+)
+
+func init() {
+	Register(GPUsName, GPUs{})
+}
+
+// GPUs counts the GPUs provisioned on the nodes of the runtime
+type GPUs struct{}
+
+func (GPUs) Calculate(input Input, options Options) (interface{}, error) {
+	resourceName := corev1.ResourceName(defaultGPUsResource)
+	if name := options[ResourceNameOption]; name != "" {
+		resourceName = corev1.ResourceName(name)
+	}
+	if input.Nodes == nil {
+		return int64(0), nil
+	}
+
+	gpus := int64(0)
+	for _, node := range input.Nodes.Items {
+		if capacity, found := node.Status.Capacity[resourceName]; found {
+			gpus += capacity.Value()
+		}
+	}
+	return gpus, nil
+}
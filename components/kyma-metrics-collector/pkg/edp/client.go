@@ -33,6 +33,23 @@ const (
 	clientName             = "edp-client"
 )
 
+// ResponseError is returned when EDP responds with an unexpected status code
+type ResponseError struct {
+	StatusCode int
+}
+
+func (e ResponseError) Error() string {
+	return fmt.Sprintf("failed to send event stream as EDP returned HTTP: %d", e.StatusCode)
+}
+
+// Retryable returns false for the client errors which EDP returns again when the same event stream is sent
+func (e ResponseError) Retryable() bool {
+	if e.StatusCode == http.StatusRequestTimeout || e.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	return e.StatusCode < http.StatusBadRequest || e.StatusCode >= http.StatusInternalServerError
+}
+
 func NewClient(config *Config, logger *zap.SugaredLogger) *Client {
 	httpClient := &http.Client{
 		Transport: http.DefaultTransport,
@@ -77,10 +94,11 @@ func (eClient Client) Send(req *http.Request, payload []byte) (*http.Response, e
 		Jitter:   0.1,
 	}
 	err = retry.OnError(customBackoff, func(err error) bool {
-		if err != nil {
-			return true
+		var respErr ResponseError
+		if errors.As(err, &respErr) {
+			return respErr.Retryable()
 		}
-		return false
+		return err != nil
 	}, func() (err error) {
 		metricTimer := prometheus.NewTimer(sentRequestDuration)
 		req.Body = ioutil.NopCloser(bytes.NewReader(payload))
@@ -94,8 +112,12 @@ func (eClient Client) Send(req *http.Request, payload []byte) (*http.Response, e
 		}
 
 		if resp.StatusCode != http.StatusCreated {
-			non2xxErr := fmt.Errorf("failed to send event stream as EDP returned HTTP: %d", resp.StatusCode)
-			eClient.namedLogger().With(log.KeyError, non2xxErr.Error()).With(log.KeyRetry, log.ValueTrue).
+			non2xxErr := ResponseError{StatusCode: resp.StatusCode}
+			willRetry := log.ValueTrue
+			if !non2xxErr.Retryable() {
+				willRetry = log.ValueFalse
+			}
+			eClient.namedLogger().With(log.KeyError, non2xxErr.Error()).With(log.KeyRetry, willRetry).
 				Warn("send event stream as EDP")
 			err = non2xxErr
 		}
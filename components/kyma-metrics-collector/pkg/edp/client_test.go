@@ -1,6 +1,7 @@
 package edp
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -120,6 +121,31 @@ func TestClientRetry(t *testing.T) {
 	g.Expect(testutil.ToFloat64(status500Counter)).Should(gomega.Equal(float64(1)))
 }
 
+func TestClientDoesNotRetryRejectedEventStream(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	expectedPath := fmt.Sprintf("/namespaces/%s/dataStreams/%s/%s/dataTenants/%s/%s/events", testNamespace, testDataStreamName, testDataStreamVersion, testTenant, testEnv)
+
+	countRetry := 0
+	edpTestHandler := http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		countRetry += 1
+		rw.WriteHeader(http.StatusBadRequest)
+	})
+	srv := kmctesting.StartTestServer(expectedPath, edpTestHandler, g)
+	defer srv.Close()
+
+	edpClient := NewClient(NewTestConfig(srv.URL), logger.NewLogger(zapcore.InfoLevel))
+	gotReq, err := edpClient.NewRequest(testTenant)
+	g.Expect(err).Should(gomega.BeNil())
+
+	_, err = edpClient.Send(gotReq, []byte("foodata"))
+	g.Expect(err).ShouldNot(gomega.BeNil())
+	var respErr ResponseError
+	g.Expect(errors.As(err, &respErr)).To(gomega.BeTrue())
+	g.Expect(respErr.StatusCode).To(gomega.Equal(http.StatusBadRequest))
+	g.Expect(respErr.Retryable()).To(gomega.BeFalse())
+	g.Expect(countRetry).Should(gomega.Equal(1))
+}
+
 func NewTestConfig(url string) *Config {
 	return &Config{
 		URL:               url,
@@ -7,6 +7,8 @@ type ConsumptionMetrics struct {
 	Timestamp    string     `json:"timestamp" validate:"required"`
 	Compute      Compute    `json:"compute" validate:"required"`
 	Networking   Networking `json:"networking" validate:"required"`
+	// AdditionalDimensions contains the pricing dimensions computed by the enabled metric calculators
+	AdditionalDimensions map[string]interface{} `json:"additional_dimensions,omitempty"`
 }
 type Networking struct {
 	ProvisionedVnets int `json:"provisioned_vnets" validate:"numeric"`
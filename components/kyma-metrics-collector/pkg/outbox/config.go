@@ -0,0 +1,15 @@
+package outbox
+
+import "time"
+
+type Config struct {
+	// Dir is the directory where unsent metrics are stored, the outbox is disabled if it is empty
+	Dir            string        `envconfig:"OUTBOX_DIR" default:""`
+	ReplayInterval time.Duration `envconfig:"OUTBOX_REPLAY_INTERVAL" default:"1m"`
+	// MaxAge is the age after which unsent metrics are dropped
+	MaxAge time.Duration `envconfig:"OUTBOX_MAX_AGE" default:"168h"`
+}
+
+func (c Config) Enabled() bool {
+	return c.Dir != ""
+}
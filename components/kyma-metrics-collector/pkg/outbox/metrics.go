@@ -0,0 +1,58 @@
+package outbox
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	Namespace = "kmc"
+	Subsystem = "outbox"
+)
+
+var (
+	pendingEntries = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Subsystem: Subsystem,
+			Name:      "entries",
+			Help:      "Number of metrics waiting in the outbox to be sent to EDP.",
+		},
+	)
+
+	oldestEntryAge = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Subsystem: Subsystem,
+			Name:      "oldest_entry_age_seconds",
+			Help:      "Age of the oldest metric waiting in the outbox in seconds.",
+		},
+	)
+
+	replayedTotal = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: Namespace,
+			Subsystem: Subsystem,
+			Name:      "replayed_total",
+			Help:      "Total number of metrics from the outbox sent to EDP.",
+		},
+	)
+
+	droppedTotal = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: Namespace,
+			Subsystem: Subsystem,
+			Name:      "dropped_total",
+			Help:      "Total number of metrics dropped from the outbox after reaching the maximum age.",
+		},
+	)
+
+	deadLetteredTotal = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: Namespace,
+			Subsystem: Subsystem,
+			Name:      "dead_lettered_total",
+			Help:      "Total number of metrics from the outbox rejected by EDP and moved to the dead letters.",
+		},
+	)
+)
@@ -0,0 +1,322 @@
+package outbox
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/edp"
+	log "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/logger"
+)
+
+const (
+	entryExtension = ".json"
+	tmpExtension   = ".tmp"
+	// deadLetterDir is the subdirectory of the outbox with the metrics rejected by EDP, they are kept for an inspection
+	deadLetterDir = "dead-letter"
+)
+
+// Entry is a metric which could not be sent to EDP
+type Entry struct {
+	Tenant    string                 `json:"tenant"`
+	CreatedAt time.Time              `json:"createdAt"`
+	Metric    edp.ConsumptionMetrics `json:"metric"`
+}
+
+// Sender sends the event stream payload of the tenant to EDP
+type Sender func(tenant string, payload []byte) error
+
+type permanentError struct {
+	err error
+}
+
+func (e permanentError) Error() string { return e.err.Error() }
+
+func (e permanentError) Unwrap() error { return e.err }
+
+// Permanent marks the error of a sender which would be returned for the payload again when retried,
+// e.g. when EDP rejects the payload. Such metrics are moved to the dead letters instead of being replayed.
+func Permanent(err error) error {
+	return permanentError{err: err}
+}
+
+// IsPermanent returns true if the error was marked as permanent
+func IsPermanent(err error) bool {
+	var permanent permanentError
+	return errors.As(err, &permanent)
+}
+
+type entryRef struct {
+	name      string
+	tenant    string
+	createdAt time.Time
+}
+
+// Outbox stores metrics which could not be sent to EDP in files, one file per metric, so they survive restarts.
+// File names keep the order in which the metrics were added and the metrics of a tenant are replayed in that order.
+type Outbox struct {
+	config *Config
+	logger *zap.SugaredLogger
+	now    func() time.Time
+
+	mu       sync.Mutex
+	replayMu sync.Mutex
+	entries  []entryRef
+	lastKey  int64
+}
+
+func New(config *Config, logger *zap.SugaredLogger) (*Outbox, error) {
+	if err := os.MkdirAll(config.Dir, 0700); err != nil {
+		return nil, fmt.Errorf("while creating outbox directory %s: %w", config.Dir, err)
+	}
+
+	o := &Outbox{
+		config: config,
+		logger: logger,
+		now:    time.Now,
+	}
+	if err := o.load(); err != nil {
+		return nil, err
+	}
+	o.updateMetrics()
+
+	return o, nil
+}
+
+// Add stores the metric of the tenant to be sent later
+func (o *Outbox) Add(tenant string, metric edp.ConsumptionMetrics) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	now := o.now()
+	key := now.UnixNano()
+	if key <= o.lastKey {
+		key = o.lastKey + 1
+	}
+
+	data, err := json.Marshal(Entry{Tenant: tenant, CreatedAt: now, Metric: metric})
+	if err != nil {
+		return fmt.Errorf("while marshalling outbox entry: %w", err)
+	}
+	name := fmt.Sprintf("%020d%s", key, entryExtension)
+	tmp := filepath.Join(o.config.Dir, name+tmpExtension)
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return fmt.Errorf("while writing outbox entry: %w", err)
+	}
+	if err := os.Rename(tmp, filepath.Join(o.config.Dir, name)); err != nil {
+		return fmt.Errorf("while writing outbox entry: %w", err)
+	}
+
+	o.lastKey = key
+	o.entries = append(o.entries, entryRef{name: name, tenant: tenant, createdAt: now})
+	o.updateMetricsLocked()
+	return nil
+}
+
+// Len returns the number of metrics waiting in the outbox
+func (o *Outbox) Len() int {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	return len(o.entries)
+}
+
+// LenForTenant returns the number of metrics of the tenant waiting in the outbox
+func (o *Outbox) LenForTenant(tenant string) int {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	count := 0
+	for _, ref := range o.entries {
+		if ref.tenant == tenant {
+			count++
+		}
+	}
+	return count
+}
+
+// Replay sends the stored metrics in the order they were added and removes the sent ones.
+// After a failure, the remaining metrics of the tenant are skipped, so they are sent in order by the next replay,
+// while the metrics of other tenants are still sent. Metrics rejected with a permanent error are moved to the dead letters
+// and metrics older than the maximum age are dropped.
+func (o *Outbox) Replay(send Sender) (int, error) {
+	o.replayMu.Lock()
+	defer o.replayMu.Unlock()
+
+	o.mu.Lock()
+	refs := append([]entryRef{}, o.entries...)
+	o.mu.Unlock()
+
+	sent := 0
+	failed := map[string]bool{}
+	var errs []string
+	for _, ref := range refs {
+		if failed[ref.tenant] {
+			continue
+		}
+		if o.config.MaxAge > 0 && o.now().Sub(ref.createdAt) > o.config.MaxAge {
+			o.namedLogger().With("entry", ref.name).Warnf("dropping metric older than %v", o.config.MaxAge)
+			droppedTotal.Inc()
+			o.remove(ref.name)
+			continue
+		}
+
+		entry, err := o.read(ref.name)
+		if err != nil {
+			o.namedLogger().With(log.KeyError, err.Error()).With("entry", ref.name).Error("dropping unreadable metric")
+			droppedTotal.Inc()
+			o.remove(ref.name)
+			continue
+		}
+		payload, err := json.Marshal(entry.Metric)
+		if err != nil {
+			return sent, fmt.Errorf("while marshalling metric: %w", err)
+		}
+		if err := send(entry.Tenant, payload); err != nil {
+			if IsPermanent(err) {
+				o.namedLogger().With(log.KeyError, err.Error()).With("entry", ref.name).With(log.KeySubAccountID, entry.Tenant).
+					Error("moving metric rejected by EDP to the dead letters")
+				deadLetteredTotal.Inc()
+				o.deadLetter(ref.name)
+				continue
+			}
+			failed[ref.tenant] = true
+			errs = append(errs, fmt.Sprintf("tenant %s: %s", entry.Tenant, err))
+			continue
+		}
+
+		replayedTotal.Inc()
+		o.remove(ref.name)
+		sent++
+	}
+
+	if len(errs) > 0 {
+		return sent, fmt.Errorf("sending metrics of %d tenants failed: %s", len(errs), strings.Join(errs, ", "))
+	}
+	return sent, nil
+}
+
+// Start replays the stored metrics in the configured interval
+func (o *Outbox) Start(send Sender) {
+	ticker := time.NewTicker(o.config.ReplayInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		o.updateMetrics()
+		if o.Len() == 0 {
+			continue
+		}
+		sent, err := o.Replay(send)
+		if err != nil {
+			o.namedLogger().With(log.KeyResult, log.ValueFail).With(log.KeyError, err.Error()).
+				Warnf("replayed %d metrics, %d metrics left in the outbox", sent, o.Len())
+			continue
+		}
+		o.namedLogger().With(log.KeyResult, log.ValueSuccess).Infof("replayed %d metrics", sent)
+	}
+}
+
+func (o *Outbox) load() error {
+	files, err := os.ReadDir(o.config.Dir)
+	if err != nil {
+		return fmt.Errorf("while reading outbox directory %s: %w", o.config.Dir, err)
+	}
+
+	for _, file := range files {
+		if strings.HasSuffix(file.Name(), tmpExtension) {
+			// the process stopped while writing the entry, it was not added
+			o.remove(file.Name())
+			continue
+		}
+		if file.IsDir() || !strings.HasSuffix(file.Name(), entryExtension) {
+			continue
+		}
+		entry, err := o.read(file.Name())
+		if err != nil {
+			o.namedLogger().With(log.KeyError, err.Error()).With("entry", file.Name()).Error("dropping unreadable metric")
+			droppedTotal.Inc()
+			o.remove(file.Name())
+			continue
+		}
+		o.entries = append(o.entries, entryRef{name: file.Name(), tenant: entry.Tenant, createdAt: entry.CreatedAt})
+	}
+	sort.Slice(o.entries, func(i, j int) bool {
+		return o.entries[i].name < o.entries[j].name
+	})
+
+	if len(o.entries) > 0 {
+		o.namedLogger().Infof("loaded %d metrics from the outbox", len(o.entries))
+		last := o.entries[len(o.entries)-1].name
+		fmt.Sscanf(strings.TrimSuffix(last, entryExtension), "%d", &o.lastKey)
+	}
+	return nil
+}
+
+func (o *Outbox) read(name string) (Entry, error) {
+	var entry Entry
+	data, err := os.ReadFile(filepath.Join(o.config.Dir, name))
+	if err != nil {
+		return entry, fmt.Errorf("while reading outbox entry: %w", err)
+	}
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return entry, fmt.Errorf("while unmarshalling outbox entry: %w", err)
+	}
+	return entry, nil
+}
+
+func (o *Outbox) remove(name string) {
+	if err := os.Remove(filepath.Join(o.config.Dir, name)); err != nil && !os.IsNotExist(err) {
+		o.namedLogger().With(log.KeyError, err.Error()).With("entry", name).Error("remove metric from the outbox")
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for i, ref := range o.entries {
+		if ref.name == name {
+			o.entries = append(o.entries[:i], o.entries[i+1:]...)
+			break
+		}
+	}
+	o.updateMetricsLocked()
+}
+
+// deadLetter moves the entry to the dead letters, the directory is created on the first use
+func (o *Outbox) deadLetter(name string) {
+	dir := filepath.Join(o.config.Dir, deadLetterDir)
+	err := os.MkdirAll(dir, 0700)
+	if err == nil {
+		err = os.Rename(filepath.Join(o.config.Dir, name), filepath.Join(dir, name))
+	}
+	if err != nil {
+		o.namedLogger().With(log.KeyError, err.Error()).With("entry", name).Error("move metric to the dead letters")
+	}
+	o.remove(name)
+}
+
+func (o *Outbox) updateMetrics() {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.updateMetricsLocked()
+}
+
+func (o *Outbox) updateMetricsLocked() {
+	pendingEntries.Set(float64(len(o.entries)))
+	if len(o.entries) == 0 {
+		oldestEntryAge.Set(0)
+		return
+	}
+	oldestEntryAge.Set(o.now().Sub(o.entries[0].createdAt).Seconds())
+}
+
+func (o *Outbox) namedLogger() *zap.SugaredLogger {
+	return o.logger.With("component", "outbox")
+}
@@ -0,0 +1,198 @@
+package outbox
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/edp"
+	"github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/logger"
+)
+
+type sentMetric struct {
+	tenant string
+	metric edp.ConsumptionMetrics
+}
+
+func TestOutbox_Replay(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	config := &Config{Dir: t.TempDir(), MaxAge: time.Hour}
+
+	outbox, err := New(config, logger.NewLogger(zapcore.InfoLevel))
+	g.Expect(err).Should(gomega.BeNil())
+
+	for i := 0; i < 3; i++ {
+		err := outbox.Add(fmt.Sprintf("tenant-%d", i%2), fixMetric(i))
+		g.Expect(err).Should(gomega.BeNil())
+	}
+	g.Expect(outbox.Len()).To(gomega.Equal(3))
+	g.Expect(testutil.ToFloat64(pendingEntries)).To(gomega.Equal(float64(3)))
+
+	// EDP is still not reachable
+	var sent []sentMetric
+	failing := true
+	send := func(tenant string, payload []byte) error {
+		if failing && len(sent) == 1 {
+			return fmt.Errorf("EDP is not reachable")
+		}
+		var metric edp.ConsumptionMetrics
+		g.Expect(json.Unmarshal(payload, &metric)).Should(gomega.BeNil())
+		sent = append(sent, sentMetric{tenant: tenant, metric: metric})
+		return nil
+	}
+
+	count, err := outbox.Replay(send)
+	g.Expect(err).ShouldNot(gomega.BeNil())
+	g.Expect(count).To(gomega.Equal(1))
+	g.Expect(outbox.Len()).To(gomega.Equal(2))
+
+	// metrics survive a restart
+	outbox, err = New(config, logger.NewLogger(zapcore.InfoLevel))
+	g.Expect(err).Should(gomega.BeNil())
+	g.Expect(outbox.Len()).To(gomega.Equal(2))
+
+	// EDP is reachable again
+	failing = false
+	count, err = outbox.Replay(send)
+	g.Expect(err).Should(gomega.BeNil())
+	g.Expect(count).To(gomega.Equal(2))
+	g.Expect(outbox.Len()).To(gomega.Equal(0))
+	g.Expect(testutil.ToFloat64(pendingEntries)).To(gomega.Equal(float64(0)))
+
+	g.Expect(sent).To(gomega.Equal([]sentMetric{
+		{tenant: "tenant-0", metric: fixMetric(0)},
+		{tenant: "tenant-1", metric: fixMetric(1)},
+		{tenant: "tenant-0", metric: fixMetric(2)},
+	}))
+	files, err := os.ReadDir(config.Dir)
+	g.Expect(err).Should(gomega.BeNil())
+	g.Expect(files).To(gomega.BeEmpty())
+}
+
+func TestOutbox_ReplayKeepsOrderPerTenant(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	config := &Config{Dir: t.TempDir(), MaxAge: time.Hour}
+
+	outbox, err := New(config, logger.NewLogger(zapcore.InfoLevel))
+	g.Expect(err).Should(gomega.BeNil())
+	for i := 0; i < 4; i++ {
+		g.Expect(outbox.Add(fmt.Sprintf("tenant-%d", i%2), fixMetric(i))).Should(gomega.BeNil())
+	}
+	g.Expect(outbox.LenForTenant("tenant-0")).To(gomega.Equal(2))
+
+	// the first metric of tenant-0 cannot be sent, its later metric must wait, tenant-1 is not blocked
+	var sent []sentMetric
+	count, err := outbox.Replay(func(tenant string, payload []byte) error {
+		if tenant == "tenant-0" {
+			return fmt.Errorf("EDP is not reachable")
+		}
+		var metric edp.ConsumptionMetrics
+		g.Expect(json.Unmarshal(payload, &metric)).Should(gomega.BeNil())
+		sent = append(sent, sentMetric{tenant: tenant, metric: metric})
+		return nil
+	})
+
+	g.Expect(err).ShouldNot(gomega.BeNil())
+	g.Expect(count).To(gomega.Equal(2))
+	g.Expect(sent).To(gomega.Equal([]sentMetric{
+		{tenant: "tenant-1", metric: fixMetric(1)},
+		{tenant: "tenant-1", metric: fixMetric(3)},
+	}))
+	g.Expect(outbox.LenForTenant("tenant-0")).To(gomega.Equal(2))
+	g.Expect(outbox.LenForTenant("tenant-1")).To(gomega.Equal(0))
+}
+
+func TestOutbox_ReplayMovesRejectedMetricsToDeadLetters(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	config := &Config{Dir: t.TempDir(), MaxAge: time.Hour}
+
+	outbox, err := New(config, logger.NewLogger(zapcore.InfoLevel))
+	g.Expect(err).Should(gomega.BeNil())
+	for i := 0; i < 2; i++ {
+		g.Expect(outbox.Add("tenant", fixMetric(i))).Should(gomega.BeNil())
+	}
+
+	deadLettered := testutil.ToFloat64(deadLetteredTotal)
+	var sent []edp.ConsumptionMetrics
+	count, err := outbox.Replay(func(tenant string, payload []byte) error {
+		var metric edp.ConsumptionMetrics
+		g.Expect(json.Unmarshal(payload, &metric)).Should(gomega.BeNil())
+		if metric.RuntimeId == fixMetric(0).RuntimeId {
+			return Permanent(fmt.Errorf("EDP returned HTTP: 400"))
+		}
+		sent = append(sent, metric)
+		return nil
+	})
+
+	g.Expect(err).Should(gomega.BeNil())
+	g.Expect(count).To(gomega.Equal(1))
+	g.Expect(sent).To(gomega.Equal([]edp.ConsumptionMetrics{fixMetric(1)}))
+	g.Expect(outbox.Len()).To(gomega.Equal(0))
+	g.Expect(testutil.ToFloat64(deadLetteredTotal)).To(gomega.Equal(deadLettered + 1))
+	files, err := os.ReadDir(filepath.Join(config.Dir, deadLetterDir))
+	g.Expect(err).Should(gomega.BeNil())
+	g.Expect(files).To(gomega.HaveLen(1))
+
+	// dead letters are not loaded again
+	outbox, err = New(config, logger.NewLogger(zapcore.InfoLevel))
+	g.Expect(err).Should(gomega.BeNil())
+	g.Expect(outbox.Len()).To(gomega.Equal(0))
+}
+
+func TestOutbox_ReplayDropsExpiredMetrics(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	config := &Config{Dir: t.TempDir(), MaxAge: time.Hour}
+	now := time.Now()
+
+	outbox, err := New(config, logger.NewLogger(zapcore.InfoLevel))
+	g.Expect(err).Should(gomega.BeNil())
+	outbox.now = func() time.Time { return now.Add(-2 * time.Hour) }
+	g.Expect(outbox.Add("tenant", fixMetric(0))).Should(gomega.BeNil())
+	outbox.now = func() time.Time { return now }
+	g.Expect(outbox.Add("tenant", fixMetric(1))).Should(gomega.BeNil())
+
+	dropped := testutil.ToFloat64(droppedTotal)
+	var sent []edp.ConsumptionMetrics
+	count, err := outbox.Replay(func(tenant string, payload []byte) error {
+		var metric edp.ConsumptionMetrics
+		g.Expect(json.Unmarshal(payload, &metric)).Should(gomega.BeNil())
+		sent = append(sent, metric)
+		return nil
+	})
+
+	g.Expect(err).Should(gomega.BeNil())
+	g.Expect(count).To(gomega.Equal(1))
+	g.Expect(sent).To(gomega.Equal([]edp.ConsumptionMetrics{fixMetric(1)}))
+	g.Expect(testutil.ToFloat64(droppedTotal)).To(gomega.Equal(dropped + 1))
+}
+
+func TestOutbox_LoadSkipsIncompleteEntries(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	config := &Config{Dir: t.TempDir()}
+	g.Expect(os.WriteFile(filepath.Join(config.Dir, "00000000000000000001.json.tmp"), []byte("{"), 0600)).Should(gomega.BeNil())
+	g.Expect(os.WriteFile(filepath.Join(config.Dir, "00000000000000000002.json"), []byte("{"), 0600)).Should(gomega.BeNil())
+
+	outbox, err := New(config, logger.NewLogger(zapcore.InfoLevel))
+
+	g.Expect(err).Should(gomega.BeNil())
+	g.Expect(outbox.Len()).To(gomega.Equal(0))
+	files, err := os.ReadDir(config.Dir)
+	g.Expect(err).Should(gomega.BeNil())
+	g.Expect(files).To(gomega.BeEmpty())
+}
+
+func fixMetric(i int) edp.ConsumptionMetrics {
+	return edp.ConsumptionMetrics{
+		RuntimeId:    fmt.Sprintf("runtime-%d", i),
+		SubAccountId: fmt.Sprintf("subaccount-%d", i),
+		ShootName:    fmt.Sprintf("shoot-%d", i),
+		Timestamp:    time.Date(2023, 8, 1, 10, i, 0, 0, time.UTC).Format(time.RFC3339),
+	}
+}
@@ -3,6 +3,8 @@ package process
 import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	kmccache "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/cache"
 )
 
 var (
@@ -15,4 +17,81 @@ var (
 		},
 		[]string{"requestURI"},
 	)
+
+	consumptionLabels = []string{"subaccount_id", "runtime_id", "shoot_name", "provider", "plan"}
+
+	provisionedCPUs = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "kmc",
+			Subsystem: "consumption",
+			Name:      "provisioned_cpus",
+			Help:      "Number of CPUs provisioned for the runtime.",
+		},
+		consumptionLabels,
+	)
+
+	provisionedRAM = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "kmc",
+			Subsystem: "consumption",
+			Name:      "provisioned_ram_gb",
+			Help:      "RAM provisioned for the runtime in GB.",
+		},
+		consumptionLabels,
+	)
+
+	provisionedVolumes = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "kmc",
+			Subsystem: "consumption",
+			Name:      "provisioned_volumes_gb",
+			Help:      "Total size of the volumes provisioned for the runtime in GB.",
+		},
+		consumptionLabels,
+	)
+
+	provisionedVnets = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "kmc",
+			Subsystem: "consumption",
+			Name:      "provisioned_vnets",
+			Help:      "Number of virtual networks provisioned for the runtime.",
+		},
+		consumptionLabels,
+	)
+
+	provisionedIPs = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "kmc",
+			Subsystem: "consumption",
+			Name:      "provisioned_ips",
+			Help:      "Number of IPs provisioned for the runtime.",
+		},
+		consumptionLabels,
+	)
 )
+
+// recordConsumptionMetrics exposes the consumption of the runtime from the record
+func recordConsumptionMetrics(record kmccache.Record) {
+	if record.Metric == nil {
+		return
+	}
+	labels := consumptionLabelValues(record)
+	provisionedCPUs.WithLabelValues(labels...).Set(float64(record.Metric.Compute.ProvisionedCpus))
+	provisionedRAM.WithLabelValues(labels...).Set(record.Metric.Compute.ProvisionedRAMGb)
+	provisionedVolumes.WithLabelValues(labels...).Set(float64(record.Metric.Compute.ProvisionedVolumes.SizeGbTotal))
+	provisionedVnets.WithLabelValues(labels...).Set(float64(record.Metric.Networking.ProvisionedVnets))
+	provisionedIPs.WithLabelValues(labels...).Set(float64(record.Metric.Networking.ProvisionedIPs))
+}
+
+// deleteConsumptionMetrics removes the consumption of the runtime from the record when it is not tracked anymore
+func deleteConsumptionMetrics(record kmccache.Record) {
+	labels := consumptionLabelValues(record)
+	for _, gauge := range []*prometheus.GaugeVec{provisionedCPUs, provisionedRAM, provisionedVolumes, provisionedVnets, provisionedIPs} {
+		gauge.DeleteLabelValues(labels...)
+	}
+}
+
+func consumptionLabelValues(record kmccache.Record) []string {
+	return []string{record.SubAccountID, record.RuntimeID, record.ShootName, record.Provider, record.PlanName}
+}
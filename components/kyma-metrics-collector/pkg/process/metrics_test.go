@@ -0,0 +1,36 @@
+package process
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	kmccache "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/cache"
+)
+
+func TestConsumptionMetrics(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	record := NewRecord("subaccount-id", "shoot-name", "")
+	record.RuntimeID = "runtime-id"
+	record.Provider = "Azure"
+	record.PlanName = "azure"
+	record.Metric = NewMetric()
+	labels := consumptionLabelValues(record)
+	exposed := testutil.CollectAndCount(provisionedCPUs)
+
+	// record without metric is not exposed
+	recordConsumptionMetrics(kmccache.Record{SubAccountID: "other-subaccount-id"})
+	g.Expect(testutil.CollectAndCount(provisionedCPUs)).To(gomega.Equal(exposed))
+
+	recordConsumptionMetrics(record)
+	g.Expect(testutil.CollectAndCount(provisionedCPUs)).To(gomega.Equal(exposed + 1))
+	g.Expect(testutil.ToFloat64(provisionedCPUs.WithLabelValues(labels...))).To(gomega.Equal(float64(24)))
+	g.Expect(testutil.ToFloat64(provisionedRAM.WithLabelValues(labels...))).To(gomega.Equal(float64(96)))
+	g.Expect(testutil.ToFloat64(provisionedVolumes.WithLabelValues(labels...))).To(gomega.Equal(float64(30)))
+	g.Expect(testutil.ToFloat64(provisionedVnets.WithLabelValues(labels...))).To(gomega.Equal(float64(1)))
+	g.Expect(testutil.ToFloat64(provisionedIPs.WithLabelValues(labels...))).To(gomega.Equal(float64(2)))
+
+	deleteConsumptionMetrics(record)
+	g.Expect(testutil.CollectAndCount(provisionedCPUs)).To(gomega.Equal(exposed))
+}
@@ -29,7 +29,9 @@ import (
 	"github.com/pkg/errors"
 
 	kebruntime "github.com/kyma-project/control-plane/components/kyma-environment-broker/common/runtime"
+	"github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/calculator"
 	"github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/edp"
+	"github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/outbox"
 	"github.com/patrickmn/go-cache"
 )
 
@@ -46,6 +48,8 @@ type Process struct {
 	NodeConfig      skrnode.ConfigInf
 	PVCConfig       skrpvc.ConfigInf
 	SvcConfig       skrsvc.ConfigInf
+	Outbox          *outbox.Outbox
+	Calculators     *calculator.Calculators
 	Logger          *zap.SugaredLogger
 }
 
@@ -153,6 +157,22 @@ func (p Process) generateRecordWithNewMetrics(identifier int, subAccountID strin
 	metric.RuntimeId = record.RuntimeID
 	metric.SubAccountId = record.SubAccountID
 	metric.ShootName = record.ShootName
+
+	if p.Calculators != nil {
+		calculatorInput := calculator.Input{
+			Provider:   shoot.Spec.Provider.Type,
+			Shoot:      shoot,
+			Nodes:      nodes,
+			PVCs:       pvcList,
+			Services:   svcList,
+			KubeConfig: record.KubeConfig,
+		}
+		// additional dimensions are optional, the metric is sent without the failed ones
+		if calculatorErr := p.Calculators.Calculate(calculatorInput, metric); calculatorErr != nil {
+			p.namedLogger().With(log.KeyError, calculatorErr.Error()).With(log.KeyShoot, shootName).
+				Warn("Failed to calculate additional dimensions")
+		}
+	}
 	record.Metric = metric
 	return
 }
@@ -210,6 +230,10 @@ func (p Process) Start() {
 		p.pollKEBForRuntimes()
 	}()
 
+	if p.Outbox != nil {
+		go p.Outbox.Start(p.sendEventStreamToEDP)
+	}
+
 	for i := 0; i < p.WorkersPoolSize; i++ {
 		j := i
 		go func() {
@@ -290,7 +314,7 @@ func (p Process) processSubAccountID(subAccountID string, identifier int) {
 	// Note: EDP refers SubAccountID as tenant
 	p.namedLoggerWithRuntime(record).With(log.KeySubAccountID, subAccountID).
 		With(log.KeyWorkerID, identifier).Debugf("sending EventStreamToEDP: payload: %s", string(payload))
-	err = p.sendEventStreamToEDP(subAccountID, payload)
+	stored, err := p.sendOrStoreEventStream(subAccountID, *record.Metric, payload)
 	if err != nil {
 		p.namedLoggerWithRuntime(record).With(log.KeyResult, log.ValueFail).With(log.KeyError, err.Error()).
 			With(log.KeySubAccountID, subAccountID).With(log.KeyWorkerID, identifier).
@@ -304,11 +328,17 @@ func (p Process) processSubAccountID(subAccountID string, identifier int) {
 		// Nothing to do further hence continue
 		return
 	}
-	p.namedLoggerWithRuntime(record).With(log.KeyResult, log.ValueSuccess).With(log.KeySubAccountID, subAccountID).
-		With(log.KeyWorkerID, identifier).Infof("sent event stream, shoot: %s", record.ShootName)
+	if stored {
+		p.namedLoggerWithRuntime(record).With(log.KeyResult, log.ValueSuccess).With(log.KeySubAccountID, subAccountID).
+			With(log.KeyWorkerID, identifier).Infof("stored event stream in the outbox, shoot: %s", record.ShootName)
+	} else {
+		p.namedLoggerWithRuntime(record).With(log.KeyResult, log.ValueSuccess).With(log.KeySubAccountID, subAccountID).
+			With(log.KeyWorkerID, identifier).Infof("sent event stream, shoot: %s", record.ShootName)
+	}
 
 	if !isOldMetricValid {
 		p.Cache.Set(record.SubAccountID, *record, cache.NoExpiration)
+		recordConsumptionMetrics(*record)
 		p.namedLoggerWithRuntime(record).With(log.KeyResult, log.ValueSuccess).With(log.KeySubAccountID, record.SubAccountID).
 			With(log.KeyWorkerID, identifier).Debug("saved metric")
 	}
@@ -343,6 +373,33 @@ func (p Process) getRecordWithOldOrNewMetric(identifier int, subAccountID string
 	return &record, false, nil
 }
 
+// sendOrStoreEventStream sends the metric to EDP. When the outbox is enabled, the metric is stored in the outbox
+// if it cannot be sent, or if older metrics of the tenant are still waiting in the outbox so EDP receives the metrics
+// of the tenant in order. Metrics rejected by EDP are not stored as sending them again would fail too.
+// It returns true when the metric was stored instead of sent.
+func (p Process) sendOrStoreEventStream(tenant string, metric edp.ConsumptionMetrics, payload []byte) (bool, error) {
+	if p.Outbox == nil {
+		return false, p.sendEventStreamToEDP(tenant, payload)
+	}
+
+	if p.Outbox.LenForTenant(tenant) == 0 {
+		err := p.sendEventStreamToEDP(tenant, payload)
+		if err == nil {
+			return false, nil
+		}
+		if outbox.IsPermanent(err) {
+			return false, err
+		}
+		p.namedLogger().With(log.KeyResult, log.ValueFail).With(log.KeyError, err.Error()).With(log.KeySubAccountID, tenant).
+			Warn("send metric to EDP, storing it in the outbox")
+	}
+
+	if err := p.Outbox.Add(tenant, metric); err != nil {
+		return false, errors.Wrapf(err, "failed to store event-stream in the outbox")
+	}
+	return true, nil
+}
+
 func (p Process) sendEventStreamToEDP(tenant string, payload []byte) error {
 	edpRequest, err := p.EDPClient.NewRequest(tenant)
 	if err != nil {
@@ -351,6 +408,10 @@ func (p Process) sendEventStreamToEDP(tenant string, payload []byte) error {
 
 	resp, err := p.EDPClient.Send(edpRequest, payload)
 	if err != nil {
+		var respErr edp.ResponseError
+		if errors.As(err, &respErr) && !respErr.Retryable() {
+			return outbox.Permanent(errors.Wrapf(err, "EDP rejected event-stream"))
+		}
 		return errors.Wrapf(err, "failed to send event-stream to EDP")
 	}
 
@@ -391,6 +452,8 @@ func (p *Process) populateCacheAndQueue(runtimes *kebruntime.RuntimesPage) {
 				SubAccountID: runtime.SubAccountID,
 				RuntimeID:    runtime.RuntimeID,
 				ShootName:    runtime.ShootName,
+				Provider:     runtime.Provider,
+				PlanName:     runtime.ServicePlanName,
 				KubeConfig:   "",
 				Metric:       nil,
 			}
@@ -414,8 +477,17 @@ func (p *Process) populateCacheAndQueue(runtimes *kebruntime.RuntimesPage) {
 					// The shootname has changed hence the record in the cache is not valid anymore
 					// No need to queue as the subAccountID already exists in queue
 					p.Cache.Set(runtime.SubAccountID, newRecord, cache.NoExpiration)
+					deleteConsumptionMetrics(record)
 					p.namedLogger().With(log.KeySubAccountID, runtime.SubAccountID).With(log.KeyRuntimeID, runtime.RuntimeID).
 						Debug("Resetted the values in cache for subAccount")
+					continue
+				}
+				if record.Provider != runtime.Provider || record.PlanName != runtime.ServicePlanName {
+					// The plan has changed, the metric is still valid
+					deleteConsumptionMetrics(record)
+					record.Provider, record.PlanName = runtime.Provider, runtime.ServicePlanName
+					p.Cache.Set(runtime.SubAccountID, record, cache.NoExpiration)
+					recordConsumptionMetrics(record)
 				}
 			}
 			continue
@@ -423,6 +495,9 @@ func (p *Process) populateCacheAndQueue(runtimes *kebruntime.RuntimesPage) {
 		if isFoundInCache {
 			// Cluster is not trackable but is found in cache should be deleted
 			p.Cache.Delete(runtime.SubAccountID)
+			if record, ok := recordObj.(kmccache.Record); ok {
+				deleteConsumptionMetrics(record)
+			}
 			p.namedLogger().With(log.KeySubAccountID, runtime.SubAccountID).
 				With(log.KeyRuntimeID, runtime.RuntimeID).Debug("Deleted subAccount from cache")
 			continue
@@ -440,6 +515,7 @@ func (p *Process) populateCacheAndQueue(runtimes *kebruntime.RuntimesPage) {
 				p.namedLogger().With(log.KeySubAccountID, sAccID).
 					Error("bad item from cache, could not cast to a record obj")
 			} else {
+				deleteConsumptionMetrics(record)
 				p.namedLogger().With(log.KeySubAccountID, sAccID).With(log.KeyRuntimeID, record.RuntimeID).
 					Debug("SubAccount is not trackable anymore hence deleting it from cache")
 			}
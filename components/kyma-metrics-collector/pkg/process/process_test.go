@@ -30,6 +30,7 @@ import (
 
 	"github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/edp"
 	"github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/logger"
+	"github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/outbox"
 
 	"github.com/google/uuid"
 
@@ -498,6 +499,77 @@ func TestExecute(t *testing.T) {
 	g.Eventually(newProcess.Queue.Len()).Should(gomega.Equal(0))
 }
 
+func TestSendOrStoreEventStream(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	tenant := uuid.New().String()
+	expectedPath := fmt.Sprintf("/namespaces/%s/dataStreams/%s/%s/dataTenants/%s/%s/events", testNamespace, testDataStream, testDataStreamVersion, tenant, testEnv)
+	log := logger.NewLogger(zapcore.InfoLevel)
+
+	edpStatus := http.StatusInternalServerError
+	var received []edp.ConsumptionMetrics
+	edpTestHandler := http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if edpStatus == http.StatusCreated {
+			var metric edp.ConsumptionMetrics
+			g.Expect(json.NewDecoder(req.Body).Decode(&metric)).Should(gomega.BeNil())
+			received = append(received, metric)
+		}
+		rw.WriteHeader(edpStatus)
+	})
+	srv := kmctesting.StartTestServer(expectedPath, edpTestHandler, g)
+	defer srv.Close()
+
+	metricsOutbox, err := outbox.New(&outbox.Config{Dir: t.TempDir()}, log)
+	g.Expect(err).Should(gomega.BeNil())
+	newProcess := &Process{
+		EDPClient: edp.NewClient(newEDPConfig(srv.URL), log),
+		Outbox:    metricsOutbox,
+		Logger:    log,
+	}
+
+	first := *NewMetric()
+	first.Timestamp = "2023-08-01T10:00:00Z"
+	second := *NewMetric()
+	second.Timestamp = "2023-08-01T10:05:00Z"
+
+	// EDP is not reachable, the metric is stored
+	stored, err := newProcess.sendOrStoreEventStream(tenant, first, marshalMetric(g, first))
+	g.Expect(err).Should(gomega.BeNil())
+	g.Expect(stored).To(gomega.BeTrue())
+	g.Expect(metricsOutbox.Len()).To(gomega.Equal(1))
+
+	// EDP is reachable again, but the metric is stored after the older one to keep the order
+	edpStatus = http.StatusCreated
+	stored, err = newProcess.sendOrStoreEventStream(tenant, second, marshalMetric(g, second))
+	g.Expect(err).Should(gomega.BeNil())
+	g.Expect(stored).To(gomega.BeTrue())
+	g.Expect(metricsOutbox.Len()).To(gomega.Equal(2))
+
+	sent, err := metricsOutbox.Replay(newProcess.sendEventStreamToEDP)
+	g.Expect(err).Should(gomega.BeNil())
+	g.Expect(sent).To(gomega.Equal(2))
+	g.Expect(received).To(gomega.Equal([]edp.ConsumptionMetrics{first, second}))
+
+	// the outbox is empty, the metric is sent directly
+	stored, err = newProcess.sendOrStoreEventStream(tenant, second, marshalMetric(g, second))
+	g.Expect(err).Should(gomega.BeNil())
+	g.Expect(stored).To(gomega.BeFalse())
+	g.Expect(received).To(gomega.HaveLen(3))
+
+	// EDP rejects the metric, it is not stored as it would block the later metrics of the tenant
+	edpStatus = http.StatusBadRequest
+	stored, err = newProcess.sendOrStoreEventStream(tenant, second, marshalMetric(g, second))
+	g.Expect(err).ShouldNot(gomega.BeNil())
+	g.Expect(outbox.IsPermanent(err)).To(gomega.BeTrue())
+	g.Expect(stored).To(gomega.BeFalse())
+	g.Expect(metricsOutbox.Len()).To(gomega.Equal(0))
+}
+
+func marshalMetric(g *gomega.WithT, metric edp.ConsumptionMetrics) []byte {
+	payload, err := json.Marshal(metric)
+	g.Expect(err).Should(gomega.BeNil())
+	return payload
+}
+
 func NewFakeShootClient(shoot *gardenerv1beta1.Shoot) (*gardenershoot.Client, error) {
 	scheme, err := commons.SetupSchemeOrDie()
 	if err != nil {
@@ -0,0 +1,141 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/patrickmn/go-cache"
+	"go.uber.org/zap"
+
+	kmccache "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/cache"
+	"github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/edp"
+	log "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/logger"
+)
+
+const (
+	consumptionPath = "/consumption"
+
+	providerParam = "provider"
+	planParam     = "plan"
+)
+
+// Consumption is the last consumption computed for the runtime of the subaccount
+type Consumption struct {
+	SubAccountID string                  `json:"subAccountID"`
+	RuntimeID    string                  `json:"runtimeID"`
+	ShootName    string                  `json:"shootName"`
+	Provider     string                  `json:"provider"`
+	PlanName     string                  `json:"planName"`
+	Metric       *edp.ConsumptionMetrics `json:"metric,omitempty"`
+}
+
+type ConsumptionPage struct {
+	Data  []Consumption `json:"data"`
+	Count int           `json:"count"`
+}
+
+// ConsumptionHandler exposes the consumption metrics from the cache, so the consumption of runtimes can be checked without EDP access
+type ConsumptionHandler struct {
+	Cache  *cache.Cache
+	Logger *zap.SugaredLogger
+}
+
+func NewConsumptionHandler(cache *cache.Cache, logger *zap.SugaredLogger) *ConsumptionHandler {
+	return &ConsumptionHandler{
+		Cache:  cache,
+		Logger: logger,
+	}
+}
+
+func (h *ConsumptionHandler) AttachRoutes(router *mux.Router) {
+	router.HandleFunc(consumptionPath, h.listConsumption).Methods(http.MethodGet)
+	router.HandleFunc(fmt.Sprintf("%s/{subaccount}", consumptionPath), h.getConsumption).Methods(http.MethodGet)
+}
+
+func (h *ConsumptionHandler) getConsumption(w http.ResponseWriter, req *http.Request) {
+	subAccountID := mux.Vars(req)["subaccount"]
+
+	obj, found := h.Cache.Get(subAccountID)
+	if !found {
+		h.writeError(w, http.StatusNotFound, fmt.Sprintf("subaccount %s is not tracked", subAccountID))
+		return
+	}
+	record, ok := obj.(kmccache.Record)
+	if !ok {
+		h.writeError(w, http.StatusInternalServerError, "bad item from cache, could not cast to a record obj")
+		return
+	}
+
+	h.writeResponse(w, newConsumption(record))
+}
+
+func (h *ConsumptionHandler) listConsumption(w http.ResponseWriter, req *http.Request) {
+	query := req.URL.Query()
+	providers := query[providerParam]
+	plans := query[planParam]
+
+	page := ConsumptionPage{Data: []Consumption{}}
+	for _, item := range h.Cache.Items() {
+		record, ok := item.Object.(kmccache.Record)
+		if !ok {
+			continue
+		}
+		if !matches(record.Provider, providers) || !matches(record.PlanName, plans) {
+			continue
+		}
+		page.Data = append(page.Data, newConsumption(record))
+	}
+	sort.Slice(page.Data, func(i, j int) bool {
+		return page.Data[i].SubAccountID < page.Data[j].SubAccountID
+	})
+	page.Count = len(page.Data)
+
+	h.writeResponse(w, page)
+}
+
+func (h *ConsumptionHandler) writeResponse(w http.ResponseWriter, response interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		h.namedLogger().With(log.KeyResult, log.ValueFail).With(log.KeyError, err.Error()).Error("write consumption response")
+	}
+}
+
+func (h *ConsumptionHandler) writeError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(map[string]string{"error": message}); err != nil {
+		h.namedLogger().With(log.KeyResult, log.ValueFail).With(log.KeyError, err.Error()).Error("write consumption response")
+	}
+}
+
+func (h *ConsumptionHandler) namedLogger() *zap.SugaredLogger {
+	return h.Logger.With("component", "consumption")
+}
+
+func newConsumption(record kmccache.Record) Consumption {
+	return Consumption{
+		SubAccountID: record.SubAccountID,
+		RuntimeID:    record.RuntimeID,
+		ShootName:    record.ShootName,
+		Provider:     record.Provider,
+		PlanName:     record.PlanName,
+		Metric:       record.Metric,
+	}
+}
+
+// matches returns true when no values are given or the value is one of them, ignoring case
+func matches(value string, values []string) bool {
+	if len(values) == 0 {
+		return true
+	}
+	for _, v := range values {
+		if strings.EqualFold(value, v) {
+			return true
+		}
+	}
+	return false
+}
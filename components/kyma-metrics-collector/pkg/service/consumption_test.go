@@ -0,0 +1,109 @@
+package service
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/onsi/gomega"
+	gocache "github.com/patrickmn/go-cache"
+	"go.uber.org/zap/zapcore"
+
+	kmccache "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/cache"
+	"github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/edp"
+	"github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/logger"
+)
+
+func TestConsumptionHandler(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	cache := gocache.New(gocache.NoExpiration, gocache.NoExpiration)
+	awsRecord := kmccache.Record{
+		SubAccountID: "subaccount-1",
+		RuntimeID:    "runtime-1",
+		ShootName:    "shoot-1",
+		Provider:     "AWS",
+		PlanName:     "aws",
+		KubeConfig:   "kubeconfig",
+		Metric: &edp.ConsumptionMetrics{
+			RuntimeId:    "runtime-1",
+			SubAccountId: "subaccount-1",
+			ShootName:    "shoot-1",
+			Compute:      edp.Compute{ProvisionedCpus: 24, ProvisionedRAMGb: 96},
+		},
+	}
+	azureRecord := kmccache.Record{
+		SubAccountID: "subaccount-2",
+		RuntimeID:    "runtime-2",
+		ShootName:    "shoot-2",
+		Provider:     "Azure",
+		PlanName:     "azure",
+	}
+	g.Expect(cache.Add(awsRecord.SubAccountID, awsRecord, gocache.NoExpiration)).Should(gomega.BeNil())
+	g.Expect(cache.Add(azureRecord.SubAccountID, azureRecord, gocache.NoExpiration)).Should(gomega.BeNil())
+
+	router := mux.NewRouter()
+	NewConsumptionHandler(cache, logger.NewLogger(zapcore.InfoLevel)).AttachRoutes(router)
+
+	t.Run("should return consumption of the subaccount", func(t *testing.T) {
+		resp := doRequest(router, "/consumption/subaccount-1")
+
+		g.Expect(resp.Code).To(gomega.Equal(http.StatusOK))
+		var consumption Consumption
+		g.Expect(json.Unmarshal(resp.Body.Bytes(), &consumption)).Should(gomega.BeNil())
+		g.Expect(consumption).To(gomega.Equal(newConsumption(awsRecord)))
+		g.Expect(resp.Body.String()).NotTo(gomega.ContainSubstring("kubeconfig"))
+	})
+
+	t.Run("should return not found for not tracked subaccount", func(t *testing.T) {
+		resp := doRequest(router, "/consumption/subaccount-3")
+
+		g.Expect(resp.Code).To(gomega.Equal(http.StatusNotFound))
+	})
+
+	for _, tc := range []struct {
+		name     string
+		path     string
+		expected []Consumption
+	}{
+		{
+			name:     "should list consumption of all subaccounts",
+			path:     "/consumption",
+			expected: []Consumption{newConsumption(awsRecord), newConsumption(azureRecord)},
+		},
+		{
+			name:     "should filter by provider ignoring case",
+			path:     "/consumption?provider=azure",
+			expected: []Consumption{newConsumption(azureRecord)},
+		},
+		{
+			name:     "should filter by plan",
+			path:     "/consumption?plan=aws&plan=gcp",
+			expected: []Consumption{newConsumption(awsRecord)},
+		},
+		{
+			name:     "should return empty list when nothing matches",
+			path:     "/consumption?provider=aws&plan=azure",
+			expected: []Consumption{},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := doRequest(router, tc.path)
+
+			g.Expect(resp.Code).To(gomega.Equal(http.StatusOK))
+			var page ConsumptionPage
+			g.Expect(json.Unmarshal(resp.Body.Bytes(), &page)).Should(gomega.BeNil())
+			g.Expect(page.Count).To(gomega.Equal(len(tc.expected)))
+			g.Expect(page.Data).To(gomega.Equal(tc.expected))
+		})
+	}
+}
+
+func doRequest(router *mux.Router, path string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, req)
+	return resp
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"crypto/tls"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"os"
@@ -39,6 +40,8 @@ func newProvisioningService(
 	provisioningQueue queue.OperationQueue,
 	deprovisioningQueue queue.OperationQueue,
 	shootUpgradeQueue queue.OperationQueue,
+	hibernationQueue queue.OperationQueue,
+	wakeUpQueue queue.OperationQueue,
 	defaultEnableKubernetesVersionAutoUpdate,
 	defaultEnableMachineImageVersionAutoUpdate bool) provisioning.Service {
 
@@ -47,7 +50,7 @@ func newProvisioningService(
 	inputConverter := provisioning.NewInputConverter(uuidGenerator, gardenerProject, defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate)
 	graphQLConverter := provisioning.NewGraphQLConverter()
 
-	return provisioning.NewProvisioningService(inputConverter, graphQLConverter, directorService, dbsFactory, provisioner, uuidGenerator, shootProvider, provisioningQueue, deprovisioningQueue, shootUpgradeQueue)
+	return provisioning.NewProvisioningService(inputConverter, graphQLConverter, directorService, dbsFactory, provisioner, uuidGenerator, shootProvider, provisioningQueue, deprovisioningQueue, shootUpgradeQueue, hibernationQueue, wakeUpQueue)
 }
 
 func newDirectorClient(config config) (director.DirectorClient, error) {
@@ -102,6 +105,20 @@ func newGardenerClusterConfig(cfg config) (*restclient.Config, error) {
 	return gardenerClusterConfig, nil
 }
 
+func newEncryptionKeys(cfg config) (dbsession.EncryptionKeys, error) {
+	keys := dbsession.EncryptionKeys{
+		SecretKey:   cfg.Database.SecretKey,
+		SecretKeyID: cfg.Database.SecretKeyID,
+	}
+	if cfg.Database.LegacySecretKeys != "" {
+		if err := json.Unmarshal([]byte(cfg.Database.LegacySecretKeys), &keys.LegacySecretKeys); err != nil {
+			return dbsession.EncryptionKeys{}, fmt.Errorf("failed to parse legacy secret keys: %s", err.Error())
+		}
+	}
+
+	return keys, nil
+}
+
 func newHTTPClient(skipCertVerification bool) *http.Client {
 	return &http.Client{
 		Transport: &http.Transport{
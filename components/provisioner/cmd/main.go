@@ -20,6 +20,7 @@ import (
 	"github.com/kyma-project/control-plane/components/provisioner/internal/healthz"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/metrics"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/operations/notification"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/operations/queue"
 	provisioningStages "github.com/kyma-project/control-plane/components/provisioner/internal/operations/stages/provisioning"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/database"
@@ -38,12 +39,14 @@ import (
 const connStringFormat string = "host=%s port=%s user=%s password=%s dbname=%s sslmode=%s sslrootcert=%s"
 
 type config struct {
-	Address                      string `envconfig:"default=127.0.0.1:3000"`
-	APIEndpoint                  string `envconfig:"default=/graphql"`
-	PlaygroundAPIEndpoint        string `envconfig:"default=/graphql"`
-	DirectorURL                  string `envconfig:"default=http://compass-director.compass-system.svc.cluster.local:3000/graphql"`
-	SkipDirectorCertVerification bool   `envconfig:"default=false"`
-	DirectorOAuthPath            string `envconfig:"APP_DIRECTOR_OAUTH_PATH,default=./dev/director.yaml"`
+	Address               string `envconfig:"default=127.0.0.1:3000"`
+	APIEndpoint           string `envconfig:"default=/graphql"`
+	PlaygroundAPIEndpoint string `envconfig:"default=/graphql"`
+	// WebsocketKeepAliveInterval defines how often keep-alive messages are sent to GraphQL subscription clients
+	WebsocketKeepAliveInterval   time.Duration `envconfig:"default=10s"`
+	DirectorURL                  string        `envconfig:"default=http://compass-director.compass-system.svc.cluster.local:3000/graphql"`
+	SkipDirectorCertVerification bool          `envconfig:"default=false"`
+	DirectorOAuthPath            string        `envconfig:"APP_DIRECTOR_OAUTH_PATH,default=./dev/director.yaml"`
 
 	Database struct {
 		User        string `envconfig:"default=postgres"`
@@ -54,6 +57,16 @@ type config struct {
 		SSLMode     string `envconfig:"default=disable"`
 		SSLRootCert string `envconfig:"optional"`
 		SecretKey   string `envconfig:"optional"`
+		// SecretKeyID identifies the SecretKey in encrypted values, empty for values stored without a key ID
+		SecretKeyID string `envconfig:"optional"`
+		// LegacySecretKeys is a JSON object of key IDs and keys used only to decrypt values encrypted before a key rotation
+		LegacySecretKeys string `envconfig:"optional"`
+	}
+
+	Reencryption struct {
+		Enabled       bool          `envconfig:"default=false"`
+		BatchSize     int           `envconfig:"default=100"`
+		BatchInterval time.Duration `envconfig:"default=1s"`
 	}
 
 	ProvisioningTimeout   queue.ProvisioningTimeouts
@@ -140,10 +153,22 @@ func main() {
 	connection, err := database.InitializeDatabaseConnection(connString, databaseConnectionRetries)
 	exitOnError(err, "Failed to initialize persistence")
 
-	dbsFactory, err := dbsession.NewFactory(connection, cfg.Database.SecretKey)
+	encryptionKeys, err := newEncryptionKeys(cfg)
+	exitOnError(err, "Failed to load encryption keys")
+
+	dbsFactory, err := dbsession.NewFactoryWithKeys(connection, encryptionKeys)
 
 	exitOnError(err, "Cannot create database session")
 
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	if cfg.Reencryption.Enabled {
+		reencrypter, err := dbsession.NewReencrypter(connection, encryptionKeys)
+		exitOnError(err, "Cannot create re-encrypter")
+		go reencrypter.Run(cfg.Reencryption.BatchSize, cfg.Reencryption.BatchInterval, ctx.Done())
+	}
+
 	gardenerNamespace := fmt.Sprintf("garden-%s", cfg.Gardener.Project)
 
 	gardenerClusterConfig, err := newGardenerClusterConfig(cfg)
@@ -166,6 +191,8 @@ func main() {
 
 	runtimeConfigurator := runtime.NewRuntimeConfigurator(k8sClientProvider, directorClient)
 
+	statusHub := notification.NewHub()
+
 	provisioningQueue := queue.CreateProvisioningQueue(
 		cfg.ProvisioningTimeout,
 		dbsFactory,
@@ -174,11 +201,16 @@ func main() {
 		secretsInterface,
 		cfg.OperatorRoleBinding,
 		k8sClientProvider,
-		runtimeConfigurator)
+		runtimeConfigurator,
+		statusHub)
+
+	deprovisioningQueue := queue.CreateDeprovisioningQueue(cfg.DeprovisioningTimeout, dbsFactory, directorClient, shootClient, statusHub)
 
-	deprovisioningQueue := queue.CreateDeprovisioningQueue(cfg.DeprovisioningTimeout, dbsFactory, directorClient, shootClient)
+	shootUpgradeQueue := queue.CreateShootUpgradeQueue(cfg.ProvisioningTimeout, dbsFactory, directorClient, shootClient, cfg.OperatorRoleBinding, k8sClientProvider, secretsInterface, statusHub)
 
-	shootUpgradeQueue := queue.CreateShootUpgradeQueue(cfg.ProvisioningTimeout, dbsFactory, directorClient, shootClient, cfg.OperatorRoleBinding, k8sClientProvider, secretsInterface)
+	hibernationQueue := queue.CreateHibernationQueue(cfg.HibernationTimeout, dbsFactory, directorClient, shootClient, statusHub)
+
+	wakeUpQueue := queue.CreateWakeUpQueue(cfg.HibernationTimeout, dbsFactory, directorClient, shootClient, statusHub)
 
 	provisioner := gardener.NewProvisioner(gardenerNamespace, shootClient, dbsFactory, cfg.Gardener.AuditLogsPolicyConfigMap, cfg.Gardener.MaintenanceWindowConfigPath)
 	shootController, err := newShootController(gardenerNamespace, gardenerClusterConfig, dbsFactory, cfg.Gardener.AuditLogsTenantConfigPath)
@@ -197,15 +229,14 @@ func main() {
 		provisioningQueue,
 		deprovisioningQueue,
 		shootUpgradeQueue,
+		hibernationQueue,
+		wakeUpQueue,
 		cfg.Gardener.DefaultEnableKubernetesVersionAutoUpdate,
 		cfg.Gardener.DefaultEnableMachineImageVersionAutoUpdate)
 
 	tenantUpdater := api.NewTenantUpdater(dbsFactory.NewReadWriteSession())
 	validator := api.NewValidator()
-	resolver := api.NewResolver(provisioningSVC, validator, tenantUpdater)
-
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
+	resolver := api.NewResolver(provisioningSVC, validator, tenantUpdater, statusHub)
 
 	provisioningQueue.Run(ctx.Done())
 
@@ -213,6 +244,10 @@ func main() {
 
 	shootUpgradeQueue.Run(ctx.Done())
 
+	hibernationQueue.Run(ctx.Done())
+
+	wakeUpQueue.Run(ctx.Done())
+
 	gqlCfg := gqlschema.Config{
 		Resolvers: resolver,
 	}
@@ -229,6 +264,9 @@ func main() {
 	gqlHandler := handler.New(executableSchema)
 	gqlHandler.AddTransport(transport.POST{})
 	gqlHandler.AddTransport(transport.GET{})
+	gqlHandler.AddTransport(transport.Websocket{
+		KeepAlivePingInterval: cfg.WebsocketKeepAliveInterval,
+	})
 	gqlHandler.Use(extension.Introspection{})
 	gqlHandler.SetErrorPresenter(presenter.Do)
 	router.Handle(cfg.APIEndpoint, gqlHandler)
@@ -268,14 +306,14 @@ func main() {
 	}()
 
 	if cfg.EnqueueInProgressOperations {
-		err = enqueueOperationsInProgress(dbsFactory, provisioningQueue, deprovisioningQueue, shootUpgradeQueue)
+		err = enqueueOperationsInProgress(dbsFactory, provisioningQueue, deprovisioningQueue, shootUpgradeQueue, hibernationQueue, wakeUpQueue)
 		exitOnError(err, "Failed to enqueue in progress operations")
 	}
 
 	wg.Wait()
 }
 
-func enqueueOperationsInProgress(dbFactory dbsession.Factory, provisioningQueue, deprovisioningQueue, shootUpgradeQueue queue.OperationQueue) error {
+func enqueueOperationsInProgress(dbFactory dbsession.Factory, provisioningQueue, deprovisioningQueue, shootUpgradeQueue, hibernationQueue, wakeUpQueue queue.OperationQueue) error {
 	readSession := dbFactory.NewReadSession()
 
 	var inProgressOps []model.Operation
@@ -303,6 +341,10 @@ func enqueueOperationsInProgress(dbFactory dbsession.Factory, provisioningQueue,
 			deprovisioningQueue.Add(op.ID)
 		case model.UpgradeShoot:
 			shootUpgradeQueue.Add(op.ID)
+		case model.Hibernate:
+			hibernationQueue.Add(op.ID)
+		case model.WakeUp:
+			wakeUpQueue.Add(op.ID)
 		}
 	}
 
@@ -3,6 +3,7 @@ package api
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/kyma-project/control-plane/components/provisioner/internal/api/middlewares"
 	"github.com/pkg/errors"
@@ -14,31 +15,30 @@ import (
 )
 
 type Resolver struct {
-	provisioning  provisioning.Service
-	validator     Validator
-	tenantUpdater TenantUpdater
+	provisioning     provisioning.Service
+	validator        Validator
+	tenantUpdater    TenantUpdater
+	statusSubscriber StatusSubscriber
+	resyncInterval   time.Duration
 }
 
 func (r *Resolver) Mutation() gqlschema.MutationResolver {
-	return &Resolver{
-		provisioning:  r.provisioning,
-		validator:     r.validator,
-		tenantUpdater: r.tenantUpdater,
-	}
+	return r
 }
 func (r *Resolver) Query() gqlschema.QueryResolver {
-	return &Resolver{
-		provisioning:  r.provisioning,
-		validator:     r.validator,
-		tenantUpdater: r.tenantUpdater,
-	}
+	return r
+}
+func (r *Resolver) Subscription() gqlschema.SubscriptionResolver {
+	return r
 }
 
-func NewResolver(provisioningService provisioning.Service, validator Validator, tenantUpdater TenantUpdater) *Resolver {
+func NewResolver(provisioningService provisioning.Service, validator Validator, tenantUpdater TenantUpdater, statusSubscriber StatusSubscriber) *Resolver {
 	return &Resolver{
-		provisioning:  provisioningService,
-		validator:     validator,
-		tenantUpdater: tenantUpdater,
+		provisioning:     provisioningService,
+		validator:        validator,
+		tenantUpdater:    tenantUpdater,
+		statusSubscriber: statusSubscriber,
+		resyncInterval:   defaultResyncInterval,
 	}
 }
 
@@ -169,8 +169,42 @@ func (r *Resolver) UpgradeShoot(ctx context.Context, runtimeID string, input gql
 	return status, nil
 }
 
-func (r *Resolver) HibernateRuntime(context.Context, string) (*gqlschema.OperationStatus, error) {
-	return nil, nil
+func (r *Resolver) HibernateRuntime(ctx context.Context, runtimeID string) (*gqlschema.OperationStatus, error) {
+	log.Infof("Requested hibernation of Runtime %s.", runtimeID)
+
+	err := r.tenantUpdater.GetAndUpdateTenant(runtimeID, ctx)
+	if err != nil {
+		log.Errorf("Failed to hibernate Runtime %s: %s", runtimeID, err)
+		return nil, err
+	}
+
+	status, err := r.provisioning.HibernateRuntime(runtimeID)
+	if err != nil {
+		log.Errorf("Failed to hibernate Runtime %s: %s", runtimeID, err)
+		return nil, err
+	}
+	log.Infof("Hibernation started for Runtime %s. Operation id %s", runtimeID, *status.ID)
+
+	return status, nil
+}
+
+func (r *Resolver) WakeUpRuntime(ctx context.Context, runtimeID string) (*gqlschema.OperationStatus, error) {
+	log.Infof("Requested wake-up of Runtime %s.", runtimeID)
+
+	err := r.tenantUpdater.GetAndUpdateTenant(runtimeID, ctx)
+	if err != nil {
+		log.Errorf("Failed to wake up Runtime %s: %s", runtimeID, err)
+		return nil, err
+	}
+
+	status, err := r.provisioning.WakeUpRuntime(runtimeID)
+	if err != nil {
+		log.Errorf("Failed to wake up Runtime %s: %s", runtimeID, err)
+		return nil, err
+	}
+	log.Infof("Wake-up started for Runtime %s. Operation id %s", runtimeID, *status.ID)
+
+	return status, nil
 }
 
 func getSubAccount(ctx context.Context) string {
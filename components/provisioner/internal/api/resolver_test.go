@@ -58,7 +58,7 @@ func TestResolver_ProvisionRuntime(t *testing.T) {
 		provisioningService := &mocks.Service{}
 		validator := &validatorMocks.Validator{}
 		tenantUpdater := &validatorMocks.TenantUpdater{}
-		resolver := api.NewResolver(provisioningService, validator, tenantUpdater)
+		resolver := api.NewResolver(provisioningService, validator, tenantUpdater, nil)
 
 		tenantUpdater.On("GetTenant", ctx).Return(tenant, nil)
 
@@ -109,7 +109,7 @@ func TestResolver_ProvisionRuntime(t *testing.T) {
 		provisioningService := &mocks.Service{}
 		validator := &validatorMocks.Validator{}
 		tenantUpdater := &validatorMocks.TenantUpdater{}
-		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater)
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater, nil)
 
 		kymaConfig := &gqlschema.KymaConfigInput{
 			Version: "1.5",
@@ -133,7 +133,7 @@ func TestResolver_ProvisionRuntime(t *testing.T) {
 		provisioningService := &mocks.Service{}
 		validator := &validatorMocks.Validator{}
 		tenantUpdater := &validatorMocks.TenantUpdater{}
-		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater)
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater, nil)
 
 		kymaConfig := &gqlschema.KymaConfigInput{
 			Version: "1.5",
@@ -165,7 +165,7 @@ func TestResolver_ProvisionRuntime(t *testing.T) {
 		provisioningService := &mocks.Service{}
 		validator := &validatorMocks.Validator{}
 		tenantUpdater := &validatorMocks.TenantUpdater{}
-		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater)
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater, nil)
 
 		kymaConfig := &gqlschema.KymaConfigInput{
 			Version: "1.5",
@@ -205,7 +205,7 @@ func TestResolver_DeprovisionRuntime(t *testing.T) {
 		provisioningService := &mocks.Service{}
 		validator := &validatorMocks.Validator{}
 		tenantUpdater := &validatorMocks.TenantUpdater{}
-		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater)
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater, nil)
 
 		expectedID := "ec781980-0533-4098-aab7-96b535569732"
 
@@ -225,7 +225,7 @@ func TestResolver_DeprovisionRuntime(t *testing.T) {
 		provisioningService := &mocks.Service{}
 		validator := &validatorMocks.Validator{}
 		tenantUpdater := &validatorMocks.TenantUpdater{}
-		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater)
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater, nil)
 		provisioningService.On("DeprovisionRuntime", runtimeID).Return("", apperrors.Internal("Deprovisioning fails because reasons"))
 		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(nil)
 
@@ -243,7 +243,7 @@ func TestResolver_DeprovisionRuntime(t *testing.T) {
 		provisioningService := &mocks.Service{}
 		validator := &validatorMocks.Validator{}
 		tenantUpdater := &validatorMocks.TenantUpdater{}
-		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater)
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater, nil)
 		expectedID := "ec781980-0533-4098-aab7-96b535569732"
 
 		ctx := context.Background()
@@ -270,7 +270,7 @@ func TestResolver_RuntimeStatus(t *testing.T) {
 		validator := &validatorMocks.Validator{}
 		tenantUpdater := &validatorMocks.TenantUpdater{}
 
-		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater)
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater, nil)
 
 		operationID := "acc5040c-3bb6-47b8-8651-07f6950bd0a7"
 		message := "some message"
@@ -304,7 +304,7 @@ func TestResolver_RuntimeStatus(t *testing.T) {
 		validator := &validatorMocks.Validator{}
 		tenantUpdater := &validatorMocks.TenantUpdater{}
 
-		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater)
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater, nil)
 
 		provisioningService.On("RuntimeStatus", runtimeID).Return(nil, apperrors.Internal("Runtime status fails"))
 		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(nil)
@@ -329,7 +329,7 @@ func TestResolver_RuntimeOperationStatus(t *testing.T) {
 		validator := &validatorMocks.Validator{}
 		tenantUpdater := &validatorMocks.TenantUpdater{}
 
-		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater)
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater, nil)
 
 		operationID := "acc5040c-3bb6-47b8-8651-07f6950bd0a7"
 		message := "some message"
@@ -360,7 +360,7 @@ func TestResolver_RuntimeOperationStatus(t *testing.T) {
 		tenantUpdater := &validatorMocks.TenantUpdater{}
 
 		validator.On("ValidateTenantForOperation", operationID, tenant).Return(nil)
-		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater)
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater, nil)
 
 		provisioningService.On("RuntimeOperationStatus", operationID).Return(nil, apperrors.Internal("Some error"))
 		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(nil)
@@ -398,7 +398,7 @@ func TestResolver_UpgradeShoot(t *testing.T) {
 		validator.On("ValidateUpgradeShootInput", upgradeShootInput).Return(nil)
 		provisioningService.On("UpgradeGardenerShoot", runtimeID, upgradeShootInput).Return(operation, nil)
 
-		resolver := api.NewResolver(provisioningService, validator, tenantUpdater)
+		resolver := api.NewResolver(provisioningService, validator, tenantUpdater, nil)
 
 		//when
 		status, err := resolver.UpgradeShoot(ctx, runtimeID, upgradeShootInput)
@@ -420,7 +420,7 @@ func TestResolver_UpgradeShoot(t *testing.T) {
 		validator.On("ValidateUpgradeShootInput", upgradeShootInput).Return(apperrors.BadRequest("error"))
 		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(nil)
 
-		resolver := api.NewResolver(provisioningService, validator, tenantUpdater)
+		resolver := api.NewResolver(provisioningService, validator, tenantUpdater, nil)
 
 		//when
 		_, err := resolver.UpgradeShoot(ctx, runtimeID, upgradeShootInput)
@@ -431,6 +431,73 @@ func TestResolver_UpgradeShoot(t *testing.T) {
 	})
 }
 
+func TestResolver_Hibernation(t *testing.T) {
+	ctx := context.WithValue(context.Background(), middlewares.Tenant, tenant)
+
+	operation := func(operationType gqlschema.OperationType) *gqlschema.OperationStatus {
+		return &gqlschema.OperationStatus{
+			ID:        util.StringPtr(operationID),
+			Operation: operationType,
+			State:     gqlschema.OperationStateInProgress,
+			RuntimeID: util.StringPtr(runtimeID),
+		}
+	}
+
+	t.Run("Should start hibernation and return operation", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+
+		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(nil)
+		provisioningService.On("HibernateRuntime", runtimeID).Return(operation(gqlschema.OperationTypeHibernate), nil)
+
+		resolver := api.NewResolver(provisioningService, nil, tenantUpdater, nil)
+
+		//when
+		status, err := resolver.HibernateRuntime(ctx, runtimeID)
+
+		//then
+		require.NoError(t, err)
+		assert.Equal(t, operation(gqlschema.OperationTypeHibernate), status)
+	})
+
+	t.Run("Should start wake-up and return operation", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+
+		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(nil)
+		provisioningService.On("WakeUpRuntime", runtimeID).Return(operation(gqlschema.OperationTypeWakeUp), nil)
+
+		resolver := api.NewResolver(provisioningService, nil, tenantUpdater, nil)
+
+		//when
+		status, err := resolver.WakeUpRuntime(ctx, runtimeID)
+
+		//then
+		require.NoError(t, err)
+		assert.Equal(t, operation(gqlschema.OperationTypeWakeUp), status)
+	})
+
+	t.Run("Should return error when Runtime belongs to other tenant", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+
+		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(apperrors.BadRequest("provided tenant does not match tenant used to provision cluster"))
+
+		resolver := api.NewResolver(provisioningService, nil, tenantUpdater, nil)
+
+		//when
+		_, err := resolver.HibernateRuntime(ctx, runtimeID)
+
+		//then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+		provisioningService.AssertNotCalled(t, "HibernateRuntime", runtimeID)
+	})
+}
+
 func oidcInput() *gqlschema.OIDCConfigInput {
 	return &gqlschema.OIDCConfigInput{
 		ClientID:       "9bd05ed7-a930-44e6-8c79-e6defeb2222",
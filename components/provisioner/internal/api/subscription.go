@@ -0,0 +1,133 @@
+package api
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+)
+
+// defaultResyncInterval defines how often subscriptions re-read the status without being notified,
+// which covers changes made outside of the operation executors of this instance
+const defaultResyncInterval = 30 * time.Second
+
+type StatusSubscriber interface {
+	SubscribeOperation(operationID string) (<-chan struct{}, func())
+	SubscribeRuntime(runtimeID string) (<-chan struct{}, func())
+}
+
+func (r *Resolver) OperationStatusChanged(ctx context.Context, operationID string) (<-chan *gqlschema.OperationStatus, error) {
+	log.Infof("Requested subscription to status of Operation %s.", operationID)
+
+	notifications, cancel := r.statusSubscriber.SubscribeOperation(operationID)
+
+	status, err := r.RuntimeOperationStatus(ctx, operationID)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	updates := make(chan *gqlschema.OperationStatus, 1)
+	updates <- status
+
+	if operationFinished(status) {
+		cancel()
+		close(updates)
+		return updates, nil
+	}
+
+	go func() {
+		defer close(updates)
+		defer cancel()
+
+		r.watch(ctx, notifications, func() bool {
+			current, err := r.provisioning.RuntimeOperationStatus(operationID)
+			if err != nil {
+				log.Errorf("Failed to get Runtime operation status for subscription: %s Operation ID: %s", err, operationID)
+				return false
+			}
+			if reflect.DeepEqual(current, status) {
+				return false
+			}
+			status = current
+
+			select {
+			case updates <- current:
+			case <-ctx.Done():
+				return true
+			}
+
+			return operationFinished(current)
+		})
+	}()
+
+	return updates, nil
+}
+
+func (r *Resolver) RuntimeStatusChanged(ctx context.Context, runtimeID string) (<-chan *gqlschema.RuntimeStatus, error) {
+	log.Infof("Requested subscription to status of Runtime %s.", runtimeID)
+
+	notifications, cancel := r.statusSubscriber.SubscribeRuntime(runtimeID)
+
+	status, err := r.RuntimeStatus(ctx, runtimeID)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	updates := make(chan *gqlschema.RuntimeStatus, 1)
+	updates <- status
+
+	go func() {
+		defer close(updates)
+		defer cancel()
+
+		r.watch(ctx, notifications, func() bool {
+			current, err := r.provisioning.RuntimeStatus(runtimeID)
+			if err != nil {
+				log.Errorf("Failed to get status for subscription to Runtime %s: %s", runtimeID, err)
+				return false
+			}
+			if reflect.DeepEqual(current, status) {
+				return false
+			}
+			status = current
+
+			select {
+			case updates <- current:
+			case <-ctx.Done():
+				return true
+			}
+
+			return false
+		})
+	}()
+
+	return updates, nil
+}
+
+// watch calls refresh on every notification and every resync interval until the context is done or refresh returns true
+func (r *Resolver) watch(ctx context.Context, notifications <-chan struct{}, refresh func() bool) {
+	ticker := time.NewTicker(r.resyncInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-notifications:
+		case <-ticker.C:
+		}
+
+		if refresh() {
+			return
+		}
+	}
+}
+
+func operationFinished(status *gqlschema.OperationStatus) bool {
+	return status.State == gqlschema.OperationStateSucceeded || status.State == gqlschema.OperationStateFailed
+}
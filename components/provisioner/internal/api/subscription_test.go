@@ -0,0 +1,151 @@
+package api_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/api"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/api/middlewares"
+	validatorMocks "github.com/kyma-project/control-plane/components/provisioner/internal/api/mocks"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/operations/notification"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/mocks"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolver_OperationStatusChanged(t *testing.T) {
+	ctx := context.WithValue(context.Background(), middlewares.Tenant, tenant)
+
+	operationStatus := func(state gqlschema.OperationState, message string) *gqlschema.OperationStatus {
+		return &gqlschema.OperationStatus{
+			ID:        util.StringPtr(operationID),
+			Operation: gqlschema.OperationTypeProvision,
+			State:     state,
+			Message:   util.StringPtr(message),
+			RuntimeID: util.StringPtr(runtimeID),
+		}
+	}
+
+	t.Run("Should send operation status changes and complete when operation finishes", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+		hub := notification.NewHub()
+
+		inProgress := operationStatus(gqlschema.OperationStateInProgress, "Operation in progress. Stage WaitingForClusterCreation")
+		nextStage := operationStatus(gqlschema.OperationStateInProgress, "Operation in progress. Stage ConnectRuntimeAgent")
+		succeeded := operationStatus(gqlschema.OperationStateSucceeded, "Operation succeeded")
+
+		provisioningService.On("RuntimeOperationStatus", operationID).Return(inProgress, nil).Once()
+		provisioningService.On("RuntimeOperationStatus", operationID).Return(nextStage, nil).Once()
+		provisioningService.On("RuntimeOperationStatus", operationID).Return(succeeded, nil).Once()
+		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(nil)
+
+		resolver := api.NewResolver(provisioningService, nil, tenantUpdater, hub)
+
+		//when
+		updates, err := resolver.OperationStatusChanged(ctx, operationID)
+		require.NoError(t, err)
+
+		//then
+		assert.Equal(t, inProgress, <-updates)
+
+		hub.OperationChanged(operationID, runtimeID)
+		assert.Equal(t, nextStage, <-updates)
+
+		hub.OperationChanged(operationID, runtimeID)
+		assert.Equal(t, succeeded, <-updates)
+
+		_, open := <-updates
+		assert.False(t, open)
+	})
+
+	t.Run("Should complete after current status when operation is already finished", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+
+		failed := operationStatus(gqlschema.OperationStateFailed, "timeout")
+
+		provisioningService.On("RuntimeOperationStatus", operationID).Return(failed, nil)
+		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(nil)
+
+		resolver := api.NewResolver(provisioningService, nil, tenantUpdater, notification.NewHub())
+
+		//when
+		updates, err := resolver.OperationStatusChanged(ctx, operationID)
+		require.NoError(t, err)
+
+		//then
+		assert.Equal(t, failed, <-updates)
+
+		_, open := <-updates
+		assert.False(t, open)
+	})
+
+	t.Run("Should return error when Runtime belongs to other tenant", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+
+		provisioningService.On("RuntimeOperationStatus", operationID).Return(operationStatus(gqlschema.OperationStateInProgress, ""), nil)
+		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(apperrors.BadRequest("provided tenant does not match tenant used to provision cluster"))
+
+		resolver := api.NewResolver(provisioningService, nil, tenantUpdater, notification.NewHub())
+
+		//when
+		_, err := resolver.OperationStatusChanged(ctx, operationID)
+
+		//then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+	})
+}
+
+func TestResolver_RuntimeStatusChanged(t *testing.T) {
+	t.Run("Should send Runtime status changes until subscription is closed", func(t *testing.T) {
+		//given
+		ctx, cancel := context.WithCancel(context.WithValue(context.Background(), middlewares.Tenant, tenant))
+		defer cancel()
+
+		provisioningService := &mocks.Service{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+		hub := notification.NewHub()
+
+		provisioning := &gqlschema.RuntimeStatus{
+			LastOperationStatus: &gqlschema.OperationStatus{
+				ID:    util.StringPtr(operationID),
+				State: gqlschema.OperationStateInProgress,
+			},
+		}
+		provisioned := &gqlschema.RuntimeStatus{
+			LastOperationStatus: &gqlschema.OperationStatus{
+				ID:    util.StringPtr(operationID),
+				State: gqlschema.OperationStateSucceeded,
+			},
+		}
+
+		provisioningService.On("RuntimeStatus", runtimeID).Return(provisioning, nil).Once()
+		provisioningService.On("RuntimeStatus", runtimeID).Return(provisioned, nil)
+		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(nil)
+
+		resolver := api.NewResolver(provisioningService, nil, tenantUpdater, hub)
+
+		//when
+		updates, err := resolver.RuntimeStatusChanged(ctx, runtimeID)
+		require.NoError(t, err)
+
+		//then
+		assert.Equal(t, provisioning, <-updates)
+
+		hub.OperationChanged(operationID, runtimeID)
+		assert.Equal(t, provisioned, <-updates)
+
+		cancel()
+		_, open := <-updates
+		assert.False(t, open)
+	})
+}
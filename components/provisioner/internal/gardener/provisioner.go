@@ -127,6 +127,70 @@ func (g *GardenerProvisioner) UpgradeCluster(clusterID string, upgradeConfig mod
 	return nil
 }
 
+func (g *GardenerProvisioner) HibernateCluster(clusterID string, gardenerConfig model.GardenerConfig) apperrors.AppError {
+	return g.setHibernation(clusterID, gardenerConfig, true)
+}
+
+func (g *GardenerProvisioner) WakeUpCluster(clusterID string, gardenerConfig model.GardenerConfig) apperrors.AppError {
+	return g.setHibernation(clusterID, gardenerConfig, false)
+}
+
+func (g *GardenerProvisioner) setHibernation(clusterID string, gardenerConfig model.GardenerConfig, enabled bool) apperrors.AppError {
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		shoot, err := g.shootClient.Get(context.Background(), gardenerConfig.Name, v1.GetOptions{})
+		if err != nil {
+			appErr := util.K8SErrorToAppError(err).SetComponent(apperrors.ErrGardenerClient)
+			return appErr.Append("error getting Shoot for cluster ID %s and name %s", clusterID, gardenerConfig.Name)
+		}
+
+		if shoot.Spec.Hibernation == nil {
+			shoot.Spec.Hibernation = &gardener_types.Hibernation{}
+		}
+		shoot.Spec.Hibernation.Enabled = util.BoolPtr(enabled)
+
+		setObjectFields(shoot)
+
+		shootData, err := json.Marshal(shoot)
+		if err != nil {
+			apperr := util.K8SErrorToAppError(err).SetComponent(apperrors.ErrProvisioner)
+			return apperr.Append("error during marshaling Shoot data")
+		}
+
+		_, err = g.shootClient.Patch(context.Background(), shoot.Name, types.ApplyPatchType, shootData, v1.PatchOptions{FieldManager: "provisioner", Force: util.BoolPtr(true)})
+		return err
+	})
+	if err != nil {
+		apperr := util.K8SErrorToAppError(err).SetComponent(apperrors.ErrGardenerClient)
+		return apperr.Append("error setting hibernation of Shoot to %t", enabled)
+	}
+
+	return nil
+}
+
+func (g *GardenerProvisioner) GetHibernationStatus(clusterID string, gardenerConfig model.GardenerConfig) (model.HibernationStatus, apperrors.AppError) {
+	shoot, err := g.shootClient.Get(context.Background(), gardenerConfig.Name, v1.GetOptions{})
+	if err != nil {
+		appErr := util.K8SErrorToAppError(err).SetComponent(apperrors.ErrGardenerClient)
+		return model.HibernationStatus{}, appErr.Append("error getting Shoot for cluster ID %s and name %s", clusterID, gardenerConfig.Name)
+	}
+
+	return model.HibernationStatus{
+		Hibernated:          shoot.Status.IsHibernated,
+		HibernationPossible: isHibernationPossible(shoot),
+	}, nil
+}
+
+// isHibernationPossible checks the Shoot constraint set by Gardener, hibernation is possible when the constraint is not reported
+func isHibernationPossible(shoot *gardener_types.Shoot) bool {
+	for _, constraint := range shoot.Status.Constraints {
+		if constraint.Type == gardener_types.ShootHibernationPossible {
+			return constraint.Status != gardener_types.ConditionFalse
+		}
+	}
+
+	return true
+}
+
 func (g *GardenerProvisioner) DeprovisionCluster(cluster model.Cluster, operationId string) (model.Operation, apperrors.AppError) {
 	shoot, err := g.shootClient.Get(context.Background(), cluster.ClusterConfig.Name, v1.GetOptions{})
 	if err != nil {
@@ -214,6 +214,83 @@ func TestGardenerProvisioner_UpgradeCluster(t *testing.T) {
 	})
 }
 
+func TestGardenerProvisioner_Hibernation(t *testing.T) {
+	gcpGardenerConfig, err := model.NewGCPGardenerConfig(&gqlschema.GCPProviderConfigInput{Zones: []string{"zone-1"}})
+	require.NoError(t, err)
+	cluster := newClusterConfig(clusterName, nil, gcpGardenerConfig, region, purpose)
+
+	t.Run("should hibernate and wake up shoot", func(t *testing.T) {
+		// given
+		clientset := fake.NewSimpleClientset(testkit.NewTestShoot(clusterName).InNamespace(gardenerNamespace).ToShoot())
+		shootClient := clientset.CoreV1beta1().Shoots(gardenerNamespace)
+
+		provisioner := NewProvisioner(gardenerNamespace, shootClient, &sessionMocks.Factory{}, auditLogsPolicyCMName, "")
+
+		// when
+		apperr := provisioner.HibernateCluster(cluster.ID, cluster.ClusterConfig)
+		require.NoError(t, apperr)
+
+		// then
+		shoot, err := shootClient.Get(context.Background(), clusterName, v1.GetOptions{})
+		require.NoError(t, err)
+		require.NotNil(t, shoot.Spec.Hibernation)
+		assert.True(t, *shoot.Spec.Hibernation.Enabled)
+
+		// when
+		apperr = provisioner.WakeUpCluster(cluster.ID, cluster.ClusterConfig)
+		require.NoError(t, apperr)
+
+		// then
+		shoot, err = shootClient.Get(context.Background(), clusterName, v1.GetOptions{})
+		require.NoError(t, err)
+		assert.False(t, *shoot.Spec.Hibernation.Enabled)
+	})
+
+	t.Run("should return error when failed to get shoot from Gardener", func(t *testing.T) {
+		// given
+		clientset := fake.NewSimpleClientset()
+		shootClient := clientset.CoreV1beta1().Shoots(gardenerNamespace)
+
+		provisioner := NewProvisioner(gardenerNamespace, shootClient, &sessionMocks.Factory{}, auditLogsPolicyCMName, "")
+
+		// when
+		apperr := provisioner.HibernateCluster(cluster.ID, cluster.ClusterConfig)
+
+		// then
+		require.Error(t, apperr)
+		assert.Equal(t, apperrors.CodeInternal, apperr.Code())
+	})
+
+	for _, testCase := range []struct {
+		description         string
+		hibernationPossible bool
+		hibernated          bool
+	}{
+		{description: "hibernated shoot", hibernationPossible: true, hibernated: true},
+		{description: "running shoot", hibernationPossible: true, hibernated: false},
+		{description: "shoot which cannot be hibernated", hibernationPossible: false, hibernated: false},
+	} {
+		t.Run("should return hibernation status of "+testCase.description, func(t *testing.T) {
+			// given
+			clientset := fake.NewSimpleClientset(testkit.NewTestShoot(clusterName).
+				InNamespace(gardenerNamespace).
+				WithHibernationState(testCase.hibernationPossible, testCase.hibernated).
+				ToShoot())
+			shootClient := clientset.CoreV1beta1().Shoots(gardenerNamespace)
+
+			provisioner := NewProvisioner(gardenerNamespace, shootClient, &sessionMocks.Factory{}, auditLogsPolicyCMName, "")
+
+			// when
+			status, apperr := provisioner.GetHibernationStatus(cluster.ID, cluster.ClusterConfig)
+
+			// then
+			require.NoError(t, apperr)
+			assert.Equal(t, testCase.hibernated, status.Hibernated)
+			assert.Equal(t, testCase.hibernationPossible, status.HibernationPossible)
+		})
+	}
+}
+
 func newClusterConfig(name string, subAccountID *string, providerConfig model.GardenerProviderConfig, region string, purpose string) model.Cluster {
 	return model.Cluster{
 		ID:           runtimeId,
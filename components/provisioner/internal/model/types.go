@@ -23,6 +23,7 @@ const (
 	DeprovisionNoInstall OperationType = "DEPROVISION_NO_INSTALL"
 	ReconnectRuntime     OperationType = "RECONNECT_RUNTIME"
 	Hibernate            OperationType = "HIBERNATE"
+	WakeUp               OperationType = "WAKE_UP"
 )
 
 type OperationStage string
@@ -48,6 +49,7 @@ const (
 	WaitingForShootNewVersion OperationStage = "WaitingForShootNewVersion"
 
 	WaitForHibernation OperationStage = "WaitForHibernation"
+	WaitForWakeUp      OperationStage = "WaitForWakeUp"
 
 	FinishedStage OperationStage = "Finished"
 )
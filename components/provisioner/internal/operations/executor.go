@@ -27,7 +27,8 @@ func NewExecutor(
 	operation model.OperationType,
 	stages map[model.OperationStage]Step,
 	failureHandler FailureHandler,
-	directorClient director.DirectorClient) *Executor {
+	directorClient director.DirectorClient,
+	notifier StatusNotifier) *Executor {
 
 	return &Executor{
 		dbSession:      session,
@@ -36,6 +37,7 @@ func NewExecutor(
 		failureHandler: failureHandler,
 		log:            logrus.WithFields(logrus.Fields{"Component": "Executor", "OperationType": operation}),
 		directorClient: directorClient,
+		notifier:       notifier,
 	}
 }
 
@@ -45,6 +47,7 @@ type Executor struct {
 	operation      model.OperationType
 	failureHandler FailureHandler
 	directorClient director.DirectorClient
+	notifier       StatusNotifier
 
 	log logrus.FieldLogger
 }
@@ -84,6 +87,7 @@ func (e *Executor) Execute(operationID string) ProcessingResult {
 				log.Errorf("unrecoverable error occurred while processing operation: %s", err.Error())
 				e.handleOperationFailure(operation, cluster, log)
 				e.updateOperationStatus(log, operation.ID, nonRecoverable.Error(), model.Failed, time.Now())
+				e.notifier.OperationChanged(operation.ID, operation.ClusterID)
 				e.setRuntimeStatusCondition(log, cluster.ID, cluster.Tenant)
 
 				return ProcessingResult{Requeue: false}
@@ -131,12 +135,14 @@ func (e *Executor) process(operation model.Operation, cluster model.Cluster, log
 		if result.Stage == model.FinishedStage {
 			log.Infof("Finished processing operation")
 			e.updateOperationStage(log, operation.ID, "Provisioning steps finished", model.FinishedStage, time.Now())
+			e.notifier.OperationChanged(operation.ID, operation.ClusterID)
 			break
 		}
 
 		if result.Stage != step.Name() {
 			transitionTime := time.Now()
 			e.updateOperationStage(log, operation.ID, fmt.Sprintf("Operation in progress. Stage %s", result.Stage), result.Stage, transitionTime)
+			e.notifier.OperationChanged(operation.ID, operation.ClusterID)
 			step = e.stages[result.Stage]
 			operation.Stage = result.Stage
 			operation.LastTransition = &transitionTime
@@ -150,6 +156,7 @@ func (e *Executor) process(operation model.Operation, cluster model.Cluster, log
 
 	logger.Infof("Setting operation to succeeded")
 	e.updateOperationStatus(logger, operation.ID, "Operation succeeded", model.Succeeded, time.Now())
+	e.notifier.OperationChanged(operation.ID, operation.ClusterID)
 
 	return false, 0, nil
 }
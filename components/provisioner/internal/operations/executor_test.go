@@ -62,7 +62,9 @@ func TestStagesExecutor_Execute(t *testing.T) {
 
 		directorClient := &directorMocks.DirectorClient{}
 
-		executor := NewExecutor(dbSession, model.Provision, installationStages, failure.NewNoopFailureHandler(), directorClient)
+		notifier := &MockStatusNotifier{}
+
+		executor := NewExecutor(dbSession, model.Provision, installationStages, failure.NewNoopFailureHandler(), directorClient, notifier)
 
 		// when
 		result := executor.Execute(operationId)
@@ -70,6 +72,7 @@ func TestStagesExecutor_Execute(t *testing.T) {
 		// then
 		assert.Equal(t, false, result.Requeue)
 		assert.True(t, mockStage.called)
+		assert.Equal(t, []string{operationId, operationId}, notifier.operations)
 	})
 
 	t.Run("should requeue operation if error occurred", func(t *testing.T) {
@@ -88,7 +91,9 @@ func TestStagesExecutor_Execute(t *testing.T) {
 
 		directorClient := &directorMocks.DirectorClient{}
 
-		executor := NewExecutor(dbSession, model.Provision, installationStages, failure.NewNoopFailureHandler(), directorClient)
+		notifier := &MockStatusNotifier{}
+
+		executor := NewExecutor(dbSession, model.Provision, installationStages, failure.NewNoopFailureHandler(), directorClient, notifier)
 
 		// when
 		result := executor.Execute(operationId)
@@ -96,6 +101,7 @@ func TestStagesExecutor_Execute(t *testing.T) {
 		// then
 		assert.Equal(t, true, result.Requeue)
 		assert.True(t, mockStage.called)
+		assert.Empty(t, notifier.operations)
 	})
 
 	t.Run("should not requeue operation and run failure handler if NonRecoverable error occurred", func(t *testing.T) {
@@ -119,7 +125,9 @@ func TestStagesExecutor_Execute(t *testing.T) {
 
 		failureHandler := MockFailureHandler{}
 
-		executor := NewExecutor(dbSession, model.Provision, installationStages, &failureHandler, directorClient)
+		notifier := &MockStatusNotifier{}
+
+		executor := NewExecutor(dbSession, model.Provision, installationStages, &failureHandler, directorClient, notifier)
 
 		// when
 		result := executor.Execute(operationId)
@@ -128,6 +136,7 @@ func TestStagesExecutor_Execute(t *testing.T) {
 		assert.Equal(t, false, result.Requeue)
 		assert.True(t, mockStage.called)
 		assert.True(t, failureHandler.called)
+		assert.Equal(t, []string{operationId}, notifier.operations)
 	})
 
 	t.Run("should not requeue operation and run failure handler if NonRecoverable error occurred but failed to update Director", func(t *testing.T) {
@@ -151,7 +160,9 @@ func TestStagesExecutor_Execute(t *testing.T) {
 
 		failureHandler := MockFailureHandler{}
 
-		executor := NewExecutor(dbSession, model.Provision, installationStages, &failureHandler, directorClient)
+		notifier := &MockStatusNotifier{}
+
+		executor := NewExecutor(dbSession, model.Provision, installationStages, &failureHandler, directorClient, notifier)
 
 		// when
 		result := executor.Execute(operationId)
@@ -184,7 +195,9 @@ func TestStagesExecutor_Execute(t *testing.T) {
 
 		failureHandler := MockFailureHandler{}
 
-		executor := NewExecutor(dbSession, model.Provision, installationStages, &failureHandler, directorClient)
+		notifier := &MockStatusNotifier{}
+
+		executor := NewExecutor(dbSession, model.Provision, installationStages, &failureHandler, directorClient, notifier)
 
 		// when
 		result := executor.Execute(operationId)
@@ -254,6 +267,14 @@ func (m *MockFailureHandler) HandleFailure(operation model.Operation, cluster mo
 	return nil
 }
 
+type MockStatusNotifier struct {
+	operations []string
+}
+
+func (m *MockStatusNotifier) OperationChanged(operationID, runtimeID string) {
+	m.operations = append(m.operations, operationID)
+}
+
 func TestConvertToAppError(t *testing.T) {
 	t.Run("should convert to app error", func(t *testing.T) {
 		//given
@@ -0,0 +1,76 @@
+package notification
+
+import (
+	"sync"
+)
+
+// Hub fans out notifications about operation changes to subscribers watching
+// a single operation or all operations of a single Runtime.
+// Notifications carry no payload, subscribers are expected to read the current state on their own.
+// Notifications are coalesced, a subscriber which did not consume the previous signal receives only one.
+type Hub struct {
+	mu         sync.Mutex
+	operations map[string]map[chan struct{}]struct{}
+	runtimes   map[string]map[chan struct{}]struct{}
+}
+
+func NewHub() *Hub {
+	return &Hub{
+		operations: map[string]map[chan struct{}]struct{}{},
+		runtimes:   map[string]map[chan struct{}]struct{}{},
+	}
+}
+
+// OperationChanged notifies subscribers of the operation and of the Runtime the operation belongs to
+func (h *Hub) OperationChanged(operationID, runtimeID string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	signal(h.operations[operationID])
+	signal(h.runtimes[runtimeID])
+}
+
+// SubscribeOperation returns a channel signalled on every change of the operation and a function releasing the subscription
+func (h *Hub) SubscribeOperation(operationID string) (<-chan struct{}, func()) {
+	return h.subscribe(h.operations, operationID)
+}
+
+// SubscribeRuntime returns a channel signalled on every change of any operation of the Runtime and a function releasing the subscription
+func (h *Hub) SubscribeRuntime(runtimeID string) (<-chan struct{}, func()) {
+	return h.subscribe(h.runtimes, runtimeID)
+}
+
+func (h *Hub) subscribe(subscriptions map[string]map[chan struct{}]struct{}, id string) (<-chan struct{}, func()) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	ch := make(chan struct{}, 1)
+	if subscriptions[id] == nil {
+		subscriptions[id] = map[chan struct{}]struct{}{}
+	}
+	subscriptions[id][ch] = struct{}{}
+
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			h.mu.Lock()
+			defer h.mu.Unlock()
+
+			delete(subscriptions[id], ch)
+			if len(subscriptions[id]) == 0 {
+				delete(subscriptions, id)
+			}
+		})
+	}
+
+	return ch, cancel
+}
+
+func signal(subscribers map[chan struct{}]struct{}) {
+	for ch := range subscribers {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package notification
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHub(t *testing.T) {
+	t.Run("should notify operation and runtime subscribers", func(t *testing.T) {
+		// given
+		hub := NewHub()
+
+		operationCh, cancelOperation := hub.SubscribeOperation("op-1")
+		defer cancelOperation()
+		runtimeCh, cancelRuntime := hub.SubscribeRuntime("runtime-1")
+		defer cancelRuntime()
+		otherCh, cancelOther := hub.SubscribeOperation("op-2")
+		defer cancelOther()
+
+		// when
+		hub.OperationChanged("op-1", "runtime-1")
+
+		// then
+		assert.Len(t, operationCh, 1)
+		assert.Len(t, runtimeCh, 1)
+		assert.Len(t, otherCh, 0)
+	})
+
+	t.Run("should coalesce notifications not consumed by subscriber", func(t *testing.T) {
+		// given
+		hub := NewHub()
+
+		operationCh, cancel := hub.SubscribeOperation("op-1")
+		defer cancel()
+
+		// when
+		hub.OperationChanged("op-1", "runtime-1")
+		hub.OperationChanged("op-1", "runtime-1")
+		hub.OperationChanged("op-1", "runtime-1")
+
+		// then
+		assert.Len(t, operationCh, 1)
+	})
+
+	t.Run("should not notify cancelled subscription", func(t *testing.T) {
+		// given
+		hub := NewHub()
+
+		operationCh, cancel := hub.SubscribeOperation("op-1")
+
+		// when
+		cancel()
+		cancel()
+		hub.OperationChanged("op-1", "runtime-1")
+
+		// then
+		assert.Len(t, operationCh, 0)
+		assert.Empty(t, hub.operations)
+	})
+}
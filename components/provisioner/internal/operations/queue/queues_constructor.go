@@ -10,6 +10,7 @@ import (
 	"github.com/kyma-project/control-plane/components/provisioner/internal/operations"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/operations/failure"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/operations/stages/deprovisioning"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/operations/stages/hibernation"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/operations/stages/provisioning"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/operations/stages/shootupgrade"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession"
@@ -39,6 +40,7 @@ type DeprovisioningTimeouts struct {
 
 type HibernationTimeouts struct {
 	WaitingForClusterHibernation time.Duration `envconfig:"default=60m"`
+	WaitingForClusterWakeUp      time.Duration `envconfig:"default=60m"`
 }
 
 func CreateProvisioningQueue(
@@ -49,7 +51,8 @@ func CreateProvisioningQueue(
 	secretsClient v1core.SecretInterface,
 	operatorRoleBindingConfig provisioning.OperatorRoleBinding,
 	k8sClientProvider k8s.K8sClientProvider,
-	configurator runtime.Configurator) OperationQueue {
+	configurator runtime.Configurator,
+	notifier operations.StatusNotifier) OperationQueue {
 
 	configureAgentStep := provisioning.NewConnectAgentStep(configurator, model.FinishedStage, timeouts.AgentConfiguration)
 	createBindingsForOperatorsStep := provisioning.NewCreateBindingsForOperatorsStep(k8sClientProvider, operatorRoleBindingConfig, configureAgentStep.Name(), timeouts.BindingsCreation)
@@ -69,6 +72,7 @@ func CreateProvisioningQueue(
 		provisionSteps,
 		failure.NewNoopFailureHandler(),
 		directorClient,
+		notifier,
 	)
 
 	return NewQueue(provisioningExecutor)
@@ -79,6 +83,7 @@ func CreateDeprovisioningQueue(
 	factory dbsession.Factory,
 	directorClient director.DirectorClient,
 	shootClient gardener_apis.ShootInterface,
+	notifier operations.StatusNotifier,
 ) OperationQueue {
 
 	waitForClusterDeletion := deprovisioning.NewWaitForClusterDeletionStep(shootClient, factory, directorClient, model.FinishedStage, timeouts.WaitingForClusterDeletion)
@@ -95,6 +100,7 @@ func CreateDeprovisioningQueue(
 		deprovisioningSteps,
 		failure.NewNoopFailureHandler(),
 		directorClient,
+		notifier,
 	)
 
 	return NewQueue(deprovisioningExecutor)
@@ -108,6 +114,7 @@ func CreateShootUpgradeQueue(
 	operatorRoleBindingConfig provisioning.OperatorRoleBinding,
 	k8sClientProvider k8s.K8sClientProvider,
 	secretsClient v1core.SecretInterface,
+	notifier operations.StatusNotifier,
 ) OperationQueue {
 
 	createBindingsForOperatorsStep := provisioning.NewCreateBindingsForOperatorsStep(k8sClientProvider, operatorRoleBindingConfig, model.FinishedStage, timeouts.BindingsCreation)
@@ -126,7 +133,60 @@ func CreateShootUpgradeQueue(
 		upgradeSteps,
 		failure.NewNoopFailureHandler(),
 		directorClient,
+		notifier,
 	)
 
 	return NewQueue(upgradeClusterExecutor)
 }
+
+func CreateHibernationQueue(
+	timeouts HibernationTimeouts,
+	factory dbsession.Factory,
+	directorClient director.DirectorClient,
+	shootClient gardener_apis.ShootInterface,
+	notifier operations.StatusNotifier,
+) OperationQueue {
+
+	waitForHibernation := hibernation.NewWaitForHibernationStep(shootClient, model.FinishedStage, timeouts.WaitingForClusterHibernation)
+
+	hibernationSteps := map[model.OperationStage]operations.Step{
+		model.WaitForHibernation: waitForHibernation,
+	}
+
+	hibernateClusterExecutor := operations.NewExecutor(
+		factory.NewReadWriteSession(),
+		model.Hibernate,
+		hibernationSteps,
+		failure.NewNoopFailureHandler(),
+		directorClient,
+		notifier,
+	)
+
+	return NewQueue(hibernateClusterExecutor)
+}
+
+func CreateWakeUpQueue(
+	timeouts HibernationTimeouts,
+	factory dbsession.Factory,
+	directorClient director.DirectorClient,
+	shootClient gardener_apis.ShootInterface,
+	notifier operations.StatusNotifier,
+) OperationQueue {
+
+	waitForWakeUp := hibernation.NewWaitForWakeUpStep(shootClient, model.FinishedStage, timeouts.WaitingForClusterWakeUp)
+
+	wakeUpSteps := map[model.OperationStage]operations.Step{
+		model.WaitForWakeUp: waitForWakeUp,
+	}
+
+	wakeUpClusterExecutor := operations.NewExecutor(
+		factory.NewReadWriteSession(),
+		model.WakeUp,
+		wakeUpSteps,
+		failure.NewNoopFailureHandler(),
+		directorClient,
+		notifier,
+	)
+
+	return NewQueue(wakeUpClusterExecutor)
+}
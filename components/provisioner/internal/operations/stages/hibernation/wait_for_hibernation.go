@@ -0,0 +1,69 @@
+package hibernation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/operations"
+	"github.com/sirupsen/logrus"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type GardenerClient interface {
+	Get(ctx context.Context, name string, options v1.GetOptions) (*gardener_types.Shoot, error)
+}
+
+type WaitForHibernationStep struct {
+	gardenerClient GardenerClient
+	nextStep       model.OperationStage
+	timeLimit      time.Duration
+}
+
+func NewWaitForHibernationStep(gardenerClient GardenerClient, nextStep model.OperationStage, timeLimit time.Duration) *WaitForHibernationStep {
+	return &WaitForHibernationStep{
+		gardenerClient: gardenerClient,
+		nextStep:       nextStep,
+		timeLimit:      timeLimit,
+	}
+}
+
+func (s WaitForHibernationStep) Name() model.OperationStage {
+	return model.WaitForHibernation
+}
+
+func (s *WaitForHibernationStep) TimeLimit() time.Duration {
+	return s.timeLimit
+}
+
+func (s *WaitForHibernationStep) Run(cluster model.Cluster, _ model.Operation, logger logrus.FieldLogger) (operations.StageResult, error) {
+	return waitForHibernationState(s.gardenerClient, cluster, true, s.Name(), s.nextStep, logger)
+}
+
+// waitForHibernationState moves to the next step when Gardener reconciled the Shoot to the expected hibernation state
+func waitForHibernationState(gardenerClient GardenerClient, cluster model.Cluster, hibernated bool, stage, nextStep model.OperationStage, logger logrus.FieldLogger) (operations.StageResult, error) {
+	shoot, err := gardenerClient.Get(context.Background(), cluster.ClusterConfig.Name, v1.GetOptions{})
+	if err != nil {
+		return operations.StageResult{}, err
+	}
+
+	if shoot.Status.ObservedGeneration != shoot.ObjectMeta.Generation {
+		return operations.StageResult{Stage: stage, Delay: 5 * time.Second}, nil
+	}
+
+	lastOperation := shoot.Status.LastOperation
+	if lastOperation != nil && lastOperation.State == gardener_types.LastOperationStateFailed {
+		logger.Warningf("Gardener Shoot cluster hibernation state change failed! Last state: %s, Description: %s", lastOperation.State, lastOperation.Description)
+
+		err := fmt.Errorf("Gardener Shoot cluster hibernation state change failed. Last Shoot state: %s, Shoot description: %s", lastOperation.State, lastOperation.Description)
+		return operations.StageResult{}, operations.NewNonRecoverableError(err)
+	}
+
+	if shoot.Status.IsHibernated == hibernated && (lastOperation == nil || lastOperation.State == gardener_types.LastOperationStateSucceeded) {
+		return operations.StageResult{Stage: nextStep, Delay: 0}, nil
+	}
+
+	return operations.StageResult{Stage: stage, Delay: 20 * time.Second}, nil
+}
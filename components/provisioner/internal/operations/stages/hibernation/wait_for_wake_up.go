@@ -0,0 +1,35 @@
+package hibernation
+
+import (
+	"time"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/operations"
+	"github.com/sirupsen/logrus"
+)
+
+type WaitForWakeUpStep struct {
+	gardenerClient GardenerClient
+	nextStep       model.OperationStage
+	timeLimit      time.Duration
+}
+
+func NewWaitForWakeUpStep(gardenerClient GardenerClient, nextStep model.OperationStage, timeLimit time.Duration) *WaitForWakeUpStep {
+	return &WaitForWakeUpStep{
+		gardenerClient: gardenerClient,
+		nextStep:       nextStep,
+		timeLimit:      timeLimit,
+	}
+}
+
+func (s WaitForWakeUpStep) Name() model.OperationStage {
+	return model.WaitForWakeUp
+}
+
+func (s *WaitForWakeUpStep) TimeLimit() time.Duration {
+	return s.timeLimit
+}
+
+func (s *WaitForWakeUpStep) Run(cluster model.Cluster, _ model.Operation, logger logrus.FieldLogger) (operations.StageResult, error) {
+	return waitForHibernationState(s.gardenerClient, cluster, false, s.Name(), s.nextStep, logger)
+}
@@ -41,6 +41,11 @@ type FailureHandler interface {
 	HandleFailure(operation model.Operation, cluster model.Cluster) error
 }
 
+// StatusNotifier is informed about every change of the operation state or stage made by the Executor
+type StatusNotifier interface {
+	OperationChanged(operationID, runtimeID string)
+}
+
 func ConvertToAppError(err error) apperrors.AppError {
 	if nonRecoverErr := (NonRecoverableError{}); errors.As(err, &nonRecoverErr) {
 		err = nonRecoverErr.error
@@ -21,6 +21,7 @@ func (c graphQLConverter) RuntimeStatusToGraphQLStatus(status model.RuntimeStatu
 		LastOperationStatus:     c.OperationStatusToGQLOperationStatus(status.LastOperationStatus),
 		RuntimeConnectionStatus: c.runtimeConnectionStatusToGraphQLStatus(status.RuntimeConnectionStatus),
 		RuntimeConfiguration:    c.clusterToToGraphQLRuntimeConfiguration(status.RuntimeConfiguration),
+		HibernationStatus:       c.hibernationStatusToGraphQLStatus(status.HibernationStatus),
 	}
 }
 
@@ -39,6 +40,13 @@ func (c graphQLConverter) OperationStatusToGQLOperationStatus(operation model.Op
 	}
 }
 
+func (c graphQLConverter) hibernationStatusToGraphQLStatus(status model.HibernationStatus) *gqlschema.HibernationStatus {
+	return &gqlschema.HibernationStatus{
+		Hibernated:          &status.Hibernated,
+		HibernationPossible: &status.HibernationPossible,
+	}
+}
+
 func (c graphQLConverter) runtimeConnectionStatusToGraphQLStatus(status model.RuntimeAgentConnectionStatus) *gqlschema.RuntimeConnectionStatus {
 	return &gqlschema.RuntimeConnectionStatus{Status: c.runtimeAgentConnectionStatusToGraphQLStatus(status)}
 }
@@ -176,6 +184,8 @@ func (c graphQLConverter) operationTypeToGraphQLType(operationType model.Operati
 		return gqlschema.OperationTypeReconnectRuntime
 	case model.Hibernate:
 		return gqlschema.OperationTypeHibernate
+	case model.WakeUp:
+		return gqlschema.OperationTypeWakeUp
 	default:
 		return ""
 	}
@@ -216,6 +216,10 @@ func TestRuntimeStatusToGraphQLStatus(t *testing.T) {
 				KymaConfig: fixKymaGraphQLConfig(nil),
 				Kubeconfig: &kubeconfig,
 			},
+			HibernationStatus: &gqlschema.HibernationStatus{
+				Hibernated:          util.BoolPtr(false),
+				HibernationPossible: util.BoolPtr(false),
+			},
 		}
 
 		//when
@@ -364,6 +368,10 @@ func TestRuntimeStatusToGraphQLStatus(t *testing.T) {
 				},
 				Kubeconfig: &kubeconfig,
 			},
+			HibernationStatus: &gqlschema.HibernationStatus{
+				Hibernated:          util.BoolPtr(false),
+				HibernationPossible: util.BoolPtr(false),
+			},
 		}
 
 		//when
@@ -505,6 +513,10 @@ func TestRuntimeStatusToGraphQLStatus(t *testing.T) {
 				KymaConfig: fixKymaGraphQLConfig(&gqlProductionProfile),
 				Kubeconfig: &kubeconfig,
 			},
+			HibernationStatus: &gqlschema.HibernationStatus{
+				Hibernated:          util.BoolPtr(false),
+				HibernationPossible: util.BoolPtr(false),
+			},
 		}
 
 		//when
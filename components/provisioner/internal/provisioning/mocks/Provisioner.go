@@ -66,6 +66,22 @@ func (_m *Provisioner) GetHibernationStatus(clusterID string, gardenerConfig mod
 	return r0, r1
 }
 
+// HibernateCluster provides a mock function with given fields: clusterID, gardenerConfig
+func (_m *Provisioner) HibernateCluster(clusterID string, gardenerConfig model.GardenerConfig) apperrors.AppError {
+	ret := _m.Called(clusterID, gardenerConfig)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, model.GardenerConfig) apperrors.AppError); ok {
+		r0 = rf(clusterID, gardenerConfig)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
 // ProvisionCluster provides a mock function with given fields: cluster, operationId
 func (_m *Provisioner) ProvisionCluster(cluster model.Cluster, operationId string) apperrors.AppError {
 	ret := _m.Called(cluster, operationId)
@@ -98,6 +114,22 @@ func (_m *Provisioner) UpgradeCluster(clusterID string, upgradeConfig model.Gard
 	return r0
 }
 
+// WakeUpCluster provides a mock function with given fields: clusterID, gardenerConfig
+func (_m *Provisioner) WakeUpCluster(clusterID string, gardenerConfig model.GardenerConfig) apperrors.AppError {
+	ret := _m.Called(clusterID, gardenerConfig)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, model.GardenerConfig) apperrors.AppError); ok {
+		r0 = rf(clusterID, gardenerConfig)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
 // NewProvisioner creates a new instance of Provisioner. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewProvisioner(t interface {
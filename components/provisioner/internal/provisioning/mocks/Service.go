@@ -40,6 +40,34 @@ func (_m *Service) DeprovisionRuntime(id string) (string, apperrors.AppError) {
 	return r0, r1
 }
 
+// HibernateRuntime provides a mock function with given fields: id
+func (_m *Service) HibernateRuntime(id string) (*gqlschema.OperationStatus, apperrors.AppError) {
+	ret := _m.Called(id)
+
+	var r0 *gqlschema.OperationStatus
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string) (*gqlschema.OperationStatus, apperrors.AppError)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) *gqlschema.OperationStatus); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gqlschema.OperationStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) apperrors.AppError); ok {
+		r1 = rf(id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // ProvisionRuntime provides a mock function with given fields: config, tenant, subAccount
 func (_m *Service) ProvisionRuntime(config gqlschema.ProvisionRuntimeInput, tenant string, subAccount string) (*gqlschema.OperationStatus, apperrors.AppError) {
 	ret := _m.Called(config, tenant, subAccount)
@@ -178,6 +206,34 @@ func (_m *Service) UpgradeGardenerShoot(id string, input gqlschema.UpgradeShootI
 	return r0, r1
 }
 
+// WakeUpRuntime provides a mock function with given fields: id
+func (_m *Service) WakeUpRuntime(id string) (*gqlschema.OperationStatus, apperrors.AppError) {
+	ret := _m.Called(id)
+
+	var r0 *gqlschema.OperationStatus
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string) (*gqlschema.OperationStatus, apperrors.AppError)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) *gqlschema.OperationStatus); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gqlschema.OperationStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) apperrors.AppError); ok {
+		r1 = rf(id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // NewService creates a new instance of Service. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewService(t interface {
@@ -7,17 +7,96 @@ import (
 	"encoding/base64"
 	"fmt"
 	"io"
+	"strings"
 )
 
 type encryptFunc func([]byte) ([]byte, error)
 type decryptFunc func([]byte) ([]byte, error)
 
+// keyIDSeparator separates the key ID from the encrypted value, it never occurs in base64 encoded values
+const keyIDSeparator = ":"
+
+// EncryptionKeys configures the encryption of sensitive data. New values are encrypted with the SecretKey and prefixed
+// with the SecretKeyID, if it is set. LegacySecretKeys are used only to decrypt values encrypted before a key rotation,
+// the legacy key with an empty ID decrypts values stored without a key ID.
+type EncryptionKeys struct {
+	SecretKey        string
+	SecretKeyID      string
+	LegacySecretKeys map[string]string
+}
+
+type keyRing struct {
+	activeKeyID string
+	keys        map[string][]byte
+}
+
+func newKeyRing(cfg EncryptionKeys) (keyRing, error) {
+	if strings.Contains(cfg.SecretKeyID, keyIDSeparator) {
+		return keyRing{}, fmt.Errorf("secret key ID must not contain %q", keyIDSeparator)
+	}
+	keys := map[string][]byte{}
+	for id, key := range cfg.LegacySecretKeys {
+		if strings.Contains(id, keyIDSeparator) {
+			return keyRing{}, fmt.Errorf("legacy secret key ID must not contain %q", keyIDSeparator)
+		}
+		keys[id] = []byte(key)
+	}
+	keys[cfg.SecretKeyID] = []byte(cfg.SecretKey)
+
+	return keyRing{activeKeyID: cfg.SecretKeyID, keys: keys}, nil
+}
+
 func newEncryptFunc(key []byte) encryptFunc {
-	return func(obj []byte) ([]byte, error) { return encrypt(key, obj) }
+	return keyRing{keys: map[string][]byte{"": key}}.encrypt
 }
 
 func newDecryptFunc(key []byte) decryptFunc {
-	return func(obj []byte) ([]byte, error) { return decrypt(key, obj) }
+	return keyRing{keys: map[string][]byte{"": key}}.decrypt
+}
+
+func (k keyRing) encrypt(obj []byte) ([]byte, error) {
+	encrypted, err := encrypt(k.keys[k.activeKeyID], obj)
+	if err != nil {
+		return nil, err
+	}
+	if k.activeKeyID == "" {
+		return encrypted, nil
+	}
+	return append([]byte(k.activeKeyID+keyIDSeparator), encrypted...), nil
+}
+
+func (k keyRing) decrypt(obj []byte) ([]byte, error) {
+	keyID, encrypted := splitKeyID(obj)
+	key, found := k.keys[keyID]
+	if !found {
+		return nil, fmt.Errorf("unknown encryption key ID %q", keyID)
+	}
+	return decrypt(key, encrypted)
+}
+
+// reencrypt encrypts the value with the active key if it was encrypted with another one,
+// the returned flag tells if the value was changed
+func (k keyRing) reencrypt(obj []byte) ([]byte, bool, error) {
+	if keyID, _ := splitKeyID(obj); keyID == k.activeKeyID {
+		return obj, false, nil
+	}
+	decrypted, err := k.decrypt(obj)
+	if err != nil {
+		return nil, false, err
+	}
+	encrypted, err := k.encrypt(decrypted)
+	if err != nil {
+		return nil, false, err
+	}
+	return encrypted, true, nil
+}
+
+func splitKeyID(obj []byte) (string, []byte) {
+	parts := strings.SplitN(string(obj), keyIDSeparator, 2)
+	if len(parts) == 1 {
+		return "", obj
+	}
+	return parts[0], []byte(parts[1])
 }
 
 func encrypt(key, obj []byte) ([]byte, error) {
@@ -1,6 +1,7 @@
 package dbsession
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -57,3 +58,95 @@ func TestCipher(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestKeyRing(t *testing.T) {
+	text := "Lorem ipsum dolor sit amet, consectetur adipiscing elit, sed do eiusmod tempor incididunt ut labore..."
+	legacyKey := "qbl92bqtl6zshtjb4bvbwwc2qk7vtw2d"
+	activeKey := "1zxwf0v2pmrsd9mtg6u0xc3kj8yah4qn"
+
+	t.Run("should decrypt values encrypted with a legacy key", func(t *testing.T) {
+		// given
+		legacy, err := newKeyRing(EncryptionKeys{SecretKey: legacyKey})
+		require.NoError(t, err)
+		encryptedText, err := legacy.encrypt([]byte(text))
+		require.NoError(t, err)
+
+		ring, err := newKeyRing(EncryptionKeys{SecretKey: activeKey, SecretKeyID: "v2", LegacySecretKeys: map[string]string{"": legacyKey}})
+		require.NoError(t, err)
+
+		// when
+		decryptedText, err := ring.decrypt(encryptedText)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, text, string(decryptedText))
+	})
+
+	t.Run("should prefix values with the active key ID", func(t *testing.T) {
+		// given
+		ring, err := newKeyRing(EncryptionKeys{SecretKey: activeKey, SecretKeyID: "v2"})
+		require.NoError(t, err)
+
+		// when
+		encryptedText, err := ring.encrypt([]byte(text))
+		require.NoError(t, err)
+
+		// then
+		assert.True(t, strings.HasPrefix(string(encryptedText), "v2:"))
+		decryptedText, err := ring.decrypt(encryptedText)
+		require.NoError(t, err)
+		assert.Equal(t, text, string(decryptedText))
+	})
+
+	t.Run("should re-encrypt values encrypted with a legacy key only", func(t *testing.T) {
+		// given
+		legacy, err := newKeyRing(EncryptionKeys{SecretKey: legacyKey})
+		require.NoError(t, err)
+		legacyText, err := legacy.encrypt([]byte(text))
+		require.NoError(t, err)
+
+		ring, err := newKeyRing(EncryptionKeys{SecretKey: activeKey, SecretKeyID: "v2", LegacySecretKeys: map[string]string{"": legacyKey}})
+		require.NoError(t, err)
+
+		// when
+		reencryptedText, changed, err := ring.reencrypt(legacyText)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, changed)
+		_, changed, err = ring.reencrypt(reencryptedText)
+		require.NoError(t, err)
+		assert.False(t, changed)
+
+		activeOnly, err := newKeyRing(EncryptionKeys{SecretKey: activeKey, SecretKeyID: "v2"})
+		require.NoError(t, err)
+		decryptedText, err := activeOnly.decrypt(reencryptedText)
+		require.NoError(t, err)
+		assert.Equal(t, text, string(decryptedText))
+	})
+
+	t.Run("should fail to decrypt values encrypted with an unknown key", func(t *testing.T) {
+		// given
+		old, err := newKeyRing(EncryptionKeys{SecretKey: legacyKey, SecretKeyID: "v1"})
+		require.NoError(t, err)
+		encryptedText, err := old.encrypt([]byte(text))
+		require.NoError(t, err)
+
+		ring, err := newKeyRing(EncryptionKeys{SecretKey: activeKey, SecretKeyID: "v2"})
+		require.NoError(t, err)
+
+		// when
+		_, err = ring.decrypt(encryptedText)
+
+		// then
+		assert.EqualError(t, err, `unknown encryption key ID "v1"`)
+	})
+
+	t.Run("should reject key IDs with the separator", func(t *testing.T) {
+		// when
+		_, err := newKeyRing(EncryptionKeys{SecretKey: activeKey, SecretKeyID: "v:2"})
+
+		// then
+		assert.Error(t, err)
+	})
+}
@@ -73,13 +73,23 @@ type factory struct {
 }
 
 func NewFactory(connection *dbr.Connection, secretKey string) (Factory, error) {
-	if len(secretKey) == 0 {
+	return NewFactoryWithKeys(connection, EncryptionKeys{SecretKey: secretKey})
+}
+
+// NewFactoryWithKeys creates a factory of sessions which encrypt data with the active key and decrypt data
+// encrypted with the active or legacy keys
+func NewFactoryWithKeys(connection *dbr.Connection, keys EncryptionKeys) (Factory, error) {
+	if len(keys.SecretKey) == 0 {
 		return nil, errors.New("empty encryption key provided")
 	}
+	ring, err := newKeyRing(keys)
+	if err != nil {
+		return nil, err
+	}
 	return &factory{
 		connection: connection,
-		encrypt:    newEncryptFunc([]byte(secretKey)),
-		decrypt:    newDecryptFunc([]byte(secretKey)),
+		encrypt:    ring.encrypt,
+		decrypt:    ring.decrypt,
 	}, nil
 }
 
@@ -0,0 +1,134 @@
+package dbsession
+
+import (
+	"time"
+
+	dbr "github.com/gocraft/dbr/v2"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// maxListRetries limits the consecutive failures of listing the records of a table, the table is skipped after that
+const maxListRetries = 5
+
+type encryptedColumn struct {
+	table        string
+	column       string
+	encryptedBit string
+}
+
+var encryptedColumns = []encryptedColumn{
+	{table: "cluster", column: "kubeconfig", encryptedBit: "is_kubeconfig_encrypted"},
+	{table: "cluster_administrator", column: "user_id", encryptedBit: "is_user_id_encrypted"},
+}
+
+type encryptedRecordDTO struct {
+	ID   string
+	Data string
+}
+
+// Reencrypter re-encrypts kubeconfigs and administrator user IDs stored with legacy keys using the active key
+type Reencrypter struct {
+	connection *dbr.Connection
+	keys       keyRing
+}
+
+func NewReencrypter(connection *dbr.Connection, keys EncryptionKeys) (*Reencrypter, error) {
+	if len(keys.SecretKey) == 0 {
+		return nil, errors.New("empty encryption key provided")
+	}
+	ring, err := newKeyRing(keys)
+	if err != nil {
+		return nil, err
+	}
+	return &Reencrypter{connection: connection, keys: ring}, nil
+}
+
+// Run re-encrypts all encrypted columns in batches until all data is encrypted with the active key
+// or the stop channel is closed. A table which records cannot be listed is skipped after maxListRetries attempts.
+func (r *Reencrypter) Run(batchSize int, batchInterval time.Duration, stop <-chan struct{}) {
+	totalFailed, skippedTables := 0, 0
+	for _, c := range encryptedColumns {
+		logger := log.WithField("table", c.table)
+		lastID, reencrypted, failed, listRetries := "", 0, 0, 0
+		for {
+			select {
+			case <-stop:
+				return
+			case <-time.After(batchInterval):
+			}
+
+			records, err := r.listBatch(c, lastID, batchSize)
+			if err != nil {
+				listRetries++
+				if listRetries >= maxListRetries {
+					logger.Errorf("Failed to list records to re-encrypt %d times, skipping the table: %s", listRetries, err)
+					skippedTables++
+					break
+				}
+				logger.Errorf("Failed to list records to re-encrypt, retrying: %s", err)
+				continue
+			}
+			listRetries = 0
+			for _, record := range records {
+				changed, err := r.reencryptRecord(c, record)
+				if err != nil {
+					logger.Warnf("Unable to re-encrypt record %s: %s", record.ID, err)
+					failed++
+					continue
+				}
+				if changed {
+					reencrypted++
+				}
+			}
+			if len(records) < batchSize {
+				break
+			}
+			lastID = records[len(records)-1].ID
+		}
+		logger.Infof("Re-encryption of the table finished, re-encrypted: %d, failed: %d", reencrypted, failed)
+		totalFailed += failed
+	}
+	if totalFailed > 0 || skippedTables > 0 {
+		log.Warnf("Re-encryption finished, %d records could not be re-encrypted and %d tables were skipped, the legacy keys are still needed", totalFailed, skippedTables)
+		return
+	}
+	log.Info("Re-encryption finished, all data is encrypted with the active key")
+}
+
+func (r *Reencrypter) listBatch(c encryptedColumn, afterID string, batchSize int) ([]encryptedRecordDTO, error) {
+	var records []encryptedRecordDTO
+	stmt := r.connection.NewSession(nil).
+		Select("id::text AS id", c.column+" AS data").
+		From(c.table).
+		Where(dbr.Eq(c.encryptedBit, true)).
+		Where(dbr.Neq(c.column, nil)).
+		OrderBy("id").
+		Limit(uint64(batchSize))
+	if afterID != "" {
+		stmt = stmt.Where(dbr.Gt("id", afterID))
+	}
+	_, err := stmt.Load(&records)
+
+	return records, err
+}
+
+// reencryptRecord replaces the value only if it was not changed since it was read, values written
+// in the meantime are already encrypted with the active key
+func (r *Reencrypter) reencryptRecord(c encryptedColumn, record encryptedRecordDTO) (bool, error) {
+	reencrypted, changed, err := r.keys.reencrypt([]byte(record.Data))
+	if err != nil || !changed {
+		return false, err
+	}
+	_, err = r.connection.NewSession(nil).
+		Update(c.table).
+		Set(c.column, string(reencrypted)).
+		Where(dbr.Eq("id", record.ID)).
+		Where(dbr.Eq(c.column, record.Data)).
+		Exec()
+	if err != nil {
+		return false, errors.Wrapf(err, "while updating record %s", record.ID)
+	}
+
+	return true, nil
+}
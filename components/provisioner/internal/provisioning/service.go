@@ -22,6 +22,8 @@ type Service interface {
 	ProvisionRuntime(config gqlschema.ProvisionRuntimeInput, tenant, subAccount string) (*gqlschema.OperationStatus, apperrors.AppError)
 	DeprovisionRuntime(id string) (string, apperrors.AppError)
 	UpgradeGardenerShoot(id string, input gqlschema.UpgradeShootInput) (*gqlschema.OperationStatus, apperrors.AppError)
+	HibernateRuntime(id string) (*gqlschema.OperationStatus, apperrors.AppError)
+	WakeUpRuntime(id string) (*gqlschema.OperationStatus, apperrors.AppError)
 	ReconnectRuntimeAgent(id string) (string, apperrors.AppError)
 	RuntimeStatus(id string) (*gqlschema.RuntimeStatus, apperrors.AppError)
 	RuntimeOperationStatus(id string) (*gqlschema.OperationStatus, apperrors.AppError)
@@ -32,6 +34,9 @@ type Provisioner interface {
 	ProvisionCluster(cluster model.Cluster, operationId string) apperrors.AppError
 	DeprovisionCluster(cluster model.Cluster, operationId string) (model.Operation, apperrors.AppError)
 	UpgradeCluster(clusterID string, upgradeConfig model.GardenerConfig) apperrors.AppError
+	HibernateCluster(clusterID string, gardenerConfig model.GardenerConfig) apperrors.AppError
+	WakeUpCluster(clusterID string, gardenerConfig model.GardenerConfig) apperrors.AppError
+	GetHibernationStatus(clusterID string, gardenerConfig model.GardenerConfig) (model.HibernationStatus, apperrors.AppError)
 }
 
 //go:generate mockery --name=ShootProvider
@@ -54,6 +59,7 @@ type service struct {
 	upgradeQueue        queue.OperationQueue
 	shootUpgradeQueue   queue.OperationQueue
 	hibernationQueue    queue.OperationQueue
+	wakeUpQueue         queue.OperationQueue
 }
 
 func NewProvisioningService(
@@ -67,7 +73,8 @@ func NewProvisioningService(
 	provisioningQueue queue.OperationQueue,
 	deprovisioningQueue queue.OperationQueue,
 	shootUpgradeQueue queue.OperationQueue,
-
+	hibernationQueue queue.OperationQueue,
+	wakeUpQueue queue.OperationQueue,
 ) Service {
 	return &service{
 		inputConverter:      inputConverter,
@@ -79,6 +86,8 @@ func NewProvisioningService(
 		provisioningQueue:   provisioningQueue,
 		deprovisioningQueue: deprovisioningQueue,
 		shootUpgradeQueue:   shootUpgradeQueue,
+		hibernationQueue:    hibernationQueue,
+		wakeUpQueue:         wakeUpQueue,
 		shootProvider:       shootProvider,
 	}
 }
@@ -251,6 +260,90 @@ func (r *service) UpgradeGardenerShoot(runtimeID string, input gqlschema.Upgrade
 	return r.graphQLConverter.OperationStatusToGQLOperationStatus(operation), nil
 }
 
+func (r *service) HibernateRuntime(runtimeID string) (*gqlschema.OperationStatus, apperrors.AppError) {
+	log.Infof("Starting hibernation of Runtime '%s'...", runtimeID)
+
+	session := r.dbSessionFactory.NewReadSession()
+
+	err := r.verifyLastOperationFinished(session, runtimeID)
+	if err != nil {
+		return nil, err
+	}
+
+	cluster, dberr := session.GetCluster(runtimeID)
+	if dberr != nil {
+		return nil, apperrors.Internal("Failed to find cluster to hibernate in database: %s", dberr.Error())
+	}
+
+	hibernationStatus, err := r.provisioner.GetHibernationStatus(cluster.ID, cluster.ClusterConfig)
+	if err != nil {
+		return nil, err.Append("Failed to get hibernation status")
+	}
+	if !hibernationStatus.HibernationPossible {
+		return nil, apperrors.BadRequest("hibernation of %s Runtime is not possible", runtimeID)
+	}
+
+	return r.changeHibernation(cluster, model.Hibernate, model.WaitForHibernation, "Starting hibernation", r.provisioner.HibernateCluster, r.hibernationQueue)
+}
+
+func (r *service) WakeUpRuntime(runtimeID string) (*gqlschema.OperationStatus, apperrors.AppError) {
+	log.Infof("Starting wake-up of Runtime '%s'...", runtimeID)
+
+	session := r.dbSessionFactory.NewReadSession()
+
+	err := r.verifyLastOperationFinished(session, runtimeID)
+	if err != nil {
+		return nil, err
+	}
+
+	cluster, dberr := session.GetCluster(runtimeID)
+	if dberr != nil {
+		return nil, apperrors.Internal("Failed to find cluster to wake up in database: %s", dberr.Error())
+	}
+
+	return r.changeHibernation(cluster, model.WakeUp, model.WaitForWakeUp, "Starting wake-up", r.provisioner.WakeUpCluster, r.wakeUpQueue)
+}
+
+// changeHibernation stores the operation before it triggers the hibernation change of the Shoot,
+// the operation is marked as failed when the Shoot could not be changed
+func (r *service) changeHibernation(
+	cluster model.Cluster,
+	operationType model.OperationType,
+	operationStage model.OperationStage,
+	message string,
+	change func(clusterID string, gardenerConfig model.GardenerConfig) apperrors.AppError,
+	operationQueue queue.OperationQueue) (*gqlschema.OperationStatus, apperrors.AppError) {
+
+	txSession, dbErr := r.dbSessionFactory.NewSessionWithinTransaction()
+	if dbErr != nil {
+		return nil, apperrors.Internal("Failed to start database transaction: %s", dbErr.Error())
+	}
+	defer txSession.RollbackUnlessCommitted()
+
+	operation, dbErr := r.setOperationStarted(txSession, cluster.ID, operationType, operationStage, time.Now(), message)
+	if dbErr != nil {
+		return nil, dbErr.Append("Failed to start %s operation", operationType)
+	}
+
+	dbErr = txSession.Commit()
+	if dbErr != nil {
+		return nil, apperrors.Internal("Failed to commit %s transaction: %s", operationType, dbErr.Error())
+	}
+
+	err := change(cluster.ID, cluster.ClusterConfig)
+	if err != nil {
+		failErr := r.dbSessionFactory.NewWriteSession().UpdateOperationState(operation.ID, "Failed to change hibernation of Cluster", model.Failed, time.Now())
+		if failErr != nil {
+			log.Errorf("Failed to set %s operation %s as failed: %s", operationType, operation.ID, failErr.Error())
+		}
+		return nil, err.Append("Failed to change hibernation of Cluster")
+	}
+
+	operationQueue.Add(operation.ID)
+
+	return r.graphQLConverter.OperationStatusToGQLOperationStatus(operation), nil
+}
+
 func (r *service) verifyLastOperationFinished(session dbsession.ReadSession, runtimeId string) apperrors.AppError {
 	lastOperation, dberr := session.GetLastOperation(runtimeId)
 	if dberr != nil {
@@ -301,12 +394,26 @@ func (r *service) getRuntimeStatus(runtimeID string) (model.RuntimeStatus, apper
 		return model.RuntimeStatus{}, err
 	}
 
+	hibernationStatus := hibernationStatusFromOperation(operation)
+
 	return model.RuntimeStatus{
 		LastOperationStatus:  operation,
 		RuntimeConfiguration: cluster,
+		HibernationStatus:    hibernationStatus,
 	}, nil
 }
 
+// hibernationStatusFromOperation derives the hibernation status from the last operation of the Runtime stored in the database,
+// the hibernation constraint of the Shoot is checked only when the hibernation is requested
+func hibernationStatusFromOperation(operation model.Operation) model.HibernationStatus {
+	hibernated := operation.Type == model.Hibernate && operation.State == model.Succeeded
+
+	return model.HibernationStatus{
+		Hibernated:          hibernated,
+		HibernationPossible: !hibernated && operation.State != model.InProgress,
+	}
+}
+
 func (r *service) setProvisioningStarted(dbSession dbsession.WriteSession, runtimeID string, cluster model.Cluster) (model.Operation, dberrors.Error) {
 	timestamp := time.Now()
 	cluster.CreationTimestamp = timestamp
@@ -11,9 +11,11 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"strconv"
 	"time"
 
 	"github.com/kyma-project/control-plane/components/schema-migrator/cleaner"
+	"github.com/kyma-project/control-plane/components/schema-migrator/schema"
 
 	"github.com/golang-migrate/migrate/v4"
 	"github.com/golang-migrate/migrate/v4/database/postgres"
@@ -105,8 +107,28 @@ func invokeMigration() error {
 		log.Println("Migration UP")
 	case "down":
 		log.Println("Migration DOWN")
+	case "status":
+		log.Println("Migration STATUS")
+	case "verify":
+		log.Println("Schema VERIFY")
 	default:
-		return errors.New("ERROR: DIRECTION variable accepts only two values: up or down")
+		return errors.New("ERROR: DIRECTION variable accepts only following values: up, down, status or verify")
+	}
+
+	dryRun := os.Getenv("DRY_RUN") == "true"
+	if dryRun {
+		log.Println("Dry run, the migration files are only printed")
+	}
+
+	var targetVersion *uint
+	if value, present := os.LookupEnv("TO_VERSION"); present && value != "" {
+		version, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return fmt.Errorf("ERROR: TO_VERSION must be a migration version: %w", err)
+		}
+		target := uint(version)
+		targetVersion = &target
+		log.Printf("Target version: %d\n", target)
 	}
 
 	dbName := os.Getenv("DB_NAME")
@@ -208,7 +230,37 @@ func invokeMigration() error {
 	}(migrateInstance)
 	migrateInstance.Log = &Logger{}
 
-	if direction == "up" {
+	migrations, err := schema.ReadMigrations(migrationExecPath)
+	if err != nil {
+		return fmt.Errorf("while reading migrations: %w", err)
+	}
+	version, dirty, err := currentVersion(migrateInstance)
+	if err != nil {
+		return fmt.Errorf("while reading current version: %w", err)
+	}
+
+	switch direction {
+	case "status":
+		printStatus(migrations, version, dirty)
+		return nil
+	case "verify":
+		return verifySchema(db, migrations, version, dirty)
+	}
+
+	plan, err := schema.Plan(migrations, direction, version, targetVersion)
+	if err != nil {
+		return fmt.Errorf("while planning migration: %w", err)
+	}
+	if dryRun {
+		if dirty {
+			return fmt.Errorf("database is dirty at version %d, fix it before migrating", version)
+		}
+		return printPlan(plan)
+	}
+
+	if targetVersion != nil {
+		err = migrateInstance.Migrate(*targetVersion)
+	} else if direction == "up" {
 		err = migrateInstance.Up()
 	} else if direction == "down" {
 		err = migrateInstance.Down()
@@ -223,6 +275,72 @@ func invokeMigration() error {
 	return nil
 }
 
+func currentVersion(migrateInstance *migrate.Migrate) (uint, bool, error) {
+	version, dirty, err := migrateInstance.Version()
+	if errors.Is(err, migrate.ErrNilVersion) {
+		return 0, false, nil
+	}
+	return version, dirty, err
+}
+
+func printStatus(migrations []schema.Migration, version uint, dirty bool) {
+	latest := uint(0)
+	if len(migrations) > 0 {
+		latest = migrations[len(migrations)-1].Version
+	}
+	pending := schema.Pending(migrations, version)
+
+	log.Printf("Current version: %d, dirty: %t\n", version, dirty)
+	log.Printf("Latest version: %d\n", latest)
+	log.Printf("Pending migrations: %d\n", len(pending))
+	for _, m := range pending {
+		log.Printf("  %d_%s\n", m.Version, m.Name)
+	}
+}
+
+func printPlan(files []string) error {
+	if len(files) == 0 {
+		log.Println("No Changes. Nothing to migrate.")
+		return nil
+	}
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return fmt.Errorf("while reading %s: %w", file, err)
+		}
+		fmt.Printf("-- %s\n%s\n", filepath.Base(file), content)
+	}
+	return nil
+}
+
+// verifySchema compares the live schema with the tables and columns created by the migrations applied so far
+func verifySchema(db *sql.DB, migrations []schema.Migration, version uint, dirty bool) error {
+	printStatus(migrations, version, dirty)
+	if dirty {
+		return fmt.Errorf("database is dirty at version %d, the migration was not fully applied", version)
+	}
+
+	expected, err := schema.ExpectedTables(migrations, version)
+	if err != nil {
+		return fmt.Errorf("while reading expected schema: %w", err)
+	}
+	live, err := schema.LiveTables(db)
+	if err != nil {
+		return fmt.Errorf("while reading live schema: %w", err)
+	}
+
+	diffs := schema.Diff(expected, live)
+	if len(diffs) > 0 {
+		for _, diff := range diffs {
+			log.Printf("  %s\n", diff)
+		}
+		return fmt.Errorf("schema at version %d differs from the migrations in %d places", version, len(diffs))
+	}
+	log.Println("Schema matches the migrations.")
+
+	return nil
+}
+
 type Logger struct{}
 
 func (l *Logger) Printf(format string, v ...interface{}) {
@@ -0,0 +1,12 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS leases (
+    id             varchar(255) NOT NULL PRIMARY KEY,
+    owner          varchar(255) NOT NULL,
+    expires_at     timestamp with time zone NOT NULL,
+    updated_at     timestamp with time zone NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS leases_owner ON leases USING HASH (owner);
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE operations
+    DROP COLUMN next_retry_at;
+
+COMMIT;
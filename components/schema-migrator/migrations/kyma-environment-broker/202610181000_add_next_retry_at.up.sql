@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE operations
+    ADD COLUMN next_retry_at TIMESTAMPTZ;
+
+COMMIT;
@@ -0,0 +1,12 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS orchestration_freezes (
+    id                varchar(255) NOT NULL PRIMARY KEY,
+    global_account_id varchar(255),
+    start_time        timestamp with time zone NOT NULL,
+    end_time          timestamp with time zone NOT NULL,
+    reason            text,
+    created_at        timestamp with time zone NOT NULL
+);
+
+COMMIT;
@@ -0,0 +1,17 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id           varchar(255) NOT NULL PRIMARY KEY,
+    subscription varchar(255) NOT NULL,
+    event_type   varchar(255) NOT NULL,
+    payload      text NOT NULL,
+    state        varchar(32) NOT NULL,
+    attempts     integer NOT NULL DEFAULT 0,
+    last_error   text,
+    created_at   timestamp with time zone NOT NULL,
+    updated_at   timestamp with time zone NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS webhook_deliveries_state_idx ON webhook_deliveries (state);
+
+COMMIT;
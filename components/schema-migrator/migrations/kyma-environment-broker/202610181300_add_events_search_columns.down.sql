@@ -0,0 +1,14 @@
+BEGIN;
+
+DROP INDEX IF EXISTS events_sub_account_id_idx;
+DROP INDEX IF EXISTS events_global_account_id_idx;
+DROP INDEX IF EXISTS events_instance_id_idx;
+DROP INDEX IF EXISTS events_created_at_id_idx;
+
+ALTER TABLE events
+    DROP COLUMN IF EXISTS step_name,
+    DROP COLUMN IF EXISTS plan_id,
+    DROP COLUMN IF EXISTS sub_account_id,
+    DROP COLUMN IF EXISTS global_account_id;
+
+COMMIT;
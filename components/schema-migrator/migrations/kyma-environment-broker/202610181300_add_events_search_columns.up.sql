@@ -0,0 +1,14 @@
+BEGIN;
+
+ALTER TABLE events
+    ADD COLUMN IF NOT EXISTS global_account_id varchar(255),
+    ADD COLUMN IF NOT EXISTS sub_account_id    varchar(255),
+    ADD COLUMN IF NOT EXISTS plan_id           varchar(255),
+    ADD COLUMN IF NOT EXISTS step_name         varchar(255);
+
+CREATE INDEX IF NOT EXISTS events_created_at_id_idx ON events (created_at, id);
+CREATE INDEX IF NOT EXISTS events_instance_id_idx ON events (instance_id);
+CREATE INDEX IF NOT EXISTS events_global_account_id_idx ON events (global_account_id);
+CREATE INDEX IF NOT EXISTS events_sub_account_id_idx ON events (sub_account_id);
+
+COMMIT;
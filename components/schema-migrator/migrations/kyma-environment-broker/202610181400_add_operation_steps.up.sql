@@ -0,0 +1,16 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS operation_steps (
+    operation_id    varchar(255) NOT NULL,
+    step_name       varchar(255) NOT NULL,
+    state           varchar(32) NOT NULL,
+    attempts        integer NOT NULL DEFAULT 0,
+    last_error      text,
+    next_delay      bigint NOT NULL DEFAULT 0,
+    processing_time bigint NOT NULL DEFAULT 0,
+    started_at      timestamp with time zone NOT NULL,
+    finished_at     timestamp with time zone NOT NULL,
+    PRIMARY KEY (operation_id, step_name)
+);
+
+COMMIT;
@@ -0,0 +1,18 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS bindings (
+    id                 varchar(255) NOT NULL PRIMARY KEY,
+    instance_id        varchar(255) NOT NULL,
+    expiration_seconds bigint NOT NULL,
+    kubeconfig         text,
+    expires_at         timestamp with time zone,
+    last_operation     varchar(32) NOT NULL,
+    state              varchar(32) NOT NULL,
+    description        text NOT NULL DEFAULT '',
+    created_at         timestamp with time zone NOT NULL,
+    updated_at         timestamp with time zone NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS bindings_instance_id_idx ON bindings (instance_id);
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP TABLE kubeconfig_audit;
+
+COMMIT;
@@ -0,0 +1,24 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS kubeconfig_audit (
+    id                varchar(255) NOT NULL PRIMARY KEY,
+    source            varchar(64) NOT NULL,
+    user_id           varchar(255) NOT NULL DEFAULT '',
+    issuer            varchar(255) NOT NULL DEFAULT '',
+    groups            text NOT NULL DEFAULT '',
+    instance_id       varchar(255) NOT NULL DEFAULT '',
+    runtime_id        varchar(255) NOT NULL DEFAULT '',
+    global_account_id varchar(255) NOT NULL DEFAULT '',
+    sub_account_id    varchar(255) NOT NULL DEFAULT '',
+    role              varchar(255) NOT NULL DEFAULT '',
+    source_ip         varchar(64) NOT NULL DEFAULT '',
+    user_agent        text NOT NULL DEFAULT '',
+    expires_at        timestamp with time zone,
+    created_at        timestamp with time zone NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS kubeconfig_audit_created_at_idx ON kubeconfig_audit (created_at);
+CREATE INDEX IF NOT EXISTS kubeconfig_audit_runtime_id_idx ON kubeconfig_audit (runtime_id);
+CREATE INDEX IF NOT EXISTS kubeconfig_audit_user_id_idx ON kubeconfig_audit (user_id);
+
+COMMIT;
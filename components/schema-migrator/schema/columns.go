@@ -0,0 +1,252 @@
+package schema
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const migrationsTable = "schema_migrations"
+
+// Tables maps table names to the names of their columns
+type Tables map[string]map[string]bool
+
+var (
+	commentRegexp     = regexp.MustCompile(`--[^\n]*`)
+	whitespaceRegexp  = regexp.MustCompile(`\s+`)
+	createTableRegexp = regexp.MustCompile(`^create table (?:if not exists )?([\w.]+) ?\((.*)\)$`)
+	alterTableRegexp  = regexp.MustCompile(`^alter table (?:if exists )?(?:only )?([\w.]+) (.*)$`)
+	dropTableRegexp   = regexp.MustCompile(`^drop table (?:if exists )?(.*?)(?: cascade| restrict)?$`)
+)
+
+// keywords which start a table constraint instead of a column definition
+var constraintKeywords = map[string]bool{
+	"constraint": true,
+	"primary":    true,
+	"unique":     true,
+	"foreign":    true,
+	"check":      true,
+	"exclude":    true,
+	"like":       true,
+}
+
+// ExpectedTables replays the up files of migrations up to the given version and returns the tables and columns
+// they create. Only CREATE TABLE, ALTER TABLE and DROP TABLE statements change the result.
+func ExpectedTables(migrations []Migration, version uint) (Tables, error) {
+	tables := Tables{}
+	for _, m := range migrations {
+		if m.Version > version {
+			break
+		}
+		if m.UpFile == "" {
+			continue
+		}
+		content, err := os.ReadFile(m.UpFile)
+		if err != nil {
+			return nil, fmt.Errorf("while reading %s: %w", m.UpFile, err)
+		}
+		if err := tables.apply(string(content)); err != nil {
+			return nil, fmt.Errorf("while applying %s: %w", m.UpFile, err)
+		}
+	}
+
+	return tables, nil
+}
+
+// LiveTables returns tables and columns of the current schema of the database
+func LiveTables(db *sql.DB) (Tables, error) {
+	rows, err := db.Query(`SELECT table_name, column_name FROM information_schema.columns WHERE table_schema = current_schema()`)
+	if err != nil {
+		return nil, fmt.Errorf("while querying columns: %w", err)
+	}
+	defer rows.Close()
+
+	tables := Tables{}
+	for rows.Next() {
+		var table, column string
+		if err := rows.Scan(&table, &column); err != nil {
+			return nil, fmt.Errorf("while reading columns: %w", err)
+		}
+		if table == migrationsTable {
+			continue
+		}
+		tables.addColumn(table, column)
+	}
+
+	return tables, rows.Err()
+}
+
+// Diff returns differences between the expected and live schema, sorted by table and column
+func Diff(expected, live Tables) []string {
+	var diffs []string
+	for table, columns := range expected {
+		liveColumns, found := live[table]
+		if !found {
+			diffs = append(diffs, fmt.Sprintf("missing table %s", table))
+			continue
+		}
+		for column := range columns {
+			if !liveColumns[column] {
+				diffs = append(diffs, fmt.Sprintf("missing column %s.%s", table, column))
+			}
+		}
+		for column := range liveColumns {
+			if !columns[column] {
+				diffs = append(diffs, fmt.Sprintf("unexpected column %s.%s", table, column))
+			}
+		}
+	}
+	for table := range live {
+		if _, found := expected[table]; !found {
+			diffs = append(diffs, fmt.Sprintf("unexpected table %s", table))
+		}
+	}
+	sort.Slice(diffs, func(i, j int) bool {
+		return diffSubject(diffs[i]) < diffSubject(diffs[j])
+	})
+
+	return diffs
+}
+
+func diffSubject(diff string) string {
+	return diff[strings.LastIndex(diff, " ")+1:]
+}
+
+func (t Tables) apply(sql string) error {
+	sql = commentRegexp.ReplaceAllString(sql, "")
+	for _, stmt := range strings.Split(sql, ";") {
+		stmt = strings.ToLower(strings.TrimSpace(whitespaceRegexp.ReplaceAllString(stmt, " ")))
+		stmt = strings.ReplaceAll(stmt, `"`, "")
+
+		if matches := createTableRegexp.FindStringSubmatch(stmt); matches != nil {
+			table := tableName(matches[1])
+			t[table] = map[string]bool{}
+			for _, definition := range splitTopLevel(matches[2]) {
+				column := firstWord(definition)
+				if column == "" || constraintKeywords[column] {
+					continue
+				}
+				t.addColumn(table, column)
+			}
+			continue
+		}
+		if matches := alterTableRegexp.FindStringSubmatch(stmt); matches != nil {
+			if err := t.alter(tableName(matches[1]), matches[2]); err != nil {
+				return err
+			}
+			continue
+		}
+		if matches := dropTableRegexp.FindStringSubmatch(stmt); matches != nil {
+			for _, table := range strings.Split(matches[1], ",") {
+				delete(t, tableName(table))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (t Tables) alter(table, actions string) error {
+	if _, found := t[table]; !found {
+		return fmt.Errorf("table %s altered before it was created", table)
+	}
+	for _, action := range splitTopLevel(actions) {
+		fields := strings.Fields(action)
+		if len(fields) < 2 {
+			continue
+		}
+		switch fields[0] {
+		case "add":
+			column := skipKeywords(fields[1:], "column", "if", "not", "exists")
+			if column != "" && !constraintKeywords[column] {
+				t.addColumn(table, column)
+			}
+		case "drop":
+			column := skipKeywords(fields[1:], "column", "if", "exists")
+			if column != "" && !constraintKeywords[column] {
+				delete(t[table], column)
+			}
+		case "rename":
+			rest := fields[1:]
+			if rest[0] == "to" && len(rest) == 2 {
+				t[tableName(rest[1])] = t[table]
+				delete(t, table)
+				return nil
+			}
+			if rest[0] == "column" {
+				rest = rest[1:]
+			}
+			if len(rest) == 3 && rest[1] == "to" && t[table][rest[0]] {
+				delete(t[table], rest[0])
+				t.addColumn(table, rest[2])
+			}
+		}
+	}
+
+	return nil
+}
+
+func (t Tables) addColumn(table, column string) {
+	if _, found := t[table]; !found {
+		t[table] = map[string]bool{}
+	}
+	t[table][column] = true
+}
+
+// skipKeywords returns the first field which is not one of the keywords
+func skipKeywords(fields []string, keywords ...string) string {
+	for _, field := range fields {
+		skip := false
+		for _, keyword := range keywords {
+			if field == keyword {
+				skip = true
+				break
+			}
+		}
+		if !skip {
+			return field
+		}
+	}
+	return ""
+}
+
+// firstWord returns the first identifier of the text, "unique(id)" returns "unique"
+func firstWord(text string) string {
+	fields := strings.FieldsFunc(text, func(r rune) bool {
+		return r == ' ' || r == '('
+	})
+	if len(fields) == 0 {
+		return ""
+	}
+	return fields[0]
+}
+
+func tableName(name string) string {
+	name = strings.TrimSpace(name)
+	return name[strings.LastIndex(name, ".")+1:]
+}
+
+// splitTopLevel splits the text by commas which are not enclosed in parentheses or quotes
+func splitTopLevel(text string) []string {
+	var parts []string
+	depth, quoted, start := 0, false, 0
+	for i, c := range text {
+		switch {
+		case c == '\'':
+			quoted = !quoted
+		case quoted:
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+		case c == ',' && depth == 0:
+			parts = append(parts, text[start:i])
+			start = i + 1
+		}
+	}
+
+	return append(parts, text[start:])
+}
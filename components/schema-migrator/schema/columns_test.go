@@ -0,0 +1,121 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpectedTables(t *testing.T) {
+	// given
+	dir := fixMigrations(t, map[string]string{
+		"1_init.up.sql": `
+-- Instances
+CREATE TABLE IF NOT EXISTS instances (
+    id varchar(255) PRIMARY KEY,
+    state varchar(32) NOT NULL DEFAULT 'a,b',
+    size numeric(10, 2),
+    created_at TIMESTAMPTZ NOT NULL,
+    unique(id, state),
+    CONSTRAINT fk FOREIGN KEY (id) REFERENCES other (id)
+);
+CREATE TABLE legacy (id int);
+CREATE INDEX instances_state ON instances (state);`,
+		"2_alter.up.sql": `BEGIN;
+ALTER TABLE instances
+    ADD COLUMN IF NOT EXISTS "Owner" varchar(255),
+    ADD region text,
+    DROP COLUMN size,
+    DROP CONSTRAINT fk;
+ALTER TABLE instances RENAME COLUMN created_at TO creation_time;
+ALTER TABLE instances ALTER COLUMN state DROP DEFAULT;
+ALTER TABLE legacy RENAME TO archive;
+COMMIT;`,
+		"3_drop.up.sql": `DROP TABLE IF EXISTS archive;`,
+	})
+	migrations, err := ReadMigrations(dir)
+	require.NoError(t, err)
+
+	// when
+	atVersion2, err := ExpectedTables(migrations, 2)
+	require.NoError(t, err)
+	latest, err := ExpectedTables(migrations, 3)
+	require.NoError(t, err)
+
+	// then
+	assert.Equal(t, Tables{
+		"instances": {"id": true, "state": true, "owner": true, "region": true, "creation_time": true},
+		"archive":   {"id": true},
+	}, atVersion2)
+	assert.Equal(t, Tables{
+		"instances": {"id": true, "state": true, "owner": true, "region": true, "creation_time": true},
+	}, latest)
+}
+
+func TestExpectedTables_AlterUnknownTable(t *testing.T) {
+	// given
+	dir := fixMigrations(t, map[string]string{
+		"1_alter.up.sql": `ALTER TABLE missing ADD COLUMN id int;`,
+	})
+	migrations, err := ReadMigrations(dir)
+	require.NoError(t, err)
+
+	// when
+	_, err = ExpectedTables(migrations, 1)
+
+	// then
+	assert.ErrorContains(t, err, "table missing altered before it was created")
+}
+
+func TestDiff(t *testing.T) {
+	// given
+	expected := Tables{
+		"instances":  {"id": true, "state": true},
+		"operations": {"id": true},
+	}
+	live := Tables{
+		"instances": {"id": true, "owner": true},
+		"backup":    {"id": true},
+	}
+
+	// when
+	diffs := Diff(expected, live)
+
+	// then
+	assert.Equal(t, []string{
+		"unexpected table backup",
+		"unexpected column instances.owner",
+		"missing column instances.state",
+		"missing table operations",
+	}, diffs)
+}
+
+func TestDiff_NoChanges(t *testing.T) {
+	// given
+	tables := Tables{"instances": {"id": true}}
+
+	// when
+	diffs := Diff(tables, Tables{"instances": {"id": true}})
+
+	// then
+	assert.Empty(t, diffs)
+}
+
+func TestExpectedTables_RepositoryMigrations(t *testing.T) {
+	for _, dir := range []string{"../migrations/kyma-environment-broker", "../migrations/provisioner"} {
+		t.Run(dir, func(t *testing.T) {
+			// given
+			migrations, err := ReadMigrations(dir)
+			require.NoError(t, err)
+			require.NotEmpty(t, migrations)
+
+			// when
+			tables, err := ExpectedTables(migrations, migrations[len(migrations)-1].Version)
+
+			// then
+			require.NoError(t, err)
+			assert.NotEmpty(t, tables)
+		})
+	}
+}
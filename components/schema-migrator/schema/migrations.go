@@ -0,0 +1,122 @@
+package schema
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+)
+
+var migrationFileRegexp = regexp.MustCompile(`^([0-9]+)_(.*)\.(up|down)\.sql$`)
+
+// Migration describes one version of the schema with its up and down files
+type Migration struct {
+	Version  uint
+	Name     string
+	UpFile   string
+	DownFile string
+}
+
+// ReadMigrations returns migrations found in the given directory sorted by version
+func ReadMigrations(dir string) ([]Migration, error) {
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("while reading migrations directory: %w", err)
+	}
+
+	byVersion := map[uint]*Migration{}
+	for _, file := range files {
+		matches := migrationFileRegexp.FindStringSubmatch(file.Name())
+		if file.IsDir() || matches == nil {
+			continue
+		}
+		version, err := strconv.ParseUint(matches[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("while parsing version of %s: %w", file.Name(), err)
+		}
+		m, found := byVersion[uint(version)]
+		if !found {
+			m = &Migration{Version: uint(version), Name: matches[2]}
+			byVersion[uint(version)] = m
+		}
+		if matches[3] == "up" {
+			m.UpFile = filepath.Join(dir, file.Name())
+		} else {
+			m.DownFile = filepath.Join(dir, file.Name())
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
+
+// Pending returns migrations with a version greater than the current one
+func Pending(migrations []Migration, current uint) []Migration {
+	var pending []Migration
+	for _, m := range migrations {
+		if m.Version > current {
+			pending = append(pending, m)
+		}
+	}
+	return pending
+}
+
+// Plan returns the files which are executed to migrate the schema from the current version in the given direction,
+// in the order of execution. Versions without a file for the direction only change the version, like in migrate.
+// If the target is nil, the up direction migrates to the latest version and the down direction reverts all migrations.
+func Plan(migrations []Migration, direction string, current uint, target *uint) ([]string, error) {
+	if target != nil && !containsVersion(migrations, *target) {
+		return nil, fmt.Errorf("target version %d does not exist", *target)
+	}
+
+	var files []string
+	switch direction {
+	case "up":
+		if target != nil && *target < current {
+			return nil, fmt.Errorf("target version %d is lower than the current version %d", *target, current)
+		}
+		for _, m := range migrations {
+			if m.Version <= current || (target != nil && m.Version > *target) {
+				continue
+			}
+			if m.UpFile != "" {
+				files = append(files, m.UpFile)
+			}
+		}
+	case "down":
+		if target != nil && *target > current {
+			return nil, fmt.Errorf("target version %d is greater than the current version %d", *target, current)
+		}
+		for i := len(migrations) - 1; i >= 0; i-- {
+			m := migrations[i]
+			if m.Version > current || (target != nil && m.Version <= *target) {
+				continue
+			}
+			if m.DownFile != "" {
+				files = append(files, m.DownFile)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unknown direction %q", direction)
+	}
+
+	return files, nil
+}
+
+func containsVersion(migrations []Migration, version uint) bool {
+	for _, m := range migrations {
+		if m.Version == version {
+			return true
+		}
+	}
+	return false
+}
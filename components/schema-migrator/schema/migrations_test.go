@@ -0,0 +1,101 @@
+package schema
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadMigrations(t *testing.T) {
+	// given
+	dir := fixMigrations(t, map[string]string{
+		"202001010000_init.up.sql":        "CREATE TABLE a (id int);",
+		"202001010000_init.down.sql":      "DROP TABLE a;",
+		"202002010000_add_b.up.sql":       "ALTER TABLE a ADD COLUMN b int;",
+		"202002010000_add_b.down.sql":     "ALTER TABLE a DROP COLUMN b;",
+		"202003010000_only_down.down.sql": "SELECT 1;",
+		"README.md":                       "",
+	})
+
+	// when
+	migrations, err := ReadMigrations(dir)
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, migrations, 3)
+	assert.Equal(t, uint(202001010000), migrations[0].Version)
+	assert.Equal(t, "init", migrations[0].Name)
+	assert.Equal(t, filepath.Join(dir, "202002010000_add_b.up.sql"), migrations[1].UpFile)
+	assert.Empty(t, migrations[2].UpFile)
+	assert.Len(t, Pending(migrations, 202001010000), 2)
+}
+
+func TestPlan(t *testing.T) {
+	migrations := []Migration{
+		{Version: 1, UpFile: "1.up.sql", DownFile: "1.down.sql"},
+		{Version: 2, UpFile: "2.up.sql", DownFile: "2.down.sql"},
+		{Version: 3, DownFile: "3.down.sql"},
+		{Version: 4, UpFile: "4.up.sql", DownFile: "4.down.sql"},
+	}
+	version := func(v uint) *uint { return &v }
+
+	for name, tc := range map[string]struct {
+		direction string
+		current   uint
+		target    *uint
+		expected  []string
+	}{
+		"up to the latest version": {
+			direction: "up", current: 1,
+			expected: []string{"2.up.sql", "4.up.sql"},
+		},
+		"up to the target version": {
+			direction: "up", current: 0, target: version(2),
+			expected: []string{"1.up.sql", "2.up.sql"},
+		},
+		"down to the target version": {
+			direction: "down", current: 4, target: version(2),
+			expected: []string{"4.down.sql", "3.down.sql"},
+		},
+		"down reverting all migrations": {
+			direction: "down", current: 2,
+			expected: []string{"2.down.sql", "1.down.sql"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// when
+			files, err := Plan(migrations, tc.direction, tc.current, tc.target)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, files)
+		})
+	}
+
+	t.Run("should fail when the target is in the opposite direction", func(t *testing.T) {
+		// when
+		_, err := Plan(migrations, "up", 4, version(2))
+
+		// then
+		assert.EqualError(t, err, "target version 2 is lower than the current version 4")
+	})
+
+	t.Run("should fail when the target does not exist", func(t *testing.T) {
+		// when
+		_, err := Plan(migrations, "down", 4, version(5))
+
+		// then
+		assert.EqualError(t, err, "target version 5 does not exist")
+	})
+}
+
+func fixMigrations(t *testing.T, files map[string]string) string {
+	dir := t.TempDir()
+	for name, content := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0644))
+	}
+	return dir
+}
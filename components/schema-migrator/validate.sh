@@ -1,7 +1,7 @@
 #!/usr/bin/env bash
 
 # This script is responsible for validating if migrations scripts are correct.
-# It starts Postgres, executes UP and DOWN migrations.
+# It starts Postgres, executes UP migrations, verifies the schema and executes DOWN migrations.
 # This script requires `kcp-schema-migrator` Docker image.
 
 RED='\033[0;31m'
@@ -67,6 +67,24 @@ function migrationUP() {
     docker exec ${POSTGRES_CONTAINER} psql -U usr ${db_name} -c "select * from schema_migrations"
 }
 
+function migrationVERIFY() {
+    echo -e "${GREEN}Verify schema after UP migrations ${NC}"
+
+    migration_path=$1
+    db_name=$2
+    docker run --rm --network=${NETWORK} \
+            -e DB_USER=${DB_USER} \
+            -e DB_PASSWORD=${DB_PWD} \
+            -e DB_HOST=${POSTGRES_CONTAINER} \
+            -e DB_PORT=${DB_PORT} \
+            -e DB_NAME=${db_name} \
+            -e DB_SSL=${DB_SSL_PARAM} \
+            -e MIGRATION_PATH=${migration_path} \
+            -e DIRECTION="verify" \
+            -v $(pwd)/../../resources/kcp/charts/${migration_path}/migrations:/migrate/new-migrations/${migration_path} \
+        ${IMG_NAME}
+}
+
 function migrationDOWN() {
     echo -e "${GREEN}Run DOWN migrations ${NC}"
 
@@ -95,6 +113,7 @@ function migrationProcess() {
 
     echo -e "${GREEN}Migrations for \"${db}\" database and \"${path}\" path${NC}"
     migrationUP "${path}" "${db}"
+    migrationVERIFY "${path}" "${db}"
     migrationDOWN "${path}" "${db}"
 }
 
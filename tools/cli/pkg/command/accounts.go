@@ -0,0 +1,240 @@
+package command
+
+import (
+	"fmt"
+	"strconv"
+
+	"golang.org/x/oauth2"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/hyperscaler"
+	"github.com/kyma-project/control-plane/tools/cli/pkg/logger"
+	"github.com/kyma-project/control-plane/tools/cli/pkg/printer"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// AccountsCommand represents an execution of the kcp accounts command
+type AccountsCommand struct {
+	cobraCmd *cobra.Command
+	log      logger.Logger
+	output   string
+	state    string
+	euAccess string
+	filter   hyperscaler.AccountFilter
+}
+
+var accountsTableColumns = []printer.Column{
+	{
+		Header:    "SECRET BINDING",
+		FieldSpec: "{.Name}",
+	},
+	{
+		Header:    "HYPERSCALER",
+		FieldSpec: "{.HyperscalerType}",
+	},
+	{
+		Header:    "EU ACCESS",
+		FieldSpec: "{.EuAccess}",
+	},
+	{
+		Header:    "STATE",
+		FieldSpec: "{.State}",
+	},
+	{
+		Header:    "TENANT",
+		FieldSpec: "{.TenantName}",
+	},
+	{
+		Header:    "SHOOTS",
+		FieldSpec: "{.Shoots}",
+	},
+	{
+		Header:    "INTERNAL",
+		FieldSpec: "{.Internal}",
+	},
+}
+
+var accountsCapacityTableColumns = []printer.Column{
+	{
+		Header:    "HYPERSCALER",
+		FieldSpec: "{.HyperscalerType}",
+	},
+	{
+		Header:    "EU ACCESS",
+		FieldSpec: "{.EuAccess}",
+	},
+	{
+		Header:    "FREE",
+		FieldSpec: "{.Free}",
+	},
+	{
+		Header:    "ASSIGNED",
+		FieldSpec: "{.Assigned}",
+	},
+	{
+		Header:    "DIRTY",
+		FieldSpec: "{.Dirty}",
+	},
+	{
+		Header:    "SHARED",
+		FieldSpec: "{.Shared}",
+	},
+	{
+		Header:    "QUARANTINED",
+		FieldSpec: "{.Quarantined}",
+	},
+	{
+		Header:    "TOTAL",
+		FieldSpec: "{.Total}",
+	},
+	{
+		Header:         "EXHAUSTED",
+		FieldFormatter: func(obj interface{}) string { return strconv.FormatBool(obj.(hyperscaler.PoolCapacityDTO).Exhausted()) },
+	},
+}
+
+// NewAccountsCmd constructs a new instance of AccountsCommand and configures it in terms of a cobra.Command
+func NewAccountsCmd() *cobra.Command {
+	cmd := AccountsCommand{}
+	cobraCmd := &cobra.Command{
+		Use:     "accounts",
+		Aliases: []string{"account", "acc"},
+		Short:   "Displays the hyperscaler account pool.",
+		Long: `Displays the secret bindings of the hyperscaler account pool with their tenant assignment and state.
+A secret binding is free if it can be assigned to a new tenant. Dirty secret bindings wait for the subscription cleanup job, shared ones are used by many tenants, and quarantined ones are excluded from the pool by an operator.
+Use the capacity subcommand to display the number of secret bindings per pool, and the release and quarantine subcommands to manage a single secret binding.`,
+		Example: `  kcp accounts                                   Display all secret bindings of the account pool.
+  kcp accounts --hyperscaler gcp --state free      Display the free GCP secret bindings.
+  kcp accounts --tenant GAID -o json               Display the secret bindings assigned to the given global account in the JSON format.
+  kcp accounts capacity                          Display the number of secret bindings per pool.`,
+		PreRunE: func(_ *cobra.Command, _ []string) error { return cmd.Validate() },
+		RunE:    func(_ *cobra.Command, _ []string) error { return cmd.Run() },
+	}
+	cmd.cobraCmd = cobraCmd
+
+	cobraCmd.Flags().StringVarP(&cmd.output, "output", "o", tableOutput, fmt.Sprintf("Output type of displayed secret bindings. The possible values are: %s, %s.", tableOutput, jsonOutput))
+	cobraCmd.Flags().StringVar(&cmd.filter.HyperscalerType, "hyperscaler", "", "Filter by hyperscaler type, e.g. gcp, azure, aws, openstack.")
+	cobraCmd.Flags().StringVar(&cmd.state, "state", "", "Filter by state. The possible values are: free, assigned, dirty, shared, quarantined.")
+	cobraCmd.Flags().StringVarP(&cmd.filter.TenantName, "tenant", "g", "", "Filter by the global account ID the secret binding is assigned to.")
+	cobraCmd.Flags().StringVar(&cmd.euAccess, "eu-access", "", "Filter by the EU access pool. The possible values are: true, false.")
+
+	cobraCmd.AddCommand(
+		NewAccountsCapacityCmd(),
+		NewAccountsReleaseCmd(),
+		NewAccountsQuarantineCmd(),
+	)
+
+	return cobraCmd
+}
+
+// Validate checks the input parameters of the accounts command
+func (cmd *AccountsCommand) Validate() error {
+	if cmd.output != tableOutput && cmd.output != jsonOutput {
+		return fmt.Errorf("invalid value for output: %s", cmd.output)
+	}
+	switch state := hyperscaler.AccountState(cmd.state); state {
+	case "", hyperscaler.AccountStateFree, hyperscaler.AccountStateAssigned, hyperscaler.AccountStateDirty,
+		hyperscaler.AccountStateShared, hyperscaler.AccountStateQuarantined:
+		cmd.filter.State = state
+	default:
+		return fmt.Errorf("invalid value for state: %s", cmd.state)
+	}
+	if cmd.euAccess != "" {
+		euAccess, err := strconv.ParseBool(cmd.euAccess)
+		if err != nil {
+			return fmt.Errorf("invalid value for eu-access: %s", cmd.euAccess)
+		}
+		cmd.filter.EuAccess = &euAccess
+	}
+
+	return nil
+}
+
+// Run executes the accounts command
+func (cmd *AccountsCommand) Run() error {
+	cmd.log = logger.New()
+	httpClient := oauth2.NewClient(cmd.cobraCmd.Context(), CLICredentialManager(cmd.log))
+	client := hyperscaler.NewClient(GlobalOpts.KEBAPIURL(), httpClient)
+
+	accounts, err := client.ListAccounts(cmd.filter)
+	if err != nil {
+		return errors.Wrap(err, "while listing accounts")
+	}
+
+	return printAccounts(cmd.output, accounts)
+}
+
+func printAccounts(output string, accounts []hyperscaler.AccountDTO) error {
+	switch output {
+	case tableOutput:
+		tp, err := printer.NewTablePrinter(accountsTableColumns, false)
+		if err != nil {
+			return err
+		}
+		return tp.PrintObj(accounts)
+	case jsonOutput:
+		jp := printer.NewJSONPrinter("  ")
+		return jp.PrintObj(accounts)
+	}
+	return nil
+}
+
+// AccountsCapacityCommand represents an execution of the kcp accounts capacity command
+type AccountsCapacityCommand struct {
+	cobraCmd *cobra.Command
+	log      logger.Logger
+	output   string
+}
+
+// NewAccountsCapacityCmd constructs a new instance of AccountsCapacityCommand and configures it in terms of a cobra.Command
+func NewAccountsCapacityCmd() *cobra.Command {
+	cmd := AccountsCapacityCommand{}
+	cobraCmd := &cobra.Command{
+		Use:   "capacity",
+		Short: "Displays the number of secret bindings per account pool.",
+		Long: `Displays the number of secret bindings per hyperscaler type and EU access pool by their state.
+A pool is exhausted if it has dedicated secret bindings but none of them is free, so provisioning for new tenants fails.`,
+		Example: `  kcp accounts capacity           Display the capacity of all pools.
+  kcp accounts capacity -o json   Display the capacity of all pools in the JSON format.`,
+		PreRunE: func(_ *cobra.Command, _ []string) error { return cmd.Validate() },
+		RunE:    func(_ *cobra.Command, _ []string) error { return cmd.Run() },
+	}
+	cmd.cobraCmd = cobraCmd
+
+	cobraCmd.Flags().StringVarP(&cmd.output, "output", "o", tableOutput, fmt.Sprintf("Output type of displayed pools. The possible values are: %s, %s.", tableOutput, jsonOutput))
+
+	return cobraCmd
+}
+
+// Validate checks the input parameters of the accounts capacity command
+func (cmd *AccountsCapacityCommand) Validate() error {
+	if cmd.output != tableOutput && cmd.output != jsonOutput {
+		return fmt.Errorf("invalid value for output: %s", cmd.output)
+	}
+	return nil
+}
+
+// Run executes the accounts capacity command
+func (cmd *AccountsCapacityCommand) Run() error {
+	cmd.log = logger.New()
+	httpClient := oauth2.NewClient(cmd.cobraCmd.Context(), CLICredentialManager(cmd.log))
+	client := hyperscaler.NewClient(GlobalOpts.KEBAPIURL(), httpClient)
+
+	capacity, err := client.Capacity()
+	if err != nil {
+		return errors.Wrap(err, "while getting capacity of account pools")
+	}
+
+	switch cmd.output {
+	case tableOutput:
+		tp, err := printer.NewTablePrinter(accountsCapacityTableColumns, false)
+		if err != nil {
+			return err
+		}
+		return tp.PrintObj(capacity)
+	case jsonOutput:
+		jp := printer.NewJSONPrinter("  ")
+		return jp.PrintObj(capacity)
+	}
+	return nil
+}
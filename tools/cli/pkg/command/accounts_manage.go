@@ -0,0 +1,92 @@
+package command
+
+import (
+	"fmt"
+
+	"golang.org/x/oauth2"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/hyperscaler"
+	"github.com/kyma-project/control-plane/tools/cli/pkg/logger"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+type accountAction string
+
+const (
+	releaseAccountAction    accountAction = "release"
+	quarantineAccountAction accountAction = "quarantine"
+)
+
+// AccountsManageCommand represents an execution of the kcp accounts release and quarantine commands
+type AccountsManageCommand struct {
+	cobraCmd          *cobra.Command
+	log               logger.Logger
+	action            accountAction
+	output            string
+	secretBindingName string
+}
+
+// NewAccountsReleaseCmd constructs the kcp accounts release command
+func NewAccountsReleaseCmd() *cobra.Command {
+	return newAccountsManageCmd(releaseAccountAction, &cobra.Command{
+		Use:   "release SECRET_BINDING",
+		Short: "Returns a secret binding to the account pool.",
+		Long: `Returns a secret binding to the account pool by removing its tenant assignment and the dirty and quarantined labels.
+Use it after you made sure the hyperscaler account is clean. A dedicated secret binding used by any shoot cannot be released.`,
+		Example: `  kcp accounts release sb-gcp-0042   Return the given secret binding to the account pool.`,
+	})
+}
+
+// NewAccountsQuarantineCmd constructs the kcp accounts quarantine command
+func NewAccountsQuarantineCmd() *cobra.Command {
+	return newAccountsManageCmd(quarantineAccountAction, &cobra.Command{
+		Use:   "quarantine SECRET_BINDING",
+		Short: "Excludes a secret binding from the account pool.",
+		Long: `Excludes a secret binding from the account pool. A quarantined secret binding is not assigned to new tenants and is not cleaned up by the subscription cleanup job.
+Shoots which already use the secret binding are not affected. Use the release command to return the secret binding to the pool.`,
+		Example: `  kcp accounts quarantine sb-gcp-0042   Exclude the given secret binding from the account pool.`,
+	})
+}
+
+func newAccountsManageCmd(action accountAction, cobraCmd *cobra.Command) *cobra.Command {
+	cmd := AccountsManageCommand{action: action}
+	cobraCmd.Args = cobra.ExactArgs(1)
+	cobraCmd.PreRunE = func(_ *cobra.Command, args []string) error { return cmd.Validate(args) }
+	cobraCmd.RunE = func(_ *cobra.Command, _ []string) error { return cmd.Run() }
+	cmd.cobraCmd = cobraCmd
+
+	cobraCmd.Flags().StringVarP(&cmd.output, "output", "o", tableOutput, fmt.Sprintf("Output type of the displayed secret binding. The possible values are: %s, %s.", tableOutput, jsonOutput))
+
+	return cobraCmd
+}
+
+// Validate checks the input parameters of the accounts release and quarantine commands
+func (cmd *AccountsManageCommand) Validate(args []string) error {
+	if cmd.output != tableOutput && cmd.output != jsonOutput {
+		return fmt.Errorf("invalid value for output: %s", cmd.output)
+	}
+	cmd.secretBindingName = args[0]
+	return nil
+}
+
+// Run executes the accounts release or quarantine command
+func (cmd *AccountsManageCommand) Run() error {
+	cmd.log = logger.New()
+	httpClient := oauth2.NewClient(cmd.cobraCmd.Context(), CLICredentialManager(cmd.log))
+	client := hyperscaler.NewClient(GlobalOpts.KEBAPIURL(), httpClient)
+
+	var account hyperscaler.AccountDTO
+	var err error
+	switch cmd.action {
+	case releaseAccountAction:
+		account, err = client.ReleaseAccount(cmd.secretBindingName)
+	case quarantineAccountAction:
+		account, err = client.QuarantineAccount(cmd.secretBindingName)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "while trying to %s secret binding %s", cmd.action, cmd.secretBindingName)
+	}
+
+	return printAccounts(cmd.output, []hyperscaler.AccountDTO{account})
+}
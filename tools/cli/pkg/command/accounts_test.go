@@ -0,0 +1,47 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/hyperscaler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountsCommand_Validate(t *testing.T) {
+	t.Run("should build the filter", func(t *testing.T) {
+		// given
+		cmd := AccountsCommand{output: tableOutput, state: "free", euAccess: "true"}
+
+		// when
+		err := cmd.Validate()
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, hyperscaler.AccountStateFree, cmd.filter.State)
+		require.NotNil(t, cmd.filter.EuAccess)
+		assert.True(t, *cmd.filter.EuAccess)
+	})
+
+	t.Run("should select both pools without eu-access", func(t *testing.T) {
+		// given
+		cmd := AccountsCommand{output: jsonOutput}
+
+		// when
+		err := cmd.Validate()
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, cmd.filter.EuAccess)
+	})
+
+	for name, cmd := range map[string]AccountsCommand{
+		"invalid output":    {output: "yaml"},
+		"invalid state":     {output: tableOutput, state: "broken"},
+		"invalid eu-access": {output: tableOutput, euAccess: "maybe"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.Error(t, cmd.Validate())
+		})
+	}
+}
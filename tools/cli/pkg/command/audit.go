@@ -0,0 +1,189 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/audit"
+	"github.com/kyma-project/control-plane/tools/cli/pkg/logger"
+	"github.com/kyma-project/control-plane/tools/cli/pkg/printer"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// AuditKubeconfigsCommand represents an execution of the kcp audit kubeconfigs command
+type AuditKubeconfigsCommand struct {
+	cobraCmd *cobra.Command
+	log      logger.Logger
+	output   string
+	params   audit.ListParameters
+	since    time.Duration
+	from     string
+	to       string
+}
+
+var auditKubeconfigsTableColumns = []printer.Column{
+	{
+		Header:         "CREATED AT",
+		FieldFormatter: auditCreatedAt,
+	},
+	{
+		Header:    "SOURCE",
+		FieldSpec: "{.Source}",
+	},
+	{
+		Header:    "USER",
+		FieldSpec: "{.UserID}",
+	},
+	{
+		Header:    "INSTANCE ID",
+		FieldSpec: "{.InstanceID}",
+	},
+	{
+		Header:    "RUNTIME ID",
+		FieldSpec: "{.RuntimeID}",
+	},
+	{
+		Header:    "ROLE",
+		FieldSpec: "{.Role}",
+	},
+	{
+		Header:    "SOURCE IP",
+		FieldSpec: "{.SourceIP}",
+	},
+	{
+		Header:         "EXPIRES AT",
+		FieldFormatter: auditExpiresAt,
+	},
+}
+
+// NewAuditCmd constructs the kcp audit command grouping the audit trail commands
+func NewAuditCmd() *cobra.Command {
+	cobraCmd := &cobra.Command{
+		Use:   "audit",
+		Short: "Displays audit trails recorded by Kyma Control Plane.",
+		Long:  `Displays audit trails recorded by Kyma Control Plane.`,
+	}
+
+	cobraCmd.AddCommand(
+		NewAuditKubeconfigsCmd(),
+	)
+
+	return cobraCmd
+}
+
+// NewAuditKubeconfigsCmd constructs a new instance of AuditKubeconfigsCommand and configures it in terms of a cobra.Command
+func NewAuditKubeconfigsCmd() *cobra.Command {
+	cmd := AuditKubeconfigsCommand{}
+	cobraCmd := &cobra.Command{
+		Use:     "kubeconfigs",
+		Aliases: []string{"kubeconfig", "kc"},
+		Short:   "Displays the issued kubeconfigs of Kyma Runtimes.",
+		Long: `Displays the audit records of the kubeconfigs issued by Kyma Environment Broker and kubeconfig-service, starting from the newest one.
+The command supports filtering records based on various attributes. See the list of options for more details.`,
+		Example: `  kcp audit kubeconfigs -r RUNTIME_ID --since 24h            Display kubeconfigs of the given runtime issued within the last day.
+  kcp audit kubeconfigs -u john.smith@email.com -o json       Display kubeconfigs issued to the given user in the JSON format.
+  kcp audit kubeconfigs --source kubeconfig-service           Display kubeconfigs issued by kubeconfig-service.`,
+		PreRunE: func(_ *cobra.Command, _ []string) error { return cmd.Validate() },
+		RunE:    func(_ *cobra.Command, _ []string) error { return cmd.Run() },
+	}
+	cmd.cobraCmd = cobraCmd
+
+	cobraCmd.Flags().StringVarP(&cmd.output, "output", "o", tableOutput, fmt.Sprintf("Output type of displayed records. The possible values are: %s, %s.", tableOutput, jsonOutput))
+	cobraCmd.Flags().StringSliceVarP(&cmd.params.InstanceIDs, "instance-id", "i", nil, "Filter by instance ID. You can provide multiple values, either separated by a comma (e.g. ID1,ID2), or by specifying the option multiple times.")
+	cobraCmd.Flags().StringSliceVarP(&cmd.params.RuntimeIDs, "runtime-id", "r", nil, "Filter by runtime ID. You can provide multiple values, either separated by a comma (e.g. ID1,ID2), or by specifying the option multiple times.")
+	cobraCmd.Flags().StringSliceVarP(&cmd.params.UserIDs, "user", "u", nil, "Filter by user ID. You can provide multiple values, either separated by a comma (e.g. USER1,USER2), or by specifying the option multiple times.")
+	cobraCmd.Flags().StringSliceVar(&cmd.params.Sources, "source", nil, "Filter by the service issuing the kubeconfig. The possible values are: kyma-environment-broker, kubeconfig-service.")
+	cobraCmd.Flags().DurationVar(&cmd.since, "since", 0, "Display only records created within the given duration before now, e.g. 2h or 30m.")
+	cobraCmd.Flags().StringVar(&cmd.from, "from", "", "Display only records created at or after the given time in the RFC3339 format, e.g. 2026-10-18T12:00:00Z.")
+	cobraCmd.Flags().StringVar(&cmd.to, "to", "", "Display only records created at or before the given time in the RFC3339 format, e.g. 2026-10-18T14:00:00Z.")
+	cobraCmd.Flags().IntVarP(&cmd.params.Page, "page", "p", 0, "Page number of displayed records. All pages are displayed when not set.")
+	cobraCmd.Flags().IntVar(&cmd.params.PageSize, "page-size", 0, "Number of records displayed on one page.")
+
+	return cobraCmd
+}
+
+// Validate checks the input parameters of the audit kubeconfigs command
+func (cmd *AuditKubeconfigsCommand) Validate() error {
+	if cmd.output != tableOutput && cmd.output != jsonOutput {
+		return fmt.Errorf("invalid value for output: %s", cmd.output)
+	}
+	for i, source := range cmd.params.Sources {
+		source = strings.ToLower(source)
+		if source != "kyma-environment-broker" && source != "kubeconfig-service" {
+			return fmt.Errorf("invalid value for source: %s", cmd.params.Sources[i])
+		}
+		cmd.params.Sources[i] = source
+	}
+	if cmd.since > 0 && cmd.from != "" {
+		return errors.New("only one of --since and --from can be provided")
+	}
+	if cmd.since > 0 {
+		cmd.params.From = time.Now().Add(-cmd.since)
+	}
+	var err error
+	if cmd.from != "" {
+		if cmd.params.From, err = time.Parse(time.RFC3339, cmd.from); err != nil {
+			return errors.Wrap(err, "invalid value for from")
+		}
+	}
+	if cmd.to != "" {
+		if cmd.params.To, err = time.Parse(time.RFC3339, cmd.to); err != nil {
+			return errors.Wrap(err, "invalid value for to")
+		}
+	}
+	if cmd.params.Page < 0 || cmd.params.PageSize < 0 {
+		return errors.New("page and page-size cannot be negative")
+	}
+
+	return nil
+}
+
+// Run executes the audit kubeconfigs command
+func (cmd *AuditKubeconfigsCommand) Run() error {
+	cmd.log = logger.New()
+	httpClient := oauth2.NewClient(cmd.cobraCmd.Context(), CLICredentialManager(cmd.log))
+	client := audit.NewClient(GlobalOpts.KEBAPIURL(), httpClient)
+
+	records, err := client.ListKubeconfigRecords(cmd.params)
+	if err != nil {
+		return errors.Wrap(err, "while listing kubeconfig audit records")
+	}
+
+	err = cmd.printRecords(records)
+	if err != nil {
+		return errors.Wrap(err, "while printing kubeconfig audit records")
+	}
+
+	return nil
+}
+
+func (cmd *AuditKubeconfigsCommand) printRecords(records audit.KubeconfigRecordsPage) error {
+	switch cmd.output {
+	case tableOutput:
+		tp, err := printer.NewTablePrinter(auditKubeconfigsTableColumns, false)
+		if err != nil {
+			return err
+		}
+		return tp.PrintObj(records.Data)
+	case jsonOutput:
+		jp := printer.NewJSONPrinter("  ")
+		return jp.PrintObj(records)
+	}
+	return nil
+}
+
+func auditCreatedAt(obj interface{}) string {
+	return obj.(audit.KubeconfigRecordDTO).CreatedAt.Format("2006/01/02 15:04:05")
+}
+
+func auditExpiresAt(obj interface{}) string {
+	expiresAt := obj.(audit.KubeconfigRecordDTO).ExpiresAt
+	if expiresAt == nil {
+		return ""
+	}
+	return expiresAt.Format("2006/01/02 15:04:05")
+}
@@ -0,0 +1,38 @@
+package command
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditKubeconfigsCommand_Validate(t *testing.T) {
+	t.Run("should parse filters", func(t *testing.T) {
+		// given
+		cmd := AuditKubeconfigsCommand{output: tableOutput, from: "2026-10-18T12:00:00Z"}
+		cmd.params.Sources = []string{"Kubeconfig-Service"}
+
+		// when
+		err := cmd.Validate()
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"kubeconfig-service"}, cmd.params.Sources)
+		assert.Equal(t, time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC), cmd.params.From)
+	})
+
+	t.Run("should reject unknown source", func(t *testing.T) {
+		cmd := AuditKubeconfigsCommand{output: tableOutput}
+		cmd.params.Sources = []string{"kubectl"}
+
+		assert.Error(t, cmd.Validate())
+	})
+
+	t.Run("should reject since together with from", func(t *testing.T) {
+		cmd := AuditKubeconfigsCommand{output: jsonOutput, since: time.Hour, from: "2026-10-18T12:00:00Z"}
+
+		assert.Error(t, cmd.Validate())
+	})
+}
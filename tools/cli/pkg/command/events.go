@@ -0,0 +1,200 @@
+package command
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/events"
+	"github.com/kyma-project/control-plane/tools/cli/pkg/logger"
+	"github.com/kyma-project/control-plane/tools/cli/pkg/printer"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// EventsCommand represents an execution of the kcp events command
+type EventsCommand struct {
+	cobraCmd *cobra.Command
+	log      logger.Logger
+	output   string
+	filter   events.EventFilter
+	levels   []string
+	sort     string
+	since    time.Duration
+	from     string
+	to       string
+	all      bool
+}
+
+var eventsTableColumns = []printer.Column{
+	{
+		Header:         "CREATED AT",
+		FieldFormatter: eventCreatedAt,
+	},
+	{
+		Header:    "LEVEL",
+		FieldSpec: "{.Level}",
+	},
+	{
+		Header:         "INSTANCE ID",
+		FieldFormatter: func(obj interface{}) string { return stringValue(obj.(events.EventDTO).InstanceID) },
+	},
+	{
+		Header:         "OPERATION ID",
+		FieldFormatter: func(obj interface{}) string { return stringValue(obj.(events.EventDTO).OperationID) },
+	},
+	{
+		Header:         "STEP",
+		FieldFormatter: func(obj interface{}) string { return stringValue(obj.(events.EventDTO).StepName) },
+	},
+	{
+		Header:    "MESSAGE",
+		FieldSpec: "{.Message}",
+	},
+}
+
+// NewEventsCmd constructs a new instance of EventsCommand and configures it in terms of a cobra.Command
+func NewEventsCmd() *cobra.Command {
+	cmd := EventsCommand{}
+	cobraCmd := &cobra.Command{
+		Use:     "events",
+		Aliases: []string{"event", "ev"},
+		Short:   "Displays tracing events of Kyma Runtime operations.",
+		Long: `Displays tracing events recorded by Kyma Environment Broker while processing operations.
+The command supports filtering events based on various attributes and searching in event messages. See the list of options for more details.
+Events are displayed in pages, use the --cursor option with the value displayed after the page to get the next one, or --all to get all pages.`,
+		Example: `  kcp events -i INSTANCE_ID                                  Display events of the given instance.
+  kcp events -g GAID --level error --since 24h                Display error events of the given global account from the last day.
+  kcp events --step Create_Runtime -m timeout --sort desc     Display the latest events of the given step containing the "timeout" text.
+  kcp events -s SAID --all -o json                            Display all events of the given subaccount in the JSON format.`,
+		PreRunE: func(_ *cobra.Command, _ []string) error { return cmd.Validate() },
+		RunE:    func(_ *cobra.Command, _ []string) error { return cmd.Run() },
+	}
+	cmd.cobraCmd = cobraCmd
+
+	cobraCmd.Flags().StringVarP(&cmd.output, "output", "o", tableOutput, fmt.Sprintf("Output type of displayed events. The possible values are: %s, %s.", tableOutput, jsonOutput))
+	cobraCmd.Flags().StringSliceVarP(&cmd.filter.InstanceIDs, "instance-id", "i", nil, "Filter by instance ID. You can provide multiple values, either separated by a comma (e.g. ID1,ID2), or by specifying the option multiple times.")
+	cobraCmd.Flags().StringSliceVar(&cmd.filter.OperationIDs, "operation-id", nil, "Filter by operation ID. You can provide multiple values, either separated by a comma (e.g. ID1,ID2), or by specifying the option multiple times.")
+	cobraCmd.Flags().StringSliceVarP(&cmd.filter.GlobalAccountIDs, "account", "g", nil, "Filter by global account ID. You can provide multiple values, either separated by a comma (e.g. GAID1,GAID2), or by specifying the option multiple times.")
+	cobraCmd.Flags().StringSliceVarP(&cmd.filter.SubAccountIDs, "subaccount", "s", nil, "Filter by subaccount ID. You can provide multiple values, either separated by a comma (e.g. SAID1,SAID2), or by specifying the option multiple times.")
+	cobraCmd.Flags().StringSliceVar(&cmd.filter.PlanIDs, "plan-id", nil, "Filter by service plan ID. You can provide multiple values, either separated by a comma (e.g. ID1,ID2), or by specifying the option multiple times.")
+	cobraCmd.Flags().StringSliceVar(&cmd.filter.StepNames, "step", nil, "Filter by the name of the operation step. You can provide multiple values, either separated by a comma (e.g. Create_Runtime,Check_Runtime), or by specifying the option multiple times.")
+	cobraCmd.Flags().StringSliceVarP(&cmd.levels, "level", "l", nil, "Filter by event level. The possible values are: info, error.")
+	cobraCmd.Flags().StringVarP(&cmd.filter.Message, "message", "m", "", "Display only events which message contains the given text, ignoring case.")
+	cobraCmd.Flags().DurationVar(&cmd.since, "since", 0, "Display only events created within the given duration before now, e.g. 2h or 30m.")
+	cobraCmd.Flags().StringVar(&cmd.from, "from", "", "Display only events created at or after the given time in the RFC3339 format, e.g. 2026-10-18T12:00:00Z.")
+	cobraCmd.Flags().StringVar(&cmd.to, "to", "", "Display only events created at or before the given time in the RFC3339 format, e.g. 2026-10-18T14:00:00Z.")
+	cobraCmd.Flags().StringVar(&cmd.sort, "sort", string(events.AscendingSortOrder), "Sort order of events by the creation time. The possible values are: asc, desc.")
+	cobraCmd.Flags().IntVar(&cmd.filter.Limit, "limit", 100, "Maximum number of events displayed on one page.")
+	cobraCmd.Flags().StringVar(&cmd.filter.Cursor, "cursor", "", "Display the page of events following the given cursor.")
+	cobraCmd.Flags().BoolVar(&cmd.all, "all", false, "Display all pages of events.")
+
+	return cobraCmd
+}
+
+// Validate checks the input parameters of the events command
+func (cmd *EventsCommand) Validate() error {
+	if cmd.output != tableOutput && cmd.output != jsonOutput {
+		return fmt.Errorf("invalid value for output: %s", cmd.output)
+	}
+	for _, l := range cmd.levels {
+		level := events.EventLevel(strings.ToLower(l))
+		switch level {
+		case events.InfoEventLevel, events.ErrorEventLevel:
+			cmd.filter.Levels = append(cmd.filter.Levels, level)
+		default:
+			return fmt.Errorf("invalid value for level: %s", l)
+		}
+	}
+	switch sort := events.SortOrder(strings.ToLower(cmd.sort)); sort {
+	case events.AscendingSortOrder, events.DescendingSortOrder:
+		cmd.filter.Sort = sort
+	default:
+		return fmt.Errorf("invalid value for sort: %s", cmd.sort)
+	}
+	if cmd.since > 0 && cmd.from != "" {
+		return errors.New("only one of --since and --from can be provided")
+	}
+	if cmd.since > 0 {
+		cmd.filter.From = time.Now().Add(-cmd.since)
+	}
+	var err error
+	if cmd.from != "" {
+		if cmd.filter.From, err = time.Parse(time.RFC3339, cmd.from); err != nil {
+			return errors.Wrap(err, "invalid value for from")
+		}
+	}
+	if cmd.to != "" {
+		if cmd.filter.To, err = time.Parse(time.RFC3339, cmd.to); err != nil {
+			return errors.Wrap(err, "invalid value for to")
+		}
+	}
+	if cmd.filter.Limit < 1 {
+		return fmt.Errorf("invalid value for limit: %d", cmd.filter.Limit)
+	}
+
+	return nil
+}
+
+// Run executes the events command
+func (cmd *EventsCommand) Run() error {
+	cmd.log = logger.New()
+	httpClient := oauth2.NewClient(cmd.cobraCmd.Context(), CLICredentialManager(cmd.log))
+	client := events.NewClient(GlobalOpts.KEBAPIURL(), httpClient)
+
+	var eventList []events.EventDTO
+	filter := cmd.filter
+	for {
+		page, err := client.SearchEvents(filter)
+		if err != nil {
+			return errors.Wrap(err, "while searching events")
+		}
+		eventList = append(eventList, page.Data...)
+		filter.Cursor = page.NextCursor
+		if filter.Cursor == "" || !cmd.all {
+			break
+		}
+	}
+
+	err := cmd.printEvents(eventList)
+	if err != nil {
+		return errors.Wrap(err, "while printing events")
+	}
+	if filter.Cursor != "" {
+		fmt.Fprintf(os.Stderr, "\nMore events available, use --cursor %s to display the next page\n", filter.Cursor)
+	}
+
+	return nil
+}
+
+func (cmd *EventsCommand) printEvents(eventList []events.EventDTO) error {
+	if eventList == nil {
+		eventList = []events.EventDTO{}
+	}
+	switch cmd.output {
+	case tableOutput:
+		tp, err := printer.NewTablePrinter(eventsTableColumns, false)
+		if err != nil {
+			return err
+		}
+		return tp.PrintObj(eventList)
+	case jsonOutput:
+		jp := printer.NewJSONPrinter("  ")
+		return jp.PrintObj(eventList)
+	}
+	return nil
+}
+
+func eventCreatedAt(obj interface{}) string {
+	return obj.(events.EventDTO).CreatedAt.Format("2006/01/02 15:04:05")
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
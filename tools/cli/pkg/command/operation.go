@@ -3,23 +3,37 @@ package command
 import (
 	"context"
 
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/events"
+	"github.com/kyma-project/control-plane/tools/cli/pkg/logger"
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	"golang.org/x/oauth2"
 )
 
 type operationCmd struct {
-	ctx context.Context
+	ctx   context.Context
+	watch bool
 }
 
 func NewOperationCmd() *cobra.Command {
 	cmd := operationCmd{}
 	cobraCmd := &cobra.Command{
-		Use:   "operation",
+		Use:   "operation [id]",
 		Short: "Manage operations",
+		Long: `Manage operations.
+When an operation ID is given as an argument together with the --watch flag, the command watches progress of the operation until interrupted.`,
+		Example: `  kcp operation 8a7bfd9b-f2f5-43d1-bb67-177d2434053c --watch    Watch progress of the given operation.`,
+		Args:    cobra.MaximumNArgs(1),
+		PreRunE: func(_ *cobra.Command, args []string) error { return cmd.Validate(args) },
+		RunE:    func(cobraCmd *cobra.Command, args []string) error { return cmd.Run(cobraCmd, args) },
 	}
 
+	cobraCmd.Flags().BoolVarP(&cmd.watch, "watch", "w", false, "Watch progress of the operation given as an argument until interrupted.")
+
 	cobraCmd.AddCommand(
 		NewOperationStopCmd(),
 		NewOperationDebugLogsCmd(),
+		NewOperationStepsCmd(),
 	)
 
 	if cobraCmd.Parent() != nil && cobraCmd.Parent().Context() != nil {
@@ -29,3 +43,19 @@ func NewOperationCmd() *cobra.Command {
 	cmd.ctx = context.Background()
 	return cobraCmd
 }
+
+func (cmd *operationCmd) Validate(args []string) error {
+	if cmd.watch && len(args) != 1 {
+		return errors.New("--watch requires an operation ID as an argument")
+	}
+	return nil
+}
+
+func (cmd *operationCmd) Run(cobraCmd *cobra.Command, args []string) error {
+	if !cmd.watch {
+		return cobraCmd.Help()
+	}
+
+	httpClient := oauth2.NewClient(cobraCmd.Context(), CLICredentialManager(logger.New()))
+	return watchProgress(cobraCmd.Context(), httpClient, events.StreamFilter{OperationIDs: []string{args[0]}})
+}
@@ -0,0 +1,178 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/runtime"
+	"github.com/kyma-project/control-plane/tools/cli/pkg/logger"
+	"github.com/kyma-project/control-plane/tools/cli/pkg/printer"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+const timelineWidth = 40
+
+// OperationStepsCommand represents an execution of the kcp operation steps command
+type OperationStepsCommand struct {
+	cobraCmd    *cobra.Command
+	log         logger.Logger
+	output      string
+	operationID string
+}
+
+// operationStepRow is a step with the offsets needed to draw the step on the timeline of the operation
+type operationStepRow struct {
+	runtime.OperationStep
+	offset time.Duration
+	bar    string
+}
+
+var operationStepsTableColumns = []printer.Column{
+	{
+		Header:    "STEP",
+		FieldSpec: "{.StepName}",
+	},
+	{
+		Header:    "STATE",
+		FieldSpec: "{.State}",
+	},
+	{
+		Header:    "ATTEMPTS",
+		FieldSpec: "{.Attempts}",
+	},
+	{
+		Header:         "STARTED",
+		FieldFormatter: func(obj interface{}) string { return "+" + obj.(operationStepRow).offset.String() },
+	},
+	{
+		Header:         "DURATION",
+		FieldFormatter: func(obj interface{}) string { return milliseconds(obj.(operationStepRow).DurationMs).String() },
+	},
+	{
+		Header:         "PROCESSING",
+		FieldFormatter: func(obj interface{}) string { return milliseconds(obj.(operationStepRow).ProcessingTimeMs).String() },
+	},
+	{
+		Header:         "TIMELINE",
+		FieldFormatter: func(obj interface{}) string { return obj.(operationStepRow).bar },
+	},
+	{
+		Header:    "LAST ERROR",
+		FieldSpec: "{.LastError}",
+	},
+}
+
+// NewOperationStepsCmd constructs a new instance of OperationStepsCommand and configures it in terms of a cobra.Command
+func NewOperationStepsCmd() *cobra.Command {
+	cmd := OperationStepsCommand{}
+	cobraCmd := &cobra.Command{
+		Use:   "steps OPERATION_ID",
+		Short: "Displays the step timeline of an operation.",
+		Long: `Displays the steps of a Kyma Runtime operation recorded by Kyma Environment Broker ordered by the start time.
+For every step, the command displays the number of attempts, the time elapsed from the start of the first attempt to the end of the last one, and the time spent on processing the step.
+The timeline column shows when the step was running relative to the other steps of the operation.`,
+		Example: `  kcp operation steps 8a7bfd9b-f2f5-43d1-bb67-177d2434053c           Display the step timeline of the given operation.
+  kcp operation steps 8a7bfd9b-f2f5-43d1-bb67-177d2434053c -o json   Display the steps of the given operation in the JSON format.`,
+		Args:    cobra.ExactArgs(1),
+		PreRunE: func(_ *cobra.Command, args []string) error { return cmd.Validate(args) },
+		RunE:    func(_ *cobra.Command, _ []string) error { return cmd.Run() },
+	}
+	cmd.cobraCmd = cobraCmd
+
+	cobraCmd.Flags().StringVarP(&cmd.output, "output", "o", tableOutput, fmt.Sprintf("Output type of displayed steps. The possible values are: %s, %s.", tableOutput, jsonOutput))
+
+	return cobraCmd
+}
+
+// Validate checks the input parameters of the operation steps command
+func (cmd *OperationStepsCommand) Validate(args []string) error {
+	if cmd.output != tableOutput && cmd.output != jsonOutput {
+		return fmt.Errorf("invalid value for output: %s", cmd.output)
+	}
+	cmd.operationID = args[0]
+
+	return nil
+}
+
+// Run executes the operation steps command
+func (cmd *OperationStepsCommand) Run() error {
+	cmd.log = logger.New()
+	httpClient := oauth2.NewClient(cmd.cobraCmd.Context(), CLICredentialManager(cmd.log))
+	client := runtime.NewClient(GlobalOpts.KEBAPIURL(), httpClient)
+
+	steps, err := client.ListOperationSteps(cmd.operationID)
+	if err != nil {
+		return errors.Wrap(err, "while listing operation steps")
+	}
+
+	err = cmd.printSteps(steps)
+	if err != nil {
+		return errors.Wrap(err, "while printing operation steps")
+	}
+
+	return nil
+}
+
+func (cmd *OperationStepsCommand) printSteps(steps runtime.OperationSteps) error {
+	switch cmd.output {
+	case tableOutput:
+		fmt.Printf("Operation %s (%s): %s\n\n", steps.OperationID, steps.Type, steps.State)
+		tp, err := printer.NewTablePrinter(operationStepsTableColumns, false)
+		if err != nil {
+			return err
+		}
+		return tp.PrintObj(timelineRows(steps.Data, timelineWidth))
+	case jsonOutput:
+		jp := printer.NewJSONPrinter("  ")
+		return jp.PrintObj(steps)
+	}
+	return nil
+}
+
+// timelineRows places the steps on a timeline of the given width spanning from the start of the first step to the end of the last one
+func timelineRows(steps []runtime.OperationStep, width int) []operationStepRow {
+	rows := make([]operationStepRow, 0, len(steps))
+	if len(steps) == 0 {
+		return rows
+	}
+
+	start, end := steps[0].StartedAt, steps[0].FinishedAt
+	for _, step := range steps {
+		if step.StartedAt.Before(start) {
+			start = step.StartedAt
+		}
+		if step.FinishedAt.After(end) {
+			end = step.FinishedAt
+		}
+	}
+	total := end.Sub(start)
+
+	for _, step := range steps {
+		from, to := 0, width
+		if total > 0 {
+			from = int(int64(width) * int64(step.StartedAt.Sub(start)) / int64(total))
+			to = int(int64(width) * int64(step.FinishedAt.Sub(start)) / int64(total))
+		}
+		if to >= width {
+			to = width - 1
+		}
+		if from > to {
+			from = to
+		}
+		rows = append(rows, operationStepRow{
+			OperationStep: step,
+			offset:        step.StartedAt.Sub(start).Round(time.Second),
+			bar:           strings.Repeat(" ", from) + strings.Repeat("#", to-from+1) + strings.Repeat(" ", width-to-1),
+		})
+	}
+
+	return rows
+}
+
+func milliseconds(ms int64) time.Duration {
+	return (time.Duration(ms) * time.Millisecond).Round(time.Millisecond)
+}
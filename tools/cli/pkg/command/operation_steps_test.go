@@ -0,0 +1,33 @@
+package command
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimelineRows(t *testing.T) {
+	// given
+	start := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	steps := []runtime.OperationStep{
+		{StepName: "Create_Runtime", StartedAt: start, FinishedAt: start.Add(2 * time.Second)},
+		{StepName: "Check_Runtime", StartedAt: start.Add(2 * time.Second), FinishedAt: start.Add(10 * time.Second)},
+	}
+
+	// when
+	rows := timelineRows(steps, 10)
+
+	// then
+	require.Len(t, rows, 2)
+	assert.Equal(t, time.Duration(0), rows[0].offset)
+	assert.Equal(t, "###       ", rows[0].bar)
+	assert.Equal(t, 2*time.Second, rows[1].offset)
+	assert.Equal(t, "  ########", rows[1].bar)
+}
+
+func TestTimelineRows_NoSteps(t *testing.T) {
+	assert.Empty(t, timelineRows(nil, 10))
+}
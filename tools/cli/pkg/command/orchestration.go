@@ -8,7 +8,9 @@ import (
 	"text/template"
 
 	"github.com/pkg/errors"
+	"golang.org/x/oauth2"
 
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/events"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
 	"github.com/kyma-project/control-plane/tools/cli/pkg/logger"
 	"github.com/kyma-project/control-plane/tools/cli/pkg/printer"
@@ -17,6 +19,8 @@ import (
 
 const (
 	cancelCommand     = "cancel"
+	pauseCommand      = "pause"
+	resumeCommand     = "resume"
 	retryCommand      = "retry"
 	operationsCommand = "operations"
 	opsCommand        = "ops"
@@ -32,6 +36,7 @@ type OrchestrationCommand struct {
 	operations []string
 	subCommand string
 	now        bool
+	watch      bool
 	listParams orchestration.ListParameters
 }
 
@@ -43,6 +48,7 @@ var cliStates = map[string]string{
 	"canceled":   orchestration.Canceled,
 	"canceling":  orchestration.Canceling,
 	"retrying":   orchestration.Retrying,
+	"paused":     orchestration.Paused,
 }
 
 var orchestrationColumns = []printer.Column{
@@ -192,6 +198,12 @@ State:              {{.State}}
 Description:        {{.Description}}
 Kubernetes Version: {{with .ClusterConfig}}{{.KubernetesVersion}}{{end}}
 Kyma Version:       {{with .KymaConfig}}{{.Version}}{{end}}
+{{- if .TaskResults }}
+Task Results:
+{{- range .TaskResults }}
+  {{.Action}} {{.Kind}} {{if .Namespace}}{{.Namespace}}/{{end}}{{.Name}}{{if .Error}}: {{.Error}}{{end}}
+{{- end }}
+{{- end }}
 {{end}}
 `
 
@@ -206,7 +218,7 @@ Message:            {{ .Msg }}
 func NewOrchestrationCmd() *cobra.Command {
 	cmd := OrchestrationCommand{}
 	cobraCmd := &cobra.Command{
-		Use:     "orchestrations [id] [ops|operations] [cancel] [retry]",
+		Use:     "orchestrations [id] [ops|operations] [cancel] [pause] [resume] [retry]",
 		Aliases: []string{"orchestration", "o"},
 		Short:   "Displays Kyma Control Plane (KCP) orchestrations.",
 		Long: `Displays KCP orchestrations and their primary attributes, such as identifiers, type, state, parameters, or Runtime operations.
@@ -214,8 +226,11 @@ The command has the following modes:
   - Without specifying an orchestration ID as an argument. In this mode, the command lists all orchestrations, or orchestrations matching the --state option, if provided.
   - When specifying an orchestration ID as an argument. In this mode, the command displays details about the specific orchestration.
       If the optional --operation flag is provided, it displays details of the specified Runtime operation within the orchestration.
+      If the optional --watch flag is provided, it watches progress of the orchestration's Runtime operations until interrupted.
   - When specifying an orchestration ID and ` + "`operations` or `ops`" + ` as arguments. In this mode, the command displays the Runtime operations for the given orchestration.
   - When specifying an orchestration ID and ` + "`cancel`" + ` as arguments. In this mode, the command cancels the orchestration and all pending Runtime operations.
+  - When specifying an orchestration ID and ` + "`pause`" + ` as arguments. In this mode, the command pauses the orchestration in progress. No new Runtime operations are started, operations in progress are completed.
+  - When specifying an orchestration ID and ` + "`resume`" + ` as arguments. In this mode, the command resumes the paused orchestration.
   - When specifying an orchestration ID and ` + "`retry`" + ` as arguments. In this mode, the command retries all failed Runtime operations of the given orchestration. The ` + "`retry` " + `command only applies to the failed or in progress orchestration.
       If the optional --operation flag is provided, it retries the specified Runtime operation of the given orchestration.`,
 		Example: `  kcp orchestrations --state inprogress                                              Display all orchestrations which are in progress.
@@ -223,8 +238,11 @@ The command has the following modes:
                                                                                      Display all orchestations with specific custom fields.
   kcp orchestration 0c4357f5-83e0-4b72-9472-49b5cd417c00                             Display details about a specific orchestration.
   kcp orchestration 0c4357f5-83e0-4b72-9472-49b5cd417c00 --operation OID1,OID2       Display details of the specified Runtime operation within the orchestration.
+  kcp orchestration 0c4357f5-83e0-4b72-9472-49b5cd417c00 --watch                     Display details about a specific orchestration and watch progress of its operations.
   kcp orchestration 0c4357f5-83e0-4b72-9472-49b5cd417c00 operations                  Display the operations of the given orchestration.
   kcp orchestration 0c4357f5-83e0-4b72-9472-49b5cd417c00 cancel                      Cancel the given orchestration.
+  kcp orchestration 0c4357f5-83e0-4b72-9472-49b5cd417c00 pause                       Pause the given orchestration.
+  kcp orchestration 0c4357f5-83e0-4b72-9472-49b5cd417c00 resume                      Resume the given paused orchestration.
   kcp orchestration 0c4357f5-83e0-4b72-9472-49b5cd417c00 retry                       Retry all failed operations of the given orchestration.
   kcp orchestration 0c4357f5-83e0-4b72-9472-49b5cd417c00 retry --operation OID1,OID2 Retry the given operations of the given orchestration
   kcp orchestration 0c4357f5-83e0-4b72-9472-49b5cd417c00 retry --now --operation OID1 Retry the given operations of the given orchestration schedule immediately`,
@@ -238,6 +256,7 @@ The command has the following modes:
 	cobraCmd.Flags().StringSliceVarP(&cmd.states, "state", "s", nil, fmt.Sprintf("Filter output by state. You can provide multiple values, either separated by a comma (e.g. failed,inprogress), or by specifying the option multiple times. The possible values are: %s.", strings.Join(cliOrchestrationStates(), ", ")))
 	cobraCmd.Flags().StringSliceVar(&cmd.operations, "operation", nil, "Option that displays details of the specified Runtime operation when a given orchestration is selected.")
 	cobraCmd.Flags().BoolVarP(&cmd.now, "now", "n", false, "retry failed operations with schedule immediate.")
+	cobraCmd.Flags().BoolVarP(&cmd.watch, "watch", "w", false, "Option that watches progress of the Runtime operations when a given orchestration is selected.")
 	return cobraCmd
 }
 
@@ -285,7 +304,12 @@ func (cmd *OrchestrationCommand) Run(args []string) error {
 	case 1:
 		// Called with orchestration ID but without subcommand
 		if len(cmd.operations) == 0 {
-			return cmd.showOneOrchestration(args[0])
+			err := cmd.showOneOrchestration(args[0])
+			if err != nil || !cmd.watch {
+				return err
+			}
+			httpClient := oauth2.NewClient(cmd.cobraCmd.Context(), CLICredentialManager(cmd.log))
+			return watchProgress(cmd.cobraCmd.Context(), httpClient, events.StreamFilter{OrchestrationIDs: []string{args[0]}})
 		}
 		return cmd.showOperationsDetails(args[0])
 	case 2:
@@ -293,6 +317,10 @@ func (cmd *OrchestrationCommand) Run(args []string) error {
 		switch cmd.subCommand {
 		case cancelCommand:
 			return cmd.cancelOrchestration(args[0])
+		case pauseCommand:
+			return cmd.pauseOrchestration(args[0])
+		case resumeCommand:
+			return cmd.resumeOrchestration(args[0])
 		case retryCommand:
 			return cmd.retryOrchestration(args[0])
 		case operationsCommand, opsCommand:
@@ -318,6 +346,9 @@ func (cmd *OrchestrationCommand) Validate(args []string) error {
 	if len(cmd.operations) != 0 && len(args) == 0 {
 		return errors.New("--operation should only be used when orchestration id is given as an argument")
 	}
+	if cmd.watch && (len(args) != 1 || len(cmd.operations) != 0) {
+		return errors.New("--watch should only be used when only orchestration id is given as an argument")
+	}
 	if len(cmd.operations) != 0 && len(cmd.states) > 0 {
 		return errors.New("--state should not be used together with --operation")
 	}
@@ -325,7 +356,7 @@ func (cmd *OrchestrationCommand) Validate(args []string) error {
 	if len(args) == 2 {
 		cmd.subCommand = args[1]
 		switch cmd.subCommand {
-		case cancelCommand, retryCommand, operationsCommand, opsCommand:
+		case cancelCommand, pauseCommand, resumeCommand, retryCommand, operationsCommand, opsCommand:
 		default:
 			return fmt.Errorf("invalid subcommand: %s", cmd.subCommand)
 		}
@@ -471,6 +502,40 @@ func (cmd *OrchestrationCommand) cancelOrchestration(orchestrationID string) err
 
 }
 
+func (cmd *OrchestrationCommand) pauseOrchestration(orchestrationID string) error {
+	sr, err := cmd.client.GetOrchestration(orchestrationID)
+	if err != nil {
+		return errors.Wrap(err, "while getting orchestration")
+	}
+	switch sr.State {
+	case orchestration.Paused:
+		fmt.Println("Orchestration is already paused.")
+		return nil
+	case orchestration.InProgress:
+	default:
+		return fmt.Errorf("orchestration is %s, only orchestrations in progress can be paused", sr.State)
+	}
+
+	if !PromptUser(fmt.Sprintf("%d pending operation(s) will not be started until the orchestration is resumed, %d in progress operation(s) will still be completed. \n Do you want to pause?", sr.OperationStats[orchestration.Pending]+sr.OperationStats[orchestration.Retrying], sr.OperationStats[orchestration.InProgress])) {
+		fmt.Println("pause is not run.")
+		return nil
+	}
+
+	return cmd.client.PauseOrchestration(orchestrationID)
+}
+
+func (cmd *OrchestrationCommand) resumeOrchestration(orchestrationID string) error {
+	sr, err := cmd.client.GetOrchestration(orchestrationID)
+	if err != nil {
+		return errors.Wrap(err, "while getting orchestration")
+	}
+	if sr.State != orchestration.Paused {
+		return fmt.Errorf("orchestration is %s, only paused orchestrations can be resumed", sr.State)
+	}
+
+	return cmd.client.ResumeOrchestration(orchestrationID)
+}
+
 func (cmd *OrchestrationCommand) retryOrchestration(orchestrationID string) error {
 	sr, err := cmd.client.GetOrchestration(orchestrationID)
 	if err != nil {
@@ -515,6 +580,9 @@ func orchestrationType(obj interface{}) string {
 	if sr.Type == orchestration.UpgradeClusterOrchestration {
 		return "cluster upgrade"
 	}
+	if sr.Type == orchestration.RuntimeTaskOrchestration {
+		return "runtime task"
+	}
 	return string(sr.Type)
 }
 
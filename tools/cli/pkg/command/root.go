@@ -51,6 +51,9 @@ See the **Global Options** section of each command for the description of these
 	cmd.AddCommand(
 		NewLoginCmd(),
 		NewRuntimeCmd(),
+		NewEventsCmd(),
+		NewAuditCmd(),
+		NewAccountsCmd(),
 		NewOrchestrationCmd(),
 		NewKubeconfigCmd(),
 		NewUpgradeCmd(),
@@ -2,6 +2,7 @@ package command
 
 import (
 	"fmt"
+	"net/http"
 	"os"
 	"strings"
 
@@ -24,6 +25,7 @@ type RuntimeCommand struct {
 	params   runtime.ListParameters
 	states   []string
 	opDetail bool
+	watch    bool
 	display  Display
 }
 
@@ -64,6 +66,10 @@ var tableColumns = []printer.Column{
 		Header:         "EU ACCESS",
 		FieldFormatter: euAccess,
 	},
+	{
+		Header:         "NEXT RETRY AT",
+		FieldFormatter: runtimeNextRetryAt,
+	},
 }
 
 // NewRuntimeCmd constructs a new instance of RuntimeCommand and configures it in terms of a cobra.Command
@@ -85,7 +91,8 @@ https://github.com/kyma-project/control-plane/blob/main/docs/kyma-environment-br
   kcp runtimes -c bbc3ee7 -o custom="INSTANCE ID:instanceID,SHOOTNAME:shootName"
                                                          Display the custom fields about one Runtime identified by a Shoot name.
   kcp runtimes -o custom="INSTANCE ID:instanceID,SHOOTNAME:shootName,runtimeID:runtimeID,STATUS:{status.provisioning}"
-                                                         Display all Runtimes with specific custom fields.`,
+                                                         Display all Runtimes with specific custom fields.
+  kcp runtimes -g GAID1 --watch                          Display all Runtimes of a given global account and watch progress of their operations.`,
 		PreRunE: func(_ *cobra.Command, _ []string) error { return cmd.Validate() },
 		RunE:    func(_ *cobra.Command, _ []string) error { return cmd.Run() },
 	}
@@ -107,6 +114,7 @@ https://github.com/kyma-project/control-plane/blob/main/docs/kyma-environment-br
 	cobraCmd.Flags().BoolVar(&cmd.params.Expired, "expired", false, "Lists only expired runtimes.")
 	cobraCmd.Flags().StringVar(&cmd.params.Events, "events", "none", "Enhance output with tracing events. Enables by default --ops. You can provide one value (all, info, error, none) for filtering events or leave it blank to get all events.")
 	cobraCmd.Flags().Lookup("events").NoOptDefVal = "all"
+	cobraCmd.Flags().BoolVarP(&cmd.watch, "watch", "w", false, "After displaying the runtimes, watch progress of their operations until interrupted. Watching is limited to 100 runtimes.")
 
 	return cobraCmd
 }
@@ -145,10 +153,27 @@ func (cmd *RuntimeCommand) Run() error {
 	if eventsSkipped {
 		fmt.Fprintln(os.Stderr, "\nPlease narrow down the instance list by additional filters. fetching events limitted to 100 instances, received", rp.Count)
 	}
+	if cmd.watch {
+		return cmd.watchRuntimes(httpClient, rp)
+	}
 
 	return nil
 }
 
+func (cmd *RuntimeCommand) watchRuntimes(httpClient *http.Client, rp runtime.RuntimesPage) error {
+	if rp.Count == 0 {
+		return nil
+	}
+	if rp.Count > 100 {
+		return fmt.Errorf("watching is limited to 100 runtimes, received %d, please narrow down the runtime list by additional filters", rp.Count)
+	}
+	var instanceIDs []string
+	for _, i := range rp.Data {
+		instanceIDs = append(instanceIDs, i.InstanceID)
+	}
+	return watchProgress(cmd.cobraCmd.Context(), httpClient, events.StreamFilter{InstanceIDs: instanceIDs})
+}
+
 // Validate checks the input parameters of the runtimes command
 func (cmd *RuntimeCommand) Validate() error {
 	err := ValidateOutputOpt(cmd.output)
@@ -246,6 +271,15 @@ func runtimeCreatedAt(obj interface{}) string {
 	return rt.Status.CreatedAt.Format("2006/01/02 15:04:05")
 }
 
+func runtimeNextRetryAt(obj interface{}) string {
+	rt := obj.(runtime.RuntimeDTO)
+	op := rt.LastOperation()
+	if op.NextRetryAt == nil {
+		return ""
+	}
+	return op.NextRetryAt.Format("2006/01/02 15:04:05")
+}
+
 func euAccess(obj interface{}) string {
 	rt := obj.(runtime.RuntimeDTO)
 	subAccountRegion := rt.SubAccountRegion
@@ -8,6 +8,7 @@ import (
 	"math/rand"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"strings"
 	"sync"
 	"time"
@@ -44,6 +45,14 @@ type TaskRunCommand struct {
 	noPrefixOutput      bool
 	taskCommand         *exec.Cmd
 	shell               string
+	stateFile           string
+	resumeFile          string
+	onlyFailed          bool
+	logDir              string
+	reportFile          string
+	reportFormat        string
+	args                []string
+	state               *TaskRunState
 }
 
 // RuntimeLister implements the interface to obtains runtimes info from KEB for resolver
@@ -92,7 +101,11 @@ For each subprocess, the following Runtime-specific data are passed as environme
   - RUNTIME_ID       : Runtime ID of the Runtime
   - INSTANCE_ID      : Instance ID of the Runtime
 
-  If all subprocesses finish successfully with the zero status code, the exit status is zero (0). If one or more subprocesses exit with a non-zero status, the command will also exit with a non-zero status.`,
+  If all subprocesses finish successfully with the zero status code, the exit status is zero (0). If one or more subprocesses exit with a non-zero status, the command will also exit with a non-zero status.
+
+The status, exit code, and output log path of the execution on every Runtime are recorded in the state file after every change.
+The output of every subprocess is written to a log file in the --log-dir directory.
+An interrupted run can be resumed with the --resume option. The resumed run executes the command of the state file on the Runtimes of the state file which did not succeed, or only on the failed ones with the --only-failed option.`,
 		Example: `  kcp taskrun --target all -- kubectl patch deployment valid-deployment -p '{"metadata":{"labels":{"my-label": "my-value"}}}'
     Execute a kubectl patch operation for all Runtimes.
   kcp taskrun --target account=CA4836781TID000000000123456789 /usr/local/bin/awesome-script.sh
@@ -100,8 +113,12 @@ For each subprocess, the following Runtime-specific data are passed as environme
   kcp taskrun --target all -- helm upgrade -i -n kyma-system my-kyma-addon --values overrides.yaml
     Deploy a Helm chart on all Runtimes.
   kcp taskrun -t all -s "/bin/bash -i -c" -- kc get ns
-    Run an alias command (kc for kubectl) defined in user's .bashrc invocation script`,
-		Args:    cobra.MinimumNArgs(1),
+    Run an alias command (kc for kubectl) defined in user's .bashrc invocation script
+  kcp taskrun --target all --state upgrade.json --report upgrade.xml --report-format junit -- ./upgrade.sh
+    Run a script for all Runtimes recording the progress in the upgrade.json file and write a JUnit report.
+  kcp taskrun --resume upgrade.json --only-failed
+    Run the script again on the Runtimes where it failed.`,
+		Args:    cobra.ArbitraryArgs,
 		PreRunE: func(_ *cobra.Command, args []string) error { return cmd.Validate(args) },
 		RunE:    func(_ *cobra.Command, args []string) error { return cmd.Run(args) },
 	}
@@ -115,6 +132,12 @@ For each subprocess, the following Runtime-specific data are passed as environme
 	cobraCmd.Flags().BoolVar(&cmd.noPrefixOutput, "no-prefix-output", false, "Option that omits the prefixing of each output line with the Runtime name. By default, all output lines are prepended for better traceability.")
 	cobraCmd.Flags().StringP("shell", "s", "", "Invoke the task command using the given shell and it's options. Useful when the task command uses alias(es) defined in the shell's invocation scripts. Can also be set in the KCP configuration file or with the KCP_SHELL environment variable.")
 	viper.BindPFlag("shell", cobraCmd.Flags().Lookup("shell"))
+	cobraCmd.Flags().StringVar(&cmd.stateFile, "state", "", "Path to the state file recording the execution on every Runtime. By default, it is the taskrun-{TIMESTAMP}.json file in the current directory, or the file given with --resume.")
+	cobraCmd.Flags().StringVar(&cmd.resumeFile, "resume", "", "Resume the run recorded in the given state file. The command, the shell, and the Runtimes are taken from the state file.")
+	cobraCmd.Flags().BoolVar(&cmd.onlyFailed, "only-failed", false, "Option that resumes the execution only on the Runtimes where it failed. Requires --resume.")
+	cobraCmd.Flags().StringVar(&cmd.logDir, "log-dir", "", "Directory to write the output of every Runtime to. By default, it is the {STATE FILE}.logs directory.")
+	cobraCmd.Flags().StringVar(&cmd.reportFile, "report", "", "Path to the summary report written after the execution.")
+	cobraCmd.Flags().StringVar(&cmd.reportFormat, "report-format", jsonReport, fmt.Sprintf("Format of the summary report. The possible values are: %s, %s.", jsonReport, junitReport))
 	return cobraCmd
 }
 
@@ -124,10 +147,19 @@ func (cmd *TaskRunCommand) Run(args []string) error {
 	cmd.cred = CLICredentialManager(cmd.log)
 	defer cmd.cleanupTempKubeConfigDir()
 
-	operations, err := cmd.resolveOperations()
+	runtimes, err := cmd.runtimes()
 	if err != nil {
 		return err
 	}
+	cmd.log.Infof("Recording the state of the run in %s\n", cmd.stateFile)
+
+	operations := make([]orchestration.RuntimeOperation, 0, len(runtimes))
+	for _, rt := range runtimes {
+		operations = append(operations, orchestration.RuntimeOperation{
+			Runtime: rt,
+			ID:      randomString(16),
+		})
+	}
 
 	mgr := NewRuntimeTaskMakager(cmd, operations)
 	strategy := strategies.NewParallelOrchestrationStrategy(mgr, cmd.log, 0)
@@ -141,6 +173,12 @@ func (cmd *TaskRunCommand) Run(args []string) error {
 	}
 	strategy.Wait(execID)
 
+	if cmd.reportFile != "" {
+		if err := cmd.state.Report().WriteReport(cmd.reportFile, cmd.reportFormat); err != nil {
+			return err
+		}
+	}
+
 	return mgr.exitStatus()
 }
 
@@ -151,16 +189,14 @@ func (cmd *TaskRunCommand) Validate(args []string) error {
 		return fmt.Errorf("missing required %s option", GlobalOpts.kubeconfigAPIURL)
 	}
 
-	// Validate gardener-kubeconfig global option
-	if GlobalOpts.GardenerKubeconfig() == "" || GlobalOpts.GardenerNamespace() == "" {
-		return fmt.Errorf("missing required %s/%s options", GlobalOpts.gardenerKubeconfig, GlobalOpts.gardenerNamespace)
-	}
-
-	// Validate target options
-	err := ValidateTransformRuntimeTargetOpts(cmd.targetInputs, cmd.targetExcludeInputs, &cmd.targets)
+	// Validate state and report options
+	err := cmd.validateState(args)
 	if err != nil {
 		return err
 	}
+	if cmd.reportFormat != jsonReport && cmd.reportFormat != junitReport {
+		return fmt.Errorf("invalid value for report-format: %s", cmd.reportFormat)
+	}
 
 	// Validate kubeconfig directory
 	if cmd.kubeconfigDir != "" {
@@ -179,26 +215,95 @@ func (cmd *TaskRunCommand) Validate(args []string) error {
 		cmd.kubeconfingDirTemp = true
 	}
 
+	// Validate log directory
+	if cmd.logDir == "" {
+		cmd.logDir = strings.TrimSuffix(cmd.stateFile, filepath.Ext(cmd.stateFile)) + ".logs"
+	}
+	if err := os.MkdirAll(cmd.logDir, 0755); err != nil {
+		return errors.Wrap(err, "while creating log directory")
+	}
+
 	// Validate task command and shell wrapper
 	// Construct task command object
-	cmd.shell = viper.GetString("shell")
 	if cmd.shell != "" {
 		splitSh := strings.Split(cmd.shell, " ")
 		if _, err := exec.LookPath(splitSh[0]); err != nil {
 			return err
 		}
-		allArgs := append(splitSh[1:], strings.Join(args, " "))
+		allArgs := append(splitSh[1:], strings.Join(cmd.args, " "))
 		cmd.taskCommand = exec.CommandContext(cmd.cobraCmd.Context(), splitSh[0], allArgs...)
 	} else {
-		if _, err := exec.LookPath(args[0]); err != nil {
+		if _, err := exec.LookPath(cmd.args[0]); err != nil {
+			return err
+		}
+		cmd.taskCommand = exec.CommandContext(cmd.cobraCmd.Context(), cmd.args[0], cmd.args[1:]...)
+	}
+	return nil
+}
+
+// validateState takes the command and the shell from the arguments of a new run, or from the state file of the resumed run
+func (cmd *TaskRunCommand) validateState(args []string) error {
+	cmd.shell = viper.GetString("shell")
+	if cmd.resumeFile == "" {
+		if cmd.onlyFailed {
+			return errors.New("--only-failed requires --resume")
+		}
+		if len(args) == 0 {
+			return errors.New("missing task command")
+		}
+		// Validate gardener-kubeconfig global option
+		if GlobalOpts.GardenerKubeconfig() == "" || GlobalOpts.GardenerNamespace() == "" {
+			return fmt.Errorf("missing required %s/%s options", GlobalOpts.gardenerKubeconfig, GlobalOpts.gardenerNamespace)
+		}
+		// Validate target options
+		err := ValidateTransformRuntimeTargetOpts(cmd.targetInputs, cmd.targetExcludeInputs, &cmd.targets)
+		if err != nil {
 			return err
 		}
-		cmd.taskCommand = exec.CommandContext(cmd.cobraCmd.Context(), args[0], args[1:]...)
+		if cmd.stateFile == "" {
+			cmd.stateFile = fmt.Sprintf("taskrun-%s.json", time.Now().Format("20060102-150405"))
+		}
+		cmd.args = args
+		return nil
 	}
+
+	if len(args) > 0 || len(cmd.targetInputs) > 0 || len(cmd.targetExcludeInputs) > 0 {
+		return errors.New("the command and the targets of a resumed run are taken from the state file")
+	}
+	state, err := LoadTaskRunState(cmd.resumeFile)
+	if err != nil {
+		return err
+	}
+	if cmd.stateFile == "" {
+		cmd.stateFile = cmd.resumeFile
+	}
+	state.path = cmd.stateFile
+	if cmd.shell == "" {
+		cmd.shell = state.Shell
+	}
+	cmd.args = state.Command
+	cmd.targets = state.Targets
+	cmd.state = state
 	return nil
 }
 
-func (cmd *TaskRunCommand) resolveOperations() ([]orchestration.RuntimeOperation, error) {
+// runtimes returns the runtimes of the run, the pending runtimes of the state file are returned for the resumed run
+func (cmd *TaskRunCommand) runtimes() ([]orchestration.Runtime, error) {
+	if cmd.state != nil {
+		runtimes := cmd.state.Pending(cmd.onlyFailed)
+		cmd.log.Infof("Number of runtimes to resume: %d/%d\n", len(runtimes), len(cmd.state.Runtimes))
+		return runtimes, cmd.state.Save()
+	}
+
+	runtimes, err := cmd.resolveRuntimes()
+	if err != nil {
+		return nil, err
+	}
+	cmd.state = NewTaskRunState(cmd.stateFile, cmd.args, cmd.shell, cmd.targets, runtimes)
+	return runtimes, cmd.state.Save()
+}
+
+func (cmd *TaskRunCommand) resolveRuntimes() ([]orchestration.Runtime, error) {
 	gardenCfg, err := gardener.NewGardenerClusterConfig(GlobalOpts.GardenerKubeconfig())
 	if err != nil {
 		return nil, errors.Wrap(err, "while getting Gardener kubeconfig")
@@ -217,15 +322,8 @@ func (cmd *TaskRunCommand) resolveOperations() ([]orchestration.RuntimeOperation
 	}
 
 	cmd.log.Infof("Number of resolved runtimes: %d\n", len(runtimes))
-	operations := make([]orchestration.RuntimeOperation, 0, len(runtimes))
-	for _, rt := range runtimes {
-		operations = append(operations, orchestration.RuntimeOperation{
-			Runtime: rt,
-			ID:      randomString(16),
-		})
-	}
 
-	return operations, nil
+	return runtimes, nil
 }
 
 func (cmd *TaskRunCommand) cleanupTempKubeConfigDir() error {
@@ -268,18 +366,41 @@ func NewRuntimeTaskMakager(cmd *TaskRunCommand, operations []orchestration.Runti
 	return mgr
 }
 
-// Execute runs the task on the runtime identified by the operationID
+// Execute runs the task on the runtime identified by the operationID and records the result in the state of the run
 func (mgr *RuntimeTaskMakager) Execute(operationID string) (time.Duration, error) {
 	task := mgr.tasks[operationID]
 	log := mgr.cmd.log.WithField("shoot", task.operation.ShootName)
 
+	logPath := filepath.Join(mgr.cmd.logDir, fmt.Sprintf("%s.log", task.operation.ShootName))
+	if err := mgr.cmd.state.Started(task.operation.RuntimeID, logPath); err != nil {
+		log.Errorf("Error: while recording state: %s\n", err.Error())
+	}
+	exitCode, err := mgr.execute(task, logPath)
+	task.result = err
+	if err := mgr.cmd.state.Finished(task.operation.RuntimeID, exitCode, task.result); err != nil {
+		log.Errorf("Error: while recording state: %s\n", err.Error())
+	}
+
+	return 0, task.result
+}
+
+// execute runs the task command and returns its exit code, or nil if the command was not executed
+func (mgr *RuntimeTaskMakager) execute(task *RuntimeTask, logPath string) (*int, error) {
+	log := mgr.cmd.log.WithField("shoot", task.operation.ShootName)
+
 	kubeconfigPath, err := mgr.getKubeconfig(task)
 	if err != nil {
 		log.Errorf("Error: while getting kubeconfig: %s\n", err.Error())
-		task.result = err
-		return 0, err
+		return nil, err
 	}
 
+	logFile, err := os.Create(logPath)
+	if err != nil {
+		log.Errorf("Error: while creating output log: %s\n", err.Error())
+		return nil, err
+	}
+	defer logFile.Close()
+
 	command := *mgr.cmd.taskCommand
 
 	// Prepare environment variables
@@ -299,14 +420,12 @@ func (mgr *RuntimeTaskMakager) Execute(operationID string) (time.Duration, error
 	stdout, err := command.StdoutPipe()
 	if err != nil {
 		log.Errorf("Error: while creating stdout: %s\n", err.Error())
-		task.result = err
-		return 0, err
+		return nil, err
 	}
 	stderr, err := command.StderrPipe()
 	if err != nil {
 		log.Errorf("Error: while creating stderr: %s\n", err.Error())
-		task.result = err
-		return 0, err
+		return nil, err
 	}
 
 	// Prepare echoer stdout / stderr writers
@@ -318,6 +437,7 @@ func (mgr *RuntimeTaskMakager) Execute(operationID string) (time.Duration, error
 				fmt.Fprintf(dst, "%s ", task.operation.ShootName)
 			}
 			fmt.Fprintln(dst, scanner.Text())
+			fmt.Fprintln(logFile, scanner.Text())
 		}
 		if err := scanner.Err(); err != nil {
 			log.Errorf("Error: while reading from child process: %s\n", err)
@@ -332,6 +452,8 @@ func (mgr *RuntimeTaskMakager) Execute(operationID string) (time.Duration, error
 	err = command.Start()
 	if err != nil {
 		log.Errorf("Error: command started with error: %s\n", err.Error())
+		echoerWg.Wait()
+		return nil, err
 	}
 	// Wait for the command subprocess to finish
 	echoerWg.Wait()
@@ -339,9 +461,9 @@ func (mgr *RuntimeTaskMakager) Execute(operationID string) (time.Duration, error
 	if err != nil {
 		log.Errorf("Error: command exited with error: %s\n", err.Error())
 	}
-	task.result = err
+	exitCode := command.ProcessState.ExitCode()
 
-	return 0, err
+	return &exitCode, err
 }
 
 func (mgr *RuntimeTaskMakager) Reschedule(operationID string, maintenanceWindowBegin, maintenanceWindowEnd time.Time) error {
@@ -0,0 +1,291 @@
+package command
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/pkg/errors"
+)
+
+// TaskStatus is the status of the task execution on one runtime
+type TaskStatus string
+
+const (
+	TaskPending   TaskStatus = "pending"
+	TaskRunning   TaskStatus = "running"
+	TaskSucceeded TaskStatus = "succeeded"
+	TaskFailed    TaskStatus = "failed"
+)
+
+const (
+	jsonReport  = "json"
+	junitReport = "junit"
+)
+
+// RuntimeTaskState is the recorded execution of the task on one runtime
+type RuntimeTaskState struct {
+	orchestration.Runtime
+	Status     TaskStatus `json:"status"`
+	ExitCode   *int       `json:"exitCode,omitempty"`
+	Error      string     `json:"error,omitempty"`
+	LogPath    string     `json:"logPath,omitempty"`
+	Attempts   int        `json:"attempts"`
+	StartedAt  *time.Time `json:"startedAt,omitempty"`
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+}
+
+// TaskRunState is the state of a taskrun stored in the state file after every change,
+// so an interrupted run can be resumed with the same command on the runtimes which did not succeed
+type TaskRunState struct {
+	Command   []string                     `json:"command"`
+	Shell     string                       `json:"shell,omitempty"`
+	Targets   orchestration.TargetSpec     `json:"targets"`
+	StartedAt time.Time                    `json:"startedAt"`
+	UpdatedAt time.Time                    `json:"updatedAt"`
+	Runtimes  map[string]*RuntimeTaskState `json:"runtimes"`
+
+	mu   sync.Mutex
+	path string
+}
+
+// NewTaskRunState constructs the state of a new taskrun of the command on the runtimes stored in the given path
+func NewTaskRunState(path string, command []string, shell string, targets orchestration.TargetSpec, runtimes []orchestration.Runtime) *TaskRunState {
+	state := &TaskRunState{
+		Command:   command,
+		Shell:     shell,
+		Targets:   targets,
+		StartedAt: time.Now().UTC(),
+		Runtimes:  make(map[string]*RuntimeTaskState, len(runtimes)),
+		path:      path,
+	}
+	for _, rt := range runtimes {
+		state.Runtimes[rt.RuntimeID] = &RuntimeTaskState{Runtime: rt, Status: TaskPending}
+	}
+	return state
+}
+
+// LoadTaskRunState reads the state of a previous taskrun, further changes are stored in the same path
+func LoadTaskRunState(path string) (*TaskRunState, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "while reading taskrun state")
+	}
+	state := &TaskRunState{}
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, errors.Wrapf(err, "while parsing taskrun state %s", path)
+	}
+	if len(state.Command) == 0 {
+		return nil, fmt.Errorf("taskrun state %s contains no command", path)
+	}
+	state.path = path
+	return state, nil
+}
+
+// Pending returns the runtimes to run the task on when the taskrun is resumed, ordered by the shoot name.
+// All runtimes without successful execution are returned, or only the failed ones if onlyFailed is set.
+func (s *TaskRunState) Pending(onlyFailed bool) []orchestration.Runtime {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	runtimes := make([]orchestration.Runtime, 0)
+	for _, rt := range s.Runtimes {
+		switch {
+		case rt.Status == TaskSucceeded:
+		case onlyFailed && rt.Status != TaskFailed:
+		default:
+			runtimes = append(runtimes, rt.Runtime)
+		}
+	}
+	sort.Slice(runtimes, func(i, j int) bool { return runtimes[i].ShootName < runtimes[j].ShootName })
+	return runtimes
+}
+
+// Started records the start of the execution on the runtime
+func (s *TaskRunState) Started(runtimeID, logPath string) error {
+	return s.update(runtimeID, func(rt *RuntimeTaskState) {
+		now := time.Now().UTC()
+		rt.Status = TaskRunning
+		rt.LogPath = logPath
+		rt.Attempts++
+		rt.StartedAt = &now
+		rt.FinishedAt = nil
+		rt.ExitCode = nil
+		rt.Error = ""
+	})
+}
+
+// Finished records the result of the execution on the runtime, exitCode is nil if the command was not executed
+func (s *TaskRunState) Finished(runtimeID string, exitCode *int, result error) error {
+	return s.update(runtimeID, func(rt *RuntimeTaskState) {
+		now := time.Now().UTC()
+		rt.FinishedAt = &now
+		rt.ExitCode = exitCode
+		rt.Status = TaskSucceeded
+		if result != nil {
+			rt.Status = TaskFailed
+			rt.Error = result.Error()
+		}
+	})
+}
+
+func (s *TaskRunState) update(runtimeID string, change func(rt *RuntimeTaskState)) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rt, found := s.Runtimes[runtimeID]
+	if !found {
+		return fmt.Errorf("runtime %s is not part of the taskrun", runtimeID)
+	}
+	change(rt)
+	return s.save()
+}
+
+// Save stores the state in the state file
+func (s *TaskRunState) Save() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.save()
+}
+
+// save replaces the state file with a new one, so the file is never left partially written
+func (s *TaskRunState) save() error {
+	s.UpdatedAt = time.Now().UTC()
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "while encoding taskrun state")
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(s.path), filepath.Base(s.path)+".tmp-")
+	if err != nil {
+		return errors.Wrap(err, "while creating taskrun state")
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return errors.Wrap(err, "while writing taskrun state")
+	}
+	if err := tmp.Close(); err != nil {
+		return errors.Wrap(err, "while writing taskrun state")
+	}
+	return errors.Wrap(os.Rename(tmp.Name(), s.path), "while replacing taskrun state")
+}
+
+// TaskRunReport is the summary of a taskrun
+type TaskRunReport struct {
+	Command   []string           `json:"command"`
+	StartedAt time.Time          `json:"startedAt"`
+	UpdatedAt time.Time          `json:"updatedAt"`
+	Total     int                `json:"total"`
+	Succeeded int                `json:"succeeded"`
+	Failed    int                `json:"failed"`
+	Pending   int                `json:"pending"`
+	Runtimes  []RuntimeTaskState `json:"runtimes"`
+}
+
+// Report returns the summary of the taskrun with the runtimes ordered by the shoot name
+func (s *TaskRunState) Report() TaskRunReport {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	report := TaskRunReport{
+		Command:   s.Command,
+		StartedAt: s.StartedAt,
+		UpdatedAt: s.UpdatedAt,
+		Total:     len(s.Runtimes),
+		Runtimes:  make([]RuntimeTaskState, 0, len(s.Runtimes)),
+	}
+	for _, rt := range s.Runtimes {
+		switch rt.Status {
+		case TaskSucceeded:
+			report.Succeeded++
+		case TaskFailed:
+			report.Failed++
+		default:
+			report.Pending++
+		}
+		report.Runtimes = append(report.Runtimes, *rt)
+	}
+	sort.Slice(report.Runtimes, func(i, j int) bool { return report.Runtimes[i].ShootName < report.Runtimes[j].ShootName })
+	return report
+}
+
+type junitTestSuite struct {
+	XMLName  xml.Name        `xml:"testsuite"`
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Skipped  int             `xml:"skipped,attr"`
+	Time     float64         `xml:"time,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      float64       `xml:"time,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Skipped   *junitMessage `xml:"skipped,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+}
+
+// WriteReport writes the summary of the taskrun in the JSON or JUnit XML format to the given path
+func (r TaskRunReport) WriteReport(path, format string) error {
+	var data []byte
+	var err error
+	switch format {
+	case jsonReport:
+		data, err = json.MarshalIndent(r, "", "  ")
+	case junitReport:
+		data, err = xml.MarshalIndent(r.junit(), "", "  ")
+		data = append([]byte(xml.Header), data...)
+	default:
+		return fmt.Errorf("unknown report format %s", format)
+	}
+	if err != nil {
+		return errors.Wrap(err, "while encoding taskrun report")
+	}
+	return errors.Wrap(ioutil.WriteFile(path, data, 0644), "while writing taskrun report")
+}
+
+func (r TaskRunReport) junit() junitTestSuite {
+	suite := junitTestSuite{
+		Name:     "kcp taskrun",
+		Tests:    r.Total,
+		Failures: r.Failed,
+		Skipped:  r.Pending,
+		Time:     r.UpdatedAt.Sub(r.StartedAt).Seconds(),
+	}
+	for _, rt := range r.Runtimes {
+		tc := junitTestCase{
+			Name:      rt.ShootName,
+			ClassName: rt.GlobalAccountID,
+		}
+		if rt.StartedAt != nil && rt.FinishedAt != nil {
+			tc.Time = rt.FinishedAt.Sub(*rt.StartedAt).Seconds()
+		}
+		if rt.LogPath != "" {
+			tc.SystemOut = fmt.Sprintf("runtime %s, output in %s", rt.RuntimeID, rt.LogPath)
+		}
+		switch rt.Status {
+		case TaskSucceeded:
+		case TaskFailed:
+			tc.Failure = &junitMessage{Message: rt.Error}
+		default:
+			tc.Skipped = &junitMessage{Message: fmt.Sprintf("task %s", rt.Status)}
+		}
+		suite.Cases = append(suite.Cases, tc)
+	}
+	return suite
+}
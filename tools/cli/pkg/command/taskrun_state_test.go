@@ -0,0 +1,114 @@
+package command
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskRunState_Resume(t *testing.T) {
+	// given
+	path := filepath.Join(t.TempDir(), "taskrun.json")
+	state := NewTaskRunState(path, []string{"kubectl", "get", "ns"}, "", orchestration.TargetSpec{}, []orchestration.Runtime{
+		{RuntimeID: "rt-1", ShootName: "c-1"},
+		{RuntimeID: "rt-2", ShootName: "c-2"},
+		{RuntimeID: "rt-3", ShootName: "c-3"},
+		{RuntimeID: "rt-4", ShootName: "c-4"},
+	})
+	require.NoError(t, state.Save())
+	success, failure := 0, 1
+
+	require.NoError(t, state.Started("rt-1", "logs/c-1.log"))
+	require.NoError(t, state.Finished("rt-1", &success, nil))
+	require.NoError(t, state.Started("rt-2", "logs/c-2.log"))
+	require.NoError(t, state.Finished("rt-2", &failure, errors.New("exit status 1")))
+	require.NoError(t, state.Started("rt-3", "logs/c-3.log"))
+	assert.Error(t, state.Started("rt-5", "logs/c-5.log"))
+
+	// when
+	resumed, err := LoadTaskRunState(path)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []string{"kubectl", "get", "ns"}, resumed.Command)
+	assert.Equal(t, TaskFailed, resumed.Runtimes["rt-2"].Status)
+	assert.Equal(t, 1, *resumed.Runtimes["rt-2"].ExitCode)
+	assert.Equal(t, "logs/c-2.log", resumed.Runtimes["rt-2"].LogPath)
+	assert.Equal(t, []string{"rt-2", "rt-3", "rt-4"}, runtimeIDs(resumed.Pending(false)))
+	assert.Equal(t, []string{"rt-2"}, runtimeIDs(resumed.Pending(true)))
+
+	// when
+	require.NoError(t, resumed.Started("rt-2", "logs/c-2.log"))
+	require.NoError(t, resumed.Finished("rt-2", &success, nil))
+
+	// then
+	assert.Equal(t, 2, resumed.Runtimes["rt-2"].Attempts)
+	assert.Empty(t, resumed.Runtimes["rt-2"].Error)
+	assert.Empty(t, resumed.Pending(true))
+}
+
+func TestTaskRunReport(t *testing.T) {
+	// given
+	dir := t.TempDir()
+	state := NewTaskRunState(filepath.Join(dir, "taskrun.json"), []string{"./script.sh"}, "", orchestration.TargetSpec{}, []orchestration.Runtime{
+		{RuntimeID: "rt-1", ShootName: "c-1", GlobalAccountID: "ga-1"},
+		{RuntimeID: "rt-2", ShootName: "c-2", GlobalAccountID: "ga-1"},
+		{RuntimeID: "rt-3", ShootName: "c-3", GlobalAccountID: "ga-2"},
+	})
+	success, failure := 0, 2
+	require.NoError(t, state.Started("rt-1", "logs/c-1.log"))
+	require.NoError(t, state.Finished("rt-1", &success, nil))
+	require.NoError(t, state.Started("rt-2", "logs/c-2.log"))
+	require.NoError(t, state.Finished("rt-2", &failure, errors.New("exit status 2")))
+	report := state.Report()
+
+	t.Run("should summarize the run", func(t *testing.T) {
+		assert.Equal(t, 3, report.Total)
+		assert.Equal(t, 1, report.Succeeded)
+		assert.Equal(t, 1, report.Failed)
+		assert.Equal(t, 1, report.Pending)
+	})
+
+	t.Run("should write JSON report", func(t *testing.T) {
+		// when
+		path := filepath.Join(dir, "report.json")
+		require.NoError(t, report.WriteReport(path, jsonReport))
+
+		// then
+		data, err := ioutil.ReadFile(path)
+		require.NoError(t, err)
+		written := TaskRunReport{}
+		require.NoError(t, json.Unmarshal(data, &written))
+		assert.Equal(t, 1, written.Failed)
+		assert.Equal(t, "c-2", written.Runtimes[1].ShootName)
+	})
+
+	t.Run("should write JUnit report", func(t *testing.T) {
+		// when
+		path := filepath.Join(dir, "report.xml")
+		require.NoError(t, report.WriteReport(path, junitReport))
+
+		// then
+		data, err := ioutil.ReadFile(path)
+		require.NoError(t, err)
+		xml := string(data)
+		assert.Contains(t, xml, `<testsuite name="kcp taskrun" tests="3" failures="1" skipped="1"`)
+		assert.Contains(t, xml, `<failure message="exit status 2"></failure>`)
+		assert.Equal(t, 3, strings.Count(xml, "<testcase "))
+	})
+}
+
+func runtimeIDs(runtimes []orchestration.Runtime) []string {
+	ids := make([]string, 0, len(runtimes))
+	for _, rt := range runtimes {
+		ids = append(ids, rt.RuntimeID)
+	}
+	return ids
+}
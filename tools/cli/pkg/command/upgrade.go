@@ -19,6 +19,7 @@ type UpgradeCommand struct {
 	schedule            string
 	maintenancewindow   bool
 	notification        bool
+	canarySoakTime      time.Duration
 	orchestrationParams orchestration.Parameters
 }
 
@@ -46,8 +47,11 @@ func NewUpgradeCmd() *cobra.Command {
 // SetUpgradeOpts configures the upgrade specific options on the given command
 func (cmd *UpgradeCommand) SetUpgradeOpts(cobraCmd *cobra.Command) {
 	SetRuntimeTargetOpts(cobraCmd, &cmd.targetInputs, &cmd.targetExcludeInputs)
-	cobraCmd.Flags().StringVar(&cmd.strategy, "strategy", string(orchestration.ParallelStrategy), "Orchestration strategy to use.")
+	cobraCmd.Flags().StringVar(&cmd.strategy, "strategy", string(orchestration.ParallelStrategy), "Orchestration strategy to use. Possible values: \"parallel\", \"canary\".")
 	cobraCmd.Flags().IntVar(&cmd.orchestrationParams.Strategy.Parallel.Workers, "parallel-workers", 1, "Number of parallel workers to use in parallel orchestration strategy. By default the amount of workers will be auto-selected on control plane server side.")
+	cobraCmd.Flags().StringSliceVar(&cmd.orchestrationParams.Strategy.Canary.Waves, "canary-waves", nil, "Sizes of consecutive waves in the canary orchestration strategy, as a number of Runtimes (5) or a percentage of all Runtimes (10%). Runtimes not covered by the waves are upgraded in the last wave.")
+	cobraCmd.Flags().DurationVar(&cmd.canarySoakTime, "canary-soak-time", 0, "Time to wait after a wave is finished before the next wave starts in the canary orchestration strategy, for example 30m.")
+	cobraCmd.Flags().Float64Var(&cmd.orchestrationParams.Strategy.Canary.FailureThreshold, "canary-failure-threshold", 0, "Highest ratio (0-1) of failed operations in a wave of the canary orchestration strategy which does not halt the orchestration.")
 	cobraCmd.Flags().BoolVarP(&cmd.maintenancewindow, "maintenancewindow", "", false, "Schedule the upgrade in the next possible maintenancewindow after 'schedule'. (default: false)")
 	cobraCmd.Flags().StringVar(&cmd.schedule, "schedule", "now", "Orchestration schedule to use. Possible values: \"immediate\", \"now\" or a date (2006-01-01) . By default the schedule will be auto-selected on control plane server side.")
 	cobraCmd.Flags().BoolVarP(&cmd.notification, "notification", "", false, "Schedule the upgrade with customer notification enabled. (default: false)")
@@ -93,6 +97,17 @@ func (cmd *UpgradeCommand) ValidateTransformUpgradeOpts() error {
 	switch cmd.strategy {
 	case string(orchestration.ParallelStrategy):
 		cmd.orchestrationParams.Strategy.Type = orchestration.StrategyType(cmd.strategy)
+	case string(orchestration.CanaryStrategy):
+		if len(cmd.orchestrationParams.Strategy.Canary.Waves) == 0 {
+			return fmt.Errorf("the canary strategy requires the --canary-waves option")
+		}
+		if cmd.orchestrationParams.Strategy.Canary.FailureThreshold < 0 || cmd.orchestrationParams.Strategy.Canary.FailureThreshold > 1 {
+			return fmt.Errorf("invalid value for canary-failure-threshold: %v, must be between 0 and 1", cmd.orchestrationParams.Strategy.Canary.FailureThreshold)
+		}
+		if cmd.canarySoakTime > 0 {
+			cmd.orchestrationParams.Strategy.Canary.SoakTime = cmd.canarySoakTime.String()
+		}
+		cmd.orchestrationParams.Strategy.Type = orchestration.StrategyType(cmd.strategy)
 	default:
 		return fmt.Errorf("invalid value for strategy: %s", cmd.strategy)
 	}
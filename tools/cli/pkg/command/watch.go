@@ -0,0 +1,48 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/events"
+	"github.com/pkg/errors"
+)
+
+const watchTimeFormat = "2006/01/02 15:04:05"
+
+// watchProgress streams progress of operations matching the filter from the KEB event stream until interrupted
+func watchProgress(ctx context.Context, httpClient *http.Client, filter events.StreamFilter) error {
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt)
+	defer stop()
+
+	fmt.Fprintln(os.Stderr, "Watching progress, press Ctrl+C to stop")
+	client := events.NewClient(GlobalOpts.KEBAPIURL(), httpClient)
+	err := client.StreamEvents(ctx, filter, printStreamEvent)
+	if err != nil && ctx.Err() == nil {
+		return errors.Wrap(err, "while watching progress")
+	}
+	return nil
+}
+
+func printStreamEvent(ev events.StreamEvent) error {
+	switch {
+	case ev.Progress != nil:
+		p := ev.Progress
+		line := fmt.Sprintf("%s  %s  %-11s %-40s %s", p.CreatedAt.Format(watchTimeFormat), p.OperationID, p.State, p.StepName, p.Description)
+		if p.Error != "" {
+			line = fmt.Sprintf("%s: %s", line, p.Error)
+		}
+		fmt.Println(line)
+	case ev.Event != nil:
+		e := ev.Event
+		operationID := ""
+		if e.OperationID != nil {
+			operationID = *e.OperationID
+		}
+		fmt.Printf("%s  %s  %-11s %s\n", e.CreatedAt.Format(watchTimeFormat), operationID, e.Level, e.Message)
+	}
+	return nil
+}